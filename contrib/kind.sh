@@ -859,6 +859,7 @@ create_ovn_kube_manifests() {
     --egress-ip-healthcheck-port="${OVN_EGRESSIP_HEALTHCHECK_PORT}" \
     --egress-firewall-enable=true \
     --egress-qos-enable=true \
+    --network-qos-enable=true \
     --egress-service-enable=true \
     --v4-join-subnet="${JOIN_SUBNET_IPV4}" \
     --v6-join-subnet="${JOIN_SUBNET_IPV6}" \
@@ -962,7 +963,11 @@ install_ovn() {
   run_kubectl apply -f k8s.ovn.org_adminpolicybasedexternalroutes.yaml
   run_kubectl apply -f k8s.ovn.org_userdefinednetworks.yaml
   run_kubectl apply -f k8s.ovn.org_clusteruserdefinednetworks.yaml
+  run_kubectl apply -f k8s.ovn.org_userdefinednetworkquotas.yaml
+  run_kubectl apply -f k8s.ovn.org_userdefinednetworkcidrpools.yaml
   run_kubectl apply -f k8s.ovn.org_routeadvertisements.yaml
+  run_kubectl apply -f k8s.ovn.org_clusterlinks.yaml
+  run_kubectl apply -f k8s.ovn.org_networkqoses.yaml
   # NOTE: When you update vendoring versions for the ANP & BANP APIs, we must update the version of the CRD we pull from in the below URL
   run_kubectl apply -f https://raw.githubusercontent.com/kubernetes-sigs/network-policy-api/v0.1.5/config/crd/experimental/policy.networking.k8s.io_adminnetworkpolicies.yaml
   run_kubectl apply -f https://raw.githubusercontent.com/kubernetes-sigs/network-policy-api/v0.1.5/config/crd/experimental/policy.networking.k8s.io_baselineadminnetworkpolicies.yaml
@@ -67,6 +67,7 @@ OVN_EGRESSIP_ENABLE=
 OVN_EGRESSIP_HEALTHCHECK_PORT=
 OVN_EGRESSFIREWALL_ENABLE=
 OVN_EGRESSQOS_ENABLE=
+OVN_NETWORK_QOS_ENABLE=
 OVN_EGRESSSERVICE_ENABLE=
 OVN_DISABLE_OVN_IFACE_ID_VER="false"
 OVN_MULTI_NETWORK_ENABLE=
@@ -265,6 +266,9 @@ while [ "$1" != "" ]; do
   --egress-qos-enable)
     OVN_EGRESSQOS_ENABLE=$VALUE
     ;;
+  --network-qos-enable)
+    OVN_NETWORK_QOS_ENABLE=$VALUE
+    ;;
   --multi-network-enable)
     OVN_MULTI_NETWORK_ENABLE=$VALUE
     ;;
@@ -452,6 +456,8 @@ ovn_egress_firewall_enable=${OVN_EGRESSFIREWALL_ENABLE}
 echo "ovn_egress_firewall_enable: ${ovn_egress_firewall_enable}"
 ovn_egress_qos_enable=${OVN_EGRESSQOS_ENABLE}
 echo "ovn_egress_qos_enable: ${ovn_egress_qos_enable}"
+ovn_network_qos_enable=${OVN_NETWORK_QOS_ENABLE}
+echo "ovn_network_qos_enable: ${ovn_network_qos_enable}"
 ovn_egress_service_enable=${OVN_EGRESSSERVICE_ENABLE}
 echo "ovn_egress_service_enable: ${ovn_egress_service_enable}"
 ovn_disable_ovn_iface_id_ver=${OVN_DISABLE_OVN_IFACE_ID_VER}
@@ -747,6 +753,8 @@ ovn_image=${ovnkube_image} \
   ovn_egress_ip_healthcheck_port=${ovn_egress_ip_healthcheck_port} \
   ovn_egress_firewall_enable=${ovn_egress_firewall_enable} \
   ovn_egress_qos_enable=${ovn_egress_qos_enable} \
+  ovn_network_qos_enable=${ovn_network_qos_enable} \
+  enable_ipsec=${enable_ipsec} \
   ovn_multi_network_enable=${ovn_multi_network_enable} \
   ovn_network_segmentation_enable=${ovn_network_segmentation_enable} \
   ovn_route_advertisements_enable=${ovn_route_advertisements_enable} \
@@ -795,6 +803,7 @@ ovn_image=${ovnkube_image} \
   ovn_egress_ip_healthcheck_port=${ovn_egress_ip_healthcheck_port} \
   ovn_egress_firewall_enable=${ovn_egress_firewall_enable} \
   ovn_egress_qos_enable=${ovn_egress_qos_enable} \
+  ovn_network_qos_enable=${ovn_network_qos_enable} \
   ovn_multi_network_enable=${ovn_multi_network_enable} \
   ovn_network_segmentation_enable=${ovn_network_segmentation_enable} \
   ovn_route_advertisements_enable=${ovn_route_advertisements_enable} \
@@ -874,6 +883,8 @@ ovn_image=${ovnkube_image} \
   ovn_egress_ip_healthcheck_port=${ovn_egress_ip_healthcheck_port} \
   ovn_egress_firewall_enable=${ovn_egress_firewall_enable} \
   ovn_egress_qos_enable=${ovn_egress_qos_enable} \
+  ovn_network_qos_enable=${ovn_network_qos_enable} \
+  enable_ipsec=${enable_ipsec} \
   ovn_multi_network_enable=${ovn_multi_network_enable} \
   ovn_network_segmentation_enable=${ovn_network_segmentation_enable} \
   ovn_route_advertisements_enable=${ovn_route_advertisements_enable} \
@@ -940,6 +951,8 @@ ovn_image=${ovnkube_image} \
   ovn_egress_service_enable=${ovn_egress_service_enable} \
   ovn_egress_firewall_enable=${ovn_egress_firewall_enable} \
   ovn_egress_qos_enable=${ovn_egress_qos_enable} \
+  ovn_network_qos_enable=${ovn_network_qos_enable} \
+  enable_ipsec=${enable_ipsec} \
   ovn_multi_network_enable=${ovn_multi_network_enable} \
   ovn_network_segmentation_enable=${ovn_network_segmentation_enable} \
   ovn_route_advertisements_enable=${ovn_route_advertisements_enable} \
@@ -1055,6 +1068,10 @@ cp ../templates/k8s.ovn.org_egressservices.yaml.j2 ${output_dir}/k8s.ovn.org_egr
 cp ../templates/k8s.ovn.org_adminpolicybasedexternalroutes.yaml.j2 ${output_dir}/k8s.ovn.org_adminpolicybasedexternalroutes.yaml
 cp ../templates/k8s.ovn.org_userdefinednetworks.yaml.j2 ${output_dir}/k8s.ovn.org_userdefinednetworks.yaml
 cp ../templates/k8s.ovn.org_clusteruserdefinednetworks.yaml.j2 ${output_dir}/k8s.ovn.org_clusteruserdefinednetworks.yaml
+cp ../templates/k8s.ovn.org_userdefinednetworkquotas.yaml.j2 ${output_dir}/k8s.ovn.org_userdefinednetworkquotas.yaml
+cp ../templates/k8s.ovn.org_userdefinednetworkcidrpools.yaml.j2 ${output_dir}/k8s.ovn.org_userdefinednetworkcidrpools.yaml
 cp ../templates/k8s.ovn.org_routeadvertisements.yaml.j2 ${output_dir}/k8s.ovn.org_routeadvertisements.yaml
+cp ../templates/k8s.ovn.org_clusterlinks.yaml.j2 ${output_dir}/k8s.ovn.org_clusterlinks.yaml
+cp ../templates/k8s.ovn.org_networkqoses.yaml.j2 ${output_dir}/k8s.ovn.org_networkqoses.yaml
 
 exit 0
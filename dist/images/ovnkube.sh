@@ -78,6 +78,7 @@ fi
 # OVN_EGRESSIP_HEALTHCHECK_PORT - egress IP node check to use grpc on this port (0 ==> dial to port 9 instead)
 # OVN_EGRESSFIREWALL_ENABLE - enable egressFirewall for ovn-kubernetes
 # OVN_EGRESSQOS_ENABLE - enable egress QoS for ovn-kubernetes
+# OVN_NETWORK_QOS_ENABLE - enable network QoS for ovn-kubernetes
 # OVN_EGRESSSERVICE_ENABLE - enable egress Service for ovn-kubernetes
 # OVN_UNPRIVILEGED_MODE - execute CNI ovs/netns commands from host (default no)
 # OVNKUBE_NODE_MODE - ovnkube node mode of operation, one of: full, dpu, dpu-host (default: full)
@@ -261,6 +262,8 @@ ovn_egress_ip_healthcheck_port=${OVN_EGRESSIP_HEALTHCHECK_PORT:-9107}
 ovn_egressfirewall_enable=${OVN_EGRESSFIREWALL_ENABLE:-false}
 #OVN_EGRESSQOS_ENABLE - enable egress QoS for ovn-kubernetes
 ovn_egressqos_enable=${OVN_EGRESSQOS_ENABLE:-false}
+#OVN_NETWORK_QOS_ENABLE - enable network QoS for ovn-kubernetes
+ovn_network_qos_enable=${OVN_NETWORK_QOS_ENABLE:-false}
 #OVN_EGRESSSERVICE_ENABLE - enable egress Service for ovn-kubernetes
 ovn_egressservice_enable=${OVN_EGRESSSERVICE_ENABLE:-false}
 #OVN_DISABLE_OVN_IFACE_ID_VER - disable usage of the OVN iface-id-ver option
@@ -286,6 +289,11 @@ ovn_enable_interconnect=${OVN_ENABLE_INTERCONNECT:-false}
 ovn_enable_multi_external_gateway=${OVN_ENABLE_MULTI_EXTERNAL_GATEWAY:-false}
 #OVN_ENABLE_OVNKUBE_IDENTITY - enable per node cert
 ovn_enable_ovnkube_identity=${OVN_ENABLE_OVNKUBE_IDENTITY:-true}
+#ENABLE_IPSEC - enable IPsec encryption of east-west traffic
+enable_ipsec=${ENABLE_IPSEC:-false}
+#OVN_IPSEC_SIGNER_CA_CERT, OVN_IPSEC_SIGNER_CA_KEY - CA ovnkube-identity signs the node IPsec certificates with
+ovn_ipsec_signer_ca_cert=${OVN_IPSEC_SIGNER_CA_CERT:-}
+ovn_ipsec_signer_ca_key=${OVN_IPSEC_SIGNER_CA_KEY:-}
 #OVN_ENABLE_PERSISTENT_IPS - enable IPAM for virtualization workloads (KubeVirt persistent IPs)
 ovn_enable_persistent_ips=${OVN_ENABLE_PERSISTENT_IPS:-false}
 
@@ -1078,6 +1086,11 @@ ovnkube-identity() {
       ovnkube_enable_hybrid_overlay_flag="--enable-hybrid-overlay"
     fi
 
+    ipsec_signer_flags=
+    if [[ -n "${ovn_ipsec_signer_ca_cert}" && -n "${ovn_ipsec_signer_ca_key}" ]]; then
+      ipsec_signer_flags="--ipsec-signer-ca-cert=${ovn_ipsec_signer_ca_cert} --ipsec-signer-ca-key=${ovn_ipsec_signer_ca_key}"
+    fi
+
     # extra-allowed-user:
     #   ovnkube-master service account - required for compact mode
     #   ovnkube-cluster-manager service account - required for multi-homing
@@ -1085,6 +1098,7 @@ ovnkube-identity() {
     --webhook-cert-dir="/etc/webhook-cert" \
     ${ovnkube_enable_interconnect_flag} \
     ${ovnkube_enable_hybrid_overlay_flag} \
+    ${ipsec_signer_flags} \
     --extra-allowed-user="system:serviceaccount:ovn-kubernetes:ovnkube-cluster-manager" \
     --extra-allowed-user="system:serviceaccount:ovn-kubernetes:ovnkube-master" \
     --loglevel="${ovnkube_loglevel}"
@@ -1211,6 +1225,16 @@ ovn-master() {
 	  egressqos_enabled_flag="--enable-egress-qos"
   fi
 
+  network_qos_enabled_flag=
+  if [[ ${ovn_network_qos_enable} == "true" ]]; then
+	  network_qos_enabled_flag="--enable-network-qos"
+  fi
+
+  ipsec_enabled_flag=
+  if [[ ${enable_ipsec} == "true" ]]; then
+	  ipsec_enabled_flag="--enable-ipsec"
+  fi
+
   multi_network_enabled_flag=
   if [[ ${ovn_multi_network_enable} == "true" ]]; then
 	  multi_network_enabled_flag="--enable-multi-network --enable-multi-networkpolicy"
@@ -1321,6 +1345,8 @@ ovn-master() {
     ${egressip_enabled_flag} \
     ${egressip_healthcheck_port_flag} \
     ${egressqos_enabled_flag} \
+    ${network_qos_enabled_flag} \
+    ${ipsec_enabled_flag} \
     ${egressservice_enabled_flag} \
     ${empty_lb_events_flag} \
     ${hybrid_overlay_flags} \
@@ -1506,6 +1532,18 @@ ovnkube-controller() {
   fi
   echo "egressqos_enabled_flag=${egressqos_enabled_flag}"
 
+  network_qos_enabled_flag=
+  if [[ ${ovn_network_qos_enable} == "true" ]]; then
+	  network_qos_enabled_flag="--enable-network-qos"
+  fi
+  echo "network_qos_enabled_flag=${network_qos_enabled_flag}"
+
+  ipsec_enabled_flag=
+  if [[ ${enable_ipsec} == "true" ]]; then
+	  ipsec_enabled_flag="--enable-ipsec"
+  fi
+  echo "ipsec_enabled_flag=${ipsec_enabled_flag}"
+
   multi_network_enabled_flag=
   if [[ ${ovn_multi_network_enable} == "true" ]]; then
 	  multi_network_enabled_flag="--enable-multi-network --enable-multi-networkpolicy"
@@ -1618,6 +1656,8 @@ ovnkube-controller() {
     ${egressip_enabled_flag} \
     ${egressip_healthcheck_port_flag} \
     ${egressqos_enabled_flag} \
+    ${network_qos_enabled_flag} \
+    ${ipsec_enabled_flag} \
     ${egressservice_enabled_flag} \
     ${empty_lb_events_flag} \
     ${hybrid_overlay_flags} \
@@ -1796,6 +1836,18 @@ ovnkube-controller-with-node() {
   fi
   echo "egressqos_enabled_flag=${egressqos_enabled_flag}"
 
+  network_qos_enabled_flag=
+  if [[ ${ovn_network_qos_enable} == "true" ]]; then
+	  network_qos_enabled_flag="--enable-network-qos"
+  fi
+  echo "network_qos_enabled_flag=${network_qos_enabled_flag}"
+
+  ipsec_enabled_flag=
+  if [[ ${enable_ipsec} == "true" ]]; then
+	  ipsec_enabled_flag="--enable-ipsec"
+  fi
+  echo "ipsec_enabled_flag=${ipsec_enabled_flag}"
+
   multi_network_enabled_flag=
   if [[ ${ovn_multi_network_enable} == "true" ]]; then
 	  multi_network_enabled_flag="--enable-multi-network --enable-multi-networkpolicy"
@@ -2043,6 +2095,8 @@ ovnkube-controller-with-node() {
     ${egressip_enabled_flag} \
     ${egressip_healthcheck_port_flag} \
     ${egressqos_enabled_flag} \
+    ${network_qos_enabled_flag} \
+    ${ipsec_enabled_flag} \
     ${egressservice_enabled_flag} \
     ${empty_lb_events_flag} \
     ${enable_lflow_cache} \
@@ -2159,6 +2213,12 @@ ovn-cluster-manager() {
   fi
   echo "egressqos_enabled_flag=${egressqos_enabled_flag}"
 
+  network_qos_enabled_flag=
+  if [[ ${ovn_network_qos_enable} == "true" ]]; then
+	  network_qos_enabled_flag="--enable-network-qos"
+  fi
+  echo "network_qos_enabled_flag=${network_qos_enabled_flag}"
+
   hybrid_overlay_flags=
   if [[ ${ovn_hybrid_overlay_enable} == "true" ]]; then
     hybrid_overlay_flags="--enable-hybrid-overlay"
@@ -2277,6 +2337,7 @@ ovn-cluster-manager() {
     ${egressip_enabled_flag} \
     ${egressip_healthcheck_port_flag} \
     ${egressqos_enabled_flag} \
+    ${network_qos_enabled_flag} \
     ${egressservice_enabled_flag} \
     ${empty_lb_events_flag} \
     ${hybrid_overlay_flags} \
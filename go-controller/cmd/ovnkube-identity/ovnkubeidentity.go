@@ -64,6 +64,8 @@ type config struct {
 	csrAcceptanceConditions    []csrapprover.CSRAcceptanceCondition
 	podAdmissionConditionFile  string
 	podAdmissionConditions     []ovnwebhook.PodAdmissionConditionOption
+	ipsecSignerCACert          string
+	ipsecSignerCAKey           string
 }
 
 var cliCfg config
@@ -275,6 +277,17 @@ func main() {
 			Usage:       "Configure additional pod validate admission conditions",
 			Destination: &cliCfg.podAdmissionConditionFile,
 		},
+		&cli.StringFlag{
+			Name: "ipsec-signer-ca-cert",
+			Usage: "The CA certificate used to sign the approved node IPsec CSRs, for the k8s.ovn.org/ipsec signer. " +
+				"The node IPsec certificates are signed only if set together with ipsec-signer-ca-key",
+			Destination: &cliCfg.ipsecSignerCACert,
+		},
+		&cli.StringFlag{
+			Name:        "ipsec-signer-ca-key",
+			Usage:       "The private key of the CA certificate used to sign the approved node IPsec CSRs",
+			Destination: &cliCfg.ipsecSignerCAKey,
+		},
 	}
 	ctx := context.Background()
 
@@ -468,6 +481,32 @@ func runCSRApproverManager(ctx context.Context, leaderID string, restCfg *rest.C
 		os.Exit(1)
 	}
 
+	if cliCfg.ipsecSignerCACert != "" && cliCfg.ipsecSignerCAKey != "" {
+		signer, err := csrapprover.NewIPsecSigner(
+			mgr.GetClient(),
+			cliCfg.ipsecSignerCACert,
+			cliCfg.ipsecSignerCAKey,
+			csrapprover.MaxDuration,
+			mgr.GetEventRecorderFor(csrapprover.IPsecSignerControllerName),
+		)
+		if err != nil {
+			return err
+		}
+		err = ctrl.
+			NewControllerManagedBy(mgr).
+			Named(csrapprover.IPsecSignerControllerName).
+			For(&certificatesv1.CertificateSigningRequest{}, builder.WithPredicates(csrapprover.Predicate)).
+			WithOptions(controller.Options{
+				NeedLeaderElection: utilpointer.To(true),
+				RecoverPanic:       utilpointer.To(true),
+			}).
+			Complete(signer)
+		if err != nil {
+			klog.Errorf("Failed to create %s: %v", csrapprover.IPsecSignerControllerName, err)
+			os.Exit(1)
+		}
+	}
+
 	klog.Info("Starting certificate signing request approver")
 	return mgr.Start(ctx)
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	ovnnode "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routemanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/tracing"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
@@ -271,6 +272,18 @@ func startOvnKube(ctx *cli.Context, cancel context.CancelFunc) error {
 		return fmt.Errorf("failed to initialize exec helper: %v", err)
 	}
 
+	shutdownTracing, err := tracing.Init(ctx.Context, "ovnkube")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			klog.Errorf("Failed to flush traces: %v", err)
+		}
+	}()
+
 	ovnKubeStartWg := &sync.WaitGroup{}
 	defer func() {
 		// make sure everything stops and wait
@@ -53,7 +53,7 @@ for crd in ${crds}; do
     --output-dir "${SCRIPT_ROOT}"/pkg/crd/$crd/v1/apis/clientset \
     --output-pkg github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/$crd/v1/apis/clientset \
     --apply-configuration-package github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/$crd/v1/apis/applyconfiguration \
-    --plural-exceptions="EgressQoS:EgressQoSes,RouteAdvertisements:RouteAdvertisements" \
+    --plural-exceptions="EgressQoS:EgressQoSes,NetworkQoS:NetworkQoSes,RouteAdvertisements:RouteAdvertisements" \
     "$@"
 
   echo "Generating listers for $crd"
@@ -61,7 +61,7 @@ for crd in ${crds}; do
     --go-header-file hack/boilerplate.go.txt \
     --output-dir "${SCRIPT_ROOT}"/pkg/crd/$crd/v1/apis/listers \
     --output-pkg github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/$crd/v1/apis/listers \
-    --plural-exceptions="EgressQoS:EgressQoSes,RouteAdvertisements:RouteAdvertisements" \
+    --plural-exceptions="EgressQoS:EgressQoSes,NetworkQoS:NetworkQoSes,RouteAdvertisements:RouteAdvertisements" \
     github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/$crd/v1 \
     "$@"
 
@@ -72,7 +72,7 @@ for crd in ${crds}; do
     --listers-package  github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/$crd/v1/apis/listers \
     --output-dir "${SCRIPT_ROOT}"/pkg/crd/$crd/v1/apis/informers \
     --output-pkg github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/$crd/v1/apis/informers \
-    --plural-exceptions="EgressQoS:EgressQoSes,RouteAdvertisements:RouteAdvertisements" \
+    --plural-exceptions="EgressQoS:EgressQoSes,NetworkQoS:NetworkQoSes,RouteAdvertisements:RouteAdvertisements" \
     github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/$crd/v1 \
     "$@"
 
@@ -108,5 +108,13 @@ echo "Copying userdefinednetworks CRD"
 cp _output/crds/k8s.ovn.org_userdefinednetworks.yaml ../dist/templates/k8s.ovn.org_userdefinednetworks.yaml.j2
 echo "Copying clusteruserdefinednetworks CRD"
 cp _output/crds/k8s.ovn.org_clusteruserdefinednetworks.yaml ../dist/templates/k8s.ovn.org_clusteruserdefinednetworks.yaml.j2
+echo "Copying userdefinednetworkquotas CRD"
+cp _output/crds/k8s.ovn.org_userdefinednetworkquotas.yaml ../dist/templates/k8s.ovn.org_userdefinednetworkquotas.yaml.j2
+echo "Copying userdefinednetworkcidrpools CRD"
+cp _output/crds/k8s.ovn.org_userdefinednetworkcidrpools.yaml ../dist/templates/k8s.ovn.org_userdefinednetworkcidrpools.yaml.j2
+echo "Copying networkQoSes CRD"
+cp _output/crds/k8s.ovn.org_networkqoses.yaml ../dist/templates/k8s.ovn.org_networkqoses.yaml.j2
+echo "Copying clusterLinks CRD"
+cp _output/crds/k8s.ovn.org_clusterlinks.yaml ../dist/templates/k8s.ovn.org_clusterlinks.yaml.j2
 echo "Copying routeAdvertisements CRD"
 cp _output/crds/k8s.ovn.org_routeadvertisements.yaml ../dist/templates/k8s.ovn.org_routeadvertisements.yaml.j2
@@ -11,6 +11,7 @@ import (
 	houtil "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	kapi "k8s.io/api/core/v1"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
@@ -31,7 +32,7 @@ type NodeController struct {
 	networkID       string
 	localNodeCIDR   *net.IPNet
 	localNodeIP     net.IP
-	remoteSubnetMap map[string]string // Maps a remote node to its remote subnet
+	remoteSubnetMap map[string][]string // Maps a remote node to its remote subnets
 }
 
 // newNodeController returns a node handler that listens for node events
@@ -68,7 +69,7 @@ func newNodeController(kube kube.Interface,
 	return &NodeController{
 		kube:            kube,
 		machineID:       node.Status.NodeInfo.MachineID,
-		remoteSubnetMap: make(map[string]string),
+		remoteSubnetMap: make(map[string][]string),
 	}, nil
 }
 
@@ -210,9 +211,67 @@ func (n *NodeController) AddNode(node *kapi.Node) error {
 		DestinationPrefix: cidr.String(),
 	}
 
-	n.remoteSubnetMap[node.Status.NodeInfo.MachineID] = cidr.String()
+	if err := AddRemoteSubnetPolicy(network, &networkPolicySettings); err != nil {
+		return err
+	}
+	remoteSubnets := []string{cidr.String()}
+
+	// Set up the VXLAN tunnel to the node subnets of the hybrid overlay
+	// enabled user defined networks, each through its own distributed router
+	networkSubnets, err := getNodeNetworkSubnets(node)
+	if err != nil {
+		return err
+	}
+	for subnet, networkDRMAC := range networkSubnets {
+		klog.Infof("Adding a remote subnet route for CIDR '%s' (node: '%s', remote node address: %s, distributed router MAC: %s, VNI: %v).",
+			subnet, node.Name, nodeIP.String(), networkDRMAC.String(), types.HybridOverlayVNI)
+		networkPolicySettings := hcn.RemoteSubnetRoutePolicySetting{
+			IsolationId:                 types.HybridOverlayVNI,
+			DistributedRouterMacAddress: networkDRMAC.String(),
+			ProviderAddress:             nodeIP.String(),
+			DestinationPrefix:           subnet,
+		}
+		if err := AddRemoteSubnetPolicy(network, &networkPolicySettings); err != nil {
+			return err
+		}
+		remoteSubnets = append(remoteSubnets, subnet)
+	}
+
+	// Remove the remote subnets of the networks that are gone
+	for _, staleSubnet := range n.remoteSubnetMap[node.Status.NodeInfo.MachineID] {
+		if _, ok := networkSubnets[staleSubnet]; ok || staleSubnet == cidr.String() {
+			continue
+		}
+		if err := RemoveRemoteSubnetPolicy(network, staleSubnet); err != nil {
+			return fmt.Errorf("error removing subnet policy '%s' from network '%s' for node '%s'. Error: %v",
+				staleSubnet, n.networkID, node.Name, err)
+		}
+	}
+	n.remoteSubnetMap[node.Status.NodeInfo.MachineID] = remoteSubnets
+	return nil
+}
 
-	return AddRemoteSubnetPolicy(network, &networkPolicySettings)
+// getNodeNetworkSubnets returns the node subnets of the hybrid overlay enabled
+// user defined networks, mapped to the distributed router MAC of the network
+func getNodeNetworkSubnets(node *kapi.Node) (map[string]net.HardwareAddr, error) {
+	drIPs, err := houtil.ParseHybridOverlayNetworkDRIPs(node)
+	if err != nil {
+		return nil, err
+	}
+	subnets := make(map[string]net.HardwareAddr, len(drIPs))
+	for netName, drIP := range drIPs {
+		hostSubnets, err := util.ParseNodeHostSubnetAnnotation(node, netName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get node %s subnets of network %s: %v", node.Name, netName, err)
+		}
+		for _, hostSubnet := range hostSubnets {
+			if hostSubnet.Contains(drIP) {
+				subnets[hostSubnet.String()] = util.IPAddrToHWAddr(drIP)
+				break
+			}
+		}
+	}
+	return subnets, nil
 }
 
 // Delete handles node deletions
@@ -243,14 +302,16 @@ func (n *NodeController) DeleteNode(node *kapi.Node) error {
 		return nil
 	}
 
-	nodeSubnet, ok := n.remoteSubnetMap[node.Status.NodeInfo.MachineID]
+	nodeSubnets, ok := n.remoteSubnetMap[node.Status.NodeInfo.MachineID]
 	if !ok {
 		return fmt.Errorf("can't retrieve the host subnet from the '%s' node's annotations", node.Name)
 	}
 
-	if err := RemoveRemoteSubnetPolicy(network, nodeSubnet); err != nil {
-		return fmt.Errorf("error removing subnet policy '%s' node's annotations from network '%s' on node '%s'. Error: %v",
-			nodeSubnet, n.networkID, node.Name, err)
+	for _, nodeSubnet := range nodeSubnets {
+		if err := RemoveRemoteSubnetPolicy(network, nodeSubnet); err != nil {
+			return fmt.Errorf("error removing subnet policy '%s' node's annotations from network '%s' on node '%s'. Error: %v",
+				nodeSubnet, n.networkID, node.Name, err)
+		}
 	}
 
 	delete(n.remoteSubnetMap, node.Status.NodeInfo.MachineID)
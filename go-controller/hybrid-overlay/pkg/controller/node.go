@@ -61,6 +61,7 @@ func nodeChanged(old, new interface{}) bool {
 
 	return !reflect.DeepEqual(oldCidr, newCidr) || !reflect.DeepEqual(oldNodeIP, newNodeIP) || !reflect.DeepEqual(oldDrMAC, newDrMAC) ||
 		!reflect.DeepEqual(newNode.Annotations[hotypes.HybridOverlayDRIP], oldNode.Annotations[hotypes.HybridOverlayDRIP]) ||
+		newNode.Annotations[hotypes.HybridOverlayNetworkDRIPs] != oldNode.Annotations[hotypes.HybridOverlayNetworkDRIPs] ||
 		util.NoHostSubnet(oldNode) != util.NoHostSubnet(newNode)
 }
 
@@ -75,6 +76,10 @@ func podChanged(old, new interface{}) bool {
 	if len(oldIPs) != len(newIPs) || !reflect.DeepEqual(oldMAC, newMAC) {
 		return true
 	}
+	// the IPs of the user defined networks
+	if oldPod.Annotations[util.OvnPodAnnotationName] != newPod.Annotations[util.OvnPodAnnotationName] {
+		return true
+	}
 	for i := range oldIPs {
 		if oldIPs[i].String() != newIPs[i].String() {
 			return true
@@ -24,6 +24,9 @@ type NodeController struct {
 	drIP      net.IP
 	gwLRPIP   net.IP
 	vxlanPort uint16
+	// hybrid overlay ports of the Layer3 user defined networks of the local
+	// node, by network name
+	networks map[string]*hybridOverlayNetwork
 	// contains a map of pods to corresponding tunnels
 	flowCache map[string]*flowCacheEntry
 	flowMutex sync.Mutex
@@ -64,6 +64,7 @@ func newOVNNodeController(
 		nodeName:            nodeName,
 		initState:           new(uint32),
 		vxlanPort:           uint16(config.HybridOverlay.VXLANPort),
+		networks:            make(map[string]*hybridOverlayNetwork),
 		flowCache:           make(map[string]*flowCacheEntry),
 		flowMutex:           sync.Mutex{},
 		flowChan:            make(chan struct{}, 1),
@@ -115,6 +116,20 @@ func (n *NodeController) AddPod(pod *kapi.Pod) error {
 
 		n.updateFlowCacheEntry(cookie, flows, ignoreLearn)
 	}
+	for _, podNetwork := range n.getPodNetworkDetails(pod) {
+		cookie := podIPToCookie(podNetwork.ip)
+		if cookie == "" {
+			continue
+		}
+		// Incoming vxlan traffic towards the pods of user defined networks,
+		// told apart from the default network by the network DR MAC
+		flows := []string{fmt.Sprintf(
+			"table=10,cookie=0x%s,priority=110,ip,nw_dst=%s,dl_dst=%s,"+
+				"actions=set_field:%s->eth_src,set_field:%s->eth_dst,output:%s",
+			cookie, podNetwork.ip, podNetwork.network.drMAC, podNetwork.network.drMAC,
+			podNetwork.mac, podNetwork.network.extPortName())}
+		n.updateFlowCacheEntry(cookie, flows, ignoreLearn)
+	}
 	n.requestFlowSync()
 	klog.Infof("Pod %s wired for Hybrid Overlay", pod.Name)
 	return nil
@@ -137,6 +152,13 @@ func (n *NodeController) DeletePod(pod *kapi.Pod) error {
 		}
 		n.deleteFlowsByCookie(cookie)
 	}
+	for _, podNetwork := range getPodNetworkIPs(pod) {
+		cookie := podIPToCookie(podNetwork)
+		if cookie == "" {
+			continue
+		}
+		n.deleteFlowsByCookie(cookie)
+	}
 	return nil
 }
 
@@ -241,6 +263,17 @@ func (n *NodeController) AddNode(node *kapi.Node) error {
 			return err
 		}
 
+		if atomic.LoadUint32(n.initState) >= hotypes.DistributedRouterInitialized {
+			networksChanged, err := n.syncNetworks(node)
+			if err != nil {
+				return err
+			}
+			if networksChanged && atomic.LoadUint32(n.initState) >= hotypes.PodsInitialized {
+				// rewire the pods for the updated user defined networks
+				atomic.StoreUint32(n.initState, hotypes.DistributedRouterInitialized)
+			}
+		}
+
 		if atomic.LoadUint32(n.initState) < hotypes.PodsInitialized {
 			// add pods local to our node
 			pods, err := n.localPodLister.List(labels.Everything())
@@ -550,6 +550,115 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 		}
 		appRun(app)
 	})
+	ovntest.OnSupportedPlatformsIt("sets up the hybrid overlay port and local pods of a user defined network", func() {
+		app.Action = func(ctx *cli.Context) error {
+			const (
+				netName       string = "tenant-blue"
+				nadName       string = "ns1/tenant-blue"
+				netID         int    = 2
+				netSubnet     string = "10.10.1.0/24"
+				netDRIP       string = "10.10.1.3"
+				podIP         string = "1.2.3.5"
+				podCIDR       string = podIP + "/24"
+				podMAC        string = "aa:bb:cc:dd:ee:ff"
+				podNetworkIP  string = "10.10.1.5"
+				podNetworkMAC string = "0a:58:0a:0a:01:05"
+			)
+
+			annotations := createNodeAnnotationsForSubnet(thisNodeSubnet)
+			annotations[hotypes.HybridOverlayDRMAC] = thisNodeDRMAC
+			annotations[util.OVNNodeGRLRPAddrs] = "{\"default\":{\"ipv4\":\"100.64.0.3/16\"}}"
+			annotations[hotypes.HybridOverlayDRIP] = thisNodeDRIP
+			annotations, err := util.UpdateNodeHostSubnetAnnotation(annotations, ovntest.MustParseIPNets(netSubnet), netName)
+			Expect(err).NotTo(HaveOccurred())
+			annotations, err = util.UpdateNetworkIDAnnotation(annotations, netName, netID)
+			Expect(err).NotTo(HaveOccurred())
+			annotations[hotypes.HybridOverlayNetworkDRIPs] = fmt.Sprintf("{\"%s\":\"%s\"}", netName, netDRIP)
+			node := createNode(thisNode, "linux", thisNodeIP, annotations)
+
+			testPod := createPod("test", "pod1", thisNode, podCIDR, podMAC)
+			testPod.Annotations[util.OvnPodAnnotationName] = fmt.Sprintf(
+				`{"default": {"ip_address":"%s", "mac_address":"%s", "gateway_ip": "1.2.3.1"}, `+
+					`"%s": {"ip_address":"%s/24", "mac_address":"%s", "gateway_ip": "10.10.1.1", "role": "primary"}}`,
+				podCIDR, podMAC, nadName, podNetworkIP, podNetworkMAC)
+			fakeClient := fake.NewSimpleClientset(&v1.NodeList{
+				Items: []v1.Node{*node},
+			}, &v1.PodList{
+				Items: []v1.Pod{*testPod},
+			})
+
+			addNodeSetupCmds(fexec, thisNode)
+			fexec.AddFakeCmdsNoOutputNoError([]string{
+				"ovs-vsctl --timeout=15 --may-exist add-port br-int int-2 -- --may-exist add-port br-ext ext-2 -- set Interface int-2 type=patch options:peer=ext-2 external-ids:iface-id=int-tenant.blue_" + thisNode + " -- set Interface ext-2 type=patch options:peer=int-2",
+			})
+			_, err = config.InitConfig(ctx, fexec, nil)
+			Expect(err).NotTo(HaveOccurred())
+			f := informers.NewSharedInformerFactory(fakeClient, informer.DefaultResyncInterval)
+
+			n, err := NewNode(
+				&kube.Kube{KClient: fakeClient},
+				thisNode,
+				f.Core().V1().Nodes().Informer(),
+				f.Core().V1().Pods().Informer(),
+				informer.NewTestEventHandler,
+				false,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			linuxNode, okay := n.controller.(*NodeController)
+			Expect(okay).To(BeTrue())
+			// setting the flowCacheSyncPeriod to 1 hour effectively disabling for testing
+			linuxNode.flowCacheSyncPeriod = 1 * time.Hour
+
+			addEnsureHybridOverlayBridgeMocks(nlMock, thisNodeDRIP, "")
+			// initial flowSync
+			addSyncFlows(fexec)
+			// flowsync after EnsureHybridOverlayBridge()
+			addSyncFlows(fexec)
+			// flowsyncs after adding the local pod, which may be coalesced
+			addSyncFlows(fexec)
+			addSyncFlows(fexec)
+
+			f.Start(stopChan)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				n.Run(stopChan)
+			}()
+
+			Eventually(func() bool {
+				return atomic.LoadUint32(linuxNode.initState) == hotypes.PodsInitialized
+			}, 2).Should(BeTrue())
+
+			netDRMAC := util.IPAddrToHWAddr(net.ParseIP(netDRIP)).String()
+			netCookie := nameToCookie(types.NetworkExternalID + "=" + netName)
+			podCookie := podIPToCookie(net.ParseIP(podIP))
+			podNetworkCookie := podIPToCookie(net.ParseIP(podNetworkIP))
+			expectedFlowCache := map[string]*flowCacheEntry{
+				"0x0": generateInitialFlowCacheEntry(mgmtIfAddr.IP.String(), thisNodeDRIP, thisNodeDRMAC),
+				netCookie: {
+					flows: []string{
+						"cookie=0x" + netCookie + ",table=0,priority=100,in_port=ext-2,arp_op=1,arp,arp_tpa=" + netDRIP + ",actions=move:NXM_OF_ETH_SRC[]->NXM_OF_ETH_DST[],mod_dl_src:" + netDRMAC + ",load:0x2->NXM_OF_ARP_OP[],move:NXM_NX_ARP_SHA[]->NXM_NX_ARP_THA[],move:NXM_OF_ARP_SPA[]->NXM_OF_ARP_TPA[],load:0x0a580a0a0103->NXM_NX_ARP_SHA[],load:0x0a0a0103->NXM_OF_ARP_SPA[],IN_PORT",
+						"cookie=0x" + netCookie + ",table=0,priority=100,in_port=ext-vxlan,ip,nw_dst=" + netSubnet + ",dl_dst=" + netDRMAC + ",actions=goto_table:10",
+					},
+				},
+				podCookie: {
+					flows:       []string{"table=10,cookie=0x" + podCookie + ",priority=100,ip,nw_dst=" + podIP + ",actions=set_field:" + thisNodeDRMAC + "->eth_src,set_field:" + podMAC + "->eth_dst,output:ext"},
+					ignoreLearn: true,
+				},
+				podNetworkCookie: {
+					flows:       []string{"table=10,cookie=0x" + podNetworkCookie + ",priority=110,ip,nw_dst=" + podNetworkIP + ",dl_dst=" + netDRMAC + ",actions=set_field:" + netDRMAC + "->eth_src,set_field:" + podNetworkMAC + "->eth_dst,output:ext-2"},
+					ignoreLearn: true,
+				},
+			}
+			Eventually(func() error {
+				linuxNode.flowMutex.Lock()
+				defer linuxNode.flowMutex.Unlock()
+				return compareFlowCache(linuxNode.flowCache, expectedFlowCache)
+			}, 2).Should(BeNil())
+			return nil
+		}
+		appRun(app)
+	})
 	ovntest.OnSupportedPlatformsIt("on startup will add a local linux pod that times out on the initial addPod event", func() {
 		app.Action = func(ctx *cli.Context) error {
 			const (
@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	houtil "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	kapi "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+)
+
+// hybridOverlayNetwork is the local node's hybrid overlay port of a Layer3
+// user defined network. Like the default network one, it is a patch port
+// between br-int and br-ext, bound to the network's hybrid overlay logical
+// switch port.
+type hybridOverlayNetwork struct {
+	name   string
+	id     int
+	subnet *net.IPNet
+	drIP   net.IP
+	drMAC  net.HardwareAddr
+}
+
+func (hon *hybridOverlayNetwork) intPortName() string {
+	return fmt.Sprintf("int-%d", hon.id)
+}
+
+func (hon *hybridOverlayNetwork) extPortName() string {
+	return fmt.Sprintf("ext-%d", hon.id)
+}
+
+func (hon *hybridOverlayNetwork) cookie() string {
+	return nameToCookie(types.NetworkExternalID + "=" + hon.name)
+}
+
+// getHybridOverlayNetworks returns the local node's hybrid overlay ports of
+// the user defined networks, from the distributed router IPs annotated by the
+// network controllers
+func getHybridOverlayNetworks(node *kapi.Node) (map[string]*hybridOverlayNetwork, error) {
+	drIPs, err := houtil.ParseHybridOverlayNetworkDRIPs(node)
+	if err != nil {
+		return nil, err
+	}
+	networks := make(map[string]*hybridOverlayNetwork, len(drIPs))
+	for netName, drIP := range drIPs {
+		id, err := util.ParseNetworkIDAnnotation(node, netName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the ID of network %s: %w", netName, err)
+		}
+		hostSubnets, err := util.ParseNodeHostSubnetAnnotation(node, netName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the subnets of network %s: %w", netName, err)
+		}
+		var subnet *net.IPNet
+		for _, hostSubnet := range hostSubnets {
+			if !utilnet.IsIPv6CIDR(hostSubnet) && hostSubnet.Contains(drIP) {
+				subnet = hostSubnet
+				break
+			}
+		}
+		if subnet == nil {
+			return nil, fmt.Errorf("distributed router IP %s of network %s is not in the node subnets %s",
+				drIP, netName, util.StringSlice(hostSubnets))
+		}
+		networks[netName] = &hybridOverlayNetwork{
+			name:   netName,
+			id:     id,
+			subnet: subnet,
+			drIP:   drIP,
+			drMAC:  util.IPAddrToHWAddr(drIP),
+		}
+	}
+	return networks, nil
+}
+
+// syncNetworks sets up the hybrid overlay ports of the user defined networks
+// of the local node and tears down the ones of the networks that are gone. It
+// returns whether the networks changed, in which case the local pods must be
+// wired again.
+func (n *NodeController) syncNetworks(node *kapi.Node) (bool, error) {
+	networks, err := getHybridOverlayNetworks(node)
+	if err != nil {
+		return false, err
+	}
+
+	n.Lock()
+	defer n.Unlock()
+	changed := false
+	for netName, network := range n.networks {
+		if newNetwork, ok := networks[netName]; ok && reflect.DeepEqual(newNetwork, network) {
+			continue
+		}
+		if err := n.deleteNetwork(network); err != nil {
+			return changed, err
+		}
+		delete(n.networks, netName)
+		changed = true
+	}
+	for netName, network := range networks {
+		if _, ok := n.networks[netName]; ok {
+			continue
+		}
+		if err := n.ensureNetwork(network); err != nil {
+			return changed, err
+		}
+		n.networks[netName] = network
+		changed = true
+	}
+	if changed {
+		n.requestFlowSync()
+	}
+	return changed, nil
+}
+
+// ensureNetwork sets up the local node's hybrid overlay port of the network
+func (n *NodeController) ensureNetwork(network *hybridOverlayNetwork) error {
+	portName := util.GetHybridOverlayPortName(util.GetSecondaryNetworkPrefix(network.name) + n.nodeName)
+	rampInt, rampExt := network.intPortName(), network.extPortName()
+	_, stderr, err := util.RunOVSVsctl("--may-exist", "add-port", "br-int", rampInt,
+		"--", "--may-exist", "add-port", extBridgeName, rampExt,
+		"--", "set", "Interface", rampInt, "type=patch", "options:peer="+rampExt, "external-ids:iface-id="+portName,
+		"--", "set", "Interface", rampExt, "type=patch", "options:peer="+rampInt)
+	if err != nil {
+		return fmt.Errorf("failed to create hybrid overlay bridge patch ports for network %s"+
+			", stderr:%s (%v)", network.name, stderr, err)
+	}
+
+	cookie := network.cookie()
+	drMACRaw := strings.Replace(network.drMAC.String(), ":", "", -1)
+	var flows []string
+	// Answer the ARP requests from OVN for the network distributed router IP
+	flows = append(flows,
+		fmt.Sprintf("cookie=0x%s,table=0,priority=100,in_port=%s,arp_op=1,arp,arp_tpa=%s,"+
+			"actions=move:NXM_OF_ETH_SRC[]->NXM_OF_ETH_DST[],"+
+			"mod_dl_src:%s,"+
+			"load:0x2->NXM_OF_ARP_OP[],"+
+			"move:NXM_NX_ARP_SHA[]->NXM_NX_ARP_THA[],"+
+			"move:NXM_OF_ARP_SPA[]->NXM_OF_ARP_TPA[],"+
+			"load:0x%s->NXM_NX_ARP_SHA[],"+
+			"load:0x%s->NXM_OF_ARP_SPA[],"+
+			"IN_PORT",
+			cookie, rampExt, network.drIP, network.drMAC, drMACRaw, getIPAsHexString(network.drIP)))
+	// Send incoming VXLAN traffic for the network to the pod dispatch table
+	flows = append(flows,
+		fmt.Sprintf("cookie=0x%s,table=0,priority=100,in_port="+extVXLANName+",ip,nw_dst=%s,dl_dst=%s,actions=goto_table:10",
+			cookie, network.subnet, network.drMAC))
+
+	n.updateFlowCacheEntry(cookie, flows, false)
+	klog.Infof("Hybrid overlay set up for network %s on node %s", network.name, n.nodeName)
+	return nil
+}
+
+// deleteNetwork tears down the local node's hybrid overlay port of the network
+func (n *NodeController) deleteNetwork(network *hybridOverlayNetwork) error {
+	_, stderr, err := util.RunOVSVsctl("--if-exists", "del-port", "br-int", network.intPortName(),
+		"--", "--if-exists", "del-port", extBridgeName, network.extPortName())
+	if err != nil {
+		return fmt.Errorf("failed to delete hybrid overlay bridge patch ports for network %s"+
+			", stderr:%s (%v)", network.name, stderr, err)
+	}
+	n.deleteFlowsByCookie(network.cookie())
+	klog.Infof("Hybrid overlay torn down for network %s on node %s", network.name, n.nodeName)
+	return nil
+}
+
+type podNetworkDetails struct {
+	network *hybridOverlayNetwork
+	ip      net.IP
+	mac     net.HardwareAddr
+}
+
+// getPodNetworkDetails returns the IPs the pod has on the hybrid overlay
+// enabled user defined networks. The caller must hold the controller lock.
+func (n *NodeController) getPodNetworkDetails(pod *kapi.Pod) []podNetworkDetails {
+	if len(n.networks) == 0 {
+		return nil
+	}
+	var details []podNetworkDetails
+	podNetworks, _ := util.UnmarshalPodAnnotationAllNetworks(pod.Annotations)
+	for nadName := range podNetworks {
+		if nadName == types.DefaultNetworkName {
+			continue
+		}
+		podInfo, err := util.UnmarshalPodAnnotation(pod.Annotations, nadName)
+		if err != nil {
+			klog.Warningf("Failed to get pod %s/%s annotation for %s: %v", pod.Namespace, pod.Name, nadName, err)
+			continue
+		}
+		for _, podIP := range podInfo.IPs {
+			for _, network := range n.networks {
+				if network.subnet.Contains(podIP.IP) {
+					details = append(details, podNetworkDetails{network: network, ip: podIP.IP, mac: podInfo.MAC})
+					break
+				}
+			}
+		}
+	}
+	return details
+}
+
+// getPodNetworkIPs returns the IPs the pod has on the user defined networks
+func getPodNetworkIPs(pod *kapi.Pod) []net.IP {
+	var ips []net.IP
+	podNetworks, _ := util.UnmarshalPodAnnotationAllNetworks(pod.Annotations)
+	for nadName := range podNetworks {
+		if nadName == types.DefaultNetworkName {
+			continue
+		}
+		podInfo, err := util.UnmarshalPodAnnotation(pod.Annotations, nadName)
+		if err != nil {
+			continue
+		}
+		for _, podIP := range podInfo.IPs {
+			ips = append(ips, podIP.IP)
+		}
+	}
+	return ips
+}
@@ -22,6 +22,10 @@ const (
 	HybridOverlayDRMAC = HybridOverlayAnnotationBase + "distributed-router-gateway-mac"
 	// HybridOverlayDRIP holds the port address to redirect traffic to get to the hybrid overlay
 	HybridOverlayDRIP = HybridOverlayAnnotationBase + "distributed-router-gateway-ip"
+	// HybridOverlayNetworkDRIPs holds the port addresses to redirect traffic to get to the hybrid overlay from
+	// the user defined networks, as a map of network names to addresses. The MAC address of the port is derived
+	// from its address.
+	HybridOverlayNetworkDRIPs = HybridOverlayAnnotationBase + "network-distributed-router-gateway-ips"
 	// HybridOverlayVNI is the VNI for VXLAN tunnels between nodes/endpoints
 	HybridOverlayVNI = 4097
 )
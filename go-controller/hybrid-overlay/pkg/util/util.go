@@ -1,6 +1,7 @@
 package util
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 
@@ -45,3 +46,58 @@ func GetNodeInternalIP(node *kapi.Node) (string, error) {
 	}
 	return "", fmt.Errorf("failed to read node %q InternalIP", node.Name)
 }
+
+// ParseHybridOverlayNetworkDRIPs returns the hybrid overlay distributed router
+// IPs of the user defined networks of the node, by network name
+func ParseHybridOverlayNetworkDRIPs(node *kapi.Node) (map[string]net.IP, error) {
+	drIPs := map[string]net.IP{}
+	annotation, ok := node.Annotations[types.HybridOverlayNetworkDRIPs]
+	if !ok {
+		return drIPs, nil
+	}
+	rawDRIPs := map[string]string{}
+	if err := json.Unmarshal([]byte(annotation), &rawDRIPs); err != nil {
+		return nil, fmt.Errorf("error parsing node %s annotation %s value %q: %v",
+			node.Name, types.HybridOverlayNetworkDRIPs, annotation, err)
+	}
+	for netName, rawDRIP := range rawDRIPs {
+		drIP := net.ParseIP(rawDRIP)
+		if drIP == nil {
+			return nil, fmt.Errorf("error parsing node %s annotation %s: invalid IP %q for network %s",
+				node.Name, types.HybridOverlayNetworkDRIPs, rawDRIP, netName)
+		}
+		drIPs[netName] = drIP
+	}
+	return drIPs, nil
+}
+
+// UpdateHybridOverlayNetworkDRIPs sets the hybrid overlay distributed router
+// IP of the given network in the annotations, or removes it if drIP is nil
+func UpdateHybridOverlayNetworkDRIPs(annotations map[string]string, netName string, drIP net.IP) (map[string]string, error) {
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	rawDRIPs := map[string]string{}
+	if annotation, ok := annotations[types.HybridOverlayNetworkDRIPs]; ok {
+		if err := json.Unmarshal([]byte(annotation), &rawDRIPs); err != nil {
+			return nil, fmt.Errorf("error parsing annotation %s value %q: %v",
+				types.HybridOverlayNetworkDRIPs, annotation, err)
+		}
+	}
+	if drIP != nil {
+		rawDRIPs[netName] = drIP.String()
+	} else {
+		delete(rawDRIPs, netName)
+	}
+
+	if len(rawDRIPs) == 0 {
+		delete(annotations, types.HybridOverlayNetworkDRIPs)
+		return annotations, nil
+	}
+	bytes, err := json.Marshal(rawDRIPs)
+	if err != nil {
+		return nil, err
+	}
+	annotations[types.HybridOverlayNetworkDRIPs] = string(bytes)
+	return annotations, nil
+}
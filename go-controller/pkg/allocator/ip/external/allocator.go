@@ -0,0 +1,353 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	ipallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// requestTimeout bounds the time waiting for the external IPAM to answer a
+// request
+const requestTimeout = 10 * time.Second
+
+type subnetInfo struct {
+	subnets        []*net.IPNet
+	excludeSubnets []*net.IPNet
+}
+
+// allocator implements subnet.Allocator on top of an external IPAM provider.
+// It keeps track of the subnets of each subnet set, which are passed along to
+// the provider, and of the IPs in use by its callers, to release the ones the
+// provider holds that are no longer in use; the provider is the one keeping
+// track of the allocated IPs. Subnet set names are passed to the provider as
+// the network name.
+type allocator struct {
+	provider Provider
+	cache    map[string]subnetInfo
+	// inUse are the IPs of each subnet set allocated through the allocator
+	// and not released since
+	inUse map[string]sets.Set[string]
+	sync.RWMutex
+}
+
+// NewAllocator returns a subnet allocator delegating the allocation of IPs to
+// the given provider.
+func NewAllocator(provider Provider) subnet.Allocator {
+	return &allocator{
+		provider: provider,
+		cache:    map[string]subnetInfo{},
+		inUse:    map[string]sets.Set[string]{},
+	}
+}
+
+// AddOrUpdateSubnet sets the subnets of the given subnet set
+func (allocator *allocator) AddOrUpdateSubnet(name string, subnets []*net.IPNet, excludeSubnets ...*net.IPNet) error {
+	for _, excludeSubnet := range excludeSubnets {
+		var excluded bool
+		for _, subnet := range subnets {
+			if util.ContainsCIDR(subnet, excludeSubnet) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			return fmt.Errorf("failed to exclude subnet %s for %s: not contained in any of the subnets", excludeSubnet, name)
+		}
+	}
+	allocator.Lock()
+	defer allocator.Unlock()
+	allocator.cache[name] = subnetInfo{
+		subnets:        subnets,
+		excludeSubnets: excludeSubnets,
+	}
+	if _, ok := allocator.inUse[name]; !ok {
+		allocator.inUse[name] = sets.New[string]()
+	}
+	return nil
+}
+
+// DeleteSubnet from the allocator, releasing all the IPs the provider
+// allocated from the subnet set.
+func (allocator *allocator) DeleteSubnet(name string) {
+	allocator.Lock()
+	defer allocator.Unlock()
+	info, ok := allocator.cache[name]
+	if !ok {
+		return
+	}
+	delete(allocator.cache, name)
+	delete(allocator.inUse, name)
+	if err := allocator.releaseIPs(name, info, nil); err != nil {
+		klog.Errorf("Failed to release the IPs of %s to the external IPAM: %v", name, err)
+	}
+}
+
+// ReconcileIPs releases the IPs the provider allocated from the subnet set
+// that were not allocated through the allocator since it was created, i.e.
+// IPs leaked while no allocator was running. It is meant to be called once
+// the IPs in use have been allocated again, before any new allocation.
+func (allocator *allocator) ReconcileIPs(name string) error {
+	allocator.Lock()
+	defer allocator.Unlock()
+	info, ok := allocator.cache[name]
+	if !ok {
+		return fmt.Errorf("failed to reconcile IPs of %s: %w", name, subnet.ErrSubnetNotFound)
+	}
+	return allocator.releaseIPs(name, info, allocator.inUse[name])
+}
+
+// releaseIPs releases the IPs the provider allocated from the subnets of the
+// subnet set, but the ones in use
+func (allocator *allocator) releaseIPs(name string, info subnetInfo, inUse sets.Set[string]) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	ips, err := allocator.provider.ListIPs(ctx, name)
+	if err != nil {
+		return err
+	}
+	var release []*net.IPNet
+	for _, ip := range ips {
+		if inUse.Has(ip.IP.String()) || !containsIP(info.subnets, ip) {
+			continue
+		}
+		release = append(release, ip)
+	}
+	if len(release) == 0 {
+		return nil
+	}
+	klog.Infof("Releasing IPs %v of %s no longer in use to the external IPAM", util.StringSlice(release), name)
+	return allocator.provider.ReleaseIPs(ctx, name, release)
+}
+
+// GetSubnets of a given subnet set
+func (allocator *allocator) GetSubnets(name string) ([]*net.IPNet, error) {
+	info, err := allocator.getSubnetInfo(name)
+	if err != nil {
+		return nil, err
+	}
+	subnets := make([]*net.IPNet, len(info.subnets))
+	for i, subnet := range info.subnets {
+		subnet := *subnet
+		subnets[i] = &subnet
+	}
+	return subnets, nil
+}
+
+// AllocateUntilFull is not supported with an external IPAM
+func (allocator *allocator) AllocateUntilFull(name string) error {
+	return fmt.Errorf("failed to allocate IPs for subnet %s: not supported by external IPAM", name)
+}
+
+// AllocateIPPerSubnet allocates the given IPs from the provider. ips *must*
+// feature a single IP on each of the subnets of the subnet set.
+func (allocator *allocator) AllocateIPPerSubnet(name string, ips []*net.IPNet) error {
+	if len(ips) == 0 {
+		return fmt.Errorf("failed to allocate IPs for %s: no IPs provided", name)
+	}
+	info, err := allocator.getSubnetInfo(name)
+	if err != nil {
+		return fmt.Errorf("failed to allocate IPs %v for %s: %w", util.StringSlice(ips), name, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	err = allocator.provider.AllocateIPs(ctx, name, info.subnets, ips)
+	if err != nil && !ipallocator.IsErrAllocated(err) {
+		return err
+	}
+	// IPs already allocated are in use as well, by the caller or by others
+	allocator.trackIPs(name, ips, true)
+	return err
+}
+
+// AllocateNextIPs allocates an IP from each of the subnets of the subnet set
+// from the provider.
+func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
+	info, err := allocator.getSubnetInfo(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate new IPs for %s: %w", name, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	ips, err := allocator.provider.AllocateNextIPs(ctx, name, info.subnets, info.excludeSubnets)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIPs(ips, info); err != nil {
+		// don't leak what we got
+		_ = allocator.provider.ReleaseIPs(ctx, name, ips)
+		return nil, fmt.Errorf("failed to allocate new IPs for %s: invalid IPs %v from external IPAM: %w",
+			name, util.StringSlice(ips), err)
+	}
+	allocator.trackIPs(name, ips, true)
+	return ips, nil
+}
+
+// ReleaseIPs releases the given IPs to the provider. If there aren't IPs to
+// release the method does not return an error.
+func (allocator *allocator) ReleaseIPs(name string, ips []*net.IPNet) error {
+	if len(ips) == 0 || name == "" {
+		return nil
+	}
+	if _, err := allocator.getSubnetInfo(name); err != nil {
+		return fmt.Errorf("failed to release ips for %s: %w", name, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	if err := allocator.provider.ReleaseIPs(ctx, name, ips); err != nil {
+		return err
+	}
+	allocator.trackIPs(name, ips, false)
+	return nil
+}
+
+// ConditionalIPRelease returns the result of the predicate if any of the IPs
+// belongs to the subnet set. As opposed to the in-process allocator, the
+// provider is not asked whether the IPs are allocated.
+func (allocator *allocator) ConditionalIPRelease(name string, ips []*net.IPNet, predicate func() (bool, error)) (bool, error) {
+	if len(ips) == 0 || name == "" {
+		return false, nil
+	}
+	info, err := allocator.getSubnetInfo(name)
+	if err != nil {
+		return false, nil
+	}
+	for _, ip := range ips {
+		if containsIP(info.subnets, ip) {
+			return predicate()
+		}
+	}
+	return false, nil
+}
+
+// ForSubnet returns an IP allocator for the specified subnet set
+func (allocator *allocator) ForSubnet(name string) subnet.NamedAllocator {
+	return &namedAllocator{
+		name:      name,
+		allocator: allocator,
+	}
+}
+
+// GetSubnetName returns the name of the subnet set that contains one of the
+// given subnets, if any
+func (allocator *allocator) GetSubnetName(subnets []*net.IPNet) (string, bool) {
+	allocator.RLock()
+	defer allocator.RUnlock()
+	for _, s := range subnets {
+		for name, info := range allocator.cache {
+			for _, subnet := range info.subnets {
+				if subnet.Contains(s.IP) {
+					return name, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// Usage returns the number of used and free IPs of each of the subnets of the
+// given subnet set, as reported by the provider
+func (allocator *allocator) Usage(name string) ([]subnet.SubnetUsage, error) {
+	info, err := allocator.getSubnetInfo(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage of %s: %w", name, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return allocator.provider.Usage(ctx, name, info.subnets)
+}
+
+func (allocator *allocator) getSubnetInfo(name string) (subnetInfo, error) {
+	allocator.RLock()
+	defer allocator.RUnlock()
+	info, ok := allocator.cache[name]
+	if !ok {
+		return subnetInfo{}, subnet.ErrSubnetNotFound
+	}
+	if len(info.subnets) == 0 {
+		return subnetInfo{}, errors.New("has no subnets")
+	}
+	return info, nil
+}
+
+// trackIPs records whether the given IPs of the subnet set are in use
+func (allocator *allocator) trackIPs(name string, ips []*net.IPNet, inUse bool) {
+	allocator.Lock()
+	defer allocator.Unlock()
+	if _, ok := allocator.inUse[name]; !ok {
+		return
+	}
+	for _, ip := range ips {
+		if inUse {
+			allocator.inUse[name].Insert(ip.IP.String())
+		} else {
+			allocator.inUse[name].Delete(ip.IP.String())
+		}
+	}
+}
+
+// validateIPs checks that the IPs allocated by the provider hold an IP of
+// each of the subnets of the subnet set, with the mask of the subnet, and
+// none from the excluded subnets
+func validateIPs(ips []*net.IPNet, info subnetInfo) error {
+	if len(ips) != len(info.subnets) {
+		return fmt.Errorf("expected %d IPs, got %d", len(info.subnets), len(ips))
+	}
+	allocated := make([]bool, len(info.subnets))
+	for _, ip := range ips {
+		i := slices.IndexFunc(info.subnets, func(subnet *net.IPNet) bool { return subnet.Contains(ip.IP) })
+		if i < 0 {
+			return fmt.Errorf("IP %s is not in any of the subnets %v", ip, util.StringSlice(info.subnets))
+		}
+		if allocated[i] {
+			return fmt.Errorf("more than one IP in subnet %s", info.subnets[i])
+		}
+		allocated[i] = true
+		if ip.IP.Equal(info.subnets[i].IP) || ip.Mask.String() != info.subnets[i].Mask.String() {
+			return fmt.Errorf("IP %s is not a host address of subnet %s", ip, info.subnets[i])
+		}
+		if containsIP(info.excludeSubnets, ip) {
+			return fmt.Errorf("IP %s is excluded", ip)
+		}
+	}
+	return nil
+}
+
+func containsIP(subnets []*net.IPNet, ip *net.IPNet) bool {
+	for _, subnet := range subnets {
+		if subnet.Contains(ip.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+type namedAllocator struct {
+	allocator *allocator
+	name      string
+}
+
+// AllocateIPs allocates the requested IPs
+func (ipAllocator *namedAllocator) AllocateIPs(ips []*net.IPNet) error {
+	return ipAllocator.allocator.AllocateIPPerSubnet(ipAllocator.name, ips)
+}
+
+// AllocateNextIPs allocates the next available IPs
+func (ipAllocator *namedAllocator) AllocateNextIPs() ([]*net.IPNet, error) {
+	return ipAllocator.allocator.AllocateNextIPs(ipAllocator.name)
+}
+
+// ReleaseIPs release the provided IPs
+func (ipAllocator *namedAllocator) ReleaseIPs(ips []*net.IPNet) error {
+	return ipAllocator.allocator.ReleaseIPs(ipAllocator.name, ips)
+}
@@ -0,0 +1,200 @@
+package external
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	ipam "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("External IP allocator operations", func() {
+	const subnetName = "network1"
+	var (
+		allocator subnet.Allocator
+		provider  Provider
+		server    *grpc.Server
+	)
+
+	ginkgo.BeforeEach(func() {
+		// serve the fake provider over gRPC so that requests go through the
+		// same client and server adapters external plugins would use
+		socket := filepath.Join(ginkgo.GinkgoT().TempDir(), "ipam.sock")
+		listener, err := net.Listen("unix", socket)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		server = grpc.NewServer()
+		RegisterProvider(server, NewFakeProvider())
+		go func() {
+			defer ginkgo.GinkgoRecover()
+			// the server might be stopped before serving for tests that
+			// don't send any request
+			err := server.Serve(listener)
+			gomega.Expect(err).To(gomega.Or(gomega.Succeed(), gomega.MatchError(grpc.ErrServerStopped)))
+		}()
+		grpcProvider, err := NewGRPCProvider("unix://"+socket, nil)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		ginkgo.DeferCleanup(grpcProvider.Close)
+		provider = grpcProvider
+		allocator = NewAllocator(provider)
+	})
+
+	ginkgo.AfterEach(func() {
+		server.Stop()
+	})
+
+	listIPs := func() []string {
+		ips, err := provider.ListIPs(context.Background(), subnetName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return util.StringSlice(ips)
+	}
+
+	ginkgo.It("allocates an IP from each subnet", func() {
+		err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24", "2000::/64"))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		ips, err := allocator.AllocateNextIPs(subnetName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.1/24", "2000::1/64"}))
+
+		ips, err = allocator.ForSubnet(subnetName).AllocateNextIPs()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.2/24", "2000::2/64"}))
+	})
+
+	ginkgo.It("does not allocate excluded IPs", func() {
+		err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24"), ovntest.MustParseIPNets("10.1.1.0/30")...)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		ips, err := allocator.AllocateNextIPs(subnetName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.4/24"}))
+	})
+
+	ginkgo.It("fails to exclude subnets not contained in the subnets", func() {
+		err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24"), ovntest.MustParseIPNets("10.1.2.0/30")...)
+		gomega.Expect(err).To(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("allocates and releases the requested IPs", func() {
+		err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24"))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		ips := ovntest.MustParseIPNets("10.1.1.10/24")
+		gomega.Expect(allocator.AllocateIPPerSubnet(subnetName, ips)).To(gomega.Succeed())
+		err = allocator.AllocateIPPerSubnet(subnetName, ips)
+		gomega.Expect(err).To(gomega.MatchError(ipam.ErrAllocated))
+
+		gomega.Expect(allocator.ReleaseIPs(subnetName, ips)).To(gomega.Succeed())
+		gomega.Expect(allocator.AllocateIPPerSubnet(subnetName, ips)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("reports full subnets and their usage", func() {
+		err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/30"))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		ips, err := allocator.AllocateNextIPs(subnetName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.1/30"}))
+		_, err = allocator.AllocateNextIPs(subnetName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = allocator.AllocateNextIPs(subnetName)
+		gomega.Expect(err).To(gomega.MatchError(ipam.ErrFull))
+
+		usage, err := allocator.Usage(subnetName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(usage).To(gomega.HaveLen(1))
+		gomega.Expect(usage[0].Subnet.String()).To(gomega.Equal("10.1.1.0/30"))
+		gomega.Expect(usage[0].Used).To(gomega.Equal(2))
+		gomega.Expect(usage[0].Free).To(gomega.Equal(0))
+	})
+
+	ginkgo.It("rejects IPs of the provider outside of the subnets or excluded", func() {
+		subnets := ovntest.MustParseIPNets("10.1.1.0/24")
+		excludeSubnets := ovntest.MustParseIPNets("10.1.1.0/30")
+		for _, ips := range [][]*net.IPNet{
+			ovntest.MustParseIPNets("10.1.2.10/24"),
+			ovntest.MustParseIPNets("10.1.1.2/24"),
+			ovntest.MustParseIPNets("10.1.1.10/16"),
+			ovntest.MustParseIPNets("10.1.1.10/24", "10.1.1.11/24"),
+		} {
+			invalid := &invalidProvider{Provider: provider, ips: ips}
+			allocator := NewAllocator(invalid)
+			err := allocator.AddOrUpdateSubnet(subnetName, subnets, excludeSubnets...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			_, err = allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(invalid.released).To(gomega.Equal(ips))
+		}
+	})
+
+	ginkgo.It("releases all the IPs of deleted subnet sets", func() {
+		err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24"))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = allocator.AllocateNextIPs(subnetName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(allocator.AllocateIPPerSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.10/24"))).To(gomega.Succeed())
+		gomega.Expect(listIPs()).To(gomega.ConsistOf("10.1.1.1/24", "10.1.1.10/24"))
+
+		allocator.DeleteSubnet(subnetName)
+		gomega.Expect(listIPs()).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("releases the IPs no longer in use on reconcile", func() {
+		err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24"))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		ips := ovntest.MustParseIPNets("10.1.1.10/24", "10.1.1.11/24")
+		for _, ip := range ips {
+			gomega.Expect(allocator.AllocateIPPerSubnet(subnetName, []*net.IPNet{ip})).To(gomega.Succeed())
+		}
+
+		ginkgo.By("syncing a new allocator with only one of the IPs still in use")
+		allocator = NewAllocator(provider)
+		err = allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24"))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		err = allocator.AllocateIPPerSubnet(subnetName, ips[:1])
+		gomega.Expect(err).To(gomega.MatchError(ipam.ErrAllocated))
+
+		gomega.Expect(allocator.(interface{ ReconcileIPs(string) error }).ReconcileIPs(subnetName)).To(gomega.Succeed())
+		gomega.Expect(listIPs()).To(gomega.ConsistOf("10.1.1.10/24"))
+	})
+
+	ginkgo.It("fails for unknown subnet sets", func() {
+		_, err := allocator.AllocateNextIPs(subnetName)
+		gomega.Expect(err).To(gomega.MatchError(subnet.ErrSubnetNotFound))
+
+		name, found := allocator.GetSubnetName(ovntest.MustParseIPNets("10.1.1.10/24"))
+		gomega.Expect(found).To(gomega.BeFalse())
+		gomega.Expect(name).To(gomega.BeEmpty())
+	})
+})
+
+// invalidProvider allocates the given IPs, whatever the subnets
+type invalidProvider struct {
+	Provider
+	ips      []*net.IPNet
+	released []*net.IPNet
+}
+
+func (p *invalidProvider) AllocateNextIPs(context.Context, string, []*net.IPNet, []*net.IPNet) ([]*net.IPNet, error) {
+	return p.ips, nil
+}
+
+func (p *invalidProvider) ReleaseIPs(_ context.Context, _ string, ips []*net.IPNet) error {
+	p.released = ips
+	return nil
+}
+
+func TestExternalAllocator(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "External IP allocator Operations Suite")
+}
@@ -0,0 +1,138 @@
+package external
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sync"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
+)
+
+// FakeProvider is a local provider keeping the allocations in memory with the
+// in-process IP allocator. Served with RegisterProvider, it can stand in for an
+// external IPAM plugin.
+type FakeProvider struct {
+	sync.Mutex
+	allocator subnet.Allocator
+	// subnets of each of the networks the allocator was set up with
+	subnets map[string][]*net.IPNet
+	// IPs allocated for each of the networks
+	allocated map[string]map[string]*net.IPNet
+}
+
+// NewFakeProvider returns a new FakeProvider
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		allocator: subnet.NewAllocator(),
+		subnets:   map[string][]*net.IPNet{},
+		allocated: map[string]map[string]*net.IPNet{},
+	}
+}
+
+// ensureNetwork sets up the allocator for the network if it was not yet, or
+// if its subnets changed, in which case existing allocations are lost
+func (p *FakeProvider) ensureNetwork(network string, subnets []*net.IPNet) error {
+	if current, ok := p.subnets[network]; ok && reflect.DeepEqual(current, subnets) {
+		return nil
+	}
+	if err := p.allocator.AddOrUpdateSubnet(network, subnets); err != nil {
+		return err
+	}
+	p.subnets[network] = subnets
+	p.allocated[network] = map[string]*net.IPNet{}
+	return nil
+}
+
+func (p *FakeProvider) track(network string, ips []*net.IPNet, allocated bool) {
+	for _, ip := range ips {
+		if allocated {
+			p.allocated[network][ip.IP.String()] = ip
+		} else {
+			delete(p.allocated[network], ip.IP.String())
+		}
+	}
+}
+
+func (p *FakeProvider) AllocateNextIPs(_ context.Context, network string, subnets, excludeSubnets []*net.IPNet) ([]*net.IPNet, error) {
+	p.Lock()
+	defer p.Unlock()
+	if err := p.ensureNetwork(network, subnets); err != nil {
+		return nil, err
+	}
+	for {
+		ips, err := p.allocator.AllocateNextIPs(network)
+		if err != nil {
+			return nil, err
+		}
+		var valid []*net.IPNet
+		for _, ip := range ips {
+			if !isExcluded(ip, excludeSubnets) {
+				valid = append(valid, ip)
+			}
+		}
+		if len(valid) == len(ips) {
+			p.track(network, ips, true)
+			return ips, nil
+		}
+		// leave excluded IPs allocated so that they are not handed out
+		// again, and try again until we get a valid set or run out of IPs
+		if err := p.allocator.ReleaseIPs(network, valid); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *FakeProvider) AllocateIPs(_ context.Context, network string, subnets, ips []*net.IPNet) error {
+	p.Lock()
+	defer p.Unlock()
+	if err := p.ensureNetwork(network, subnets); err != nil {
+		return err
+	}
+	if err := p.allocator.AllocateIPPerSubnet(network, ips); err != nil {
+		return err
+	}
+	p.track(network, ips, true)
+	return nil
+}
+
+func (p *FakeProvider) ReleaseIPs(_ context.Context, network string, ips []*net.IPNet) error {
+	p.Lock()
+	defer p.Unlock()
+	if _, ok := p.subnets[network]; !ok {
+		return nil
+	}
+	if err := p.allocator.ReleaseIPs(network, ips); err != nil {
+		return err
+	}
+	p.track(network, ips, false)
+	return nil
+}
+
+func (p *FakeProvider) Usage(_ context.Context, network string, subnets []*net.IPNet) ([]subnet.SubnetUsage, error) {
+	p.Lock()
+	defer p.Unlock()
+	if err := p.ensureNetwork(network, subnets); err != nil {
+		return nil, err
+	}
+	return p.allocator.Usage(network)
+}
+
+func (p *FakeProvider) ListIPs(_ context.Context, network string) ([]*net.IPNet, error) {
+	p.Lock()
+	defer p.Unlock()
+	ips := make([]*net.IPNet, 0, len(p.allocated[network]))
+	for _, ip := range p.allocated[network] {
+		ips = append(ips, ip)
+	}
+	return ips, nil
+}
+
+func isExcluded(ip *net.IPNet, excludeSubnets []*net.IPNet) bool {
+	for _, excludeSubnet := range excludeSubnets {
+		if excludeSubnet.Contains(ip.IP) {
+			return true
+		}
+	}
+	return false
+}
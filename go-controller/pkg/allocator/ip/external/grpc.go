@@ -0,0 +1,277 @@
+package external
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	ipallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/external/ipamv1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// GRPCProvider is a provider forwarding the requests to an external IPAM
+// plugin implementing the ipamv1.IPAM gRPC service.
+type GRPCProvider struct {
+	conn   *grpc.ClientConn
+	client ipamv1.IPAMClient
+}
+
+// NewGRPCProvider returns a provider forwarding the requests to the external
+// IPAM plugin listening on the given gRPC target, for example
+// unix:///var/run/ovn-kubernetes/ipam/netbox.sock. The connection is secured
+// with tlsConfig if provided, which can only be omitted for plugins running
+// alongside cluster manager.
+func NewGRPCProvider(target string, tlsConfig *tls.Config) (*GRPCProvider, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client of external IPAM %s: %w", target, err)
+	}
+	return &GRPCProvider{conn: conn, client: ipamv1.NewIPAMClient(conn)}, nil
+}
+
+// NewTLSConfig returns the TLS configuration to connect to external IPAM
+// plugins whose certificate is signed by the given CA. The client certificate
+// and key are optional, for plugins authenticating cluster manager.
+func NewTLSConfig(caCertFile, certFile, keyFile string) (*tls.Config, error) {
+	caCert, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read external IPAM CA certificate: %w", err)
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no certificate found in external IPAM CA certificate %s", caCertFile)
+	}
+	tlsConfig := &tls.Config{
+		RootCAs:    caCertPool,
+		MinVersion: tls.VersionTLS12,
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load external IPAM client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// Close closes the connection to the plugin
+func (p *GRPCProvider) Close() error {
+	return p.conn.Close()
+}
+
+func (p *GRPCProvider) AllocateNextIPs(ctx context.Context, network string, subnets, excludeSubnets []*net.IPNet) ([]*net.IPNet, error) {
+	resp, err := p.client.AllocateNextIPs(ctx, &ipamv1.AllocateNextIPsRequest{
+		Network:        network,
+		Subnets:        util.StringSlice(subnets),
+		ExcludeSubnets: util.StringSlice(excludeSubnets),
+	})
+	if err != nil {
+		return nil, fromStatus(fmt.Sprintf("failed to allocate new IPs for %s from external IPAM", network), err)
+	}
+	ips, err := util.ParseIPNets(resp.Ips)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IPs allocated for %s by external IPAM: %w", network, err)
+	}
+	return ips, nil
+}
+
+func (p *GRPCProvider) AllocateIPs(ctx context.Context, network string, subnets, ips []*net.IPNet) error {
+	_, err := p.client.AllocateIPs(ctx, &ipamv1.AllocateIPsRequest{
+		Network: network,
+		Subnets: util.StringSlice(subnets),
+		Ips:     util.StringSlice(ips),
+	})
+	if err != nil {
+		return fromStatus(fmt.Sprintf("failed to allocate IPs %v for %s from external IPAM", util.StringSlice(ips), network), err)
+	}
+	return nil
+}
+
+func (p *GRPCProvider) ReleaseIPs(ctx context.Context, network string, ips []*net.IPNet) error {
+	_, err := p.client.ReleaseIPs(ctx, &ipamv1.ReleaseIPsRequest{
+		Network: network,
+		Ips:     util.StringSlice(ips),
+	})
+	if err != nil {
+		return fromStatus(fmt.Sprintf("failed to release IPs %v for %s to external IPAM", util.StringSlice(ips), network), err)
+	}
+	return nil
+}
+
+func (p *GRPCProvider) Usage(ctx context.Context, network string, subnets []*net.IPNet) ([]subnet.SubnetUsage, error) {
+	resp, err := p.client.Usage(ctx, &ipamv1.UsageRequest{
+		Network: network,
+		Subnets: util.StringSlice(subnets),
+	})
+	if err != nil {
+		return nil, fromStatus(fmt.Sprintf("failed to get usage of %s from external IPAM", network), err)
+	}
+	usage := make([]subnet.SubnetUsage, 0, len(resp.Subnets))
+	for _, s := range resp.Subnets {
+		_, cidr, err := net.ParseCIDR(s.Subnet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subnet in usage of %s reported by external IPAM: %w", network, err)
+		}
+		usage = append(usage, subnet.SubnetUsage{Subnet: cidr, Used: int(s.Used), Free: int(s.Free)})
+	}
+	return usage, nil
+}
+
+func (p *GRPCProvider) ListIPs(ctx context.Context, network string) ([]*net.IPNet, error) {
+	resp, err := p.client.ListIPs(ctx, &ipamv1.ListIPsRequest{
+		Network: network,
+	})
+	if err != nil {
+		return nil, fromStatus(fmt.Sprintf("failed to list IPs of %s from external IPAM", network), err)
+	}
+	ips, err := util.ParseIPNets(resp.Ips)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IPs listed for %s by external IPAM: %w", network, err)
+	}
+	return ips, nil
+}
+
+// fromStatus maps the status of a failed request to the errors of the IP
+// allocator
+func fromStatus(msg string, err error) error {
+	switch status.Code(err) {
+	case codes.AlreadyExists:
+		return fmt.Errorf("%s: %w: %v", msg, ipallocator.ErrAllocated, err)
+	case codes.ResourceExhausted:
+		return fmt.Errorf("%s: %w: %v", msg, ipallocator.ErrFull, err)
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// toStatus maps the errors of the IP allocator to the status of a request
+func toStatus(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ipallocator.ErrAllocated):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ipallocator.ErrFull):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// server serves the ipamv1.IPAM gRPC service from a provider
+type server struct {
+	ipamv1.UnimplementedIPAMServer
+	provider Provider
+}
+
+// RegisterProvider registers the given provider as the implementation of the
+// ipamv1.IPAM service of the gRPC server. This allows external IPAM plugins to
+// be written by implementing Provider.
+func RegisterProvider(s *grpc.Server, provider Provider) {
+	ipamv1.RegisterIPAMServer(s, &server{provider: provider})
+}
+
+func (s *server) AllocateNextIPs(ctx context.Context, req *ipamv1.AllocateNextIPsRequest) (*ipamv1.AllocateNextIPsResponse, error) {
+	subnets, err := parseCIDRs(req.Subnets)
+	if err != nil {
+		return nil, err
+	}
+	excludeSubnets, err := parseCIDRs(req.ExcludeSubnets)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := s.provider.AllocateNextIPs(ctx, req.Network, subnets, excludeSubnets)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &ipamv1.AllocateNextIPsResponse{Ips: util.StringSlice(ips)}, nil
+}
+
+func (s *server) AllocateIPs(ctx context.Context, req *ipamv1.AllocateIPsRequest) (*ipamv1.AllocateIPsResponse, error) {
+	subnets, err := parseCIDRs(req.Subnets)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := parseIPNets(req.Ips)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.provider.AllocateIPs(ctx, req.Network, subnets, ips); err != nil {
+		return nil, toStatus(err)
+	}
+	return &ipamv1.AllocateIPsResponse{}, nil
+}
+
+func (s *server) ReleaseIPs(ctx context.Context, req *ipamv1.ReleaseIPsRequest) (*ipamv1.ReleaseIPsResponse, error) {
+	ips, err := parseIPNets(req.Ips)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.provider.ReleaseIPs(ctx, req.Network, ips); err != nil {
+		return nil, toStatus(err)
+	}
+	return &ipamv1.ReleaseIPsResponse{}, nil
+}
+
+func (s *server) Usage(ctx context.Context, req *ipamv1.UsageRequest) (*ipamv1.UsageResponse, error) {
+	subnets, err := parseCIDRs(req.Subnets)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := s.provider.Usage(ctx, req.Network, subnets)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &ipamv1.UsageResponse{}
+	for _, u := range usage {
+		resp.Subnets = append(resp.Subnets, &ipamv1.SubnetUsage{
+			Subnet: u.Subnet.String(),
+			Used:   int64(u.Used),
+			Free:   int64(u.Free),
+		})
+	}
+	return resp, nil
+}
+
+func (s *server) ListIPs(ctx context.Context, req *ipamv1.ListIPsRequest) (*ipamv1.ListIPsResponse, error) {
+	ips, err := s.provider.ListIPs(ctx, req.Network)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &ipamv1.ListIPsResponse{Ips: util.StringSlice(ips)}, nil
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	subnets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, subnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		subnets = append(subnets, subnet)
+	}
+	return subnets, nil
+}
+
+func parseIPNets(ips []string) ([]*net.IPNet, error) {
+	ipNets, err := util.ParseIPNets(ips)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return ipNets, nil
+}
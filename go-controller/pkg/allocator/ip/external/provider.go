@@ -0,0 +1,32 @@
+// Package external allows the IPs of the pods of a network to be allocated by
+// an external IPAM, for example an IP registry such as NetBox or Infoblox,
+// instead of the in-process bitmap allocator. The external IPAM is reached
+// through a gRPC plugin implementing the ipamv1.IPAM service, and is only
+// ever called from cluster manager.
+package external
+
+import (
+	"context"
+	"net"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
+)
+
+// Provider is an external IPAM answering allocate/release requests for the
+// IPs of a network. Implementations must return ip.ErrAllocated when
+// allocating an IP that is already allocated and ip.ErrFull when a subnet has
+// no IP left.
+type Provider interface {
+	// AllocateNextIPs allocates an IP from each of the given subnets of the
+	// network, other than from the excluded subnets.
+	AllocateNextIPs(ctx context.Context, network string, subnets, excludeSubnets []*net.IPNet) ([]*net.IPNet, error)
+	// AllocateIPs allocates the given IPs from the subnets of the network.
+	AllocateIPs(ctx context.Context, network string, subnets, ips []*net.IPNet) error
+	// ReleaseIPs releases the given IPs of the network.
+	ReleaseIPs(ctx context.Context, network string, ips []*net.IPNet) error
+	// Usage returns the number of used and free IPs of each of the given
+	// subnets of the network.
+	Usage(ctx context.Context, network string, subnets []*net.IPNet) ([]subnet.SubnetUsage, error)
+	// ListIPs returns the IPs allocated for the network.
+	ListIPs(ctx context.Context, network string) ([]*net.IPNet, error)
+}
@@ -27,6 +27,14 @@ type Allocator interface {
 	ConditionalIPRelease(name string, ips []*net.IPNet, predicate func() (bool, error)) (bool, error)
 	ForSubnet(name string) NamedAllocator
 	GetSubnetName(subnets []*net.IPNet) (string, bool)
+	Usage(name string) ([]SubnetUsage, error)
+}
+
+// SubnetUsage is the number of used and free IPs of a subnet
+type SubnetUsage struct {
+	Subnet *net.IPNet
+	Used   int
+	Free   int
 }
 
 // NamedAllocator manages the allocation of IPs within a specific subnet
@@ -140,6 +148,27 @@ func (allocator *allocator) GetSubnets(name string) ([]*net.IPNet, error) {
 	return nil, ErrSubnetNotFound
 }
 
+// Usage returns the number of used and free IPs of each of the subnets of the
+// given subnet set
+func (allocator *allocator) Usage(name string) ([]SubnetUsage, error) {
+	allocator.RLock()
+	defer allocator.RUnlock()
+	subnetInfo, ok := allocator.cache[name]
+	if !ok {
+		return nil, fmt.Errorf("failed to get usage of %s: %w", name, ErrSubnetNotFound)
+	}
+	usage := make([]SubnetUsage, 0, len(subnetInfo.ipams))
+	for _, ipam := range subnetInfo.ipams {
+		cidr := ipam.CIDR()
+		usage = append(usage, SubnetUsage{
+			Subnet: &cidr,
+			Used:   ipam.Used(),
+			Free:   ipam.Free(),
+		})
+	}
+	return usage, nil
+}
+
 // AllocateUntilFull used for unit testing only, allocates the rest of the subnet
 func (allocator *allocator) AllocateUntilFull(name string) error {
 	allocator.RLock()
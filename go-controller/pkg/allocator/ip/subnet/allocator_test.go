@@ -138,6 +138,33 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			}
 		})
 
+		ginkgo.It("reports the usage of each subnet", func() {
+			subnets := []string{
+				"10.1.1.0/24",
+				"2000::/64",
+			}
+
+			err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets(subnets...))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			for i := 0; i < 2; i++ {
+				_, err = allocator.AllocateNextIPs(subnetName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+
+			usage, err := allocator.Usage(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(usage).To(gomega.HaveLen(2))
+			gomega.Expect(usage[0].Subnet.String()).To(gomega.Equal("10.1.1.0/24"))
+			gomega.Expect(usage[0].Used).To(gomega.Equal(2))
+			gomega.Expect(usage[0].Free).To(gomega.Equal(252))
+			gomega.Expect(usage[1].Subnet.String()).To(gomega.Equal("2000::/64"))
+			gomega.Expect(usage[1].Used).To(gomega.Equal(2))
+			gomega.Expect(usage[1].Free).To(gomega.Equal(65533))
+
+			_, err = allocator.Usage("unknown")
+			gomega.Expect(err).To(gomega.MatchError(ErrSubnetNotFound))
+		})
+
 		ginkgo.It("fails to allocate multiple IPs from the same subnet", func() {
 			subnets := []string{"10.1.1.0/24", "2000::/64"}
 
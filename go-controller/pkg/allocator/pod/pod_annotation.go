@@ -321,11 +321,13 @@ func allocatePodAnnotationWithRollback(
 		}
 		hasIPAMClaim = ipamClaim != nil && len(ipamClaim.Status.IPs) > 0
 	}
-	if hasIPAM && hasStaticIPRequest {
+	if hasIPAM && hasStaticIPRequest && !netInfo.IsPrimaryNetwork() {
 		// for now we can't tell apart already allocated IPs from IPs excluded
 		// from allocation so we can't really honor static IP requests when
 		// there is IPAM as we don't really know if the requested IP should not
-		// be allocated or was already allocated by the same pod
+		// be allocated or was already allocated by the same pod. On primary
+		// networks static IP requests are handled as such, and a requested IP
+		// that is already allocated or excluded is a conflict.
 		err = fmt.Errorf("cannot allocate a static IP request with IPAM for pod %s", podDesc)
 		return
 	}
@@ -334,15 +336,20 @@ func allocatePodAnnotationWithRollback(
 	needsIPOrMAC := len(tentative.IPs) == 0 && (hasIPAM || hasIPRequest)
 	needsIPOrMAC = needsIPOrMAC || len(tentative.MAC) == 0
 	reallocateOnNonStaticIPRequest := len(tentative.IPs) == 0 && hasIPRequest && !hasStaticIPRequest
+	// on primary networks, the IPs persisted in the IPAMClaim take precedence
+	// over the requested IPs they originate from, so that they are kept by the
+	// pods of a VM across migrations
+	useIPAMClaim := hasIPAMClaim && (!hasIPRequest || netInfo.IsPrimaryNetwork())
+	allocateStaticIPRequest := len(tentative.IPs) == 0 && hasIPAM && hasStaticIPRequest && !useIPAMClaim
 
 	if len(tentative.IPs) == 0 {
-		if hasIPRequest {
-			tentative.IPs, err = util.ParseIPNets(network.IPRequest)
+		if useIPAMClaim {
+			tentative.IPs, err = util.ParseIPNets(ipamClaim.Status.IPs)
 			if err != nil {
 				return
 			}
-		} else if hasIPAMClaim {
-			tentative.IPs, err = util.ParseIPNets(ipamClaim.Status.IPs)
+		} else if hasIPRequest {
+			tentative.IPs, err = util.ParseIPNets(network.IPRequest)
 			if err != nil {
 				return
 			}
@@ -351,7 +358,13 @@ func allocatePodAnnotationWithRollback(
 
 	if hasIPAM {
 		if len(tentative.IPs) > 0 {
-			if err = ipAllocator.AllocateIPs(tentative.IPs); err != nil && !ip.IsErrAllocated(err) {
+			err = ipAllocator.AllocateIPs(tentative.IPs)
+			if allocateStaticIPRequest && ip.IsErrAllocated(err) {
+				err = fmt.Errorf("requested IPs %v for %s are already in use or excluded: %w",
+					util.StringSlice(tentative.IPs), podDesc, err)
+				return
+			}
+			if err != nil && !ip.IsErrAllocated(err) {
 				err = fmt.Errorf("failed to ensure requested or annotated IPs %v for %s: %w",
 					util.StringSlice(tentative.IPs), podDesc, err)
 				if !reallocateOnNonStaticIPRequest {
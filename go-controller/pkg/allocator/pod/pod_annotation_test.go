@@ -113,6 +113,7 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 		name                      string
 		args                      args
 		ipam                      bool
+		udn                       bool
 		idAllocation              bool
 		persistentIPAllocation    bool
 		role                      string
@@ -202,6 +203,44 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			wantUpdatedPod: true,
 			wantErr:        true,
 		},
+		{
+			// on primary UDNs with IPAM, expect static IP requests to be
+			// honored
+			name: "expect requested static IP, IPAM, primary UDN",
+			ipam: true,
+			udn:  true,
+			args: args{
+				ipAllocator: &ipAllocatorStub{},
+				network: &nadapi.NetworkSelectionElement{
+					IPRequest: []string{"192.168.0.3/24"},
+				},
+			},
+			wantUpdatedPod: true,
+			wantPodAnnotation: &util.PodAnnotation{
+				IPs:  ovntest.MustParseIPNets("192.168.0.3/24"),
+				MAC:  util.IPAddrToHWAddr(ovntest.MustParseIPNets("192.168.0.3/24")[0].IP),
+				Role: types.NetworkRolePrimary,
+			},
+			wantReleasedIPsOnRollback: ovntest.MustParseIPNets("192.168.0.3/24"),
+			role:                      types.NetworkRolePrimary,
+		},
+		{
+			// on primary UDNs with IPAM, expect error if the requested static
+			// IP is already allocated
+			name: "expect error, static IP request already allocated, IPAM, primary UDN",
+			ipam: true,
+			udn:  true,
+			args: args{
+				ipAllocator: &ipAllocatorStub{
+					allocateIPsError: ipam.ErrAllocated,
+				},
+				network: &nadapi.NetworkSelectionElement{
+					IPRequest: []string{"192.168.0.3/24"},
+				},
+			},
+			wantErr: true,
+			role:    types.NetworkRolePrimary,
+		},
 		{
 			// on networks with IPAM, expect a normal IP, MAC and gateway
 			// allocation
@@ -667,12 +706,17 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			var netInfo util.NetInfo
 			netInfo = &util.DefaultNetInfo{}
 			nadName := types.DefaultNetworkName
-			if !tt.ipam || tt.idAllocation || tt.persistentIPAllocation || tt.args.ipamClaim != nil {
+			if !tt.ipam || tt.udn || tt.idAllocation || tt.persistentIPAllocation || tt.args.ipamClaim != nil {
 				nadName = util.GetNADName(network.Namespace, network.Name)
 				var subnets string
 				if tt.ipam {
 					subnets = "192.168.0.0/24"
 				}
+				if tt.udn {
+					// primary UDN subnets need to match the IP mode of the cluster
+					config.IPv4Mode = true
+					defer func() { config.IPv4Mode = false }()
+				}
 				netInfo, err = util.NewNetInfo(&ovncnitypes.NetConf{
 					Topology: types.Layer2Topology,
 					NetConf: cnitypes.NetConf{
@@ -0,0 +1,412 @@
+package clusterlink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"slices"
+	"sort"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	metaapply "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	controllerutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	cltypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	clapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/applyconfiguration/clusterlink/v1"
+	clclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/clientset/versioned"
+	cllisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/listers/clusterlink/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	fieldManager = "clustermanager-clusterlink-controller"
+
+	// kubeconfigSecretKey is the key of the ClusterLink secret holding the
+	// kubeconfig of the remote cluster
+	kubeconfigSecretKey = "kubeconfig"
+
+	// remoteSyncPeriod is the period at which the nodes of the remote cluster
+	// are synced
+	remoteSyncPeriod = 30 * time.Second
+)
+
+var (
+	errConfig = errors.New("configuration error")
+)
+
+// Controller reconciles ClusterLinks
+type Controller struct {
+	clLister   cllisters.ClusterLinkLister
+	nodeLister corelisters.NodeLister
+
+	clClient   clclientset.Interface
+	kubeClient kubernetes.Interface
+
+	clController   controllerutil.Controller
+	nodeController controllerutil.Controller
+
+	// newRemoteClient builds the client of the remote cluster from its
+	// kubeconfig. Overridden in tests.
+	newRemoteClient func(kubeconfig []byte) (kubernetes.Interface, error)
+}
+
+// NewController builds a controller that reconciles ClusterLinks
+func NewController(wf *factory.WatchFactory, ovnClient *util.OVNClusterManagerClientset) *Controller {
+	c := &Controller{
+		clLister:        wf.ClusterLinkInformer().Lister(),
+		nodeLister:      wf.NodeCoreInformer().Lister(),
+		clClient:        ovnClient.ClusterLinkClient,
+		kubeClient:      ovnClient.KubeClient,
+		newRemoteClient: newRemoteClient,
+	}
+
+	clConfig := &controllerutil.ControllerConfig[cltypes.ClusterLink]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcile,
+		Threadiness:    1,
+		Informer:       wf.ClusterLinkInformer().Informer(),
+		Lister:         wf.ClusterLinkInformer().Lister().List,
+		ObjNeedsUpdate: clusterLinkNeedsUpdate,
+	}
+	c.clController = controllerutil.NewController("clustermanager clusterlink controller", clConfig)
+
+	nodeConfig := &controllerutil.ControllerConfig[core.Node]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcileNode,
+		Threadiness:    1,
+		Informer:       wf.NodeCoreInformer().Informer(),
+		Lister:         wf.NodeCoreInformer().Lister().List,
+		ObjNeedsUpdate: nodeNeedsUpdate,
+	}
+	c.nodeController = controllerutil.NewController("clustermanager clusterlink node controller", nodeConfig)
+
+	return c
+}
+
+func (c *Controller) Start() error {
+	defer klog.Infof("Cluster manager clusterlink controller started")
+	return controllerutil.Start(
+		c.clController,
+		c.nodeController,
+	)
+}
+
+func (c *Controller) Stop() {
+	controllerutil.Stop(
+		c.clController,
+		c.nodeController,
+	)
+	klog.Infof("Cluster manager clusterlink controller stopped")
+}
+
+// reconcile a ClusterLink. The nodes of the remote cluster are read with the
+// kubeconfig referenced by the ClusterLink and their interconnect information
+// is published in the ClusterLink status, for ovnkube-controller to connect
+// the local zones to them. The remote cluster is validated to be compatible
+// with the local one:
+//
+// - the pod subnets of the remote nodes don't overlap with the cluster or
+// service subnets of the local cluster
+//
+// - the IDs of the remote nodes don't collide with the IDs of the local nodes
+//
+// - the transit switch IPs of the remote nodes are in the transit switch
+// subnets of the local cluster
+//
+// The nodes of the remote cluster are polled periodically. If they can't be
+// read, the last known remote nodes are kept in the status so that
+// connectivity is not disrupted while the remote API server is unreachable.
+func (c *Controller) reconcile(name string) error {
+	startTime := time.Now()
+	klog.V(5).Infof("Syncing clusterlink %q", name)
+	defer func() {
+		klog.V(4).Infof("Finished syncing clusterlink %q, took %v", name, time.Since(startTime))
+	}()
+
+	cl, err := c.clLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get ClusterLink %q: %w", name, err)
+	}
+
+	remoteNodes, err := c.getRemoteNodes(cl)
+	switch {
+	case errors.Is(err, errConfig):
+		remoteNodes = nil
+	case err != nil:
+		klog.Errorf("Failed to get the remote nodes of ClusterLink %q: %v", name, err)
+		remoteNodes = cl.Status.RemoteNodes
+	}
+
+	// poll the remote cluster again later
+	c.clController.ReconcileAfter(name, remoteSyncPeriod)
+
+	return c.updateStatus(cl, remoteNodes, err)
+}
+
+// getRemoteNodes returns the validated interconnect information of the nodes
+// of the remote cluster, sorted by name. Nodes that have not been fully set up
+// in the remote cluster yet are skipped.
+func (c *Controller) getRemoteNodes(cl *cltypes.ClusterLink) ([]cltypes.RemoteNode, error) {
+	secret, err := c.kubeClient.CoreV1().Secrets(config.Kubernetes.OVNConfigNamespace).Get(context.TODO(), cl.Spec.KubeconfigSecret, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: secret %s/%s not found", errConfig, config.Kubernetes.OVNConfigNamespace, cl.Spec.KubeconfigSecret)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", config.Kubernetes.OVNConfigNamespace, cl.Spec.KubeconfigSecret, err)
+	}
+	kubeconfig, ok := secret.Data[kubeconfigSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("%w: secret %s/%s has no %q key", errConfig, config.Kubernetes.OVNConfigNamespace, cl.Spec.KubeconfigSecret, kubeconfigSecretKey)
+	}
+	remoteClient, err := c.newRemoteClient(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid kubeconfig in secret %s/%s: %v", errConfig, config.Kubernetes.OVNConfigNamespace, cl.Spec.KubeconfigSecret, err)
+	}
+
+	nodes, err := remoteClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the remote nodes: %w", err)
+	}
+
+	localNodeIDs, err := c.getLocalNodeIDs()
+	if err != nil {
+		return nil, err
+	}
+	localSubnets := make([]*net.IPNet, 0, len(config.Default.ClusterSubnets)+len(config.Kubernetes.ServiceCIDRs))
+	for _, clusterSubnet := range config.Default.ClusterSubnets {
+		localSubnets = append(localSubnets, clusterSubnet.CIDR)
+	}
+	localSubnets = append(localSubnets, config.Kubernetes.ServiceCIDRs...)
+	transitSwitchSubnets, err := getTransitSwitchSubnets()
+	if err != nil {
+		return nil, err
+	}
+
+	remoteNodes := make([]cltypes.RemoteNode, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		remoteNode, err := getRemoteNode(node)
+		if err != nil {
+			return nil, err
+		}
+		if remoteNode == nil {
+			klog.V(5).Infof("Skipping remote node %s of ClusterLink %q not set up yet", node.Name, cl.Name)
+			continue
+		}
+		if localNode, ok := localNodeIDs[int(remoteNode.NodeID)]; ok {
+			return nil, fmt.Errorf("%w: remote node %s has the same ID %d as local node %s", errConfig, node.Name, remoteNode.NodeID, localNode)
+		}
+		for _, subnet := range remoteNode.Subnets {
+			_, ipnet, _ := net.ParseCIDR(subnet)
+			for _, localSubnet := range localSubnets {
+				if util.ContainsCIDR(localSubnet, ipnet) || util.ContainsCIDR(ipnet, localSubnet) {
+					return nil, fmt.Errorf("%w: subnet %s of remote node %s overlaps with local subnet %s", errConfig, subnet, node.Name, localSubnet)
+				}
+			}
+		}
+		for _, ip := range remoteNode.TransitSwitchIPs {
+			transitSwitchIP, _, _ := net.ParseCIDR(ip)
+			if !slices.ContainsFunc(transitSwitchSubnets, func(subnet *net.IPNet) bool { return subnet.Contains(transitSwitchIP) }) {
+				return nil, fmt.Errorf("%w: transit switch IP %s of remote node %s is not in the local transit switch subnets", errConfig, ip, node.Name)
+			}
+		}
+		remoteNodes = append(remoteNodes, *remoteNode)
+	}
+
+	sort.Slice(remoteNodes, func(i, j int) bool {
+		return remoteNodes[i].Name < remoteNodes[j].Name
+	})
+	return remoteNodes, nil
+}
+
+// getRemoteNode returns the interconnect information of a remote node, or nil
+// if the node does not have it yet.
+func getRemoteNode(node *core.Node) (*cltypes.RemoteNode, error) {
+	nodeID := util.GetNodeID(node)
+	if nodeID == util.InvalidNodeID {
+		return nil, nil
+	}
+	chassisID, err := util.ParseNodeChassisIDAnnotation(node)
+	if util.IsAnnotationNotSetError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse chassis ID of remote node %s: %w", node.Name, err)
+	}
+	encapIPs, err := util.GetNodeEncapIPs(node)
+	if err != nil {
+		return nil, err
+	}
+	transitSwitchIPs, err := util.ParseNodeTransitSwitchPortAddrs(node)
+	if util.IsAnnotationNotSetError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse transit switch IPs of remote node %s: %w", node.Name, err)
+	}
+	subnets, err := util.ParseNodeHostSubnetAnnotation(node, types.DefaultNetworkName)
+	if util.IsAnnotationNotSetError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse subnets of remote node %s: %w", node.Name, err)
+	}
+
+	remoteNode := &cltypes.RemoteNode{
+		Name:      node.Name,
+		NodeID:    int32(nodeID),
+		ChassisID: chassisID,
+		EncapIPs:  encapIPs,
+	}
+	for _, ip := range transitSwitchIPs {
+		remoteNode.TransitSwitchIPs = append(remoteNode.TransitSwitchIPs, ip.String())
+	}
+	for _, subnet := range subnets {
+		remoteNode.Subnets = append(remoteNode.Subnets, subnet.String())
+	}
+	return remoteNode, nil
+}
+
+// getLocalNodeIDs returns the local node names indexed by their ID
+func (c *Controller) getLocalNodeIDs() (map[int]string, error) {
+	nodes, err := c.nodeLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	nodeIDs := make(map[int]string, len(nodes))
+	for _, node := range nodes {
+		if nodeID := util.GetNodeID(node); nodeID != util.InvalidNodeID {
+			nodeIDs[nodeID] = node.Name
+		}
+	}
+	return nodeIDs, nil
+}
+
+func getTransitSwitchSubnets() ([]*net.IPNet, error) {
+	var subnets []*net.IPNet
+	for _, subnet := range []string{config.ClusterManager.V4TransitSwitchSubnet, config.ClusterManager.V6TransitSwitchSubnet} {
+		if subnet == "" {
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse transit switch subnet %s: %w", subnet, err)
+		}
+		subnets = append(subnets, ipnet)
+	}
+	return subnets, nil
+}
+
+// updateStatus updates the ClusterLink remote nodes and its 'Accepted'
+// status according to the error provided
+func (c *Controller) updateStatus(cl *cltypes.ClusterLink, remoteNodes []cltypes.RemoteNode, err error) error {
+	status := "Accepted"
+	cstatus := metav1.ConditionTrue
+	reason := "Accepted"
+	msg := "ovn-kubernetes cluster-manager validated the remote cluster and published its nodes"
+	if err != nil {
+		status = fmt.Sprintf("Not Accepted: %v", err)
+		cstatus = metav1.ConditionFalse
+		msg = err.Error()
+		switch {
+		case errors.Is(err, errConfig):
+			reason = "ConfigurationError"
+		default:
+			reason = "RemoteClusterError"
+		}
+	}
+
+	condition := meta.FindStatusCondition(cl.Status.Conditions, "Accepted")
+	if condition != nil &&
+		condition.ObservedGeneration == cl.Generation &&
+		condition.Status == cstatus &&
+		condition.Reason == reason &&
+		condition.Message == msg &&
+		reflect.DeepEqual(cl.Status.RemoteNodes, remoteNodes) {
+		return nil
+	}
+
+	applyRemoteNodes := make([]*clapply.RemoteNodeApplyConfiguration, 0, len(remoteNodes))
+	for _, remoteNode := range remoteNodes {
+		applyRemoteNodes = append(applyRemoteNodes, clapply.RemoteNode().
+			WithName(remoteNode.Name).
+			WithNodeID(remoteNode.NodeID).
+			WithChassisID(remoteNode.ChassisID).
+			WithEncapIPs(remoteNode.EncapIPs...).
+			WithTransitSwitchIPs(remoteNode.TransitSwitchIPs...).
+			WithSubnets(remoteNode.Subnets...),
+		)
+	}
+
+	lastTransitionTime := metav1.NewTime(time.Now())
+	if condition != nil && condition.Status == cstatus {
+		lastTransitionTime = condition.LastTransitionTime
+	}
+
+	_, err = c.clClient.K8sV1().ClusterLinks().ApplyStatus(
+		context.Background(),
+		clapply.ClusterLink(cl.Name).WithStatus(
+			clapply.ClusterLinkStatus().
+				WithStatus(status).
+				WithConditions(
+					metaapply.Condition().
+						WithType("Accepted").
+						WithStatus(cstatus).
+						WithLastTransitionTime(lastTransitionTime).
+						WithReason(reason).
+						WithMessage(msg).
+						WithObservedGeneration(cl.Generation),
+				).
+				WithRemoteNodes(applyRemoteNodes...),
+		),
+		metav1.ApplyOptions{
+			FieldManager: fieldManager,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to apply status for ClusterLink %q: %w", cl.Name, err)
+	}
+
+	return nil
+}
+
+// reconcileNode reconciles all ClusterLinks when the ID of a local node
+// changes, as it might collide with the ID of a remote node
+func (c *Controller) reconcileNode(string) error {
+	c.clController.ReconcileAll()
+	return nil
+}
+
+func clusterLinkNeedsUpdate(oldObj, newObj *cltypes.ClusterLink) bool {
+	return oldObj == nil || newObj == nil || oldObj.Generation != newObj.Generation
+}
+
+func nodeNeedsUpdate(oldObj, newObj *core.Node) bool {
+	return oldObj == nil || newObj == nil || util.NodeIDAnnotationChanged(oldObj, newObj)
+}
+
+func newRemoteClient(kubeconfig []byte) (kubernetes.Interface, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(restConfig)
+}
@@ -0,0 +1,265 @@
+package clusterlink
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	ctesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	cltypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+type testNode struct {
+	Name            string
+	ID              int
+	Subnet          string
+	TransitSwitchIP string
+	Unready         bool
+}
+
+func (tn testNode) Node() *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: tn.Name,
+			Annotations: map[string]string{
+				"k8s.ovn.org/node-id": fmt.Sprintf("%d", tn.ID),
+			},
+		},
+	}
+	if tn.Unready {
+		return node
+	}
+	node.Annotations["k8s.ovn.org/node-chassis-id"] = tn.Name + "-chassis"
+	node.Annotations["k8s.ovn.org/node-encap-ips"] = "[\"172.19.0.10\"]"
+	node.Annotations["k8s.ovn.org/node-transit-switch-port-ifaddr"] = fmt.Sprintf("{\"ipv4\":%q}", tn.TransitSwitchIP)
+	node.Annotations["k8s.ovn.org/node-subnets"] = fmt.Sprintf("{\"default\":%q}", tn.Subnet)
+	return node
+}
+
+func TestController_reconcile(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "east-kubeconfig",
+			Namespace: "ovn-kubernetes",
+		},
+		Data: map[string][]byte{
+			kubeconfigSecretKey: []byte("kubeconfig"),
+		},
+	}
+	localNodes := []*testNode{
+		{Name: "node1", ID: 2, Subnet: "10.128.1.0/24", TransitSwitchIP: "100.88.0.2/16"},
+	}
+
+	tests := []struct {
+		name                 string
+		secret               *corev1.Secret
+		remoteNodes          []*testNode
+		existingRemoteNodes  []cltypes.RemoteNode
+		remoteError          error
+		expectAcceptedStatus metav1.ConditionStatus
+		expectReason         string
+		expectRemoteNodes    []cltypes.RemoteNode
+	}{
+		{
+			name:   "publishes the nodes of the remote cluster",
+			secret: secret,
+			remoteNodes: []*testNode{
+				{Name: "node2", ID: 5003, Subnet: "10.129.2.0/24", TransitSwitchIP: "100.88.19.139/16"},
+				{Name: "node1", ID: 5002, Subnet: "10.129.1.0/24", TransitSwitchIP: "100.88.19.138/16"},
+			},
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectReason:         "Accepted",
+			expectRemoteNodes: []cltypes.RemoteNode{
+				{
+					Name:             "node1",
+					NodeID:           5002,
+					ChassisID:        "node1-chassis",
+					EncapIPs:         []string{"172.19.0.10"},
+					TransitSwitchIPs: []string{"100.88.19.138/16"},
+					Subnets:          []string{"10.129.1.0/24"},
+				},
+				{
+					Name:             "node2",
+					NodeID:           5003,
+					ChassisID:        "node2-chassis",
+					EncapIPs:         []string{"172.19.0.10"},
+					TransitSwitchIPs: []string{"100.88.19.139/16"},
+					Subnets:          []string{"10.129.2.0/24"},
+				},
+			},
+		},
+		{
+			name:   "skips remote nodes that are not set up yet",
+			secret: secret,
+			remoteNodes: []*testNode{
+				{Name: "node1", ID: 5002, Subnet: "10.129.1.0/24", TransitSwitchIP: "100.88.19.138/16"},
+				{Name: "node2", ID: 5003, Unready: true},
+			},
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectReason:         "Accepted",
+			expectRemoteNodes: []cltypes.RemoteNode{
+				{
+					Name:             "node1",
+					NodeID:           5002,
+					ChassisID:        "node1-chassis",
+					EncapIPs:         []string{"172.19.0.10"},
+					TransitSwitchIPs: []string{"100.88.19.138/16"},
+					Subnets:          []string{"10.129.1.0/24"},
+				},
+			},
+		},
+		{
+			name:   "rejects a remote node subnet overlapping with the cluster subnet",
+			secret: secret,
+			remoteNodes: []*testNode{
+				{Name: "node1", ID: 5002, Subnet: "10.128.2.0/24", TransitSwitchIP: "100.88.19.138/16"},
+			},
+			expectAcceptedStatus: metav1.ConditionFalse,
+			expectReason:         "ConfigurationError",
+		},
+		{
+			name:   "rejects a remote node subnet overlapping with the service subnet",
+			secret: secret,
+			remoteNodes: []*testNode{
+				{Name: "node1", ID: 5002, Subnet: "172.30.1.0/24", TransitSwitchIP: "100.88.19.138/16"},
+			},
+			expectAcceptedStatus: metav1.ConditionFalse,
+			expectReason:         "ConfigurationError",
+		},
+		{
+			name:   "rejects a remote node ID colliding with a local node ID",
+			secret: secret,
+			remoteNodes: []*testNode{
+				{Name: "node1", ID: 2, Subnet: "10.129.1.0/24", TransitSwitchIP: "100.88.0.2/16"},
+			},
+			expectAcceptedStatus: metav1.ConditionFalse,
+			expectReason:         "ConfigurationError",
+		},
+		{
+			name:   "rejects a remote transit switch IP out of the transit switch subnet",
+			secret: secret,
+			remoteNodes: []*testNode{
+				{Name: "node1", ID: 5002, Subnet: "10.129.1.0/24", TransitSwitchIP: "100.89.19.138/16"},
+			},
+			expectAcceptedStatus: metav1.ConditionFalse,
+			expectReason:         "ConfigurationError",
+		},
+		{
+			name:                 "rejects a ClusterLink whose secret does not exist",
+			expectAcceptedStatus: metav1.ConditionFalse,
+			expectReason:         "ConfigurationError",
+		},
+		{
+			name:   "keeps the remote nodes if the remote cluster is not reachable",
+			secret: secret,
+			existingRemoteNodes: []cltypes.RemoteNode{
+				{Name: "node1", NodeID: 5002},
+			},
+			remoteError:          fmt.Errorf("connection refused"),
+			expectAcceptedStatus: metav1.ConditionFalse,
+			expectReason:         "RemoteClusterError",
+			expectRemoteNodes: []cltypes.RemoteNode{
+				{Name: "node1", NodeID: 5002},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+			config.Default.ClusterSubnets = []config.CIDRNetworkEntry{
+				{
+					CIDR:             ovntest.MustParseIPNet("10.128.0.0/16"),
+					HostSubnetLength: 24,
+				},
+			}
+			config.Kubernetes.ServiceCIDRs = ovntest.MustParseIPNets("172.30.0.0/16")
+			config.OVNKubernetesFeature.EnableInterconnect = true
+			config.OVNKubernetesFeature.EnableClusterLink = true
+
+			fakeClientset := util.GetOVNClientset().GetClusterManagerClientset()
+
+			cl := &cltypes.ClusterLink{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "east",
+				},
+				Spec: cltypes.ClusterLinkSpec{
+					KubeconfigSecret: "east-kubeconfig",
+				},
+				Status: cltypes.ClusterLinkStatus{
+					RemoteNodes: tt.existingRemoteNodes,
+				},
+			}
+			_, err := fakeClientset.ClusterLinkClient.K8sV1().ClusterLinks().Create(context.Background(), cl, metav1.CreateOptions{})
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			if tt.secret != nil {
+				_, err = fakeClientset.KubeClient.CoreV1().Secrets(tt.secret.Namespace).Create(context.Background(), tt.secret, metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
+			for _, node := range localNodes {
+				_, err = fakeClientset.KubeClient.CoreV1().Nodes().Create(context.Background(), node.Node(), metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
+			remoteClient := fake.NewSimpleClientset()
+			for _, node := range tt.remoteNodes {
+				_, err = remoteClient.CoreV1().Nodes().Create(context.Background(), node.Node(), metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
+			if tt.remoteError != nil {
+				remoteClient.PrependReactor("list", "nodes", func(ctesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tt.remoteError
+				})
+			}
+
+			wf, err := factory.NewClusterManagerWatchFactory(fakeClientset)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			c := NewController(wf, fakeClientset)
+			c.newRemoteClient = func(kubeconfig []byte) (kubernetes.Interface, error) {
+				g.Expect(kubeconfig).To(gomega.Equal(secret.Data[kubeconfigSecretKey]))
+				return remoteClient, nil
+			}
+
+			err = wf.Start()
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			defer wf.Shutdown()
+
+			// wait for caches to sync
+			cache.WaitForCacheSync(
+				context.Background().Done(),
+				wf.ClusterLinkInformer().Informer().HasSynced,
+				wf.NodeCoreInformer().Informer().HasSynced,
+			)
+
+			err = c.reconcile(cl.Name)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			cl, err = fakeClientset.ClusterLinkClient.K8sV1().ClusterLinks().Get(context.Background(), cl.Name, metav1.GetOptions{})
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			accepted := meta.FindStatusCondition(cl.Status.Conditions, "Accepted")
+			g.Expect(accepted).NotTo(gomega.BeNil())
+			g.Expect(accepted.Status).To(gomega.Equal(tt.expectAcceptedStatus))
+			g.Expect(accepted.Reason).To(gomega.Equal(tt.expectReason))
+			g.Expect(cl.Status.RemoteNodes).To(gomega.Equal(tt.expectRemoteNodes))
+		})
+	}
+}
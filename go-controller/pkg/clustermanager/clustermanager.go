@@ -10,6 +10,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/clusterlink"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/dnsnameresolver"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/egressservice"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/endpointslicemirror"
@@ -61,6 +62,10 @@ type ClusterManager struct {
 	networkManager networkmanager.Controller
 
 	raController *routeadvertisements.Controller
+
+	clusterLinkController *clusterlink.Controller
+
+	serviceIdleController *unidling.IdleController
 }
 
 // NewClusterManager creates a new cluster manager to manage the cluster nodes.
@@ -138,6 +143,10 @@ func NewClusterManager(ovnClient *util.OVNClusterManagerClientset, wf *factory.W
 			return nil, err
 		}
 	}
+	if config.Kubernetes.ServiceIdleTimeout > 0 {
+		cm.serviceIdleController = unidling.NewIdleController(ovnClient.KubeClient, recorder,
+			wf.ServiceCoreInformer(), config.Kubernetes.ServiceIdleTimeout)
+	}
 	if util.IsDNSNameResolverEnabled() {
 		cm.dnsNameResolverController = dnsnameresolver.NewController(ovnClient, wf)
 	}
@@ -147,9 +156,12 @@ func NewClusterManager(ovnClient *util.OVNClusterManagerClientset, wf *factory.W
 			ovnClient.NetworkAttchDefClient, wf.NADInformer(),
 			ovnClient.UserDefinedNetworkClient,
 			wf.UserDefinedNetworkInformer(), wf.ClusterUserDefinedNetworkInformer(),
+			wf.UserDefinedNetworkQuotaInformer(),
+			wf.UserDefinedNetworkCIDRPoolInformer(),
 			udntemplate.RenderNetAttachDefManifest,
 			wf.PodCoreInformer(),
 			wf.NamespaceInformer(),
+			wf.NodeCoreInformer(),
 			cm.recorder,
 		)
 		cm.userDefinedNetworkController = udnController
@@ -162,6 +174,10 @@ func NewClusterManager(ovnClient *util.OVNClusterManagerClientset, wf *factory.W
 		cm.raController = routeadvertisements.NewController(cm.networkManager.Interface(), wf, ovnClient)
 	}
 
+	if config.OVNKubernetesFeature.EnableClusterLink {
+		cm.clusterLinkController = clusterlink.NewController(wf, ovnClient)
+	}
+
 	return cm, nil
 }
 
@@ -227,6 +243,18 @@ func (cm *ClusterManager) Start(ctx context.Context) error {
 		}
 	}
 
+	if cm.clusterLinkController != nil {
+		if err := cm.clusterLinkController.Start(); err != nil {
+			return err
+		}
+	}
+
+	if cm.serviceIdleController != nil {
+		if err := cm.serviceIdleController.Start(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -256,6 +284,14 @@ func (cm *ClusterManager) Stop() {
 		cm.raController.Stop()
 		cm.raController = nil
 	}
+	if cm.clusterLinkController != nil {
+		cm.clusterLinkController.Stop()
+		cm.clusterLinkController = nil
+	}
+	if cm.serviceIdleController != nil {
+		cm.serviceIdleController.Stop()
+		cm.serviceIdleController = nil
+	}
 }
 
 func (cm *ClusterManager) NewNetworkController(netInfo util.NetInfo) (networkmanager.NetworkController, error) {
@@ -0,0 +1,109 @@
+package clustermanager
+
+import (
+	"sync"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// egressIPBFDReports tracks the BFD session status that every node reports
+// towards the egress nodes through the OVNNodeEgressIPBFDStatus annotation.
+type egressIPBFDReports struct {
+	sync.Mutex
+	// reports maps reporting node name -> egress node name -> BFD status
+	reports map[string]map[string]string
+}
+
+func newEgressIPBFDReports() *egressIPBFDReports {
+	return &egressIPBFDReports{
+		reports: map[string]map[string]string{},
+	}
+}
+
+// update caches the BFD status reported by the provided node. Reports from
+// nodes that are not ready are discarded since they might be stale.
+func (r *egressIPBFDReports) update(node *v1.Node, isReady bool) {
+	r.Lock()
+	defer r.Unlock()
+	if !isReady {
+		delete(r.reports, node.Name)
+		return
+	}
+	status, err := util.ParseNodeEgressIPBFDStatusAnnotation(node)
+	if err != nil {
+		if !util.IsAnnotationNotSetError(err) {
+			klog.Warningf("Ignoring egress IP BFD status of node %s: %v", node.Name, err)
+		}
+		delete(r.reports, node.Name)
+		return
+	}
+	r.reports[node.Name] = status
+}
+
+// delete removes the BFD status reported by the provided node
+func (r *egressIPBFDReports) delete(nodeName string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.reports, nodeName)
+}
+
+// isReachable returns true unless every node reporting on the egress node
+// sees its BFD sessions down. An egress node no one reports on yet is
+// considered reachable, as its sessions might still be initializing.
+func (r *egressIPBFDReports) isReachable(nodeName string) bool {
+	r.Lock()
+	defer r.Unlock()
+	reported := false
+	for reporter, status := range r.reports {
+		if reporter == nodeName {
+			continue
+		}
+		switch status[nodeName] {
+		case nbdb.BFDStatusUp:
+			return true
+		case nbdb.BFDStatusDown:
+			reported = true
+		}
+	}
+	return !reported
+}
+
+// updateEgressIPBFDReports caches the BFD status reported by the provided node
+// and re-evaluates the reachability of the egress nodes accordingly
+func (eIPC *egressIPClusterController) updateEgressIPBFDReports(node *v1.Node) {
+	eIPC.egressIPBFDReports.update(node, eIPC.isEgressNodeReady(node))
+	eIPC.checkEgressNodesBFDReachability()
+}
+
+// deleteEgressIPBFDReports removes the BFD status reported by the provided node
+// and re-evaluates the reachability of the egress nodes accordingly
+func (eIPC *egressIPClusterController) deleteEgressIPBFDReports(node *v1.Node) {
+	eIPC.egressIPBFDReports.delete(node.Name)
+	eIPC.checkEgressNodesBFDReachability()
+}
+
+// checkEgressNodesBFDReachability updates the reachability of all the egress
+// nodes from the cached BFD reports, removing the nodes that became
+// unreachable from egress assignment and adding back the ones that recovered.
+func (eIPC *egressIPClusterController) checkEgressNodesBFDReachability() {
+	reAddOrDelete := map[string]bool{}
+	eIPC.nodeAllocator.Lock()
+	for _, eNode := range eIPC.nodeAllocator.cache {
+		if !eNode.isEgressAssignable || !eNode.isReady {
+			continue
+		}
+		isReachable := eIPC.egressIPBFDReports.isReachable(eNode.name)
+		if eNode.isReachable && !isReachable {
+			reAddOrDelete[eNode.name] = true
+		} else if !eNode.isReachable && isReachable {
+			reAddOrDelete[eNode.name] = false
+		}
+		eNode.isReachable = isReachable
+	}
+	eIPC.nodeAllocator.Unlock()
+	eIPC.reconcileEgressNodesReachability(reAddOrDelete)
+}
@@ -0,0 +1,249 @@
+package clustermanager
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// Reasons of the Assigned condition, the unassigned ones are also used to
+// explain why a given egress IP could not be assigned
+const (
+	egressIPAssignedReason                  = "EgressIPsAssigned"
+	egressIPMultipleReasonsReason           = "MultipleReasons"
+	egressIPNoAssignableNodesReason         = "NoAssignableNodes"
+	egressIPNodesUnreachableReason          = "NodesUnreachable"
+	egressIPConflictReason                  = "IPConflict"
+	egressIPAllocatedToOtherReason          = "AllocatedToOtherEgressIP"
+	egressIPPreferredNodesUnavailableReason = "PreferredNodesUnavailable"
+	egressIPNoMatchingNodeNetworkReason     = "NoMatchingNodeNetwork"
+	egressIPNodeCapacityExhaustedReason     = "NodeCapacityExhausted"
+	egressIPAssignmentPendingReason         = "AssignmentPending"
+)
+
+// Reasons of the Reachable condition
+const (
+	egressIPNodesReachableReason = "NodesReachable"
+	egressIPNotAssignedReason    = "NotAssigned"
+)
+
+// getEgressIPConditions returns the conditions of the EgressIP given the
+// provided status items. The Assigned and Reachable conditions are
+// re-evaluated, any other condition is kept as is.
+func (eIPC *egressIPClusterController) getEgressIPConditions(eIP *egressipv1.EgressIP,
+	statusItems []egressipv1.EgressIPStatusItem) []metav1.Condition {
+	conditions := make([]metav1.Condition, 0, len(eIP.Status.Conditions)+2)
+	for _, condition := range eIP.Status.Conditions {
+		conditions = append(conditions, *condition.DeepCopy())
+	}
+	meta.SetStatusCondition(&conditions, eIPC.getEgressIPAssignedCondition(eIP, statusItems))
+	meta.SetStatusCondition(&conditions, eIPC.getEgressIPReachableCondition(eIP, statusItems))
+	return conditions
+}
+
+// isEgressIPConditionsStale returns true if the Assigned or Reachable
+// conditions reported in the EgressIP status do not match its assignments
+func (eIPC *egressIPClusterController) isEgressIPConditionsStale(eIP *egressipv1.EgressIP,
+	statusItems []egressipv1.EgressIPStatusItem) bool {
+	if eIP == nil {
+		return false
+	}
+	return !reflect.DeepEqual(eIPC.getEgressIPConditions(eIP, statusItems), eIP.Status.Conditions)
+}
+
+// isEgressIPStatusStale returns true if any part of the EgressIP status derived
+// from its assignments is stale
+func (eIPC *egressIPClusterController) isEgressIPStatusStale(eIP *egressipv1.EgressIP,
+	statusItems []egressipv1.EgressIPStatusItem) bool {
+	return isEgressIPPreferenceStatusStale(eIP, statusItems) || eIPC.isEgressIPConditionsStale(eIP, statusItems)
+}
+
+func (eIPC *egressIPClusterController) getEgressIPAssignedCondition(eIP *egressipv1.EgressIP,
+	statusItems []egressipv1.EgressIPStatusItem) metav1.Condition {
+	assigned := make(map[string]bool, len(statusItems))
+	for _, item := range statusItems {
+		assigned[item.EgressIP] = true
+	}
+	preferences := newEgressIPNodePreferences(&eIP.Spec)
+	reasons := map[string]bool{}
+	var messages []string
+	for _, egressIP := range eIP.Spec.EgressIPs {
+		ip := net.ParseIP(egressIP)
+		if ip == nil || assigned[ip.String()] {
+			continue
+		}
+		reason, message := eIPC.getEgressIPUnassignedReason(eIP.Name, ip, preferences)
+		reasons[reason] = true
+		messages = append(messages, fmt.Sprintf("%s: %s", ip.String(), message))
+	}
+	if len(messages) == 0 {
+		return metav1.Condition{
+			Type:               egressipv1.EgressIPAssignedCondition,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: eIP.Generation,
+			Reason:             egressIPAssignedReason,
+			Message:            "All egress IPs are assigned",
+		}
+	}
+	sort.Strings(messages)
+	reason := egressIPMultipleReasonsReason
+	if len(reasons) == 1 {
+		for r := range reasons {
+			reason = r
+		}
+	}
+	return metav1.Condition{
+		Type:               egressipv1.EgressIPAssignedCondition,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: eIP.Generation,
+		Reason:             reason,
+		Message:            "Egress IPs not assigned: " + strings.Join(messages, "; "),
+	}
+}
+
+// getEgressIPUnassignedReason returns the reason why the egress IP could not be
+// assigned to any node along with a message detailing it
+func (eIPC *egressIPClusterController) getEgressIPUnassignedReason(name string, eIP net.IP,
+	preferences *egressIPNodePreferences) (string, string) {
+	if isIPConflict, conflictedHost, err := eIPC.isEgressIPAddrConflict(eIP); err == nil && isIPConflict {
+		return egressIPConflictReason, fmt.Sprintf("conflicts with an IP address of node %s", conflictedHost)
+	}
+	eIPC.nodeAllocator.Lock()
+	defer eIPC.nodeAllocator.Unlock()
+	var assignableNodes, reachableNodes []*egressNode
+	for _, eNode := range eIPC.nodeAllocator.cache {
+		if owner, exists := eNode.allocations[eIP.String()]; exists {
+			if owner == name {
+				return egressIPAssignmentPendingReason, fmt.Sprintf("assignment to node %s is pending", eNode.name)
+			}
+			return egressIPAllocatedToOtherReason, fmt.Sprintf("already allocated to EgressIP %s", owner)
+		}
+		if !eNode.isEgressAssignable || !eNode.isReady {
+			continue
+		}
+		assignableNodes = append(assignableNodes, eNode)
+		if eNode.isReachable {
+			reachableNodes = append(reachableNodes, eNode)
+		}
+	}
+	if len(assignableNodes) == 0 {
+		return egressIPNoAssignableNodesReason, fmt.Sprintf("no ready node is labeled with %s", util.GetNodeEgressLabel())
+	}
+	if len(reachableNodes) == 0 {
+		return egressIPNodesUnreachableReason, "none of the egress assignable nodes are reachable"
+	}
+	candidateNodes := getEgressIPCandidateNodes(reachableNodes, preferences, eIP.String())
+	if len(candidateNodes) == 0 {
+		return egressIPPreferredNodesUnavailableReason, "none of the preferred nodes can host it and fallback is disabled"
+	}
+	for _, eNode := range candidateNodes {
+		node, err := eIPC.watchFactory.GetNode(eNode.name)
+		if err != nil {
+			klog.V(5).Infof("Failed to get node %s while evaluating why EgressIP %s IP %s is not assigned: %v",
+				eNode.name, name, eIP.String(), err)
+			continue
+		}
+		if network, err := util.GetEgressIPNetwork(node, eNode.egressIPConfig, eIP); err == nil && network != "" {
+			return egressIPNodeCapacityExhaustedReason, "the nodes which can host it have no capacity left or " +
+				"already host another egress IP of this EgressIP"
+		}
+	}
+	return egressIPNoMatchingNodeNetworkReason, "no egress assignable node has a network which can host it"
+}
+
+func (eIPC *egressIPClusterController) getEgressIPReachableCondition(eIP *egressipv1.EgressIP,
+	statusItems []egressipv1.EgressIPStatusItem) metav1.Condition {
+	if len(statusItems) == 0 {
+		return metav1.Condition{
+			Type:               egressipv1.EgressIPReachableCondition,
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: eIP.Generation,
+			Reason:             egressIPNotAssignedReason,
+			Message:            "No egress IP is assigned",
+		}
+	}
+	var unreachableNodes []string
+	eIPC.nodeAllocator.Lock()
+	for _, item := range statusItems {
+		eNode, exists := eIPC.nodeAllocator.cache[item.Node]
+		if !exists || !eNode.isReady || !eNode.isReachable {
+			unreachableNodes = append(unreachableNodes, item.Node)
+		}
+	}
+	eIPC.nodeAllocator.Unlock()
+	if len(unreachableNodes) > 0 {
+		sort.Strings(unreachableNodes)
+		return metav1.Condition{
+			Type:               egressipv1.EgressIPReachableCondition,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: eIP.Generation,
+			Reason:             egressIPNodesUnreachableReason,
+			Message:            "Egress nodes not reachable: " + strings.Join(unreachableNodes, ", "),
+		}
+	}
+	return metav1.Condition{
+		Type:               egressipv1.EgressIPReachableCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: eIP.Generation,
+		Reason:             egressIPNodesReachableReason,
+		Message:            "All egress nodes are reachable",
+	}
+}
+
+// recordEgressIPConditionEvents records a warning event when the Assigned or
+// Reachable conditions of the EgressIP turn false or change reason while false,
+// and a normal event when they recover. The last state an event was recorded
+// for is cached so that the same event is not recorded again while the
+// informer cache has not yet caught up with the status update.
+func (eIPC *egressIPClusterController) recordEgressIPConditionEvents(name string, oldConditions, newConditions []metav1.Condition) {
+	eIPRef := v1.ObjectReference{
+		Kind: "EgressIP",
+		Name: name,
+	}
+	eIPC.conditionEventsMutex.Lock()
+	defer eIPC.conditionEventsMutex.Unlock()
+	recorded, exists := eIPC.conditionEvents[name]
+	if !exists {
+		recorded = map[string]metav1.Condition{}
+		eIPC.conditionEvents[name] = recorded
+	}
+	for _, conditionType := range []string{egressipv1.EgressIPAssignedCondition, egressipv1.EgressIPReachableCondition} {
+		newCondition := meta.FindStatusCondition(newConditions, conditionType)
+		if newCondition == nil {
+			continue
+		}
+		oldCondition, exists := recorded[conditionType]
+		if !exists {
+			if condition := meta.FindStatusCondition(oldConditions, conditionType); condition != nil {
+				oldCondition = *condition
+			}
+		}
+		recorded[conditionType] = *newCondition
+		switch {
+		case newCondition.Status == metav1.ConditionFalse:
+			if oldCondition.Status == metav1.ConditionFalse && oldCondition.Reason == newCondition.Reason {
+				continue
+			}
+			eIPC.recorder.Event(&eIPRef, v1.EventTypeWarning, newCondition.Reason, newCondition.Message)
+		case newCondition.Status == metav1.ConditionTrue && oldCondition.Status == metav1.ConditionFalse:
+			eIPC.recorder.Event(&eIPRef, v1.EventTypeNormal, newCondition.Reason, newCondition.Message)
+		}
+	}
+}
+
+// deleteEgressIPConditionEvents forgets the events recorded for the EgressIP
+func (eIPC *egressIPClusterController) deleteEgressIPConditionEvents(name string) {
+	eIPC.conditionEventsMutex.Lock()
+	defer eIPC.conditionEventsMutex.Unlock()
+	delete(eIPC.conditionEvents, name)
+}
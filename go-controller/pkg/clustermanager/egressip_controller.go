@@ -28,12 +28,15 @@ import (
 	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -348,6 +351,14 @@ type egressIPClusterController struct {
 	reachabilityCheckInterval time.Duration
 	// EgressIP Node reachability gRPC port (0 means it should use dial instead)
 	egressIPNodeHealthCheckPort int
+	// egressIPBFDReports caches the egress IP BFD status reported by every
+	// node, only used if EgressIP node reachability is based on BFD
+	egressIPBFDReports *egressIPBFDReports
+	// conditionEventsMutex protects conditionEvents
+	conditionEventsMutex sync.Mutex
+	// conditionEvents caches, per EgressIP, the last Assigned and Reachable
+	// conditions events were recorded for
+	conditionEvents map[string]map[string]metav1.Condition
 	// retry framework for Egress nodes
 	retryEgressNodes *objretry.RetryFramework
 	// retry framework for egress IP
@@ -377,6 +388,7 @@ func newEgressIPController(ovnClient *util.OVNClusterManagerClientset, wf *facto
 		egressIPAssignmentMutex:           &sync.Mutex{},
 		pendingCloudPrivateIPConfigsMutex: &sync.Mutex{},
 		pendingCloudPrivateIPConfigsOps:   make(map[string]map[string]*cloudPrivateIPConfigOp),
+		conditionEvents:                   make(map[string]map[string]metav1.Condition),
 		nodeAllocator:                     nodeAllocator{&sync.Mutex{}, make(map[string]*egressNode)},
 		markAllocator:                     markAllocator,
 		watchFactory:                      wf,
@@ -386,6 +398,9 @@ func newEgressIPController(ovnClient *util.OVNClusterManagerClientset, wf *facto
 		egressIPNodeHealthCheckPort:       config.OVNKubernetesFeature.EgressIPNodeHealthCheckPort,
 		stopChan:                          make(chan struct{}),
 	}
+	if config.OVNKubernetesFeature.EgressIPReachabilityBFD {
+		eIPC.egressIPBFDReports = newEgressIPBFDReports()
+	}
 	eIPC.initRetryFramework()
 	return eIPC
 }
@@ -430,6 +445,8 @@ func (eIPC *egressIPClusterController) Start() error {
 	}
 	if config.OVNKubernetesFeature.EgressIPReachabiltyTotalTimeout == 0 {
 		klog.V(2).Infof("EgressIP node reachability check disabled")
+	} else if config.OVNKubernetesFeature.EgressIPReachabilityBFD {
+		klog.Infof("EgressIP node reachability enabled and using BFD")
 	} else if config.OVNKubernetesFeature.EgressIPNodeHealthCheckPort != 0 {
 		klog.Infof("EgressIP node reachability enabled and using gRPC port %d",
 			config.OVNKubernetesFeature.EgressIPNodeHealthCheckPort)
@@ -495,6 +512,17 @@ func (eIPC *egressIPClusterController) getSortedEgressData() ([]*egressNode, map
 }
 
 func (eIPC *egressIPClusterController) initEgressNodeReachability(nodes []interface{}) error {
+	if eIPC.egressIPBFDReports != nil {
+		// reachability is updated from the node events carrying the BFD status
+		for _, obj := range nodes {
+			node, ok := obj.(*v1.Node)
+			if !ok {
+				return fmt.Errorf("spurious object in initEgressNodeReachability: %v", obj)
+			}
+			eIPC.egressIPBFDReports.update(node, eIPC.isEgressNodeReady(node))
+		}
+		return nil
+	}
 	go eIPC.checkEgressNodesReachability()
 	return nil
 }
@@ -601,6 +629,13 @@ func checkEgressNodesReachabilityIterate(eIPC *egressIPClusterController) {
 		}
 	}
 	eIPC.nodeAllocator.Unlock()
+	eIPC.reconcileEgressNodesReachability(reAddOrDelete)
+}
+
+// reconcileEgressNodesReachability removes the nodes that became unreachable
+// from egress assignment, and adds back the ones that became reachable. The
+// boolean value of reAddOrDelete is true if the node has to be removed.
+func (eIPC *egressIPClusterController) reconcileEgressNodesReachability(reAddOrDelete map[string]bool) {
 	for nodeName, shouldDelete := range reAddOrDelete {
 		if shouldDelete {
 			metrics.RecordEgressIPUnreachableNode()
@@ -626,6 +661,9 @@ func (eIPC *egressIPClusterController) isReachable(nodeName string, mgmtIPs []ne
 		return true
 	}
 
+	if eIPC.egressIPBFDReports != nil {
+		return eIPC.egressIPBFDReports.isReachable(nodeName)
+	}
 	if eIPC.egressIPNodeHealthCheckPort == 0 {
 		return isReachableLegacy(nodeName, mgmtIPs, eIPC.egressIPTotalTimeout)
 	}
@@ -732,10 +770,11 @@ func (eIPC *egressIPClusterController) addEgressNode(nodeName string) error {
 	}
 	for _, egressIP := range egressIPs {
 		egressIP := *egressIP
-		if len(egressIP.Spec.EgressIPs) != len(egressIP.Status.Items) {
+		if len(egressIP.Spec.EgressIPs) != len(egressIP.Status.Items) || newEgressIPNodePreferences(&egressIP.Spec).hasNode(nodeName) {
 			// Send a "synthetic update" on all egress IPs which are not fully
-			// assigned, the reconciliation loop for WatchEgressIP will try to
-			// assign stuff to this new node. The workqueue's delta FIFO
+			// assigned or prefer this node, the reconciliation loop for
+			// WatchEgressIP will try to assign stuff to this new node, or move
+			// back egress IPs to it. The workqueue's delta FIFO
 			// implementation will not trigger a watch event for updates on
 			// objects which have no semantic difference, hence: call the
 			// reconciliation function directly.
@@ -903,6 +942,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		}
 	} else {
 		eIPC.deallocMark(name)
+		eIPC.deleteEgressIPConditionEvents(name)
 	}
 
 	// Validate the spec and use only the valid egress IPs when performing any
@@ -927,6 +967,15 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 			delete(validStatus, status)
 		}
 	}
+	// Egress IPs with preferred nodes are moved back to a preferred node of
+	// higher priority as soon as it can host them again.
+	preferences := newEgressIPNodePreferences(&newEIP.Spec)
+	for status := range validStatus {
+		if eIPC.shouldMoveToPreferredNode(name, status, preferences) {
+			invalidStatus[status] = ""
+			delete(validStatus, status)
+		}
+	}
 
 	invalidStatusLen := len(invalidStatus)
 	if invalidStatusLen > 0 {
@@ -993,7 +1042,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 			eIPC.deleteAllocatorEgressIPAssignments(statusToRemove)
 		}
 		if len(ipsToAssign) > 0 {
-			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
+			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList(), preferences)
 			statusToKeep = append(statusToKeep, statusToAdd...)
 		}
 		// Add all assignments which are to be kept to the allocator cache,
@@ -1002,8 +1051,8 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		eIPC.addAllocatorEgressIPAssignments(name, statusToKeep)
 		// Update the object only on an ADD/UPDATE. If we are processing a
 		// DELETE, new will be nil and we should not update the object.
-		if len(statusToAdd) > 0 || (len(statusToRemove) > 0 && new != nil) {
-			if err := eIPC.patchEgressIP(name, eIPC.generateEgressIPPatches(name, new.Annotations, statusToKeep)...); err != nil {
+		if len(statusToAdd) > 0 || (len(statusToRemove) > 0 && new != nil) || eIPC.isEgressIPStatusStale(new, statusToKeep) {
+			if err := eIPC.updateEgressIPStatus(new, statusToKeep); err != nil {
 				return err
 			}
 		}
@@ -1031,7 +1080,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 			// Update the object only on an ADD/UPDATE. If we are processing a
 			// DELETE, new will be nil and we should not update the object.
 			if new != nil {
-				if err := eIPC.patchEgressIP(name, eIPC.generateEgressIPPatches(name, new.Annotations, statusToKeep)...); err != nil {
+				if err := eIPC.updateEgressIPStatus(new, statusToKeep); err != nil {
 					return err
 				}
 			}
@@ -1057,7 +1106,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		// processing the answer from the requests we make here, and update OVN
 		// accordingly when we know what the outcome is.
 		if len(ipsToAssign) > 0 {
-			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
+			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList(), preferences)
 			statusToKeep = append(statusToKeep, statusToAdd...)
 		}
 		// Same as above: Add all assignments which are to be kept to the
@@ -1072,6 +1121,13 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		if err := eIPC.executeCloudPrivateIPConfigChange(name, statusToAdd, statusToRemove); err != nil {
 			return err
 		}
+		// The status is updated once the cloud confirms assignment changes,
+		// otherwise only the preference state of the egress IPs might be stale.
+		if len(statusToAdd) == 0 && len(statusToRemove) == 0 && eIPC.isEgressIPStatusStale(new, statusToKeep) {
+			if err := eIPC.updateEgressIPStatus(new, statusToKeep); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Record the egress IP allocator count
@@ -1115,7 +1171,7 @@ func (eIPC *egressIPClusterController) syncCloudPrivateIPConfigs(objs []interfac
 		if cloudPrivateIPNotFound {
 			// There could be one or more stale entry found in egress ip object, remove it by patching egressip
 			// object with updated status.
-			err = eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP.Name, egressIP.Annotations, updatedStatus)...)
+			err = eIPC.updateEgressIPStatus(egressIP, updatedStatus)
 			if err != nil {
 				return fmt.Errorf("syncCloudPrivateIPConfigs unable to update EgressIP status: %w", err)
 			}
@@ -1154,7 +1210,10 @@ func (eIPC *egressIPClusterController) getCloudPrivateIPConfigMap(objs []interfa
 // time, this does not guarantee complete balance, but mostly complete.
 // For Egress IPs that are hosted by secondary host networks, there must be at least
 // one node that hosts the network and exposed via the nodes host-cidrs annotation.
-func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []string) []egressipv1.EgressIPStatusItem {
+// Egress IPs with preferred nodes are assigned to the first of their preferred
+// nodes which can host them before falling back to the balanced assignment,
+// unless fallback is disabled.
+func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []string, preferences *egressIPNodePreferences) []egressipv1.EgressIPStatusItem {
 	eIPC.nodeAllocator.Lock()
 	defer eIPC.nodeAllocator.Unlock()
 	assignments := []egressipv1.EgressIPStatusItem{}
@@ -1275,52 +1334,22 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			}
 		}
 
-		var assignmentSuccessful bool
-		for i := 0; i < len(assignableNodes) && !assignmentSuccessful; i++ {
-			eNode := assignableNodes[i]
+		candidateNodes := getEgressIPCandidateNodes(assignableNodes, preferences, eIP.String())
+		if len(candidateNodes) == 0 {
+			klog.V(5).Infof("None of the preferred nodes of EgressIP %s can host IP %s and fallback is disabled", name, eIP.String())
+			continue
+		}
+		for _, eNode := range candidateNodes {
 			klog.V(5).Infof("Attempting assignment on egress node: %+v", eNode)
-			if eNode.getAllocationCountForEgressIP(name) > 0 {
-				klog.V(5).Infof("Node: %s is already in use by another egress IP for this EgressIP: %s, trying another node", eNode.name, name)
-				continue
-			}
-			node, err := eIPC.watchFactory.GetNode(eNode.name)
-			if err != nil {
-				klog.Errorf("Failed to consider node %s because lookup of kubernetes object failed: %v", eNode.name, err)
-				continue
-			}
-			egressIPNetwork, err := util.GetEgressIPNetwork(node, eNode.egressIPConfig, eIP)
-			if err != nil {
-				klog.Errorf("Failed to consider node %s for EgressIP %s IP %s because unable to find a network to host it: %v",
-					node.Name, name, eIP.String(), err)
-				continue
-			}
-			if egressIPNetwork == "" {
+			egressIPNetwork, ok := eIPC.canHostEgressIP(name, eNode, eIP)
+			if !ok {
 				continue
 			}
-			if eNode.egressIPConfig.Capacity.IP < util.UnlimitedNodeCapacity {
-				if eNode.egressIPConfig.Capacity.IP-len(eNode.allocations) <= 0 {
-					klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IP capacity, trying another node", eNode.name)
-					continue
-				}
-			}
-			if eNode.egressIPConfig.Capacity.IPv4 < util.UnlimitedNodeCapacity && utilnet.IsIPv4(eIP) {
-				if eNode.egressIPConfig.Capacity.IPv4-getIPFamilyAllocationCount(eNode.allocations, false) <= 0 {
-					klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv4 capacity, trying another node", eNode.name)
-					continue
-				}
-			}
-			if eNode.egressIPConfig.Capacity.IPv6 < util.UnlimitedNodeCapacity && utilnet.IsIPv6(eIP) {
-				if eNode.egressIPConfig.Capacity.IPv6-getIPFamilyAllocationCount(eNode.allocations, true) <= 0 {
-					klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv6 capacity, trying another node", eNode.name)
-					continue
-				}
-			}
 			assignments = append(assignments, egressipv1.EgressIPStatusItem{
 				Node:     eNode.name,
 				EgressIP: eIP.String(),
 			})
 			eNode.allocations[eIP.String()] = name
-			assignmentSuccessful = true
 			klog.Infof("Successful assignment of egress IP: %s to network %s on node: %+v", egressIP, egressIPNetwork, eNode)
 			break
 		}
@@ -1344,6 +1373,48 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 	return assignments
 }
 
+// canHostEgressIP returns true and the network which would host the egress IP
+// if it can be assigned to the egress node. The allocator lock must be held.
+func (eIPC *egressIPClusterController) canHostEgressIP(name string, eNode *egressNode, eIP net.IP) (string, bool) {
+	if eNode.getAllocationCountForEgressIP(name) > 0 {
+		klog.V(5).Infof("Node: %s is already in use by another egress IP for this EgressIP: %s, trying another node", eNode.name, name)
+		return "", false
+	}
+	node, err := eIPC.watchFactory.GetNode(eNode.name)
+	if err != nil {
+		klog.Errorf("Failed to consider node %s because lookup of kubernetes object failed: %v", eNode.name, err)
+		return "", false
+	}
+	egressIPNetwork, err := util.GetEgressIPNetwork(node, eNode.egressIPConfig, eIP)
+	if err != nil {
+		klog.Errorf("Failed to consider node %s for EgressIP %s IP %s because unable to find a network to host it: %v",
+			node.Name, name, eIP.String(), err)
+		return "", false
+	}
+	if egressIPNetwork == "" {
+		return "", false
+	}
+	if eNode.egressIPConfig.Capacity.IP < util.UnlimitedNodeCapacity {
+		if eNode.egressIPConfig.Capacity.IP-len(eNode.allocations) <= 0 {
+			klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IP capacity, trying another node", eNode.name)
+			return "", false
+		}
+	}
+	if eNode.egressIPConfig.Capacity.IPv4 < util.UnlimitedNodeCapacity && utilnet.IsIPv4(eIP) {
+		if eNode.egressIPConfig.Capacity.IPv4-getIPFamilyAllocationCount(eNode.allocations, false) <= 0 {
+			klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv4 capacity, trying another node", eNode.name)
+			return "", false
+		}
+	}
+	if eNode.egressIPConfig.Capacity.IPv6 < util.UnlimitedNodeCapacity && utilnet.IsIPv6(eIP) {
+		if eNode.egressIPConfig.Capacity.IPv6-getIPFamilyAllocationCount(eNode.allocations, true) <= 0 {
+			klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv6 capacity, trying another node", eNode.name)
+			return "", false
+		}
+	}
+	return egressIPNetwork, true
+}
+
 func getIPFamilyAllocationCount(allocations map[string]string, isIPv6 bool) (count int) {
 	for allocation := range allocations {
 		if utilnet.IsIPv4String(allocation) && !isIPv6 {
@@ -1540,7 +1611,7 @@ func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *o
 					updatedStatus = append(updatedStatus, status)
 				}
 			}
-			if err := eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP.Name, egressIP.Annotations, updatedStatus)...); err != nil {
+			if err := eIPC.updateEgressIPStatus(egressIP, updatedStatus); err != nil {
 				return err
 			}
 		}
@@ -1587,7 +1658,7 @@ func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *o
 		}
 		if !hasStatus {
 			statusToKeep := append(egressIP.Status.Items, statusItem)
-			if err := eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP.Name, egressIP.Annotations, statusToKeep)...); err != nil {
+			if err := eIPC.updateEgressIPStatus(egressIP, statusToKeep); err != nil {
 				return err
 			}
 		}
@@ -1707,6 +1778,10 @@ func (eIPC *egressIPClusterController) removePendingOpsAndGetResyncs(egressIPNam
 	return resyncs, nil
 }
 
+// egressIPFieldManager is the field manager the status of the EgressIPs is
+// applied with
+const egressIPFieldManager = "clustermanager-egressip-controller"
+
 // jsonPatchOperation contains all the info needed to perform a JSON path operation to a k8 object
 type jsonPatchOperation struct {
 	Operation string      `json:"op"`
@@ -1714,15 +1789,13 @@ type jsonPatchOperation struct {
 	Value     interface{} `json:"value,omitempty"`
 }
 
-// patchEgressIP performs a patch operation on an EgressIP.
-// There are two possible patches operations.
-// 1. Mandatory, replace operation of egress IP status field. This allows us to
-// update only the status field, without overwriting any other. This is
+// patchEgressIP performs a JSON patch operation on an EgressIP, used to add
+// its mark annotation. Status updates go through updateEgressIPStatus: only
+// patching the fields we need allows us to not overwrite any other. This is
 // important because processing egress IPs can take a while (when running on a
 // public cloud and in the worst case), hence we don't want to perform a full
 // object update which risks resetting the EgressIP object's fields to the state
 // they had when we started processing the change.
-// 2. Optional, add operation to its metadata.annotations field.
 func (eIPC *egressIPClusterController) patchEgressIP(name string, patches ...jsonPatchOperation) error {
 	klog.Infof("Patching status on EgressIP %s: %v", name, patches)
 	op, err := json.Marshal(patches)
@@ -1734,21 +1807,61 @@ func (eIPC *egressIPClusterController) patchEgressIP(name string, patches ...jso
 	})
 }
 
-// generateEgressIPPatches conditionally generates a mark patch if the mark doesn't exist. If it fails to allocate a mark,
-// log an error instead of failing because we do not wish to block primary default network egress IP assignments due to potential
-// mark range exhaustion. Primary default network egress IP currently does not utilize marks to config EgressIP.
-// Generating the status patch is mandatory
-func (eIPC *egressIPClusterController) generateEgressIPPatches(name string, annotations map[string]string,
-	statusItems []egressipv1.EgressIPStatusItem) []jsonPatchOperation {
-	patches := make([]jsonPatchOperation, 0, 1)
-	if !util.IsEgressIPMarkSet(annotations) {
-		if mark, _, err := eIPC.getOrAllocMark(name); err != nil {
-			klog.Errorf("Failed to get mark for EgressIP %s: %v", name, err)
-		} else {
-			patches = append(patches, generateMarkPatchOp(mark))
+// updateEgressIPStatus applies the status items, preferences and the Assigned
+// and Reachable conditions of the EgressIP with server side apply, under a
+// field manager distinct from the ones of the zones applying their Programmed
+// conditions, so that none of them overwrites the conditions of the others.
+// The mark annotation is applied too, allocating it if the EgressIP doesn't
+// have one yet. If it fails to allocate a mark, log an error instead of failing
+// because we do not wish to block primary default network egress IP
+// assignments due to potential mark range exhaustion. Primary default network
+// egress IP currently does not utilize marks to config EgressIP. Events are
+// recorded for the conditions which change.
+func (eIPC *egressIPClusterController) updateEgressIPStatus(eIP *egressipv1.EgressIP,
+	statusItems []egressipv1.EgressIPStatusItem) error {
+	metadata := map[string]interface{}{
+		"name": eIP.Name,
+	}
+	if util.IsEgressIPMarkSet(eIP.Annotations) {
+		metadata["annotations"] = map[string]string{util.EgressIPMarkAnnotation: eIP.Annotations[util.EgressIPMarkAnnotation]}
+	} else if mark, _, err := eIPC.getOrAllocMark(eIP.Name); err != nil {
+		klog.Errorf("Failed to get mark for EgressIP %s: %v", eIP.Name, err)
+	} else {
+		metadata["annotations"] = createAnnotWithMark(mark)
+	}
+	conditions := eIPC.getEgressIPConditions(eIP, statusItems)
+	eIPC.recordEgressIPConditionEvents(eIP.Name, eIP.Status.Conditions, conditions)
+	var ownConditions []metav1.Condition
+	for _, conditionType := range []string{egressipv1.EgressIPAssignedCondition, egressipv1.EgressIPReachableCondition} {
+		if condition := meta.FindStatusCondition(conditions, conditionType); condition != nil {
+			ownConditions = append(ownConditions, *condition)
 		}
 	}
-	return append(patches, generateStatusPatchOp(statusItems))
+	if statusItems == nil {
+		// the items are required, make sure they are never omitted
+		statusItems = []egressipv1.EgressIPStatusItem{}
+	}
+	status := map[string]interface{}{
+		"items":      statusItems,
+		"conditions": ownConditions,
+	}
+	if preferences := getEgressIPPreferenceStatus(&eIP.Spec, statusItems); len(preferences) > 0 {
+		status["preferences"] = preferences
+	}
+	applyObj := map[string]interface{}{
+		"apiVersion": egressipv1.SchemeGroupVersion.String(),
+		"kind":       "EgressIP",
+		"metadata":   metadata,
+		"status":     status,
+	}
+	klog.Infof("Applying status on EgressIP %s: %v", eIP.Name, status)
+	patch, err := json.Marshal(applyObj)
+	if err != nil {
+		return fmt.Errorf("error serializing status of EgressIP %s: %v", eIP.Name, err)
+	}
+	_, err = eIPC.kube.EIPClient.K8sV1().EgressIPs().Patch(context.TODO(), eIP.Name, k8stypes.ApplyPatchType, patch,
+		metav1.PatchOptions{FieldManager: egressIPFieldManager, Force: ptr.To(true)})
+	return err
 }
 
 func generateMarkPatchOp(mark int) jsonPatchOperation {
@@ -1763,16 +1876,6 @@ func createAnnotWithMark(mark int) map[string]string {
 	return map[string]string{util.EgressIPMarkAnnotation: fmt.Sprintf("%d", mark)}
 }
 
-func generateStatusPatchOp(statusItems []egressipv1.EgressIPStatusItem) jsonPatchOperation {
-	return jsonPatchOperation{
-		Operation: "replace",
-		Path:      "/status",
-		Value: egressipv1.EgressIPStatus{
-			Items: statusItems,
-		},
-	}
-}
-
 // syncEgressIPMarkAllocator iterates over all existing EgressIPs. It builds a mark cache of existing marks stored on each
 // EgressIP annotation or allocates and adds a new mark to an EgressIP if it doesn't exist
 func (eIPC *egressIPClusterController) syncEgressIPMarkAllocator(egressIPs []interface{}) error {
@@ -2,9 +2,12 @@ package clustermanager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/onsi/ginkgo/v2"
@@ -13,13 +16,17 @@ import (
 	ocpconfigapi "github.com/openshift/api/config/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned/fake"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/healthcheck"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	"github.com/urfave/cli/v2"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	clienttesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/util/retry"
 	utilnet "k8s.io/utils/net"
 )
@@ -231,6 +238,18 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 		return egressIPs, nodes
 	}
 
+	getEgressIPConditionReason := func(egressIPName, conditionType string) func() string {
+		return func() string {
+			tmp, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			condition := meta.FindStatusCondition(tmp.Status.Conditions, conditionType)
+			if condition == nil {
+				return ""
+			}
+			return condition.Reason
+		}
+	}
+
 	getEgressIPAnnotationValue := func(egressIPName string) func() (string, error) {
 		return func() (string, error) {
 			tmp, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
@@ -1256,7 +1275,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				gomega.Expect(fakeClusterManagerOVN.eIPC.nodeAllocator.cache).To(gomega.HaveKey(node2.Name))
 
 				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(0))
-				gomega.Eventually(fakeClusterManagerOVN.fakeRecorder.Events).Should(gomega.HaveLen(3))
+				gomega.Eventually(fakeClusterManagerOVN.fakeRecorder.Events).Should(gomega.HaveLen(4))
 				return nil
 			}
 
@@ -1674,6 +1693,296 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 			err := app.Run([]string{app.Name})
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
+
+		ginkgo.It("should move egress IPs based on the BFD status reported by other nodes", func() {
+			app.Action = func(ctx *cli.Context) error {
+				config.OVNKubernetesFeature.EnableInterconnect = true
+				config.OVNKubernetesFeature.EgressIPReachabilityBFD = true
+				egressIP := "192.168.126.101"
+				node1IPv4 := "192.168.126.51/24"
+				node2IPv4 := "192.168.126.52/24"
+				readyStatus := v1.NodeStatus{
+					Conditions: []v1.NodeCondition{
+						{
+							Type:   v1.NodeReady,
+							Status: v1.ConditionTrue,
+						},
+					},
+				}
+				node1 := v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: node1Name,
+						Annotations: map[string]string{
+							"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\"}", node1IPv4),
+							"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\"]}", v4NodeSubnet),
+							util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node1IPv4),
+						},
+						Labels: map[string]string{
+							"k8s.ovn.org/egress-assignable": "",
+						},
+					},
+					Status: readyStatus,
+				}
+				node2 := v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: node2Name,
+						Annotations: map[string]string{
+							"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\"}", node2IPv4),
+							"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\"]}", v4NodeSubnet),
+							util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node2IPv4),
+							util.OVNNodeEgressIPBFDStatus:     fmt.Sprintf("{\"%s\":\"up\"}", node1Name),
+						},
+					},
+					Status: readyStatus,
+				}
+				eIP1 := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{egressIP},
+					},
+				}
+				fakeClusterManagerOVN.start(
+					&egressipv1.EgressIPList{
+						Items: []egressipv1.EgressIP{eIP1},
+					},
+					&v1.NodeList{
+						Items: []v1.Node{node1, node2},
+					},
+				)
+				gomega.Expect(fakeClusterManagerOVN.eIPC.egressIPBFDReports).NotTo(gomega.BeNil())
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressNodes()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				_, err = fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(eIP1.Name)).Should(gomega.Equal(1))
+				gomega.Expect(nodeSwitch()).To(gomega.Equal(node1.Name))
+
+				// the peer reports the BFD session towards the egress node down
+				node2.Annotations[util.OVNNodeEgressIPBFDStatus] = fmt.Sprintf("{\"%s\":\"down\"}", node1Name)
+				_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), &node2, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getEgressIPStatusLen(eIP1.Name)).Should(gomega.Equal(0))
+				gomega.Eventually(getEgressIPAllocatorReachableSafely).WithArguments(node1.Name).Should(gomega.BeFalse())
+
+				// the session comes back up
+				node2.Annotations[util.OVNNodeEgressIPBFDStatus] = fmt.Sprintf("{\"%s\":\"up\"}", node1Name)
+				_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), &node2, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getEgressIPAllocatorReachableSafely).WithArguments(node1.Name).Should(gomega.BeTrue())
+				// this will trigger an immediate add retry for the node which we need to simulate for this test
+				fakeClusterManagerOVN.eIPC.retryEgressNodes.RequestRetryObjs()
+				gomega.Eventually(getEgressIPStatusLen(eIP1.Name)).Should(gomega.Equal(1))
+				gomega.Expect(nodeSwitch()).To(gomega.Equal(node1.Name))
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("EgressIP node preferences", func() {
+
+		getEgressIPPreferenceStates := func(egressIPName string) func() []egressipv1.EgressIPPreferenceState {
+			return func() []egressipv1.EgressIPPreferenceState {
+				tmp, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				var states []egressipv1.EgressIPPreferenceState
+				for _, preference := range tmp.Status.Preferences {
+					states = append(states, preference.State)
+				}
+				return states
+			}
+		}
+
+		getEgressNodes := func() (v1.Node, v1.Node) {
+			node1IPv4 := "192.168.126.51/24"
+			node2IPv4 := "192.168.126.52/24"
+			readyStatus := v1.NodeStatus{
+				Conditions: []v1.NodeCondition{
+					{
+						Type:   v1.NodeReady,
+						Status: v1.ConditionTrue,
+					},
+				},
+			}
+			node1 := v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: node1Name,
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\"}", node1IPv4),
+						"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\"]}", v4NodeSubnet),
+						util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node1IPv4),
+					},
+					Labels: map[string]string{
+						"k8s.ovn.org/egress-assignable": "",
+					},
+				},
+				Status: readyStatus,
+			}
+			node2 := v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: node2Name,
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\"}", node2IPv4),
+						"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\"]}", v4NodeSubnet),
+						util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node2IPv4),
+					},
+					Labels: map[string]string{
+						"k8s.ovn.org/egress-assignable": "",
+					},
+				},
+				Status: readyStatus,
+			}
+			return node1, node2
+		}
+
+		ginkgo.It("should assign the egress IP to its preferred node and move it back once the node recovers", func() {
+			app.Action = func(ctx *cli.Context) error {
+				egressIP := "192.168.126.101"
+				node1, node2 := getEgressNodes()
+				eIP1 := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{egressIP},
+						NodePreferences: []egressipv1.EgressIPNodePreference{
+							{
+								Nodes: []string{node2.Name},
+							},
+						},
+					},
+				}
+				fakeClusterManagerOVN.start(
+					&egressipv1.EgressIPList{
+						Items: []egressipv1.EgressIP{eIP1},
+					},
+					&v1.NodeList{
+						Items: []v1.Node{node1, node2},
+					},
+				)
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressNodes()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				_, err = fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(eIP1.Name)).Should(gomega.Equal(1))
+				gomega.Expect(nodeSwitch()).To(gomega.Equal(node2.Name))
+				gomega.Eventually(getEgressIPPreferenceStates(eIP1.Name)).Should(gomega.Equal(
+					[]egressipv1.EgressIPPreferenceState{egressipv1.EgressIPPreferred}))
+
+				// the preferred node can't host the egress IP anymore
+				node2.Labels = map[string]string{}
+				_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), &node2, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(nodeSwitch).Should(gomega.Equal(node1.Name))
+				gomega.Eventually(getEgressIPPreferenceStates(eIP1.Name)).Should(gomega.Equal(
+					[]egressipv1.EgressIPPreferenceState{egressipv1.EgressIPFallback}))
+
+				// the preferred node recovers
+				node2.Labels = map[string]string{
+					"k8s.ovn.org/egress-assignable": "",
+				}
+				_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), &node2, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(nodeSwitch).Should(gomega.Equal(node2.Name))
+				gomega.Eventually(getEgressIPPreferenceStates(eIP1.Name)).Should(gomega.Equal(
+					[]egressipv1.EgressIPPreferenceState{egressipv1.EgressIPPreferred}))
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should not assign the egress IP to other nodes when fallback is disabled", func() {
+			app.Action = func(ctx *cli.Context) error {
+				egressIP1 := "192.168.126.101"
+				egressIP2 := "192.168.126.102"
+				node1, node2 := getEgressNodes()
+				eIP1 := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{egressIP1, egressIP2},
+						NodePreferences: []egressipv1.EgressIPNodePreference{
+							{
+								EgressIPs: []string{egressIP1},
+								Nodes:     []string{node2.Name},
+							},
+						},
+						FailoverPolicy: egressipv1.EgressIPFailoverPolicyNoFallback,
+					},
+				}
+				fakeClusterManagerOVN.start(
+					&egressipv1.EgressIPList{
+						Items: []egressipv1.EgressIP{eIP1},
+					},
+					&v1.NodeList{
+						Items: []v1.Node{node1, node2},
+					},
+				)
+
+				// the egress IP is left unassigned for a while which records
+				// events on every reconciliation, drain them so that the
+				// recorder never blocks
+				stopEvents := make(chan struct{})
+				defer close(stopEvents)
+				go func() {
+					for {
+						select {
+						case <-fakeClusterManagerOVN.fakeRecorder.Events:
+						case <-stopEvents:
+							return
+						}
+					}
+				}()
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressNodes()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				_, err = fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(eIP1.Name)).Should(gomega.Equal(2))
+				egressIPs, nodes := getEgressIPStatus(eIP1.Name)
+				gomega.Expect(nodes[slices.Index(egressIPs, egressIP1)]).To(gomega.Equal(node2.Name))
+				gomega.Expect(nodes[slices.Index(egressIPs, egressIP2)]).To(gomega.Equal(node1.Name))
+				gomega.Eventually(getEgressIPPreferenceStates(eIP1.Name)).Should(gomega.Equal(
+					[]egressipv1.EgressIPPreferenceState{egressipv1.EgressIPPreferred}))
+
+				// the preferred node can't host the egress IP anymore, it
+				// should not fall back to the other node
+				node2.Labels = map[string]string{}
+				_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), &node2, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getEgressIPStatusLen(eIP1.Name)).Should(gomega.Equal(1))
+				egressIPs, nodes = getEgressIPStatus(eIP1.Name)
+				gomega.Expect(egressIPs).To(gomega.ConsistOf(egressIP2))
+				gomega.Expect(nodes).To(gomega.ConsistOf(node1.Name))
+				gomega.Eventually(getEgressIPPreferenceStates(eIP1.Name)).Should(gomega.Equal(
+					[]egressipv1.EgressIPPreferenceState{egressipv1.EgressIPUnassigned}))
+				gomega.Eventually(getEgressIPConditionReason(eIP1.Name, egressipv1.EgressIPAssignedCondition)).Should(
+					gomega.Equal(egressIPPreferredNodesUnavailableReason))
+				gomega.Eventually(getEgressIPConditionReason(eIP1.Name, egressipv1.EgressIPReachableCondition)).Should(
+					gomega.Equal(egressIPNodesReachableReason))
+
+				// the preferred node recovers
+				node2.Labels = map[string]string{
+					"k8s.ovn.org/egress-assignable": "",
+				}
+				_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), &node2, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getEgressIPStatusLen(eIP1.Name)).Should(gomega.Equal(2))
+				egressIPs, nodes = getEgressIPStatus(eIP1.Name)
+				gomega.Expect(nodes[slices.Index(egressIPs, egressIP1)]).To(gomega.Equal(node2.Name))
+				gomega.Eventually(getEgressIPPreferenceStates(eIP1.Name)).Should(gomega.Equal(
+					[]egressipv1.EgressIPPreferenceState{egressipv1.EgressIPPreferred}))
+				gomega.Eventually(getEgressIPConditionReason(eIP1.Name, egressipv1.EgressIPAssignedCondition)).Should(
+					gomega.Equal(egressIPAssignedReason))
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
 	})
 
 	ginkgo.Context("IPv6 assignment", func() {
@@ -1746,7 +2055,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 						EgressIPs: []string{egressIP},
 					},
 				}
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP).String()))
@@ -1828,7 +2137,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(2))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP1).String()))
@@ -1915,7 +2224,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(2))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP1).String()))
@@ -2009,7 +2318,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(2))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP1SecondaryHost).String()))
@@ -2095,10 +2404,10 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(node2Name))
-				assignedStatuses = fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses = fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(node2Name))
 				return nil
@@ -2180,7 +2489,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 
 				return nil
@@ -2263,7 +2572,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 
 				return nil
@@ -2342,7 +2651,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 				return nil
 			}
@@ -2421,7 +2730,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 				return nil
 			}
@@ -2500,7 +2809,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP).String()))
@@ -2581,7 +2890,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 				return nil
 			}
@@ -2737,7 +3046,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP).String()))
@@ -2922,6 +3231,102 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("should apply the status without overwriting the conditions of the zones", func() {
+			app.Action = func(ctx *cli.Context) error {
+				egressIP := "192.168.126.10"
+				node1IPv4 := "192.168.126.12/24"
+
+				node1 := v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: node1Name,
+						Annotations: map[string]string{
+							"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", node1IPv4, ""),
+							"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\", \"%s\"]}", v4NodeSubnet, v6NodeSubnet),
+							util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node1IPv4),
+						},
+						Labels: map[string]string{
+							"k8s.ovn.org/egress-assignable": "",
+						},
+					},
+					Status: v1.NodeStatus{
+						Conditions: []v1.NodeCondition{
+							{
+								Type:   v1.NodeReady,
+								Status: v1.ConditionTrue,
+							},
+						},
+					},
+				}
+
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{egressIP},
+						NamespaceSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"name": "does-not-exist",
+							},
+						},
+					},
+					Status: egressipv1.EgressIPStatus{
+						Items: []egressipv1.EgressIPStatusItem{},
+						Conditions: []metav1.Condition{
+							{
+								Type:   egressipv1.EgressIPProgrammedInZoneConditionPrefix + "zone1",
+								Status: metav1.ConditionTrue,
+								Reason: "Programmed",
+							},
+						},
+					},
+				}
+
+				fakeClusterManagerOVN.start(
+					&v1.NodeList{Items: []v1.Node{node1}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				)
+
+				var patches []clienttesting.PatchAction
+				var patchesLock sync.Mutex
+				fakeClusterManagerOVN.fakeClient.EgressIPClient.(*egressipfake.Clientset).PrependReactor("patch", "egressips",
+					func(action clienttesting.Action) (bool, runtime.Object, error) {
+						patchesLock.Lock()
+						defer patchesLock.Unlock()
+						patches = append(patches, action.(clienttesting.PatchAction))
+						return false, nil, nil
+					})
+
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{})
+				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(1))
+				patchesLock.Lock()
+				defer patchesLock.Unlock()
+				gomega.Expect(patches).NotTo(gomega.BeEmpty())
+				for _, patch := range patches {
+					if patch.GetPatchType() != k8stypes.ApplyPatchType {
+						// the mark annotation is patched on its own
+						gomega.Expect(string(patch.GetPatch())).NotTo(gomega.ContainSubstring("/status"))
+						continue
+					}
+					applied := egressipv1.EgressIP{}
+					gomega.Expect(json.Unmarshal(patch.GetPatch(), &applied)).To(gomega.Succeed())
+					var conditionTypes []string
+					for _, condition := range applied.Status.Conditions {
+						conditionTypes = append(conditionTypes, condition.Type)
+					}
+					gomega.Expect(conditionTypes).To(gomega.ConsistOf(egressipv1.EgressIPAssignedCondition,
+						egressipv1.EgressIPReachableCondition))
+				}
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
 		ginkgo.It("should update status correctly for single-stack IPv6", func() {
 			app.Action = func(ctx *cli.Context) error {
 				egressIP := "0:0:0:0:0:feff:c0a8:8e0d"
@@ -44,6 +44,10 @@ func (h *egressIPClusterControllerEventHandler) AddResource(obj interface{}, fro
 		if err := h.eIPC.initEgressIPAllocator(node); err != nil {
 			klog.Warningf("Egress node initialization error: %v", err)
 		}
+		// Any node may report the BFD status of the egress nodes
+		if h.eIPC.egressIPBFDReports != nil {
+			h.eIPC.updateEgressIPBFDReports(node)
+		}
 		nodeEgressLabel := util.GetNodeEgressLabel()
 		nodeLabels := node.GetLabels()
 		_, hasEgressLabel := nodeLabels[nodeEgressLabel]
@@ -99,6 +103,12 @@ func (h *egressIPClusterControllerEventHandler) UpdateResource(oldObj, newObj in
 		if err := h.eIPC.initEgressIPAllocator(newNode); err != nil {
 			klog.Warningf("Egress node initialization error: %v", err)
 		}
+		// Any node may report the BFD status of the egress nodes, hence
+		// process the reports before checking the egress label
+		if h.eIPC.egressIPBFDReports != nil && (util.NodeEgressIPBFDStatusAnnotationChanged(oldNode, newNode) ||
+			h.eIPC.isEgressNodeReady(oldNode) != h.eIPC.isEgressNodeReady(newNode)) {
+			h.eIPC.updateEgressIPBFDReports(newNode)
+		}
 		nodeEgressLabel := util.GetNodeEgressLabel()
 		oldLabels := oldNode.GetLabels()
 		newLabels := newNode.GetLabels()
@@ -178,6 +188,9 @@ func (h *egressIPClusterControllerEventHandler) DeleteResource(obj, cachedObj in
 			return nil
 		}
 		h.eIPC.deleteNodeForEgress(node)
+		if h.eIPC.egressIPBFDReports != nil {
+			h.eIPC.deleteEgressIPBFDReports(node)
+		}
 		nodeEgressLabel := util.GetNodeEgressLabel()
 		nodeLabels := node.GetLabels()
 		_, hasEgressLabel := nodeLabels[nodeEgressLabel]
@@ -0,0 +1,201 @@
+package clustermanager
+
+import (
+	"net"
+	"reflect"
+	"slices"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+
+	"k8s.io/klog/v2"
+)
+
+// egressIPNodePreferences holds the preferred nodes of the egress IPs of an
+// EgressIP object as defined by its spec.
+type egressIPNodePreferences struct {
+	// preferredNodes maps an egress IP to its preferred nodes, in decreasing
+	// order of priority
+	preferredNodes map[string][]string
+	// defaultNodes are the preferred nodes of the egress IPs which do not have
+	// a preference of their own
+	defaultNodes []string
+	// noFallback is true if egress IPs must not be assigned to nodes other
+	// than their preferred nodes
+	noFallback bool
+}
+
+// newEgressIPNodePreferences returns the node preferences defined by the
+// EgressIP spec or nil if it does not define any
+func newEgressIPNodePreferences(spec *egressipv1.EgressIPSpec) *egressIPNodePreferences {
+	if len(spec.NodePreferences) == 0 {
+		return nil
+	}
+	p := &egressIPNodePreferences{
+		preferredNodes: map[string][]string{},
+		noFallback:     spec.FailoverPolicy == egressipv1.EgressIPFailoverPolicyNoFallback,
+	}
+	for _, preference := range spec.NodePreferences {
+		if len(preference.EgressIPs) == 0 {
+			if p.defaultNodes == nil {
+				p.defaultNodes = preference.Nodes
+			}
+			continue
+		}
+		for _, egressIP := range preference.EgressIPs {
+			ip := net.ParseIP(egressIP)
+			if ip == nil {
+				klog.Warningf("Ignoring node preference of invalid egress IP %q", egressIP)
+				continue
+			}
+			if _, exists := p.preferredNodes[ip.String()]; !exists {
+				p.preferredNodes[ip.String()] = preference.Nodes
+			}
+		}
+	}
+	return p
+}
+
+// getPreferredNodes returns the preferred nodes of the egress IP, in
+// decreasing order of priority
+func (p *egressIPNodePreferences) getPreferredNodes(egressIP string) []string {
+	if p == nil {
+		return nil
+	}
+	if nodes, exists := p.preferredNodes[egressIP]; exists {
+		return nodes
+	}
+	return p.defaultNodes
+}
+
+// getPriority returns the position of the node within the preferred nodes of
+// the egress IP, or -1 if it is not one of them
+func (p *egressIPNodePreferences) getPriority(egressIP, nodeName string) int {
+	return slices.Index(p.getPreferredNodes(egressIP), nodeName)
+}
+
+// hasNode returns true if the node is a preferred node of any egress IP
+func (p *egressIPNodePreferences) hasNode(nodeName string) bool {
+	if p == nil {
+		return false
+	}
+	if slices.Contains(p.defaultNodes, nodeName) {
+		return true
+	}
+	for _, nodes := range p.preferredNodes {
+		if slices.Contains(nodes, nodeName) {
+			return true
+		}
+	}
+	return false
+}
+
+// getEgressIPCandidateNodes returns the assignable nodes in the order they
+// should be considered for the egress IP: its preferred nodes first, in
+// decreasing order of priority, followed by the rest of the assignable nodes
+// unless fallback is disabled.
+func getEgressIPCandidateNodes(assignableNodes []*egressNode, preferences *egressIPNodePreferences, egressIP string) []*egressNode {
+	preferredNodes := preferences.getPreferredNodes(egressIP)
+	if len(preferredNodes) == 0 {
+		return assignableNodes
+	}
+	candidates := make([]*egressNode, 0, len(assignableNodes))
+	for _, preferredNode := range preferredNodes {
+		for _, eNode := range assignableNodes {
+			if eNode.name == preferredNode {
+				candidates = append(candidates, eNode)
+				break
+			}
+		}
+	}
+	if preferences.noFallback {
+		return candidates
+	}
+	for _, eNode := range assignableNodes {
+		if preferences.getPriority(egressIP, eNode.name) < 0 {
+			candidates = append(candidates, eNode)
+		}
+	}
+	return candidates
+}
+
+// shouldMoveToPreferredNode returns true if the assigned egress IP should be
+// moved because a preferred node of higher priority than the one it is
+// assigned to can host it, or because it is assigned to a node which is not
+// one of its preferred nodes while fallback is disabled.
+func (eIPC *egressIPClusterController) shouldMoveToPreferredNode(name string, status egressipv1.EgressIPStatusItem,
+	preferences *egressIPNodePreferences) bool {
+	preferredNodes := preferences.getPreferredNodes(status.EgressIP)
+	if len(preferredNodes) == 0 {
+		return false
+	}
+	priority := preferences.getPriority(status.EgressIP, status.Node)
+	if priority < 0 {
+		if preferences.noFallback {
+			klog.Infof("EgressIP: %s IP %s is assigned to node %s which is not one of its preferred nodes and fallback "+
+				"is disabled, will attempt reassignment", name, status.EgressIP, status.Node)
+			return true
+		}
+		priority = len(preferredNodes)
+	}
+	eIP := net.ParseIP(status.EgressIP)
+	if eIP == nil {
+		return false
+	}
+	eIPC.nodeAllocator.Lock()
+	defer eIPC.nodeAllocator.Unlock()
+	for _, preferredNode := range preferredNodes[:priority] {
+		eNode, exists := eIPC.nodeAllocator.cache[preferredNode]
+		if !exists || !eNode.isEgressAssignable || !eNode.isReady || !eNode.isReachable {
+			continue
+		}
+		if _, ok := eIPC.canHostEgressIP(name, eNode, eIP); ok {
+			klog.Infof("EgressIP: %s IP %s assigned to node %s can be moved back to preferred node %s",
+				name, status.EgressIP, status.Node, preferredNode)
+			return true
+		}
+	}
+	return false
+}
+
+// getEgressIPPreferenceStatus returns the preference state of the egress IPs of
+// the spec which have preferred nodes given their assignments
+func getEgressIPPreferenceStatus(spec *egressipv1.EgressIPSpec, items []egressipv1.EgressIPStatusItem) []egressipv1.EgressIPPreferenceStatusItem {
+	preferences := newEgressIPNodePreferences(spec)
+	if preferences == nil {
+		return nil
+	}
+	assignments := make(map[string]string, len(items))
+	for _, item := range items {
+		assignments[item.EgressIP] = item.Node
+	}
+	var status []egressipv1.EgressIPPreferenceStatusItem
+	seen := map[string]bool{}
+	for _, egressIP := range spec.EgressIPs {
+		ip := net.ParseIP(egressIP)
+		if ip == nil || seen[ip.String()] || len(preferences.getPreferredNodes(ip.String())) == 0 {
+			continue
+		}
+		seen[ip.String()] = true
+		state := egressipv1.EgressIPUnassigned
+		if node, assigned := assignments[ip.String()]; assigned {
+			state = egressipv1.EgressIPFallback
+			if preferences.getPriority(ip.String(), node) >= 0 {
+				state = egressipv1.EgressIPPreferred
+			}
+		}
+		status = append(status, egressipv1.EgressIPPreferenceStatusItem{
+			EgressIP: ip.String(),
+			State:    state,
+		})
+	}
+	return status
+}
+
+// isEgressIPPreferenceStatusStale returns true if the preference state reported
+// in the EgressIP status does not match its assignments
+func isEgressIPPreferenceStatusStale(eIP *egressipv1.EgressIP, items []egressipv1.EgressIPStatusItem) bool {
+	if eIP == nil {
+		return false
+	}
+	return !reflect.DeepEqual(getEgressIPPreferenceStatus(&eIP.Spec, items), eIP.Status.Preferences)
+}
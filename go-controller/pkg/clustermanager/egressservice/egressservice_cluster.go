@@ -3,6 +3,7 @@ package egressservice
 import (
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -21,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -71,7 +73,7 @@ type Controller struct {
 }
 
 type svcState struct {
-	node     string
+	nodes    []string // the nodes allocated to the service, the first one being its host
 	selector labels.Selector
 	stale    bool
 }
@@ -271,19 +273,9 @@ func (c *Controller) repair() error {
 		}
 
 		nodeSelector := &es.Spec.NodeSelector
-		svcHost := es.Status.Host
+		svcHosts := util.GetEgressServiceHosts(es)
 
-		if svcHost == "" {
-			continue
-		}
-
-		node, err := c.watchFactory.GetNode(svcHost)
-		if err != nil {
-			klog.Errorf("Node %s could not be retrieved from lister, err: %v", svcHost, err)
-			continue
-		}
-		if !nodeIsReady(node) {
-			klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+		if len(svcHosts) == 0 {
 			continue
 		}
 
@@ -300,7 +292,7 @@ func (c *Controller) repair() error {
 
 		if len(epsNodes) != 0 && svc.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyTypeLocal {
 			// If the service is ETP=Local only a node with local eps can be used.
-			// We want to verify that the current selected node has a local ep.
+			// We want to verify that the current selected nodes have a local ep.
 			matchEpsNodes := metav1.LabelSelectorRequirement{
 				Key:      "kubernetes.io/hostname",
 				Operator: metav1.LabelSelectorOpIn,
@@ -315,23 +307,41 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		if !selector.Matches(labels.Set(node.Labels)) {
-			klog.Infof("Node %s does no longer match service %s selectors %s", svcHost, key, selector.String())
-			continue
-		}
-
-		nodeState, ok := c.nodes[svcHost]
-		if !ok {
-			nodeState, err = c.nodeStateFor(svcHost)
+		// Keep only the hosts that are still valid for the service
+		svcState := &svcState{selector: selector, stale: false}
+		for _, svcHost := range svcHosts {
+			node, err := c.watchFactory.GetNode(svcHost)
 			if err != nil {
-				klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+				klog.Errorf("Node %s could not be retrieved from lister, err: %v", svcHost, err)
 				continue
 			}
+			if !nodeIsReady(node) {
+				klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+				continue
+			}
+
+			if !selector.Matches(labels.Set(node.Labels)) {
+				klog.Infof("Node %s does no longer match service %s selectors %s", svcHost, key, selector.String())
+				continue
+			}
+
+			nodeState, ok := c.nodes[svcHost]
+			if !ok {
+				nodeState, err = c.nodeStateFor(svcHost)
+				if err != nil {
+					klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+					continue
+				}
+			}
+
+			svcState.nodes = append(svcState.nodes, svcHost)
+			nodeState.allocations[key] = svcState
+			c.nodes[svcHost] = nodeState
 		}
 
-		svcState := &svcState{node: svcHost, selector: selector, stale: false}
-		nodeState.allocations[key] = svcState
-		c.nodes[svcHost] = nodeState
+		if len(svcState.nodes) == 0 {
+			continue
+		}
 		c.services[key] = svcState
 	}
 
@@ -367,16 +377,16 @@ func (c *Controller) repair() error {
 
 	// now remove any stale egress service labels on nodes
 	nodes, _ := c.watchFactory.GetNodes()
-	svcLabelToNode := map[string]string{}
+	svcLabelToNodes := map[string]sets.Set[string]{}
 	for key, state := range c.services {
 		namespace, name, _ := cache.SplitMetaNamespaceKey(key)
-		svcLabelToNode[c.nodeLabelForService(namespace, name)] = state.node
+		svcLabelToNodes[c.nodeLabelForService(namespace, name)] = sets.New(state.nodes...)
 	}
 
 	for _, node := range nodes {
 		labelsToRemove := map[string]any{}
 		for labelKey := range node.Labels {
-			if strings.HasPrefix(labelKey, egressSVCLabelPrefix) && svcLabelToNode[labelKey] != node.Name {
+			if strings.HasPrefix(labelKey, egressSVCLabelPrefix) && !svcLabelToNodes[labelKey].Has(node.Name) {
 				labelsToRemove[labelKey] = nil // Patching with a nil value results in the delete of the key
 			}
 		}
@@ -591,14 +601,14 @@ func (c *Controller) syncEgressService(key string) error {
 		// This means we need to select a node for it that matches its selector.
 		c.unallocatedServices[key] = selector
 
-		node, err := c.selectNodeFor(selector)
+		node, err := c.selectNodeFor(selector, sets.New[string]())
 		if err != nil {
 			return err
 		}
 
 		// We found a node - update the caches with the new objects.
 		delete(c.unallocatedServices, key)
-		newState := &svcState{node: node.name, selector: selector, stale: false}
+		newState := &svcState{nodes: []string{node.name}, selector: selector, stale: false}
 		c.services[key] = newState
 		node.allocations[key] = newState
 		c.nodes[node.name] = node
@@ -606,29 +616,118 @@ func (c *Controller) syncEgressService(key string) error {
 	}
 
 	state.selector = selector
-	node := c.nodes[state.node]
 
-	if !state.selector.Matches(labels.Set(node.labels)) {
-		// The node no longer matches the selector.
-		// We clear its configured resources and requeue it to attempt
-		// selecting a new node for it.
-		return c.clearServiceResourcesAndRequeue(key, state, noHost)
+	// The nodes might no longer match the selector, or no longer be in the cache.
+	// We release them and attempt selecting new nodes for the service instead,
+	// clearing its configured resources and requeuing it if none of them match.
+	for _, nodeName := range slices.Clone(state.nodes) {
+		if nodeState, found := c.nodes[nodeName]; found && state.selector.Matches(labels.Set(nodeState.labels)) {
+			continue
+		}
+		if len(state.nodes) == 1 {
+			return c.clearServiceResourcesAndRequeue(key, state, noHost)
+		}
+		if err := c.releaseServiceNode(key, state, nodeName); err != nil {
+			return err
+		}
+	}
+
+	// The requested amount of hosts might have decreased, we release the
+	// most recently selected nodes until it is honored.
+	hostCount := hostCountFor(es)
+	for len(state.nodes) > hostCount {
+		if err := c.releaseServiceNode(key, state, state.nodes[len(state.nodes)-1]); err != nil {
+			return err
+		}
+	}
+
+	// Select additional nodes for the service until it reaches the requested amount of hosts.
+	// If not enough nodes are available it stays in the unallocated services cache in
+	// order to be queued again when a matching node becomes available.
+	delete(c.unallocatedServices, key)
+	for len(state.nodes) < hostCount {
+		node, err := c.selectNodeFor(selector, sets.New(state.nodes...))
+		if err != nil {
+			klog.V(4).Infof("EgressService %s/%s has %d hosts out of the %d requested: %v",
+				namespace, name, len(state.nodes), hostCount, err)
+			c.unallocatedServices[key] = selector
+			break
+		}
+		state.nodes = append(state.nodes, node.name)
+		node.allocations[key] = state
+		c.nodes[node.name] = node
+	}
+
+	// Node allocation is done - the last step is to label the nodes and set the status
+	// to mark them as the nodes holding the service.
+
+	err = c.setEgressServiceHosts(namespace, name, state.nodes) // set the EgressService status, will also override manual changes
+	if err != nil {
+		return err
+	}
+
+	for _, nodeName := range state.nodes {
+		if err := c.labelNodeForService(namespace, name, nodeName); err != nil {
+			return err
+		}
 	}
 
-	// Node allocation is done - the last step is to label the node and set the status
-	// to mark it as the node holding the service.
+	return nil
+}
 
-	err = c.setEgressServiceHost(namespace, name, state.node) // set the EgressService status, will also override manual changes
+// Releases one of the nodes allocated to a service which has other nodes allocated,
+// removing the label from the node, updating the caches and the status of the service.
+// This should only be called with the controller locked.
+func (c *Controller) releaseServiceNode(key string, svcState *svcState, nodeName string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		return err
 	}
 
-	return c.labelNodeForService(namespace, name, node.name)
+	nodes := slices.DeleteFunc(slices.Clone(svcState.nodes), func(n string) bool { return n == nodeName })
+	if err := c.setEgressServiceHosts(namespace, name, nodes); err != nil {
+		return err
+	}
+
+	if err := c.removeNodeServiceLabel(namespace, name, nodeName); err != nil {
+		return fmt.Errorf("failed to remove svc node label for %s, err: %v", nodeName, err)
+	}
+
+	if nodeState, found := c.nodes[nodeName]; found {
+		delete(nodeState.allocations, key)
+	}
+	svcState.nodes = nodes
+	return nil
+}
+
+// Releases the given node from the service, clearing all of the service resources
+// and requeuing it if it is the only node allocated to it, or queuing it to
+// select another node otherwise.
+// This should only be called with the controller locked.
+func (c *Controller) clearServiceNodeAndRequeue(key string, svcState *svcState, nodeName string) error {
+	if len(svcState.nodes) <= 1 {
+		return c.clearServiceResourcesAndRequeue(key, svcState, noHost)
+	}
+
+	if err := c.releaseServiceNode(key, svcState, nodeName); err != nil {
+		return err
+	}
+
+	c.egressServiceQueue.Add(key)
+	return nil
+}
+
+// Returns the amount of nodes that should be selected for the EgressService.
+func hostCountFor(es *egressserviceapi.EgressService) int {
+	if es.Spec.HostCount < 1 {
+		return 1
+	}
+	return int(es.Spec.HostCount)
 }
 
 // Removes the status of an egress service.
 // This includes updating the status according to the given host,
-// removing the label from the node and updating the caches.
+// removing the label from its nodes and updating the caches.
 // This also requeues the service after cleaning up to be sure we are not
 // missing an event after marking it as stale that should be handled.
 // This should only be called with the controller locked.
@@ -644,10 +743,13 @@ func (c *Controller) clearServiceResourcesAndRequeue(key string, svcState *svcSt
 		return err
 	}
 
-	nodeState, found := c.nodes[svcState.node]
-	if found {
-		if err := c.removeNodeServiceLabel(namespace, name, svcState.node); err != nil {
-			return fmt.Errorf("failed to remove svc node label for %s, err: %v", svcState.node, err)
+	for _, nodeName := range svcState.nodes {
+		nodeState, found := c.nodes[nodeName]
+		if !found {
+			continue
+		}
+		if err := c.removeNodeServiceLabel(namespace, name, nodeName); err != nil {
+			return fmt.Errorf("failed to remove svc node label for %s, err: %v", nodeName, err)
 		}
 		delete(nodeState.allocations, key)
 	}
@@ -657,8 +759,13 @@ func (c *Controller) clearServiceResourcesAndRequeue(key string, svcState *svcSt
 	return nil
 }
 
+// Sets the status of the egress service to the given nodes, the first one being its host.
+func (c *Controller) setEgressServiceHosts(namespace, name string, nodes []string) error {
+	return c.kubeOVN.UpdateEgressServiceStatus(namespace, name, nodes[0], nodes)
+}
+
 func (c *Controller) setEgressServiceHost(namespace, name, host string) error {
-	err := c.kubeOVN.UpdateEgressServiceStatus(namespace, name, host)
+	err := c.kubeOVN.UpdateEgressServiceStatus(namespace, name, host, nil)
 	if err != nil {
 		if host != "" {
 			return err
@@ -185,7 +185,7 @@ func (c *Controller) syncNode(key string) error {
 			// Services can't be assigned to a node while it is in draining status.
 			state.draining = true
 			for svcKey, svcState := range state.allocations {
-				if err := c.clearServiceResourcesAndRequeue(svcKey, svcState, noHost); err != nil {
+				if err := c.clearServiceNodeAndRequeue(svcKey, svcState, nodeName); err != nil {
 					return err
 				}
 			}
@@ -228,7 +228,7 @@ func (c *Controller) syncNode(key string) error {
 		// because we don't care about its reachability status until it becomes ready.
 		state.draining = true
 		for svcKey, svcState := range state.allocations {
-			if err := c.clearServiceResourcesAndRequeue(svcKey, svcState, noHost); err != nil {
+			if err := c.clearServiceNodeAndRequeue(svcKey, svcState, nodeName); err != nil {
 				return err
 			}
 		}
@@ -243,7 +243,7 @@ func (c *Controller) syncNode(key string) error {
 		// When it is fully drained and reachable again it will be requeued.
 		state.draining = true
 		for svcKey, svcState := range state.allocations {
-			if err := c.clearServiceResourcesAndRequeue(svcKey, svcState, noHost); err != nil {
+			if err := c.clearServiceNodeAndRequeue(svcKey, svcState, nodeName); err != nil {
 				return err
 			}
 		}
@@ -255,10 +255,16 @@ func (c *Controller) syncNode(key string) error {
 	// to run all of its allocations.
 	// If a service's selector no longer matches this node we attempt to reallocate it.
 	for svcKey, svcState := range state.allocations {
-		if !svcState.selector.Matches(labels.Set(n.Labels)) || svcState.stale {
+		if svcState.stale {
 			if err := c.clearServiceResourcesAndRequeue(svcKey, svcState, noHost); err != nil {
 				return err
 			}
+			continue
+		}
+		if !svcState.selector.Matches(labels.Set(n.Labels)) {
+			if err := c.clearServiceNodeAndRequeue(svcKey, svcState, nodeName); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -378,8 +384,9 @@ func (c *Controller) nodeLabelForService(namespace, name string) string {
 
 // Returns the most suitable nodeState of the node for the given selector -
 // The most suitable node being one that matches the selector with the
-// least amount of allocations and is not in a "draining" state.
-func (c *Controller) selectNodeFor(selector labels.Selector) (*nodeState, error) {
+// least amount of allocations, is not in a "draining" state and is not
+// one of the excluded nodes.
+func (c *Controller) selectNodeFor(selector labels.Selector, excluded sets.Set[string]) (*nodeState, error) {
 	nodes, err := c.watchFactory.GetNodesBySelector(selector)
 	if err != nil {
 		return nil, err
@@ -394,7 +401,7 @@ func (c *Controller) selectNodeFor(selector labels.Selector) (*nodeState, error)
 
 	cachedNames, cachedStates := c.cachedNodesFor(selector)
 
-	freeNodes := allReadyNodes.Difference(cachedNames)
+	freeNodes := allReadyNodes.Difference(cachedNames).Difference(excluded)
 	if freeNodes.Len() > 0 {
 		// We have a matching node with 0 allocations, we can just use it
 		// instead of using one from the cache.
@@ -410,7 +417,7 @@ func (c *Controller) selectNodeFor(selector labels.Selector) (*nodeState, error)
 	})
 
 	for _, node := range cachedStates {
-		if !node.draining {
+		if !node.draining && !excluded.Has(node.name) {
 			return node, nil
 		}
 	}
@@ -731,6 +731,152 @@ var _ = ginkgo.Describe("Cluster manager Egress Service operations", func() {
 			gomega.Expect(err).ToNot(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("should create/update/delete the hosts and node labels of a service with multiple hosts", func() {
+			app.Action = func(ctx *cli.Context) error {
+				namespaceT := *newNamespace("testns")
+				node1 := nodeFor(node1Name, node1IPv4, node1IPv6, node1IPv4Subnet, node1IPv6Subnet)
+				node1.Labels = map[string]string{"animal": "FlyingBison"}
+				node2 := nodeFor(node2Name, node2IPv4, node2IPv6, node2IPv4Subnet, node2IPv6Subnet)
+				node2.Labels = map[string]string{"animal": "FlyingBison"}
+
+				ginkgo.By("creating an egress service requesting two hosts both nodes will be selected and labeled")
+				esvc1 := egressserviceapi.EgressService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1",
+						Namespace: "testns",
+					},
+					Spec: egressserviceapi.EgressServiceSpec{
+						SourceIPBy: egressserviceapi.SourceIPLoadBalancer,
+						NodeSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"animal": "FlyingBison",
+							},
+						},
+						HostCount: 2,
+					},
+				}
+				svc1 := lbSvcFor("testns", "svc1")
+				svc1EpSlice := discovery.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1-epslice",
+						Namespace: "testns",
+						Labels: map[string]string{
+							discovery.LabelServiceName: "svc1",
+						},
+					},
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"10.128.1.5"},
+							NodeName:  &node1.Name,
+						},
+					},
+				}
+
+				objs := []runtime.Object{
+					&v1.NamespaceList{
+						Items: []v1.Namespace{
+							namespaceT,
+						},
+					},
+					&v1.NodeList{
+						Items: []v1.Node{
+							*node1,
+							*node2,
+						},
+					},
+					&v1.ServiceList{
+						Items: []v1.Service{
+							svc1,
+						},
+					},
+					&discovery.EndpointSliceList{
+						Items: []discovery.EndpointSlice{
+							svc1EpSlice,
+						},
+					},
+					&egressserviceapi.EgressServiceList{
+						Items: []egressserviceapi.EgressService{
+							esvc1,
+						},
+					},
+				}
+
+				fakeCM.start(objs...)
+
+				svcLabel := fmt.Sprintf("%s/testns-svc1", egressSVCLabelPrefix)
+				// checks that the service hosts are exactly the given amount of nodes and that only them are labeled
+				expectHosts := func(hostCount int) error {
+					es, err := fakeCM.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Get(context.TODO(), svc1.Name, metav1.GetOptions{})
+					if err != nil {
+						return err
+					}
+
+					if len(es.Status.Hosts) != hostCount {
+						return fmt.Errorf("expected svc1 to have %d hosts, got %v", hostCount, es.Status.Hosts)
+					}
+
+					if es.Status.Host != es.Status.Hosts[0] {
+						return fmt.Errorf("expected svc1's host %s to be its first host %s", es.Status.Host, es.Status.Hosts[0])
+					}
+
+					hosts := map[string]bool{}
+					for _, host := range es.Status.Hosts {
+						hosts[host] = true
+					}
+
+					for _, nodeName := range []string{node1Name, node2Name} {
+						node, err := fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+						if err != nil {
+							return err
+						}
+
+						if _, labeled := node.Labels[svcLabel]; labeled != hosts[nodeName] {
+							return fmt.Errorf("expected %s to be labeled %t, got labels %v", nodeName, hosts[nodeName], node.Labels)
+						}
+					}
+
+					return nil
+				}
+
+				gomega.Eventually(func() error {
+					return expectHosts(2)
+				}).ShouldNot(gomega.HaveOccurred())
+
+				ginkgo.By("decreasing the amount of requested hosts one of the nodes will be released")
+				esvc1.Spec.HostCount = 1
+				esvc1.ResourceVersion = "2"
+				_, err := fakeCM.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Update(context.TODO(), &esvc1, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				gomega.Eventually(func() error {
+					return expectHosts(1)
+				}).ShouldNot(gomega.HaveOccurred())
+
+				ginkgo.By("deleting the EgressService both nodes will not have the label")
+				err = fakeCM.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Delete(context.TODO(), esvc1.Name, metav1.DeleteOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				gomega.Eventually(func() error {
+					for _, nodeName := range []string{node1Name, node2Name} {
+						node, err := fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+						if err != nil {
+							return err
+						}
+
+						if _, labeled := node.Labels[svcLabel]; labeled {
+							return fmt.Errorf("expected %s to not be labeled, got labels %v", nodeName, node.Labels)
+						}
+					}
+
+					return nil
+				}).ShouldNot(gomega.HaveOccurred())
+
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
 		ginkgo.It("should do nothing when an invalid nodeSelector is specified", func() {
 			app.Action = func(ctx *cli.Context) error {
 				namespaceT := *newNamespace("testns")
@@ -2,14 +2,17 @@ package clustermanager
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
 	"reflect"
 	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	cache "k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -18,6 +21,7 @@ import (
 	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/id"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/external"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
 	annotationalloc "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/node"
@@ -25,6 +29,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/persistentips"
 	objretry "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/retry"
@@ -32,6 +37,10 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
+// podAllocatorMetricsInterval is the interval at which the pod and IP pool
+// metrics of a network are recorded
+const podAllocatorMetricsInterval = 30 * time.Second
+
 type NetworkStatusReporter func(networkName string, fieldManager string, condition *metav1.Condition, events ...*util.EventDetails) error
 
 // networkClusterController is the cluster controller for the networks. An
@@ -386,6 +395,12 @@ func (ncc *networkClusterController) Start(ctx context.Context) error {
 			return fmt.Errorf("unable to watch pods: %w", err)
 		}
 		ncc.podHandler = podHandler
+
+		ncc.wg.Add(1)
+		go func() {
+			defer ncc.wg.Done()
+			wait.Until(ncc.podAllocator.RecordMetrics, podAllocatorMetricsInterval, ncc.stopChan)
+		}()
 	}
 
 	return nil
@@ -406,6 +421,8 @@ func (ncc *networkClusterController) Stop() {
 	if ncc.podHandler != nil {
 		ncc.watchFactory.RemovePodHandler(ncc.podHandler)
 	}
+
+	metrics.DeleteNetworkClusterManagerMetrics(ncc.GetNetworkName())
 }
 
 func (ncc *networkClusterController) newRetryFramework(objectType reflect.Type, hasUpdateFunc bool) *objretry.RetryFramework {
@@ -435,6 +452,16 @@ func (ncc *networkClusterController) Cleanup() error {
 		}
 	}
 
+	if ncc.subnetAllocator != nil {
+		// release the IPs of the network held by its external IPAM provider,
+		// if any
+		ncc.subnetAllocator.DeleteSubnet(ncc.GetNetworkName())
+		if ncc.IPAMProvider() != "" {
+			releaseIPAMProvider(ncc.IPAMProvider())
+		}
+		ncc.subnetAllocator = nil
+	}
+
 	return nil
 }
 
@@ -711,10 +738,85 @@ func (h *networkClusterControllerEventHandler) clearInitialNodeNetworkUnavailabl
 	}
 }
 
+// ipamProvider is a connection to an external IPAM provider, shared by all
+// the networks using it
+type ipamProvider struct {
+	*external.GRPCProvider
+	// number of networks using the provider
+	refs int
+}
+
+var (
+	// ipamProviders are the external IPAM providers in use, by name
+	ipamProviders     = map[string]*ipamProvider{}
+	ipamProvidersLock sync.Mutex
+)
+
+// getIPAMProvider returns the external IPAM provider with the given name,
+// connecting to it on first use. Each call must be paired with a call to
+// releaseIPAMProvider once the provider is no longer used.
+func getIPAMProvider(name string) (external.Provider, error) {
+	ipamProvidersLock.Lock()
+	defer ipamProvidersLock.Unlock()
+	if provider, ok := ipamProviders[name]; ok {
+		provider.refs++
+		return provider, nil
+	}
+	target, ok := config.ClusterManager.IPAMProviders[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown IPAM provider %q", name)
+	}
+	var tlsConfig *tls.Config
+	if config.ClusterManager.IPAMProviderCACert != "" {
+		var err error
+		tlsConfig, err = external.NewTLSConfig(config.ClusterManager.IPAMProviderCACert,
+			config.ClusterManager.IPAMProviderClientCert, config.ClusterManager.IPAMProviderClientKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	grpcProvider, err := external.NewGRPCProvider(target, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	provider := &ipamProvider{GRPCProvider: grpcProvider, refs: 1}
+	ipamProviders[name] = provider
+	return provider, nil
+}
+
+// releaseIPAMProvider closes the connection to the external IPAM provider
+// with the given name once no network uses it anymore
+func releaseIPAMProvider(name string) {
+	ipamProvidersLock.Lock()
+	defer ipamProvidersLock.Unlock()
+	provider, ok := ipamProviders[name]
+	if !ok {
+		return
+	}
+	provider.refs--
+	if provider.refs > 0 {
+		return
+	}
+	delete(ipamProviders, name)
+	if err := provider.Close(); err != nil {
+		klog.Warningf("Failed to close the connection to IPAM provider %s: %v", name, err)
+	}
+}
+
 // newIPAllocatorForNetwork returns an initialized subnet allocator for the
-// subnets / excluded subnets provided in `netInfo`
+// subnets / excluded subnets provided in `netInfo`. The allocation of IPs is
+// delegated to the network's external IPAM provider, if any.
 func newIPAllocatorForNetwork(netInfo util.NetInfo) (subnet.Allocator, error) {
-	ipAllocator := subnet.NewAllocator()
+	var ipAllocator subnet.Allocator
+	if netInfo.IPAMProvider() != "" {
+		provider, err := getIPAMProvider(netInfo.IPAMProvider())
+		if err != nil {
+			return nil, err
+		}
+		ipAllocator = external.NewAllocator(provider)
+	} else {
+		ipAllocator = subnet.NewAllocator()
+	}
 
 	subnets := netInfo.Subnets()
 	ipNets := make([]*net.IPNet, 0, len(subnets))
@@ -734,6 +836,9 @@ func newIPAllocatorForNetwork(netInfo util.NetInfo) (subnet.Allocator, error) {
 		ipNets,
 		excludeSubnets...,
 	); err != nil {
+		if netInfo.IPAMProvider() != "" {
+			releaseIPAMProvider(netInfo.IPAMProvider())
+		}
 		return nil, err
 	}
 
@@ -2,6 +2,7 @@ package pod
 
 import (
 	"fmt"
+	"net"
 	"sync"
 
 	corev1 "k8s.io/api/core/v1"
@@ -11,6 +12,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	ref "k8s.io/client-go/tools/reference"
 	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
 
 	nettypes "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
@@ -18,6 +20,8 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kubevirt"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/persistentips"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -50,6 +54,31 @@ type PodAllocator struct {
 	// release more than once
 	releasedPods      map[string]sets.Set[string]
 	releasedPodsMutex sync.Mutex
+
+	// track pods that have resources allocated on the network, keyed by NAD
+	// and UID, to report them in the network metrics
+	pods      sets.Set[string]
+	podsMutex sync.Mutex
+
+	// track the MACs requested by pods on primary networks to detect
+	// conflicts
+	requestedMACs      map[string]*requestedMAC
+	requestedMACsMutex sync.Mutex
+}
+
+// requestedMAC tracks the pods a requested MAC is allocated to, keyed by NAD
+// and UID. The pods of a VM share the MAC through migrations, otherwise the
+// MAC is allocated to a single pod.
+type requestedMAC struct {
+	owner string
+	pods  sets.Set[string]
+}
+
+// ipReconciler is implemented by the IP allocators whose allocations persist
+// across restarts, to release the allocations no longer in use once the
+// allocations in use have been synced
+type ipReconciler interface {
+	ReconcileIPs(name string) error
 }
 
 // NewPodAllocator builds a new PodAllocator
@@ -66,6 +95,8 @@ func NewPodAllocator(
 		netInfo:                netInfo,
 		releasedPods:           map[string]sets.Set[string]{},
 		releasedPodsMutex:      sync.Mutex{},
+		pods:                   sets.New[string](),
+		requestedMACs:          map[string]*requestedMAC{},
 		podAnnotationAllocator: podAnnotationAllocator,
 		networkManager:         networkManager,
 		recorder:               recorder,
@@ -172,18 +203,36 @@ func (a *PodAllocator) Sync(objs []interface{}) error {
 	// completed pods that might be being used by other pods
 	releaseFromAllocator := false
 
+	pods := make([]*corev1.Pod, 0, len(objs))
 	for _, obj := range objs {
 		pod, ok := obj.(*corev1.Pod)
 		if !ok {
 			klog.Errorf("Could not cast %T object to *corev1.Pod", obj)
 			continue
 		}
+		pods = append(pods, pod)
+	}
+
+	// the requested MACs are only tracked in memory: reserve the ones already
+	// allocated before any pod is handled, so that they are not granted to
+	// other pods handled first
+	a.syncRequestedMACs(pods)
+
+	for _, pod := range pods {
 		err := a.reconcile(nil, pod, releaseFromAllocator)
 		if err != nil {
 			klog.Errorf("Failed to sync pod %s/%s: %v", pod.Namespace, pod.Name, err)
 		}
 	}
 
+	// allocations held by an external IPAM outlive cluster manager: release
+	// the ones of pods that went away in the meantime
+	if reconciler, ok := a.ipAllocator.(ipReconciler); ok {
+		if err := reconciler.ReconcileIPs(a.netInfo.GetNetworkName()); err != nil {
+			klog.Errorf("Failed to release the IPs no longer in use on network %s: %v", a.netInfo.GetNetworkName(), err)
+		}
+	}
+
 	return nil
 }
 
@@ -268,11 +317,18 @@ func (a *PodAllocator) reconcileForNAD(old, new *corev1.Pod, nad string, network
 	podDeleted := new == nil
 	podCompleted := util.PodCompleted(pod)
 
+	var err error
 	if podCompleted || podDeleted {
-		return a.releasePodOnNAD(pod, nad, network, podDeleted, releaseIPsFromAllocator)
+		err = a.releasePodOnNAD(pod, nad, network, podDeleted, releaseIPsFromAllocator)
+	} else {
+		err = a.allocatePodOnNAD(pod, nad, network)
+	}
+	if err != nil {
+		return err
 	}
 
-	return a.allocatePodOnNAD(pod, nad, network)
+	a.recordPod(nad, string(pod.UID), !podCompleted && !podDeleted)
+	return nil
 }
 
 func (a *PodAllocator) releasePodOnNAD(pod *corev1.Pod, nad string, network *nettypes.NetworkSelectionElement,
@@ -342,6 +398,10 @@ func (a *PodAllocator) releasePodOnNAD(pod *corev1.Pod, nad string, network *net
 		klog.V(5).Infof("Released IPs %v", util.StringSlice(podAnnotation.IPs))
 	}
 
+	if doRelease {
+		a.releaseRequestedMAC(nad, uid)
+	}
+
 	if podDeleted {
 		a.deleteReleasedPod(nad, string(pod.UID))
 	} else {
@@ -369,6 +429,16 @@ func (a *PodAllocator) allocatePodOnNAD(pod *corev1.Pod, nad string, network *ne
 	if err != nil {
 		return err
 	}
+
+	var reservedMAC bool
+	if a.netInfo.IsPrimaryNetwork() && network != nil && (len(network.IPRequest) > 0 || network.MacRequest != "") {
+		reservedMAC, err = a.validateStaticRequest(pod, nad, network)
+		if err != nil {
+			a.recordPodErrorEvent(pod, err)
+			return err
+		}
+	}
+
 	updatedPod, podAnnotation, err := a.podAnnotationAllocator.AllocatePodAnnotationWithTunnelID(
 		ipAllocator,
 		idAllocator,
@@ -379,6 +449,9 @@ func (a *PodAllocator) allocatePodOnNAD(pod *corev1.Pod, nad string, network *ne
 	)
 
 	if err != nil {
+		if reservedMAC {
+			a.releaseRequestedMAC(nad, string(pod.UID))
+		}
 		return err
 	}
 
@@ -396,6 +469,144 @@ func (a *PodAllocator) allocatePodOnNAD(pod *corev1.Pod, nad string, network *ne
 	return err
 }
 
+// validateStaticRequest validates the IPs and MAC requested by a pod on a
+// primary network and reserves the requested MAC for the pod. The requested
+// IPs must belong to the subnets of the network, with their prefix length,
+// and the requested MAC must not be requested by another pod nor be in the
+// range of the MACs generated from IPs. Conflicts of the requested IPs with
+// the IPs already allocated are detected when allocating them. Returns whether
+// a MAC was reserved for a pod that is not allocated yet.
+func (a *PodAllocator) validateStaticRequest(pod *corev1.Pod, nad string, network *nettypes.NetworkSelectionElement) (bool, error) {
+	podAnnotation, _ := util.UnmarshalPodAnnotation(pod.Annotations, nad)
+
+	// requests are ignored once allocated, just keep track of the MAC
+	if podAnnotation != nil && len(podAnnotation.MAC) > 0 {
+		if network.MacRequest == "" {
+			return false, nil
+		}
+		return false, a.reserveRequestedMAC(pod, nad, podAnnotation.MAC.String())
+	}
+
+	if len(network.IPRequest) > 0 {
+		ips, err := util.ParseIPNets(network.IPRequest)
+		if err != nil {
+			return false, fmt.Errorf("invalid IPs requested by pod %s/%s on network %s: %w",
+				pod.Namespace, pod.Name, a.netInfo.GetNetworkName(), err)
+		}
+		subnets := a.netInfo.Subnets()
+		if len(ips) != len(subnets) {
+			return false, fmt.Errorf("invalid IPs %v requested by pod %s/%s: network %s requires one IP from each of its %d subnets",
+				network.IPRequest, pod.Namespace, pod.Name, a.netInfo.GetNetworkName(), len(subnets))
+		}
+		requested := sets.New[string]()
+		for _, ip := range ips {
+			var found bool
+			for _, subnet := range subnets {
+				if subnet.CIDR.Contains(ip.IP) && subnet.CIDR.Mask.String() == ip.Mask.String() {
+					found = !requested.Has(subnet.CIDR.String())
+					requested.Insert(subnet.CIDR.String())
+					break
+				}
+			}
+			if !found {
+				return false, fmt.Errorf("invalid IP %s requested by pod %s/%s: must be one IP from each of the subnets %v of network %s",
+					ip, pod.Namespace, pod.Name, util.StringSlice(subnets), a.netInfo.GetNetworkName())
+			}
+		}
+	}
+
+	if network.MacRequest == "" {
+		return false, nil
+	}
+	mac, err := net.ParseMAC(network.MacRequest)
+	if err != nil {
+		return false, fmt.Errorf("invalid MAC requested by pod %s/%s on network %s: %w",
+			pod.Namespace, pod.Name, a.netInfo.GetNetworkName(), err)
+	}
+	if mac[0]&0x01 != 0 {
+		return false, fmt.Errorf("invalid MAC %s requested by pod %s/%s: must be a unicast MAC", mac, pod.Namespace, pod.Name)
+	}
+	if isMACGeneratedFromIP(mac) {
+		return false, fmt.Errorf("invalid MAC %s requested by pod %s/%s: the 0a:58 prefix is reserved for MACs generated from IPs",
+			mac, pod.Namespace, pod.Name)
+	}
+	if err := a.reserveRequestedMAC(pod, nad, mac.String()); err != nil {
+		return false, fmt.Errorf("invalid MAC %s requested by pod %s/%s: %w", mac, pod.Namespace, pod.Name, err)
+	}
+	return true, nil
+}
+
+// syncRequestedMACs reserves the MACs allocated to the pods of a primary
+// network that are not generated from their IPs, and thus were requested
+func (a *PodAllocator) syncRequestedMACs(pods []*corev1.Pod) {
+	if !a.netInfo.IsPrimaryNetwork() {
+		return
+	}
+	for _, pod := range pods {
+		if !util.PodScheduled(pod) || util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) {
+			continue
+		}
+		podNetworks, err := util.UnmarshalPodAnnotationAllNetworks(pod.Annotations)
+		if err != nil {
+			klog.Errorf("Failed to sync the MAC requested by pod %s/%s: %v", pod.Namespace, pod.Name, err)
+			continue
+		}
+		for nad, podNetwork := range podNetworks {
+			if !a.netInfo.HasNAD(nad) {
+				continue
+			}
+			mac, err := net.ParseMAC(podNetwork.MAC)
+			if err != nil || isMACGeneratedFromIP(mac) {
+				continue
+			}
+			if err := a.reserveRequestedMAC(pod, nad, mac.String()); err != nil {
+				klog.Errorf("Failed to sync the MAC requested by pod %s/%s: %v", pod.Namespace, pod.Name, err)
+			}
+		}
+	}
+}
+
+// isMACGeneratedFromIP returns whether the MAC has the 0a:58 prefix of the
+// MACs generated from IPs
+func isMACGeneratedFromIP(mac net.HardwareAddr) bool {
+	return len(mac) > 1 && mac[0] == 0x0a && mac[1] == 0x58
+}
+
+// reserveRequestedMAC reserves the MAC requested by a pod, failing if it is
+// allocated to another pod, other than a pod of the same VM
+func (a *PodAllocator) reserveRequestedMAC(pod *corev1.Pod, nad, mac string) error {
+	a.requestedMACsMutex.Lock()
+	defer a.requestedMACsMutex.Unlock()
+	name := podIdAllocationName(nad, string(pod.UID))
+	owner := name
+	if vm := kubevirt.ExtractVMNameFromPod(pod); vm != nil {
+		owner = vm.String()
+	}
+	requested, ok := a.requestedMACs[mac]
+	if !ok {
+		requested = &requestedMAC{owner: owner, pods: sets.New[string]()}
+		a.requestedMACs[mac] = requested
+	}
+	if requested.owner != owner {
+		return fmt.Errorf("MAC %s already in use on network %s", mac, a.netInfo.GetNetworkName())
+	}
+	requested.pods.Insert(name)
+	return nil
+}
+
+// releaseRequestedMAC releases the MAC requested by a pod, if any
+func (a *PodAllocator) releaseRequestedMAC(nad, uid string) {
+	a.requestedMACsMutex.Lock()
+	defer a.requestedMACsMutex.Unlock()
+	name := podIdAllocationName(nad, uid)
+	for mac, requested := range a.requestedMACs {
+		requested.pods.Delete(name)
+		if requested.pods.Len() == 0 {
+			delete(a.requestedMACs, mac)
+		}
+	}
+}
+
 func (a *PodAllocator) addReleasedPod(nad, uid string) {
 	a.releasedPodsMutex.Lock()
 	defer a.releasedPodsMutex.Unlock()
@@ -429,6 +640,49 @@ func (a *PodAllocator) isPodReleased(nad, uid string) bool {
 	return false
 }
 
+// recordPod tracks whether the pod has resources allocated on the given NAD,
+// to be reported by RecordMetrics
+func (a *PodAllocator) recordPod(nad, uid string, allocated bool) {
+	a.podsMutex.Lock()
+	defer a.podsMutex.Unlock()
+	name := podIdAllocationName(nad, uid)
+	if allocated {
+		a.pods.Insert(name)
+	} else {
+		a.pods.Delete(name)
+	}
+}
+
+// RecordMetrics records the number of pods with resources allocated on the
+// network and the usage of its IP pool. It is meant to be run periodically as
+// computing the IP pool usage walks through all of its subnets.
+func (a *PodAllocator) RecordMetrics() {
+	a.podsMutex.Lock()
+	count := a.pods.Len()
+	a.podsMutex.Unlock()
+
+	metrics.RecordNetworkPodCount(a.netInfo, count)
+
+	if a.ipAllocator == nil {
+		return
+	}
+	usage, err := a.ipAllocator.Usage(a.netInfo.GetNetworkName())
+	if err != nil {
+		klog.Warningf("Failed to get IP pool usage of network %s: %v", a.netInfo.GetNetworkName(), err)
+		return
+	}
+	used := map[string]int{}
+	free := map[string]int{}
+	for _, subnetUsage := range usage {
+		family := util.IPFamilyName(utilnet.IsIPv6CIDR(subnetUsage.Subnet))
+		used[family] += subnetUsage.Used
+		free[family] += subnetUsage.Free
+	}
+	for family := range used {
+		metrics.RecordNetworkIPPoolUsage(a.netInfo, family, used[family], free[family])
+	}
+}
+
 func (a *PodAllocator) recordPodErrorEvent(pod *corev1.Pod, podErr error) {
 	podRef, err := ref.GetReference(scheme.Scheme, pod)
 	if err != nil {
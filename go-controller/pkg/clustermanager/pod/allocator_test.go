@@ -33,6 +33,7 @@ import (
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	kubevirtv1 "kubevirt.io/api/core/v1"
 
 	kubemocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube/mocks"
 	v1mocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/mocks/k8s.io/client-go/listers/core/v1"
@@ -123,6 +124,10 @@ func (a *ipAllocatorStub) GetSubnetName([]*net.IPNet) (string, bool) {
 	panic("not implemented") // TODO: Implement
 }
 
+func (a *ipAllocatorStub) Usage(name string) ([]subnet.SubnetUsage, error) {
+	return nil, nil
+}
+
 type idAllocatorStub struct {
 	released bool
 }
@@ -608,6 +613,7 @@ func TestPodAllocator_reconcileForNAD(t *testing.T) {
 				podAnnotationAllocator: podAnnotationAllocator,
 				releasedPods:           map[string]sets.Set[string]{},
 				releasedPodsMutex:      sync.Mutex{},
+				pods:                   sets.New[string](),
 				ipamClaimsReconciler:   ipamClaimsReconciler,
 				networkManager:         fakeNetworkManager,
 				recorder:               fakeRecorder,
@@ -649,6 +655,10 @@ func TestPodAllocator_reconcileForNAD(t *testing.T) {
 				t.Errorf("expected pod tracked to be %v but it was %v", tt.expectTracked, a.releasedPods["namespace/nad"].Has("pod"))
 			}
 
+			if tt.expectAllocate && !a.pods.Has(podIdAllocationName("namespace/nad", "pod")) {
+				t.Errorf("expected allocated pod to be counted on the network")
+			}
+
 			var obtainedEvents []string
 			for {
 				if len(fakeRecorder.Events) == 0 {
@@ -661,6 +671,252 @@ func TestPodAllocator_reconcileForNAD(t *testing.T) {
 	}
 }
 
+func TestPodAllocator_validateStaticRequest(t *testing.T) {
+	type requester struct {
+		uid       string
+		vm        string
+		annotated string
+		network   *nadapi.NetworkSelectionElement
+	}
+	tests := []struct {
+		name              string
+		previous          []requester
+		requester         requester
+		expectReservedMAC bool
+		expectError       string
+	}{
+		{
+			name: "valid IP request",
+			requester: requester{
+				network: &nadapi.NetworkSelectionElement{IPRequest: []string{"10.1.130.5/24"}},
+			},
+		},
+		{
+			name: "IP request outside of the network subnets",
+			requester: requester{
+				network: &nadapi.NetworkSelectionElement{IPRequest: []string{"10.1.131.5/24"}},
+			},
+			expectError: "must be one IP from each of the subnets",
+		},
+		{
+			name: "IP request with a prefix length other than the subnet one",
+			requester: requester{
+				network: &nadapi.NetworkSelectionElement{IPRequest: []string{"10.1.130.5/32"}},
+			},
+			expectError: "must be one IP from each of the subnets",
+		},
+		{
+			name: "IP request with more IPs than subnets",
+			requester: requester{
+				network: &nadapi.NetworkSelectionElement{IPRequest: []string{"10.1.130.5/24", "10.1.130.6/24"}},
+			},
+			expectError: "requires one IP from each of its 1 subnets",
+		},
+		{
+			name: "invalid IP request",
+			requester: requester{
+				network: &nadapi.NetworkSelectionElement{IPRequest: []string{"10.1.130.5"}},
+			},
+			expectError: "invalid IPs requested",
+		},
+		{
+			name: "valid MAC request",
+			requester: requester{
+				network: &nadapi.NetworkSelectionElement{MacRequest: "02:00:00:00:00:01"},
+			},
+			expectReservedMAC: true,
+		},
+		{
+			name: "multicast MAC request",
+			requester: requester{
+				network: &nadapi.NetworkSelectionElement{MacRequest: "03:00:00:00:00:01"},
+			},
+			expectError: "must be a unicast MAC",
+		},
+		{
+			name: "MAC request in the range of generated MACs",
+			requester: requester{
+				network: &nadapi.NetworkSelectionElement{MacRequest: "0a:58:0a:01:82:05"},
+			},
+			expectError: "the 0a:58 prefix is reserved",
+		},
+		{
+			name: "MAC request conflicting with another pod",
+			previous: []requester{
+				{
+					uid:     "other",
+					network: &nadapi.NetworkSelectionElement{MacRequest: "02:00:00:00:00:01"},
+				},
+			},
+			requester: requester{
+				network: &nadapi.NetworkSelectionElement{MacRequest: "02:00:00:00:00:01"},
+			},
+			expectError: "MAC 02:00:00:00:00:01 already in use",
+		},
+		{
+			name: "MAC request shared with another pod of the same VM",
+			previous: []requester{
+				{
+					uid:     "source",
+					vm:      "vm",
+					network: &nadapi.NetworkSelectionElement{MacRequest: "02:00:00:00:00:01"},
+				},
+			},
+			requester: requester{
+				vm:      "vm",
+				network: &nadapi.NetworkSelectionElement{MacRequest: "02:00:00:00:00:01"},
+			},
+			expectReservedMAC: true,
+		},
+		{
+			name: "MAC request of an allocated pod conflicting with another pod",
+			previous: []requester{
+				{
+					uid:     "other",
+					network: &nadapi.NetworkSelectionElement{MacRequest: "02:00:00:00:00:01"},
+				},
+			},
+			requester: requester{
+				annotated: "02:00:00:00:00:01",
+				network:   &nadapi.NetworkSelectionElement{MacRequest: "02:00:00:00:00:01"},
+			},
+			expectError: "MAC 02:00:00:00:00:01 already in use",
+		},
+		{
+			name: "MAC request of an allocated pod",
+			requester: requester{
+				annotated: "02:00:00:00:00:01",
+				network:   &nadapi.NetworkSelectionElement{MacRequest: "02:00:00:00:00:01"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			config.IPv4Mode = true
+			netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+				Topology: types.Layer2Topology,
+				Role:     types.NetworkRolePrimary,
+				Subnets:  "10.1.130.0/24",
+			})
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			a := &PodAllocator{
+				netInfo:       netInfo,
+				requestedMACs: map[string]*requestedMAC{},
+			}
+			getPod := func(r requester) *corev1.Pod {
+				uid := r.uid
+				if uid == "" {
+					uid = "pod"
+				}
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        uid,
+						UID:         apitypes.UID(uid),
+						Namespace:   "namespace",
+						Labels:      map[string]string{},
+						Annotations: map[string]string{},
+					},
+				}
+				if r.vm != "" {
+					pod.Labels[kubevirtv1.VirtualMachineNameLabel] = r.vm
+				}
+				if r.annotated != "" {
+					mac, err := net.ParseMAC(r.annotated)
+					g.Expect(err).NotTo(gomega.HaveOccurred())
+					pod.Annotations, err = util.MarshalPodAnnotation(pod.Annotations, &util.PodAnnotation{
+						IPs: ovntest.MustParseIPNets("10.1.130.5/24"),
+						MAC: mac,
+					}, "namespace/nad")
+					g.Expect(err).NotTo(gomega.HaveOccurred())
+				}
+				return pod
+			}
+
+			for _, previous := range tt.previous {
+				_, err := a.validateStaticRequest(getPod(previous), "namespace/nad", previous.network)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+
+			reservedMAC, err := a.validateStaticRequest(getPod(tt.requester), "namespace/nad", tt.requester.network)
+			if tt.expectError != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.expectError)))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(reservedMAC).To(gomega.Equal(tt.expectReservedMAC))
+
+			if tt.requester.network.MacRequest != "" {
+				g.Expect(a.requestedMACs).To(gomega.HaveKey(tt.requester.network.MacRequest))
+				a.releaseRequestedMAC("namespace/nad", "pod")
+				if len(tt.previous) == 0 {
+					g.Expect(a.requestedMACs).To(gomega.BeEmpty())
+				} else {
+					g.Expect(a.requestedMACs).To(gomega.HaveKey(tt.requester.network.MacRequest))
+				}
+			}
+		})
+	}
+}
+
+func TestPodAllocator_syncRequestedMACs(t *testing.T) {
+	g := gomega.NewWithT(t)
+	config.IPv4Mode = true
+	netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		Topology: types.Layer2Topology,
+		Role:     types.NetworkRolePrimary,
+		Subnets:  "10.1.130.0/24",
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	mutableNetInfo := util.NewMutableNetInfo(netInfo)
+	mutableNetInfo.AddNADs("namespace/nad")
+
+	a := &PodAllocator{
+		netInfo:       mutableNetInfo,
+		requestedMACs: map[string]*requestedMAC{},
+	}
+	getPod := func(uid, ip, mac string, phase corev1.PodPhase) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        uid,
+				UID:         apitypes.UID(uid),
+				Namespace:   "namespace",
+				Annotations: map[string]string{},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "node",
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+		if mac != "" {
+			parsedMAC, err := net.ParseMAC(mac)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			pod.Annotations, err = util.MarshalPodAnnotation(pod.Annotations, &util.PodAnnotation{
+				IPs: ovntest.MustParseIPNets(ip),
+				MAC: parsedMAC,
+			}, "namespace/nad")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+		}
+		return pod
+	}
+
+	a.syncRequestedMACs([]*corev1.Pod{
+		getPod("requested", "10.1.130.5/24", "02:00:00:00:00:01", corev1.PodRunning),
+		getPod("generated", "10.1.130.6/24", "0a:58:0a:01:82:06", corev1.PodRunning),
+		getPod("completed", "10.1.130.7/24", "02:00:00:00:00:02", corev1.PodSucceeded),
+		getPod("pending", "", "", corev1.PodPending),
+	})
+	g.Expect(a.requestedMACs).To(gomega.HaveLen(1))
+	g.Expect(a.requestedMACs).To(gomega.HaveKey("02:00:00:00:00:01"))
+
+	_, err = a.validateStaticRequest(getPod("pending", "", "", corev1.PodPending), "namespace/nad",
+		&nadapi.NetworkSelectionElement{MacRequest: "02:00:00:00:00:01"})
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("MAC 02:00:00:00:00:01 already in use")))
+}
+
 func generateIPAMClaimsListerAndTeardownFunc(stopChannel <-chan struct{}, ipamClaims ...runtime.Object) (ipamclaimslister.IPAMClaimLister, func()) {
 	ipamClaimClient := fakeipamclaimclient.NewSimpleClientset(ipamClaims...)
 	informerFactory := ipamclaimsfactory.NewSharedInformerFactory(ipamClaimClient, 0)
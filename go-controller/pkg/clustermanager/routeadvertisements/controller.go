@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
 	"slices"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	frrclientset "github.com/metallb/frr-k8s/pkg/client/clientset/versioned"
 	frrlisters "github.com/metallb/frr-k8s/pkg/client/listers/api/v1beta1"
 	core "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	metaapply "k8s.io/client-go/applyconfigurations/meta/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -54,23 +57,34 @@ var (
 	errPending = errors.New("configuration pending")
 )
 
+// serviceVIPAdvertisements are the advertisement types of service VIPs
+var serviceVIPAdvertisements = []ratypes.AdvertisementType{
+	ratypes.LoadBalancerIP,
+	ratypes.ExternalIP,
+	ratypes.ClusterIP,
+}
+
 // Controller reconciles RouteAdvertisements
 type Controller struct {
 	eipLister  egressiplisters.EgressIPLister
+	epsLister  discoverylisters.EndpointSliceLister
 	frrLister  frrlisters.FRRConfigurationLister
 	nadLister  nadlisters.NetworkAttachmentDefinitionLister
 	nodeLister corelisters.NodeLister
 	raLister   ralisters.RouteAdvertisementsLister
+	svcLister  corelisters.ServiceLister
 
 	frrClient frrclientset.Interface
 	nadClient nadclientset.Interface
 	raClient  raclientset.Interface
 
 	eipController  controllerutil.Controller
+	epsController  controllerutil.Controller
 	frrController  controllerutil.Controller
 	nadController  controllerutil.Controller
 	nodeController controllerutil.Controller
 	raController   controllerutil.Controller
+	svcController  controllerutil.Controller
 
 	nm networkmanager.Interface
 }
@@ -83,10 +97,12 @@ func NewController(
 ) *Controller {
 	c := &Controller{
 		eipLister:  wf.EgressIPInformer().Lister(),
+		epsLister:  wf.EndpointSliceCoreInformer().Lister(),
 		frrLister:  wf.FRRConfigurationsInformer().Lister(),
 		nadLister:  wf.NADInformer().Lister(),
 		nodeLister: wf.NodeCoreInformer().Lister(),
 		raLister:   wf.RouteAdvertisementsInformer().Lister(),
+		svcLister:  wf.ServiceCoreInformer().Lister(),
 		frrClient:  ovnClient.FRRClient,
 		nadClient:  ovnClient.NetworkAttchDefClient,
 		raClient:   ovnClient.RouteAdvertisementsClient,
@@ -160,6 +176,26 @@ func NewController(
 	}
 	c.eipController = controllerutil.NewController("clustermanager routeadvertisements egressip controller", eipConfig)
 
+	svcConfig := &controllerutil.ControllerConfig[core.Service]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcileService,
+		Threadiness:    1,
+		Informer:       wf.ServiceCoreInformer().Informer(),
+		Lister:         wf.ServiceCoreInformer().Lister().List,
+		ObjNeedsUpdate: serviceNeedsUpdate,
+	}
+	c.svcController = controllerutil.NewController("clustermanager routeadvertisements service controller", svcConfig)
+
+	epsConfig := &controllerutil.ControllerConfig[discovery.EndpointSlice]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcileEndpointSlice,
+		Threadiness:    1,
+		Informer:       wf.EndpointSliceCoreInformer().Informer(),
+		Lister:         wf.EndpointSliceCoreInformer().Lister().List,
+		ObjNeedsUpdate: endpointSliceNeedsUpdate,
+	}
+	c.epsController = controllerutil.NewController("clustermanager routeadvertisements endpointslice controller", epsConfig)
+
 	return c
 }
 
@@ -167,20 +203,24 @@ func (c *Controller) Start() error {
 	defer klog.Infof("Cluster manager routeadvertisements started")
 	return controllerutil.Start(
 		c.eipController,
+		c.epsController,
 		c.frrController,
 		c.nadController,
 		c.nodeController,
 		c.raController,
+		c.svcController,
 	)
 }
 
 func (c *Controller) Stop() {
 	controllerutil.Stop(
 		c.eipController,
+		c.epsController,
 		c.frrController,
 		c.nadController,
 		c.nodeController,
 		c.raController,
+		c.svcController,
 	)
 	klog.Infof("Cluster manager routeadvertisements stoppedu")
 }
@@ -207,9 +247,19 @@ func (c *Controller) ReconcileNetwork(name string, old, new util.NetInfo) {
 // announce from the node the EgressIPs allocated to it on the matching target
 // VRFs.
 //
+// - If LoadBalancerIP, ExternalIP or ClusterIP advertisements are enabled, the
+// generated FRRConfiguration will announce from the node the corresponding
+// service VIPs on the matching target VRFs. LoadBalancerIPs and ExternalIPs of
+// services with externalTrafficPolicy set to Local are only announced from the
+// nodes that have local endpoints for them.
+//
 // - If pod network advertisements are enabled, the generated FRRConfiguration
 // will import the target VRFs on the selected networks as required.
 //
+// - If BGP attributes are set for an advertisement type, the generated
+// FRRConfiguration will set the BGP communities and local preference on the
+// prefixes announced for it.
+//
 // - The generated FRRConfiguration will be labeled with the RouteAdvertisements
 // name and annotated with an internal key to facilitate updating it when
 // needed.
@@ -221,7 +271,7 @@ func (c *Controller) ReconcileNetwork(name string, old, new util.NetInfo) {
 // Finally, it will update the status of the RouteAdvertisements.
 //
 // The controller processes selected events of RouteAdvertisements,
-// FRRConfigurations, Nodes, EgressIPs and NADs.
+// FRRConfigurations, Nodes, EgressIPs, Services, EndpointSlices and NADs.
 func (c *Controller) reconcile(name string) error {
 	startTime := time.Now()
 	klog.V(5).Infof("Syncing routeadvertisements %q", name)
@@ -284,6 +334,18 @@ type selectedNetworks struct {
 	hostNetworkSubnets map[string][]string
 	// prefixLength is a map of selected network to their prefix length
 	prefixLength map[string]uint32
+	// hostPrefixAttributes is a map of node specific prefixes to the BGP
+	// attributes set on them
+	hostPrefixAttributes map[string]*prefixAttributes
+}
+
+// prefixAttributes are the BGP attributes set on an advertised prefix
+type prefixAttributes struct {
+	// communities is the set of standard and large communities, the latter in
+	// the format expected by FRRConfigurations
+	communities sets.Set[string]
+	// localPref is the local preference, if any
+	localPref *uint32
 }
 
 // generateFRRConfigurations generates FRRConfigurations for the route
@@ -316,6 +378,13 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 		return nil, nil, fmt.Errorf("%w: no networks selected", errPending)
 	}
 
+	// subnets of the advertised networks, selected networks must not overlap
+	// with them
+	advertisedSubnets, err := c.getSubnetsAdvertisedByOthers(ra.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// validate and gather information about the networks
 	networkSet := sets.New[string]()
 	selectedNetworks := &selectedNetworks{
@@ -347,8 +416,11 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 		networkSet.Insert(networkName)
 		selectedNetworks.vrfs = append(selectedNetworks.vrfs, vrf)
 		selectedNetworks.networkVRFs[vrf] = networkName
-		// TODO check overlaps?
+		if err := checkSubnetOverlaps(networkName, network, advertisedSubnets); err != nil {
+			return nil, nil, err
+		}
 		for _, cidr := range network.Subnets() {
+			advertisedSubnets[networkName] = append(advertisedSubnets[networkName], cidr.CIDR)
 			subnet := cidr.CIDR.String()
 			len := uint32(cidr.HostSubnetLength)
 			selectedNetworks.networkSubnets[networkName] = append(selectedNetworks.networkSubnets[networkName], subnet)
@@ -372,6 +444,11 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 	if !nodeSelector.Empty() && advertisements.Has(ratypes.PodNetwork) {
 		return nil, nil, fmt.Errorf("%w: node selector cannot be specified if pod network is advertised", errConfig)
 	}
+	for _, attributes := range ra.Spec.AdvertisementAttributes {
+		if !advertisements.Has(attributes.Advertisement) {
+			return nil, nil, fmt.Errorf("%w: attributes specified for %q which is not advertised", errConfig, attributes.Advertisement)
+		}
+	}
 	nodes, err := c.nodeLister.List(nodeSelector)
 	if err != nil {
 		return nil, nil, err
@@ -454,7 +531,21 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 		return nodeEgressIPs[nodeName], nil
 	}
 
-	// helper to gather host subnets and egress ips as prefixes
+	// helper to gather service VIPs by advertisement type and cache during
+	// reconcile
+	var nodeServiceVIPs map[string]map[ratypes.AdvertisementType][]string
+	getServiceVIPs := func(nodeName string) (map[ratypes.AdvertisementType][]string, error) {
+		if nodeServiceVIPs == nil {
+			nodeServiceVIPs, err = c.getServiceVIPsByNode(advertisements, nodes)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nodeServiceVIPs[nodeName], nil
+	}
+
+	// helper to gather host subnets, egress ips and service VIPs as prefixes
+	// along with their BGP attributes
 	getPrefixes := func(nodeName string, network string) ([]string, error) {
 		// gather host subnets
 		var subnets []string
@@ -463,7 +554,10 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 			if err != nil || len(subnets) == 0 {
 				return nil, fmt.Errorf("%w: will wait for subnet annotation to be set for node %q and network %q: %w", errConfig, nodeName, network, err)
 			}
-
+			err = setPrefixAttributes(selectedNetworks.hostPrefixAttributes, ra, ratypes.PodNetwork, subnets)
+			if err != nil {
+				return nil, err
+			}
 		}
 		// gather EgressIPs
 		var eips []string
@@ -475,11 +569,35 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 			if err != nil {
 				return nil, err
 			}
+			err = setPrefixAttributes(selectedNetworks.hostPrefixAttributes, ra, ratypes.EgressIP, eips)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		prefixes := make([]string, 0, len(subnets)+len(eips))
+		// gather service VIPs
+		var vips []string
+		if advertisesServiceVIPs(advertisements) {
+			if network != types.DefaultNetworkName {
+				return nil, fmt.Errorf("%w: can't advertise service VIPs in selected non default network %q", errConfig, network)
+			}
+			nodeVIPs, err := getServiceVIPs(nodeName)
+			if err != nil {
+				return nil, err
+			}
+			for _, advertisement := range serviceVIPAdvertisements {
+				vips = append(vips, nodeVIPs[advertisement]...)
+				err = setPrefixAttributes(selectedNetworks.hostPrefixAttributes, ra, advertisement, nodeVIPs[advertisement])
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		prefixes := make([]string, 0, len(subnets)+len(eips)+len(vips))
 		prefixes = append(prefixes, subnets...)
 		prefixes = append(prefixes, eips...)
+		prefixes = append(prefixes, vips...)
 		return prefixes, nil
 	}
 
@@ -488,6 +606,7 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 		// reset node specific information
 		selectedNetworks.hostNetworkSubnets = map[string][]string{}
 		selectedNetworks.hostSubnets = []string{}
+		selectedNetworks.hostPrefixAttributes = map[string]*prefixAttributes{}
 
 		// gather node specific information
 		for _, network := range selectedNetworks.networks {
@@ -496,11 +615,14 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 				return nil, nil, err
 			}
 			selectedNetworks.hostSubnets = append(selectedNetworks.hostSubnets, selectedNetworks.hostNetworkSubnets[network]...)
-			// ordered
+			// ordered, a prefix might have been gathered for multiple
+			// advertisement types
 			slices.Sort(selectedNetworks.hostNetworkSubnets[network])
+			selectedNetworks.hostNetworkSubnets[network] = slices.Compact(selectedNetworks.hostNetworkSubnets[network])
 		}
 		// ordered
 		slices.Sort(selectedNetworks.hostSubnets)
+		selectedNetworks.hostSubnets = slices.Compact(selectedNetworks.hostSubnets)
 
 		matchedNetworks := sets.New[string]()
 		for _, frrConfig := range frrConfigs {
@@ -612,6 +734,10 @@ func (c *Controller) generateFRRConfiguration(
 					Prefixes: advertisePrefixes,
 				},
 			}
+			neighbor.ToAdvertise.PrefixesWithCommunity, neighbor.ToAdvertise.PrefixesWithLocalPref = getAdvertiseAttributes(
+				selectedNetworks.hostPrefixAttributes,
+				advertisePrefixes,
+			)
 			neighbor.ToReceive = frrtypes.Receive{
 				Allowed: frrtypes.AllowedInPrefixes{
 					Mode: frrtypes.AllowRestricted,
@@ -967,6 +1093,60 @@ func (c *Controller) getOrCreateDefaultNetworkNAD() (*nadtypes.NetworkAttachment
 	)
 }
 
+// getSubnetsAdvertisedByOthers returns the subnets of the networks advertised
+// by RouteAdvertisements other than the given one, by network name.
+func (c *Controller) getSubnetsAdvertisedByOthers(ra string) (map[string][]*net.IPNet, error) {
+	nads, err := c.nadLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	subnets := map[string][]*net.IPNet{}
+	for _, nad := range nads {
+		if nad.Annotations[types.OvnRouteAdvertisementsKey] == "" {
+			continue
+		}
+		var ras []string
+		err := json.Unmarshal([]byte(nad.Annotations[types.OvnRouteAdvertisementsKey]), &ras)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.ContainsFunc(ras, func(other string) bool { return other != ra }) {
+			continue
+		}
+		networkName := util.GetAnnotatedNetworkName(nad)
+		network := c.nm.GetNetwork(networkName)
+		if network == nil || subnets[networkName] != nil {
+			continue
+		}
+		for _, cidr := range network.Subnets() {
+			subnets[networkName] = append(subnets[networkName], cidr.CIDR)
+		}
+	}
+
+	return subnets, nil
+}
+
+// checkSubnetOverlaps checks that the subnets of the given network don't
+// overlap with the subnets of other advertised networks, as the routes to
+// them would be ambiguous.
+func checkSubnetOverlaps(networkName string, network util.NetInfo, advertisedSubnets map[string][]*net.IPNet) error {
+	for _, cidr := range network.Subnets() {
+		for otherNetwork, otherSubnets := range advertisedSubnets {
+			if otherNetwork == networkName {
+				continue
+			}
+			for _, otherSubnet := range otherSubnets {
+				if cidr.CIDR.Contains(otherSubnet.IP) || otherSubnet.Contains(cidr.CIDR.IP) {
+					return fmt.Errorf("%w: subnet %s of selected network %q overlaps with subnet %s of advertised network %q",
+						errConfig, cidr.CIDR, networkName, otherSubnet, otherNetwork)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // getEgressIPsByNode iterates all existing egress IPs and returns them indexed
 // by node
 func (c *Controller) getEgressIPsByNode() (map[string][]string, error) {
@@ -989,6 +1169,174 @@ func (c *Controller) getEgressIPsByNode() (map[string][]string, error) {
 	return eipsByNode, nil
 }
 
+// getServiceVIPsByNode iterates all existing services of the default network
+// and returns the VIPs to advertise, according to the given advertisements,
+// indexed by the given nodes and advertisement type
+func (c *Controller) getServiceVIPsByNode(advertisements sets.Set[ratypes.AdvertisementType], nodes []*core.Node) (map[string]map[ratypes.AdvertisementType][]string, error) {
+	services, err := c.svcLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	vipsByNode := make(map[string]map[ratypes.AdvertisementType]sets.Set[string], len(nodes))
+	for _, node := range nodes {
+		vipsByNode[node.Name] = map[ratypes.AdvertisementType]sets.Set[string]{}
+		for _, advertisement := range serviceVIPAdvertisements {
+			vipsByNode[node.Name][advertisement] = sets.New[string]()
+		}
+	}
+	for _, svc := range services {
+		// headless and ExternalName services have no VIPs to advertise
+		if !util.ServiceTypeHasClusterIP(svc) || !util.IsClusterIPSet(svc) {
+			continue
+		}
+		network, err := c.nm.GetActiveNetworkForNamespace(svc.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get active network for service %s/%s: %w", svc.Namespace, svc.Name, err)
+		}
+		if !network.IsDefault() {
+			continue
+		}
+
+		var clusterIPs, externalIPs, loadBalancerIPs []string
+		if advertisements.Has(ratypes.ClusterIP) {
+			clusterIPs = util.GetClusterIPs(svc)
+		}
+		if advertisements.Has(ratypes.ExternalIP) {
+			for _, externalIP := range svc.Spec.ExternalIPs {
+				ip := utilnet.ParseIPSloppy(externalIP)
+				if ip != nil {
+					externalIPs = append(externalIPs, ip.String())
+				}
+			}
+		}
+		if advertisements.Has(ratypes.LoadBalancerIP) && util.ServiceTypeHasLoadBalancer(svc) {
+			for _, ingress := range svc.Status.LoadBalancer.Ingress {
+				ip := utilnet.ParseIPSloppy(ingress.IP)
+				if ip != nil {
+					loadBalancerIPs = append(loadBalancerIPs, ip.String())
+				}
+			}
+		}
+		if len(clusterIPs) == 0 && len(externalIPs) == 0 && len(loadBalancerIPs) == 0 {
+			continue
+		}
+
+		// external VIPs of services with externalTrafficPolicy=Local are only
+		// advertised from nodes with local endpoints
+		localOnly := (len(externalIPs) > 0 || len(loadBalancerIPs) > 0) && util.ServiceExternalTrafficPolicyLocal(svc)
+		var endpointSlices []*discovery.EndpointSlice
+		if localOnly {
+			endpointSlices, err = util.GetServiceEndpointSlices(svc.Namespace, svc.Name, types.DefaultNetworkName, c.epsLister)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for nodeName, vips := range vipsByNode {
+			for _, ip := range clusterIPs {
+				vips[ratypes.ClusterIP].Insert(ip + util.GetIPFullMaskString(ip))
+			}
+			if localOnly && util.GetLocalEligibleEndpointAddressesFromSlices(endpointSlices, svc, nodeName).Len() == 0 {
+				continue
+			}
+			for _, ip := range externalIPs {
+				vips[ratypes.ExternalIP].Insert(ip + util.GetIPFullMaskString(ip))
+			}
+			for _, ip := range loadBalancerIPs {
+				vips[ratypes.LoadBalancerIP].Insert(ip + util.GetIPFullMaskString(ip))
+			}
+		}
+	}
+
+	vipsByNodeList := make(map[string]map[ratypes.AdvertisementType][]string, len(vipsByNode))
+	for nodeName, vips := range vipsByNode {
+		vipsByNodeList[nodeName] = make(map[ratypes.AdvertisementType][]string, len(vips))
+		for advertisement, advertisementVIPs := range vips {
+			vipsByNodeList[nodeName][advertisement] = sets.List(advertisementVIPs)
+		}
+	}
+	return vipsByNodeList, nil
+}
+
+// advertisesServiceVIPs returns whether any type of service VIP is among the
+// given advertisements
+func advertisesServiceVIPs(advertisements sets.Set[ratypes.AdvertisementType]) bool {
+	return advertisements.HasAny(serviceVIPAdvertisements...)
+}
+
+// setPrefixAttributes merges the BGP attributes that the RouteAdvertisements
+// sets for the given advertisement type into the attributes of each of the
+// given prefixes. Fails if a prefix would be set different local preferences.
+func setPrefixAttributes(attributes map[string]*prefixAttributes, ra *ratypes.RouteAdvertisements, advertisement ratypes.AdvertisementType, prefixes []string) error {
+	var advertisementAttributes *ratypes.AdvertisementAttributes
+	for i := range ra.Spec.AdvertisementAttributes {
+		if ra.Spec.AdvertisementAttributes[i].Advertisement == advertisement {
+			advertisementAttributes = &ra.Spec.AdvertisementAttributes[i]
+			break
+		}
+	}
+	if advertisementAttributes == nil {
+		return nil
+	}
+
+	for _, prefix := range prefixes {
+		if attributes[prefix] == nil {
+			attributes[prefix] = &prefixAttributes{communities: sets.New[string]()}
+		}
+		attributes[prefix].communities.Insert(advertisementAttributes.Communities...)
+		for _, community := range advertisementAttributes.LargeCommunities {
+			attributes[prefix].communities.Insert("large:" + community)
+		}
+		if advertisementAttributes.LocalPreference == nil {
+			continue
+		}
+		localPref := uint32(*advertisementAttributes.LocalPreference)
+		if attributes[prefix].localPref != nil && *attributes[prefix].localPref != localPref {
+			return fmt.Errorf("%w: prefix %q advertised for %q can't be set different local preferences %d and %d",
+				errConfig, prefix, advertisement, *attributes[prefix].localPref, localPref)
+		}
+		attributes[prefix].localPref = &localPref
+	}
+
+	return nil
+}
+
+// getAdvertiseAttributes returns the BGP communities and local preferences to
+// set on the given ordered prefixes, ordered themselves to generate consistent
+// FRRConfigurations
+func getAdvertiseAttributes(attributes map[string]*prefixAttributes, prefixes []string) ([]frrtypes.CommunityPrefixes, []frrtypes.LocalPrefPrefixes) {
+	communityPrefixes := map[string][]string{}
+	localPrefPrefixes := map[uint32][]string{}
+	for _, prefix := range prefixes {
+		if attributes[prefix] == nil {
+			continue
+		}
+		for community := range attributes[prefix].communities {
+			communityPrefixes[community] = append(communityPrefixes[community], prefix)
+		}
+		if attributes[prefix].localPref != nil {
+			localPrefPrefixes[*attributes[prefix].localPref] = append(localPrefPrefixes[*attributes[prefix].localPref], prefix)
+		}
+	}
+
+	var withCommunity []frrtypes.CommunityPrefixes
+	for _, community := range sets.List(sets.KeySet(communityPrefixes)) {
+		withCommunity = append(withCommunity, frrtypes.CommunityPrefixes{
+			Community: community,
+			Prefixes:  communityPrefixes[community],
+		})
+	}
+	var withLocalPref []frrtypes.LocalPrefPrefixes
+	for _, localPref := range sets.List(sets.KeySet(localPrefPrefixes)) {
+		withLocalPref = append(withLocalPref, frrtypes.LocalPrefPrefixes{
+			LocalPref: localPref,
+			Prefixes:  localPrefPrefixes[localPref],
+		})
+	}
+	return withCommunity, withLocalPref
+}
+
 // isOwnUpdate checks if an object was updated by us last, as indicated by its
 // managed fields. Used to avoid reconciling an update that we made ourselves.
 func isOwnUpdate(managedFields []metav1.ManagedFieldsEntry) bool {
@@ -1053,6 +1401,23 @@ func egressIPNeedsUpdate(oldObj, newObj *eiptypes.EgressIP) bool {
 	return false
 }
 
+func serviceNeedsUpdate(oldObj, newObj *core.Service) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return oldObj.Spec.Type != newObj.Spec.Type ||
+		oldObj.Spec.ExternalTrafficPolicy != newObj.Spec.ExternalTrafficPolicy ||
+		!reflect.DeepEqual(oldObj.Spec.ClusterIPs, newObj.Spec.ClusterIPs) ||
+		!reflect.DeepEqual(oldObj.Spec.ExternalIPs, newObj.Spec.ExternalIPs) ||
+		!reflect.DeepEqual(oldObj.Status.LoadBalancer, newObj.Status.LoadBalancer)
+}
+
+func endpointSliceNeedsUpdate(oldObj, newObj *discovery.EndpointSlice) bool {
+	return oldObj == nil || newObj == nil ||
+		!reflect.DeepEqual(oldObj.Labels, newObj.Labels) ||
+		!reflect.DeepEqual(oldObj.Endpoints, newObj.Endpoints)
+}
+
 func (c *Controller) reconcileFRRConfiguration(key string) error {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -1117,13 +1482,59 @@ func (c *Controller) reconcileNAD(key string) error {
 
 func (c *Controller) reconcileEgressIP(eipName string) error {
 	// reconcile RAs that advertise EIPs
+	return c.reconcileAdvertising(ratypes.EgressIP)
+}
+
+func (c *Controller) reconcileService(key string) error {
+	// reconcile RAs that advertise service VIPs
+	return c.reconcileAdvertising(serviceVIPAdvertisements...)
+}
+
+func (c *Controller) reconcileEndpointSlice(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.Errorf("Failed spliting EndpointSlice reconcile key %q: %v", key, err)
+		return nil
+	}
+
+	eps, err := c.epsLister.EndpointSlices(namespace).Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	// endpoints only matter for the external VIPs of services with
+	// externalTrafficPolicy=Local; if the EndpointSlice is gone we can't tell
+	// which service it belonged to so reconcile anyway
+	if eps != nil {
+		svcName := eps.Labels[discovery.LabelServiceName]
+		if svcName == "" {
+			return nil
+		}
+		svc, err := c.svcLister.Services(namespace).Get(svcName)
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !util.ServiceExternalTrafficPolicyLocal(svc) {
+			return nil
+		}
+	}
+
+	return c.reconcileAdvertising(ratypes.LoadBalancerIP, ratypes.ExternalIP)
+}
+
+// reconcileAdvertising reconciles the RAs that advertise any of the provided
+// advertisement types
+func (c *Controller) reconcileAdvertising(advertisements ...ratypes.AdvertisementType) error {
 	ras, err := c.raLister.List(labels.Everything())
 	if err != nil {
 		return err
 	}
 
 	for _, ra := range ras {
-		if sets.New(ra.Spec.Advertisements...).Has(ratypes.EgressIP) {
+		if sets.New(ra.Spec.Advertisements...).HasAny(advertisements...) {
 			c.raController.Reconcile(ra.Name)
 		}
 	}
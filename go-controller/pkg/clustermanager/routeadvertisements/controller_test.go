@@ -15,12 +15,14 @@ import (
 	nadtypes "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	frrapi "github.com/metallb/frr-k8s/api/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 
 	frrfake "github.com/metallb/frr-k8s/pkg/client/clientset/versioned/fake"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
@@ -43,6 +45,10 @@ type testRA struct {
 	FRRConfigurationSelector map[string]string
 	AdvertisePods            bool
 	AdvertiseEgressIPs       bool
+	AdvertiseLoadBalancerIPs bool
+	AdvertiseExternalIPs     bool
+	AdvertiseClusterIPs      bool
+	AdvertisementAttributes  []ratypes.AdvertisementAttributes
 }
 
 func (tra testRA) RouteAdvertisements() *ratypes.RouteAdvertisements {
@@ -51,8 +57,9 @@ func (tra testRA) RouteAdvertisements() *ratypes.RouteAdvertisements {
 			Name: tra.Name,
 		},
 		Spec: ratypes.RouteAdvertisementsSpec{
-			TargetVRF:      tra.TargetVRF,
-			Advertisements: []ratypes.AdvertisementType{},
+			TargetVRF:               tra.TargetVRF,
+			Advertisements:          []ratypes.AdvertisementType{},
+			AdvertisementAttributes: tra.AdvertisementAttributes,
 		},
 	}
 	if tra.AdvertisePods {
@@ -61,6 +68,15 @@ func (tra testRA) RouteAdvertisements() *ratypes.RouteAdvertisements {
 	if tra.AdvertiseEgressIPs {
 		ra.Spec.Advertisements = append(ra.Spec.Advertisements, ratypes.EgressIP)
 	}
+	if tra.AdvertiseLoadBalancerIPs {
+		ra.Spec.Advertisements = append(ra.Spec.Advertisements, ratypes.LoadBalancerIP)
+	}
+	if tra.AdvertiseExternalIPs {
+		ra.Spec.Advertisements = append(ra.Spec.Advertisements, ratypes.ExternalIP)
+	}
+	if tra.AdvertiseClusterIPs {
+		ra.Spec.Advertisements = append(ra.Spec.Advertisements, ratypes.ClusterIP)
+	}
 	if tra.NetworkSelector != nil {
 		ra.Spec.NetworkSelector = metav1.LabelSelector{
 			MatchLabels: tra.NetworkSelector,
@@ -100,10 +116,12 @@ func (tn testNode) Node() *corev1.Node {
 }
 
 type testNeighbor struct {
-	ASN       uint32
-	Address   string
-	Receive   []string
-	Advertise []string
+	ASN           uint32
+	Address       string
+	Receive       []string
+	Advertise     []string
+	WithCommunity []frrapi.CommunityPrefixes
+	WithLocalPref []frrapi.LocalPrefPrefixes
 }
 
 func (tn testNeighbor) Neighbor() frrapi.Neighbor {
@@ -120,6 +138,8 @@ func (tn testNeighbor) Neighbor() frrapi.Neighbor {
 				Mode:     frrapi.AllowRestricted,
 				Prefixes: tn.Advertise,
 			},
+			PrefixesWithCommunity: tn.WithCommunity,
+			PrefixesWithLocalPref: tn.WithLocalPref,
 		},
 	}
 	for _, receive := range tn.Receive {
@@ -225,6 +245,61 @@ func (te testEIP) EgressIP() *eiptypes.EgressIP {
 	return &eip
 }
 
+type testService struct {
+	Name            string
+	Namespace       string
+	ClusterIP       string
+	ExternalIPs     []string
+	LoadBalancerIPs []string
+	ETPLocal        bool
+	// EndpointNodes are the nodes hosting the endpoints of the service
+	EndpointNodes []string
+}
+
+func (ts testService) Service() *corev1.Service {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ts.Name,
+			Namespace: ts.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:                  corev1.ServiceTypeClusterIP,
+			ClusterIP:             ts.ClusterIP,
+			ClusterIPs:            []string{ts.ClusterIP},
+			ExternalIPs:           ts.ExternalIPs,
+			ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyCluster,
+		},
+	}
+	if len(ts.LoadBalancerIPs) > 0 {
+		svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+		for _, ip := range ts.LoadBalancerIPs {
+			svc.Status.LoadBalancer.Ingress = append(svc.Status.LoadBalancer.Ingress, corev1.LoadBalancerIngress{IP: ip})
+		}
+	}
+	if ts.ETPLocal {
+		svc.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyLocal
+	}
+	return svc
+}
+
+func (ts testService) EndpointSlice() *discovery.EndpointSlice {
+	eps := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ts.Name + "-eps",
+			Namespace: ts.Namespace,
+			Labels:    map[string]string{discovery.LabelServiceName: ts.Name},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+	}
+	for i, node := range ts.EndpointNodes {
+		eps.Endpoints = append(eps.Endpoints, discovery.Endpoint{
+			Addresses: []string{fmt.Sprintf("1.1.%d.%d", i, i+2)},
+			NodeName:  &node,
+		})
+	}
+	return eps
+}
+
 type testNAD struct {
 	Name        string
 	Namespace   string
@@ -324,10 +399,12 @@ func TestController_reconcile(t *testing.T) {
 	tests := []struct {
 		name                 string
 		ra                   *testRA
+		otherRAs             []*testRA
 		frrConfigs           []*testFRRConfig
 		nads                 []*testNAD
 		nodes                []*testNode
 		eips                 []*testEIP
+		services             []*testService
 		reconcile            string
 		wantErr              bool
 		expectAcceptedStatus metav1.ConditionStatus
@@ -617,6 +694,52 @@ func TestController_reconcile(t *testing.T) {
 			reconcile:            "ra",
 			expectAcceptedStatus: metav1.ConditionFalse,
 		},
+		{
+			name: "fails to reconcile if selected networks have overlapping subnets",
+			ra:   &testRA{Name: "ra", AdvertisePods: true, NetworkSelector: map[string]string{"selected": "true"}},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.1.1.0/24"}},
+						}},
+					},
+				},
+			},
+			nads: []*testNAD{
+				{Name: "red", Namespace: "red", Network: "cluster.udn.red", Topology: "layer3", Subnet: "1.2.0.0/16", Labels: map[string]string{"selected": "true"}},
+				{Name: "blue", Namespace: "blue", Network: "cluster.udn.blue", Topology: "layer3", Subnet: "1.2.0.0/16", Labels: map[string]string{"selected": "true"}},
+			},
+			nodes:                []*testNode{{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\", \"cluster.udn.red\":\"1.2.0.0/24\", \"cluster.udn.blue\":\"1.2.1.0/24\"}"}},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionFalse,
+		},
+		{
+			name:     "fails to reconcile if a selected network overlaps with a network advertised by another RouteAdvertisement",
+			ra:       &testRA{Name: "ra", AdvertisePods: true, NetworkSelector: map[string]string{"selected": "true"}},
+			otherRAs: []*testRA{{Name: "another", AdvertisePods: true, NetworkSelector: map[string]string{"name": "blue"}}},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.1.1.0/24"}},
+						}},
+					},
+				},
+			},
+			nads: []*testNAD{
+				{Name: "red", Namespace: "red", Network: "cluster.udn.red", Topology: "layer3", Subnet: "1.2.0.0/16", Labels: map[string]string{"selected": "true"}},
+				{Name: "blue", Namespace: "blue", Network: "cluster.udn.blue", Topology: "layer3", Subnet: "1.2.0.0/16", Labels: map[string]string{"name": "blue"},
+					Annotations: map[string]string{types.OvnRouteAdvertisementsKey: "[\"another\"]"}},
+			},
+			nodes:                []*testNode{{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\", \"cluster.udn.red\":\"1.2.0.0/24\", \"cluster.udn.blue\":\"1.2.1.0/24\"}"}},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionFalse,
+		},
 		{
 			name:                 "fails to reconcile pod network if node selector is not empty",
 			ra:                   &testRA{Name: "ra", AdvertisePods: true, NodeSelector: map[string]string{"selected": "true"}},
@@ -703,6 +826,212 @@ func TestController_reconcile(t *testing.T) {
 			reconcile:            "ra",
 			expectAcceptedStatus: metav1.ConditionFalse,
 		},
+		{
+			name: "reconciles service VIPs RouteAdvertisement for a single FRR config, multiple nodes and default network and target VRF",
+			ra:   &testRA{Name: "ra", AdvertiseLoadBalancerIPs: true, AdvertiseExternalIPs: true, AdvertiseClusterIPs: true},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes: []*testNode{
+				{Name: "node1", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\"}"},
+				{Name: "node2", SubnetsAnnotation: "{\"default\":\"1.1.1.0/24\"}"},
+			},
+			services: []*testService{
+				{Name: "cluster", Namespace: "ns", ClusterIP: "172.30.0.1", ExternalIPs: []string{"2.0.0.1"}, LoadBalancerIPs: []string{"3.0.0.1"}, EndpointNodes: []string{"node1"}},
+				{Name: "local", Namespace: "ns", ClusterIP: "172.30.0.2", ExternalIPs: []string{"2.0.0.2"}, LoadBalancerIPs: []string{"3.0.0.2"}, ETPLocal: true, EndpointNodes: []string{"node1"}},
+				{Name: "no-endpoints", Namespace: "ns", ClusterIP: "172.30.0.3", LoadBalancerIPs: []string{"3.0.0.3"}, ETPLocal: true},
+				{Name: "headless", Namespace: "ns", ClusterIP: corev1.ClusterIPNone, ExternalIPs: []string{"2.0.0.4"}},
+			},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node1"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node1"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"172.30.0.1/32", "172.30.0.2/32", "172.30.0.3/32", "2.0.0.1/32", "2.0.0.2/32", "3.0.0.1/32", "3.0.0.2/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"172.30.0.1/32", "172.30.0.2/32", "172.30.0.3/32", "2.0.0.1/32", "2.0.0.2/32", "3.0.0.1/32", "3.0.0.2/32"}},
+						}},
+					}},
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node2"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node2"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"172.30.0.1/32", "172.30.0.2/32", "172.30.0.3/32", "2.0.0.1/32", "3.0.0.1/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"172.30.0.1/32", "172.30.0.2/32", "172.30.0.3/32", "2.0.0.1/32", "3.0.0.1/32"}},
+						}},
+					}},
+			},
+			expectNADAnnotations: map[string]map[string]string{"default": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}},
+		},
+		{
+			name: "reconciles load balancer IP RouteAdvertisement for a single FRR config, node, default network and non default target VRF",
+			ra:   &testRA{Name: "ra", TargetVRF: "red", AdvertiseLoadBalancerIPs: true},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, VRF: "red", Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes: []*testNode{{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\"}"}},
+			services: []*testService{
+				{Name: "lb", Namespace: "ns", ClusterIP: "172.30.0.1", ExternalIPs: []string{"2.0.0.1"}, LoadBalancerIPs: []string{"3.0.0.1"}, EndpointNodes: []string{"node"}},
+			},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node"},
+					Routers: []*testRouter{
+						{ASN: 1, VRF: "red", Prefixes: []string{"3.0.0.1/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"3.0.0.1/32"}},
+						}},
+					}},
+			},
+			expectNADAnnotations: map[string]map[string]string{"default": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}},
+		},
+		{
+			name: "fails to reconcile if service VIPs are advertised for non-default network",
+			ra:   &testRA{Name: "ra", AdvertiseClusterIPs: true, NetworkSelector: map[string]string{"selected": "true"}},
+			nads: []*testNAD{
+				{Name: "red", Namespace: "red", Network: "red", Topology: "layer3", Labels: map[string]string{"selected": "true"}},
+			},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes:                []*testNode{{Name: "node", SubnetsAnnotation: "{\"red\":\"1.1.0.0/24\"}"}},
+			services:             []*testService{{Name: "svc", Namespace: "red", ClusterIP: "172.30.0.1"}},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionFalse,
+		},
+		{
+			name: "reconciles pod+eip RouteAdvertisement with BGP attributes for a single FRR config, node and default network and target VRF",
+			ra: &testRA{
+				Name:               "ra",
+				AdvertisePods:      true,
+				AdvertiseEgressIPs: true,
+				AdvertisementAttributes: []ratypes.AdvertisementAttributes{
+					{Advertisement: ratypes.PodNetwork, Communities: []string{"65000:100"}, LargeCommunities: []string{"65000:1:2"}, LocalPreference: ptr.To[int64](200)},
+					{Advertisement: ratypes.EgressIP, Communities: []string{"65000:200"}},
+				},
+			},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes:                []*testNode{{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\"}"}},
+			eips:                 []*testEIP{{Name: "eip", EIPs: map[string]string{"node": "1.0.1.1"}}},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.0.1.1/32", "1.1.0.0/24"}, Neighbors: []*testNeighbor{
+							{
+								ASN:       1,
+								Address:   "1.0.0.100",
+								Advertise: []string{"1.0.1.1/32", "1.1.0.0/24"},
+								Receive:   []string{"1.1.0.0/16/24"},
+								WithCommunity: []frrapi.CommunityPrefixes{
+									{Community: "65000:100", Prefixes: []string{"1.1.0.0/24"}},
+									{Community: "65000:200", Prefixes: []string{"1.0.1.1/32"}},
+									{Community: "large:65000:1:2", Prefixes: []string{"1.1.0.0/24"}},
+								},
+								WithLocalPref: []frrapi.LocalPrefPrefixes{
+									{LocalPref: 200, Prefixes: []string{"1.1.0.0/24"}},
+								},
+							},
+						}},
+					}},
+			},
+			expectNADAnnotations: map[string]map[string]string{"default": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}},
+		},
+		{
+			name: "fails to reconcile if a prefix is set different local preferences",
+			ra: &testRA{
+				Name:                     "ra",
+				AdvertiseLoadBalancerIPs: true,
+				AdvertiseExternalIPs:     true,
+				AdvertisementAttributes: []ratypes.AdvertisementAttributes{
+					{Advertisement: ratypes.LoadBalancerIP, LocalPreference: ptr.To[int64](100)},
+					{Advertisement: ratypes.ExternalIP, LocalPreference: ptr.To[int64](200)},
+				},
+			},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes:                []*testNode{{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\"}"}},
+			services:             []*testService{{Name: "svc", Namespace: "ns", ClusterIP: "172.30.0.1", ExternalIPs: []string{"3.0.0.1"}, LoadBalancerIPs: []string{"3.0.0.1"}}},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionFalse,
+		},
+		{
+			name: "fails to reconcile if BGP attributes are set for an advertisement that is not selected",
+			ra: &testRA{
+				Name:          "ra",
+				AdvertisePods: true,
+				AdvertisementAttributes: []ratypes.AdvertisementAttributes{
+					{Advertisement: ratypes.EgressIP, Communities: []string{"65000:200"}},
+				},
+			},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes:                []*testNode{{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\"}"}},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionFalse,
+		},
 		{
 			name: "fails to reconcile if a selectd FRRConfiguration has no matching VRF",
 			ra:   &testRA{Name: "ra", TargetVRF: "red", AdvertisePods: true},
@@ -813,6 +1142,11 @@ func TestController_reconcile(t *testing.T) {
 				g.Expect(err).ToNot(gomega.HaveOccurred())
 			}
 
+			for _, ra := range tt.otherRAs {
+				_, err := fakeClientset.RouteAdvertisementsClient.K8sV1().RouteAdvertisements().Create(context.Background(), ra.RouteAdvertisements(), metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
 			for _, frrConfig := range tt.frrConfigs {
 				_, err := fakeClientset.FRRClient.ApiV1beta1().FRRConfigurations(frrConfig.Namespace).Create(context.Background(), frrConfig.FRRConfiguration(), metav1.CreateOptions{})
 				g.Expect(err).ToNot(gomega.HaveOccurred())
@@ -837,6 +1171,13 @@ func TestController_reconcile(t *testing.T) {
 				g.Expect(err).ToNot(gomega.HaveOccurred())
 			}
 
+			for _, svc := range tt.services {
+				_, err := fakeClientset.KubeClient.CoreV1().Services(svc.Namespace).Create(context.Background(), svc.Service(), metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+				_, err = fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(svc.Namespace).Create(context.Background(), svc.EndpointSlice(), metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
 			wf, err := factory.NewClusterManagerWatchFactory(fakeClientset)
 			g.Expect(err).ToNot(gomega.HaveOccurred())
 
@@ -868,6 +1209,8 @@ func TestController_reconcile(t *testing.T) {
 				wf.NADInformer().Informer().HasSynced,
 				wf.NodeCoreInformer().Informer().HasSynced,
 				wf.EgressIPInformer().Informer().HasSynced,
+				wf.ServiceCoreInformer().Informer().HasSynced,
+				wf.EndpointSliceCoreInformer().Informer().HasSynced,
 			)
 
 			err = nm.Start()
@@ -949,10 +1292,12 @@ func TestUpdates(t *testing.T) {
 			FRRConfigurationSelector: map[string]string{"select": "2"},
 			NetworkSelector:          map[string]string{"select": "2"},
 			NodeSelector:             map[string]string{"select": "2"},
+			AdvertiseClusterIPs:      true,
 		},
 		{
 			Name:                     "ra3",
 			AdvertiseEgressIPs:       true,
+			AdvertiseLoadBalancerIPs: true,
 			FRRConfigurationSelector: map[string]string{"select": "3"},
 			NetworkSelector:          map[string]string{"select": "3"},
 			NodeSelector:             map[string]string{"select": "3"},
@@ -1097,6 +1442,33 @@ func TestUpdates(t *testing.T) {
 			oldObject: &testEIP{Name: "eip", Generation: 1, EIPs: map[string]string{"node": "ip"}},
 			newObject: &testEIP{Name: "eip", Generation: 2, EIPs: map[string]string{"node": "ip"}},
 		},
+		{
+			name:              "reconciles all RAs that advertise service VIPs on new service",
+			newObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "172.30.0.1", EndpointNodes: []string{"node1"}},
+			expectedReconcile: []string{"ra2", "ra3"},
+		},
+		{
+			name:              "reconciles all RAs that advertise service VIPs on deleted service",
+			oldObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "172.30.0.1", EndpointNodes: []string{"node1"}},
+			expectedReconcile: []string{"ra2", "ra3"},
+		},
+		{
+			name:              "reconciles all RAs that advertise service VIPs on updated service load balancer status",
+			oldObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "172.30.0.1", LoadBalancerIPs: []string{"3.0.0.1"}},
+			newObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "172.30.0.1", LoadBalancerIPs: []string{"3.0.0.2"}},
+			expectedReconcile: []string{"ra2", "ra3"},
+		},
+		{
+			name:              "reconciles all RAs that advertise external service VIPs on updated endpoints of a service with local traffic policy",
+			oldObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "172.30.0.1", ETPLocal: true, EndpointNodes: []string{"node1"}},
+			newObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "172.30.0.1", ETPLocal: true, EndpointNodes: []string{"node2"}},
+			expectedReconcile: []string{"ra3"},
+		},
+		{
+			name:      "does not reconcile RAs on updated endpoints of a service with cluster traffic policy",
+			oldObject: &testService{Name: "svc", Namespace: "ns", ClusterIP: "172.30.0.1", EndpointNodes: []string{"node1"}},
+			newObject: &testService{Name: "svc", Namespace: "ns", ClusterIP: "172.30.0.1", EndpointNodes: []string{"node2"}},
+		},
 		{
 			name:              "reconciles all RAs on new Node",
 			newObject:         &testNode{Name: "eip"},
@@ -1189,6 +1561,11 @@ func TestUpdates(t *testing.T) {
 					_, err = fakeClientset.EgressIPClient.K8sV1().EgressIPs().Create(context.Background(), t.EgressIP(), metav1.CreateOptions{})
 				case *testNode:
 					_, err = fakeClientset.KubeClient.CoreV1().Nodes().Create(context.Background(), t.Node(), metav1.CreateOptions{})
+				case *testService:
+					_, err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Create(context.Background(), t.Service(), metav1.CreateOptions{})
+					if err == nil {
+						_, err = fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(t.Namespace).Create(context.Background(), t.EndpointSlice(), metav1.CreateOptions{})
+					}
 				}
 				return err
 			}
@@ -1203,6 +1580,11 @@ func TestUpdates(t *testing.T) {
 					_, err = fakeClientset.EgressIPClient.K8sV1().EgressIPs().Update(context.Background(), t.EgressIP(), metav1.UpdateOptions{})
 				case *testNode:
 					_, err = fakeClientset.KubeClient.CoreV1().Nodes().Update(context.Background(), t.Node(), metav1.UpdateOptions{})
+				case *testService:
+					_, err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Update(context.Background(), t.Service(), metav1.UpdateOptions{})
+					if err == nil {
+						_, err = fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(t.Namespace).Update(context.Background(), t.EndpointSlice(), metav1.UpdateOptions{})
+					}
 				}
 				return err
 			}
@@ -1217,6 +1599,8 @@ func TestUpdates(t *testing.T) {
 					err = fakeClientset.EgressIPClient.K8sV1().EgressIPs().Delete(context.Background(), t.Name, metav1.DeleteOptions{})
 				case *testNode:
 					err = fakeClientset.KubeClient.CoreV1().Nodes().Delete(context.Background(), t.Name, metav1.DeleteOptions{})
+				case *testService:
+					err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Delete(context.Background(), t.Name, metav1.DeleteOptions{})
 				}
 				return err
 			}
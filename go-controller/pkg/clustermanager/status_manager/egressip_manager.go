@@ -0,0 +1,130 @@
+package status_manager
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	egressipapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/applyconfiguration/egressip/v1"
+	egressipclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned"
+	egressiplisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/listers/egressip/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+const (
+	egressIPProgrammedReason    = "Programmed"
+	egressIPNotProgrammedReason = "ProgrammingFailed"
+)
+
+type egressIPManager struct {
+	lister egressiplisters.EgressIPLister
+	client egressipclientset.Interface
+}
+
+func newEgressIPManager(lister egressiplisters.EgressIPLister, client egressipclientset.Interface) *egressIPManager {
+	return &egressIPManager{
+		lister: lister,
+		client: client,
+	}
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives https://github.com/dominikh/go-tools/issues/1440
+func (m *egressIPManager) get(_, name string) (*egressipapi.EgressIP, error) {
+	return m.lister.Get(name)
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *egressIPManager) getMessages(egressIP *egressipapi.EgressIP) []string {
+	var messages []string
+	for _, condition := range egressIP.Status.Conditions {
+		zone, found := strings.CutPrefix(condition.Type, egressipapi.EgressIPProgrammedInZoneConditionPrefix)
+		if !found {
+			continue
+		}
+		messages = append(messages, types.GetZoneStatus(zone, condition.Message))
+	}
+	return messages
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *egressIPManager) updateStatus(egressIP *egressipapi.EgressIP, applyOpts *metav1.ApplyOptions,
+	applyEmptyOrFailed bool) error {
+	if egressIP == nil {
+		return nil
+	}
+	// the status items are required, wait for them to be initialized
+	if egressIP.Status.Items == nil {
+		return nil
+	}
+	var failedZones []string
+	for _, condition := range egressIP.Status.Conditions {
+		zone, found := strings.CutPrefix(condition.Type, egressipapi.EgressIPProgrammedInZoneConditionPrefix)
+		if found && condition.Status != metav1.ConditionTrue {
+			failedZones = append(failedZones, zone)
+		}
+	}
+	var newCondition *metav1.Condition
+	switch {
+	case len(failedZones) > 0:
+		sort.Strings(failedZones)
+		newCondition = &metav1.Condition{
+			Type:    egressipapi.EgressIPProgrammedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  egressIPNotProgrammedReason,
+			Message: types.EgressIPErrorMsg + " in zones: " + strings.Join(failedZones, ", "),
+		}
+	case !applyEmptyOrFailed:
+		newCondition = &metav1.Condition{
+			Type:    egressipapi.EgressIPProgrammedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  egressIPProgrammedReason,
+			Message: "EgressIP programmed in all zones",
+		}
+	}
+
+	existingCondition := meta.FindStatusCondition(egressIP.Status.Conditions, egressipapi.EgressIPProgrammedCondition)
+	if newCondition == nil && existingCondition == nil {
+		// already cleaned up
+		return nil
+	}
+	applyStatus := egressipapply.EgressIPStatus()
+	if newCondition != nil {
+		if existingCondition != nil && existingCondition.Status == newCondition.Status &&
+			existingCondition.Reason == newCondition.Reason && existingCondition.Message == newCondition.Message {
+			// already set to the same value
+			return nil
+		}
+		lastTransitionTime := metav1.NewTime(time.Now())
+		if existingCondition != nil && existingCondition.Status == newCondition.Status {
+			lastTransitionTime = existingCondition.LastTransitionTime
+		}
+		applyStatus.WithConditions(&metaapplyv1.ConditionApplyConfiguration{
+			Type:               &newCondition.Type,
+			Status:             &newCondition.Status,
+			LastTransitionTime: &lastTransitionTime,
+			Reason:             &newCondition.Reason,
+			Message:            &newCondition.Message,
+		})
+	}
+
+	applyObj := egressipapply.EgressIP(egressIP.Name).
+		WithStatus(applyStatus)
+
+	_, err := m.client.K8sV1().EgressIPs().Apply(context.TODO(), applyObj, *applyOpts)
+	return err
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *egressIPManager) cleanupStatus(egressIP *egressipapi.EgressIP, applyOpts *metav1.ApplyOptions) error {
+	applyObj := egressipapply.EgressIP(egressIP.Name).
+		WithStatus(egressipapply.EgressIPStatus())
+
+	_, err := m.client.K8sV1().EgressIPs().Apply(context.TODO(), applyObj, *applyOpts)
+	return err
+}
@@ -18,16 +18,20 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressipapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	egressqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1"
+	networkqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 )
 
 func getNodeWithZone(nodeName, zoneName string) *v1.Node {
@@ -204,6 +208,115 @@ func checkEmptyEQStatusConsistently(egressQoS *egressqosapi.EgressQoS, fakeClien
 	}).Should(BeTrue(), "expected Status to be consistently empty")
 }
 
+func newNetworkQoS(namespace string) *networkqosapi.NetworkQoS {
+	return &networkqosapi.NetworkQoS{
+		ObjectMeta: util.NewObjectMeta("nqos", namespace),
+		Spec: networkqosapi.NetworkQoSSpec{
+			Priority: 10,
+			Egress: []networkqosapi.NetworkQoSRule{
+				{
+					DSCP: ptr.To(46),
+				},
+			},
+		},
+	}
+}
+
+func updateNetworkQoSStatus(networkQoS *networkqosapi.NetworkQoS, status *networkqosapi.NetworkQoSStatus,
+	fakeClient *util.OVNClusterManagerClientset) {
+	networkQoS.Status = *status
+	_, err := fakeClient.NetworkQoSClient.K8sV1().NetworkQoSes(networkQoS.Namespace).
+		Update(context.TODO(), networkQoS, metav1.UpdateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func checkNQStatusEventually(networkQoS *networkqosapi.NetworkQoS, expectFailure bool, expectEmpty bool, fakeClient *util.OVNClusterManagerClientset) {
+	Eventually(func() bool {
+		nq, err := fakeClient.NetworkQoSClient.K8sV1().NetworkQoSes(networkQoS.Namespace).
+			Get(context.TODO(), networkQoS.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		if expectFailure {
+			return strings.Contains(nq.Status.Status, types.NetworkQoSErrorMsg)
+		} else if expectEmpty {
+			return nq.Status.Status == ""
+		} else {
+			return strings.Contains(nq.Status.Status, "applied")
+		}
+	}).Should(BeTrue(), fmt.Sprintf("expected network QoS status with expectFailure=%v expectEmpty=%v", expectFailure, expectEmpty))
+}
+
+func checkEmptyNQStatusConsistently(networkQoS *networkqosapi.NetworkQoS, fakeClient *util.OVNClusterManagerClientset) {
+	Consistently(func() bool {
+		nq, err := fakeClient.NetworkQoSClient.K8sV1().NetworkQoSes(networkQoS.Namespace).
+			Get(context.TODO(), networkQoS.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return nq.Status.Status == ""
+	}).Should(BeTrue(), "expected Status to be consistently empty")
+}
+
+func newEgressIP(name string) *egressipapi.EgressIP {
+	return &egressipapi.EgressIP{
+		ObjectMeta: util.NewObjectMeta(name, ""),
+		Spec: egressipapi.EgressIPSpec{
+			EgressIPs: []string{"192.168.126.101"},
+		},
+		Status: egressipapi.EgressIPStatus{
+			Items: []egressipapi.EgressIPStatusItem{},
+		},
+	}
+}
+
+func newEgressIPZoneCondition(zone string, programmed bool) metav1.Condition {
+	if programmed {
+		return metav1.Condition{
+			Type:    egressipapi.EgressIPProgrammedInZoneConditionPrefix + zone,
+			Status:  metav1.ConditionTrue,
+			Reason:  "EgressIPProgrammed",
+			Message: "EgressIP programmed correctly",
+		}
+	}
+	return metav1.Condition{
+		Type:    egressipapi.EgressIPProgrammedInZoneConditionPrefix + zone,
+		Status:  metav1.ConditionFalse,
+		Reason:  "EgressIPProgrammingFailed",
+		Message: types.EgressIPErrorMsg + ": error",
+	}
+}
+
+func updateEgressIPStatus(egressIP *egressipapi.EgressIP, status *egressipapi.EgressIPStatus,
+	fakeClient *util.OVNClusterManagerClientset) {
+	egressIP.Status = *status
+	_, err := fakeClient.EgressIPClient.K8sV1().EgressIPs().
+		Update(context.TODO(), egressIP, metav1.UpdateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func checkEIPStatusEventually(egressIP *egressipapi.EgressIP, expectFailure bool, expectEmpty bool, fakeClient *util.OVNClusterManagerClientset) {
+	Eventually(func() bool {
+		eip, err := fakeClient.EgressIPClient.K8sV1().EgressIPs().
+			Get(context.TODO(), egressIP.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		condition := meta.FindStatusCondition(eip.Status.Conditions, egressipapi.EgressIPProgrammedCondition)
+		if expectFailure {
+			return condition != nil && condition.Status == metav1.ConditionFalse &&
+				strings.Contains(condition.Message, types.EgressIPErrorMsg)
+		} else if expectEmpty {
+			return condition == nil
+		} else {
+			return condition != nil && condition.Status == metav1.ConditionTrue
+		}
+	}).Should(BeTrue(), fmt.Sprintf("expected egress IP status with expectFailure=%v expectEmpty=%v", expectFailure, expectEmpty))
+}
+
+func checkEmptyEIPStatusConsistently(egressIP *egressipapi.EgressIP, fakeClient *util.OVNClusterManagerClientset) {
+	Consistently(func() bool {
+		eip, err := fakeClient.EgressIPClient.K8sV1().EgressIPs().
+			Get(context.TODO(), egressIP.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return meta.FindStatusCondition(eip.Status.Conditions, egressipapi.EgressIPProgrammedCondition) == nil
+	}).Should(BeTrue(), "expected Programmed condition to be consistently absent")
+}
+
 var _ = Describe("Cluster Manager Status Manager", func() {
 	var (
 		statusManager *StatusManager
@@ -447,6 +560,94 @@ var _ = Describe("Cluster Manager Status Manager", func() {
 		}, fakeClient)
 		checkEQStatusEventually(egressQoS, false, false, fakeClient)
 	})
+	It("updates NetworkQoS status with 2 zones", func() {
+		config.OVNKubernetesFeature.EnableNetworkQoS = true
+		zones := sets.New[string]("zone1", "zone2")
+		namespace1 := util.NewNamespace(namespace1Name)
+		networkQoS := newNetworkQoS(namespace1.Name)
+		start(zones, namespace1, networkQoS)
+
+		updateNetworkQoSStatus(networkQoS, &networkqosapi.NetworkQoSStatus{
+			Conditions: []metav1.Condition{{
+				Type:    "Ready-In-Zone-zone1",
+				Status:  metav1.ConditionTrue,
+				Reason:  "SetupSucceeded",
+				Message: "NetworkQoS Rules applied",
+			}},
+		}, fakeClient)
+
+		checkEmptyNQStatusConsistently(networkQoS, fakeClient)
+
+		updateNetworkQoSStatus(networkQoS, &networkqosapi.NetworkQoSStatus{
+			Conditions: []metav1.Condition{{
+				Type:    "Ready-In-Zone-zone1",
+				Status:  metav1.ConditionTrue,
+				Reason:  "SetupSucceeded",
+				Message: "NetworkQoS Rules applied",
+			}, {
+				Type:    "Ready-In-Zone-zone2",
+				Status:  metav1.ConditionTrue,
+				Reason:  "SetupSucceeded",
+				Message: "NetworkQoS Rules applied",
+			}},
+		}, fakeClient)
+		checkNQStatusEventually(networkQoS, false, false, fakeClient)
+	})
+
+	It("updates NetworkQoS status on failure in 1 of 2 zones", func() {
+		config.OVNKubernetesFeature.EnableNetworkQoS = true
+		zones := sets.New[string]("zone1", "zone2")
+		namespace1 := util.NewNamespace(namespace1Name)
+		networkQoS := newNetworkQoS(namespace1.Name)
+		start(zones, namespace1, networkQoS)
+
+		// a failure is reported without waiting for all the zones
+		updateNetworkQoSStatus(networkQoS, &networkqosapi.NetworkQoSStatus{
+			Conditions: []metav1.Condition{{
+				Type:    "Ready-In-Zone-zone1",
+				Status:  metav1.ConditionFalse,
+				Reason:  "SetupFailed",
+				Message: types.NetworkQoSErrorMsg + ": error",
+			}},
+		}, fakeClient)
+		checkNQStatusEventually(networkQoS, true, false, fakeClient)
+	})
+	It("updates EgressIP status with 2 zones", func() {
+		config.OVNKubernetesFeature.EnableEgressIP = true
+		zones := sets.New[string]("zone1", "zone2")
+		egressIP := newEgressIP("egressip")
+		start(zones, egressIP)
+
+		updateEgressIPStatus(egressIP, &egressipapi.EgressIPStatus{
+			Items:      []egressipapi.EgressIPStatusItem{},
+			Conditions: []metav1.Condition{newEgressIPZoneCondition("zone1", true)},
+		}, fakeClient)
+
+		checkEmptyEIPStatusConsistently(egressIP, fakeClient)
+
+		updateEgressIPStatus(egressIP, &egressipapi.EgressIPStatus{
+			Items: []egressipapi.EgressIPStatusItem{},
+			Conditions: []metav1.Condition{
+				newEgressIPZoneCondition("zone1", true),
+				newEgressIPZoneCondition("zone2", true),
+			},
+		}, fakeClient)
+		checkEIPStatusEventually(egressIP, false, false, fakeClient)
+	})
+
+	It("updates EgressIP status with a failed zone", func() {
+		config.OVNKubernetesFeature.EnableEgressIP = true
+		zones := sets.New[string]("zone1", "zone2")
+		egressIP := newEgressIP("egressip")
+		start(zones, egressIP)
+
+		// a single failure is enough to report the EgressIP as not programmed
+		updateEgressIPStatus(egressIP, &egressipapi.EgressIPStatus{
+			Items:      []egressipapi.EgressIPStatusItem{},
+			Conditions: []metav1.Condition{newEgressIPZoneCondition("zone1", false)},
+		}, fakeClient)
+		checkEIPStatusEventually(egressIP, true, false, fakeClient)
+	})
 	// cleanup can't be tested by unit test apiserver, since it relies on SSA logic with FieldManagers
 	It("test if APIServer lister/patcher is called for AdminNetworkPolicy when the zone is deleted", func() {
 		config.OVNKubernetesFeature.EnableAdminNetworkPolicy = true
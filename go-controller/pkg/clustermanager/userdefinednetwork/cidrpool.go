@@ -0,0 +1,462 @@
+package userdefinednetwork
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilnet "k8s.io/utils/net"
+
+	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+type cidrPoolError struct {
+	err error
+}
+
+func (c *cidrPoolError) Error() string {
+	return c.err.Error()
+}
+
+// applyCIDRPools checks the subnets of the given UserDefinedNetwork against
+// the CIDR pools selecting its namespace. When the UserDefinedNetwork doesn't
+// specify subnets, they are set to the subnets of its network if already
+// rendered, or to subnets allocated from the pools otherwise. Namespaces not
+// selected by any pool are not restricted.
+func (c *Controller) applyCIDRPools(udn *userdefinednetworkv1.UserDefinedNetwork) error {
+	if c.cidrPoolLister == nil || !requiresSubnets(udn) {
+		return nil
+	}
+
+	nad, err := c.nadLister.NetworkAttachmentDefinitions(udn.Namespace).Get(udn.Name)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get NetworkAttachmentDefinition [%s/%s] from cache: %w", udn.Namespace, udn.Name, err)
+	}
+	if nad != nil && !metav1.IsControlledBy(nad, udn) {
+		nad = nil
+	}
+
+	if len(udnSubnets(udn)) > 0 {
+		// networks already rendered are not affected by the pools
+		if nad != nil {
+			return nil
+		}
+		pools, err := c.selectCIDRPools(udn.Namespace)
+		if err != nil || len(pools) == 0 {
+			return err
+		}
+		return checkCIDRPools(pools, udn)
+	}
+
+	// keep the subnets of the rendered network, even if the pools changed
+	if nad != nil {
+		if subnets, err := nadSubnets(nad); err == nil && len(subnets) > 0 {
+			setUDNSubnets(udn, subnets)
+			c.recordAllocatedSubnets(udn)
+			return nil
+		}
+	}
+
+	pools, err := c.selectCIDRPools(udn.Namespace)
+	if err != nil || len(pools) == 0 {
+		return err
+	}
+	subnets, err := c.allocateSubnets(udn, pools)
+	if err != nil {
+		return err
+	}
+	setUDNSubnets(udn, subnets)
+	c.recordAllocatedSubnets(udn)
+
+	return nil
+}
+
+// selectCIDRPools returns the CIDR pools selecting the given namespace, sorted
+// by name.
+func (c *Controller) selectCIDRPools(namespaceName string) ([]*userdefinednetworkv1.UserDefinedNetworkCIDRPool, error) {
+	pools, err := c.cidrPoolLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list UserDefinedNetworkCIDRPools: %w", err)
+	}
+	if len(pools) == 0 {
+		return nil, nil
+	}
+
+	namespace, err := c.namespaceInformer.Lister().Get(namespaceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %q from cache: %w", namespaceName, err)
+	}
+
+	var selected []*userdefinednetworkv1.UserDefinedNetworkCIDRPool
+	for _, pool := range pools {
+		selector, err := metav1.LabelSelectorAsSelector(&pool.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert UserDefinedNetworkCIDRPool %q namespace selector: %w", pool.Name, err)
+		}
+		if selector.Matches(labels.Set(namespace.Labels)) {
+			selected = append(selected, pool)
+		}
+	}
+	slices.SortFunc(selected, func(a, b *userdefinednetworkv1.UserDefinedNetworkCIDRPool) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return selected, nil
+}
+
+// checkCIDRPools checks that the subnets of the given UserDefinedNetwork are
+// contained in the CIDRs of any of the given pools.
+func checkCIDRPools(pools []*userdefinednetworkv1.UserDefinedNetworkCIDRPool, udn *userdefinednetworkv1.UserDefinedNetwork) error {
+	var poolNames []string
+	var poolCIDRs []*net.IPNet
+	for _, pool := range pools {
+		poolNames = append(poolNames, pool.Name)
+		for _, r := range pool.Spec.CIDRs {
+			_, poolCIDR, err := net.ParseCIDR(string(r.CIDR))
+			if err != nil {
+				return fmt.Errorf("UserDefinedNetworkCIDRPool %q has an invalid CIDR: %w", pool.Name, err)
+			}
+			poolCIDRs = append(poolCIDRs, poolCIDR)
+		}
+	}
+	for _, cidr := range udnSubnets(udn) {
+		_, subnet, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			return fmt.Errorf("invalid subnet %q: %w", cidr, err)
+		}
+		if !util.IsContainedInAnyCIDR(subnet, poolCIDRs...) {
+			return &cidrPoolError{
+				err: fmt.Errorf("subnet %s is not contained in the CIDRs of the UserDefinedNetworkCIDRPools %v selecting namespace %q",
+					cidr, poolNames, udn.Namespace),
+			}
+		}
+	}
+	return nil
+}
+
+// allocateSubnets allocates a subnet of each of the cluster IP families from
+// the given pools for the given UserDefinedNetwork. The allocated subnets
+// don't overlap with the subnets of any other network, so that the network
+// can be advertised, nor with the subnets reserved by the cluster.
+func (c *Controller) allocateSubnets(
+	udn *userdefinednetworkv1.UserDefinedNetwork,
+	pools []*userdefinednetworkv1.UserDefinedNetworkCIDRPool,
+) ([]userdefinednetworkv1.Layer3Subnet, error) {
+	used, err := c.usedSubnets(udn)
+	if err != nil {
+		return nil, err
+	}
+
+	var subnets []userdefinednetworkv1.Layer3Subnet
+	for _, ipv6 := range []bool{false, true} {
+		if ipv6 && !config.IPv6Mode || !ipv6 && !config.IPv4Mode {
+			continue
+		}
+		subnet, err := allocateSubnetFromPools(pools, ipv6, used)
+		if err != nil {
+			return nil, err
+		}
+		subnets = append(subnets, subnet)
+	}
+
+	return subnets, nil
+}
+
+func allocateSubnetFromPools(
+	pools []*userdefinednetworkv1.UserDefinedNetworkCIDRPool,
+	ipv6 bool,
+	used []*net.IPNet,
+) (userdefinednetworkv1.Layer3Subnet, error) {
+	var poolNames []string
+	for _, pool := range pools {
+		poolNames = append(poolNames, pool.Name)
+		for _, r := range pool.Spec.CIDRs {
+			_, poolCIDR, err := net.ParseCIDR(string(r.CIDR))
+			if err != nil {
+				return userdefinednetworkv1.Layer3Subnet{}, fmt.Errorf("UserDefinedNetworkCIDRPool %q has an invalid CIDR: %w", pool.Name, err)
+			}
+			if utilnet.IsIPv6CIDR(poolCIDR) != ipv6 {
+				continue
+			}
+			subnet := allocateSubnet(poolCIDR, int(r.PrefixLength), used)
+			if subnet != nil {
+				return userdefinednetworkv1.Layer3Subnet{
+					CIDR:       userdefinednetworkv1.CIDR(subnet.String()),
+					HostSubnet: r.HostSubnet,
+				}, nil
+			}
+		}
+	}
+	family := "IPv4"
+	if ipv6 {
+		family = "IPv6"
+	}
+	return userdefinednetworkv1.Layer3Subnet{}, &cidrPoolError{
+		err: fmt.Errorf("no %s subnet available in the UserDefinedNetworkCIDRPools %v", family, poolNames),
+	}
+}
+
+// allocateSubnet returns the first subnet of the given prefix length in the
+// given CIDR that doesn't overlap with any of the used subnets, or nil if
+// there is none.
+func allocateSubnet(cidr *net.IPNet, prefixLength int, used []*net.IPNet) *net.IPNet {
+	ones, bits := cidr.Mask.Size()
+	if prefixLength < ones || prefixLength > bits {
+		return nil
+	}
+	mask := net.CIDRMask(prefixLength, bits)
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+	cidrLast := lastIP(cidr)
+
+	start := utilnet.BigForIP(cidr.IP)
+	for {
+		candidate := &net.IPNet{IP: utilnet.AddIPOffset(start, 0).Mask(mask), Mask: mask}
+		if lastIP(candidate).Cmp(cidrLast) > 0 {
+			return nil
+		}
+		var overlapLast *big.Int
+		for _, subnet := range used {
+			if !overlaps(candidate, subnet) {
+				continue
+			}
+			if subnetLast := lastIP(subnet); overlapLast == nil || subnetLast.Cmp(overlapLast) > 0 {
+				overlapLast = subnetLast
+			}
+		}
+		if overlapLast == nil {
+			return candidate
+		}
+		// continue with the first subnet after the overlapping ones
+		start = new(big.Int).Add(overlapLast, big.NewInt(1))
+		start.Add(start, new(big.Int).Sub(size, big.NewInt(1)))
+		start.Div(start, size)
+		start.Mul(start, size)
+	}
+}
+
+// usedSubnets returns the subnets reserved by the cluster and the subnets of
+// all networks but the one of the given UserDefinedNetwork, including the ones
+// requested by UserDefinedNetworks and ClusterUserDefinedNetworks that are not
+// rendered yet.
+func (c *Controller) usedSubnets(udn *userdefinednetworkv1.UserDefinedNetwork) ([]*net.IPNet, error) {
+	key := udn.Namespace + "/" + udn.Name
+	var cidrs []string
+
+	used, err := c.reservedSubnets()
+	if err != nil {
+		return nil, err
+	}
+
+	nads, err := c.nadLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NetworkAttachmentDefinitions: %w", err)
+	}
+	for _, nad := range nads {
+		if nad.Namespace+"/"+nad.Name == key {
+			continue
+		}
+		subnets, err := nadSubnets(nad)
+		if err != nil {
+			// not a network of ours
+			continue
+		}
+		for _, subnet := range subnets {
+			cidrs = append(cidrs, string(subnet.CIDR))
+		}
+	}
+
+	udns, err := c.udnLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list UserDefinedNetworks: %w", err)
+	}
+	for _, other := range udns {
+		if other.Namespace+"/"+other.Name == key {
+			continue
+		}
+		for _, subnet := range udnSubnets(other) {
+			cidrs = append(cidrs, string(subnet))
+		}
+	}
+
+	cudns, err := c.cudnLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ClusterUserDefinedNetworks: %w", err)
+	}
+	for _, cudn := range cudns {
+		if cudn.Spec.Network.Layer3 != nil {
+			for _, subnet := range cudn.Spec.Network.Layer3.Subnets {
+				cidrs = append(cidrs, string(subnet.CIDR))
+			}
+		}
+		if cudn.Spec.Network.Layer2 != nil {
+			for _, subnet := range cudn.Spec.Network.Layer2.Subnets {
+				cidrs = append(cidrs, string(subnet))
+			}
+		}
+	}
+
+	for _, cidr := range cidrs {
+		_, subnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		used = append(used, subnet)
+	}
+	for otherKey, subnets := range c.allocatedSubnets {
+		if otherKey != key {
+			used = append(used, subnets...)
+		}
+	}
+
+	return used, nil
+}
+
+// reservedSubnets returns the subnets the networks must not overlap with: the
+// subnets of the cluster default network, the service CIDRs, the join,
+// masquerade and transit subnets and the networks of the nodes.
+func (c *Controller) reservedSubnets() ([]*net.IPNet, error) {
+	var reserved []*net.IPNet
+	for _, subnet := range config.Default.ClusterSubnets {
+		reserved = append(reserved, subnet.CIDR)
+	}
+	reserved = append(reserved, config.Kubernetes.ServiceCIDRs...)
+	for _, cidr := range []string{
+		config.Gateway.V4JoinSubnet,
+		config.Gateway.V6JoinSubnet,
+		config.Gateway.V4MasqueradeSubnet,
+		config.Gateway.V6MasqueradeSubnet,
+		config.ClusterManager.V4TransitSwitchSubnet,
+		config.ClusterManager.V6TransitSwitchSubnet,
+	} {
+		_, subnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		reserved = append(reserved, subnet)
+	}
+
+	nodes, err := c.nodeInformer.Lister().List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	for _, node := range nodes {
+		ifAddr, err := util.ParseNodePrimaryIfAddr(node)
+		if err != nil {
+			// the node is not set up yet
+			continue
+		}
+		for _, addr := range []util.ParsedIFAddr{ifAddr.V4, ifAddr.V6} {
+			if addr.Net != nil {
+				reserved = append(reserved, addr.Net)
+			}
+		}
+	}
+
+	return reserved, nil
+}
+
+// recordAllocatedSubnets keeps track of the subnets allocated to the given
+// UserDefinedNetwork, so that they are not allocated again before its rendered
+// network is known to the NAD informer.
+func (c *Controller) recordAllocatedSubnets(udn *userdefinednetworkv1.UserDefinedNetwork) {
+	key := udn.Namespace + "/" + udn.Name
+	var allocated []*net.IPNet
+	for _, cidr := range udnSubnets(udn) {
+		_, subnet, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			continue
+		}
+		allocated = append(allocated, subnet)
+	}
+	c.allocatedSubnets[key] = allocated
+}
+
+func (c *Controller) releaseAllocatedSubnets(key string) {
+	delete(c.allocatedSubnets, key)
+}
+
+// requiresSubnets returns whether the network of the given UserDefinedNetwork
+// requires subnets.
+func requiresSubnets(udn *userdefinednetworkv1.UserDefinedNetwork) bool {
+	switch udn.Spec.Topology {
+	case userdefinednetworkv1.NetworkTopologyLayer3:
+		return udn.Spec.Layer3 != nil
+	case userdefinednetworkv1.NetworkTopologyLayer2:
+		if udn.Spec.Layer2 == nil {
+			return false
+		}
+		ipam := udn.Spec.Layer2.IPAM
+		return ipam == nil || ipam.Mode == "" || ipam.Mode == userdefinednetworkv1.IPAMEnabled
+	}
+	return false
+}
+
+func setUDNSubnets(udn *userdefinednetworkv1.UserDefinedNetwork, subnets []userdefinednetworkv1.Layer3Subnet) {
+	if udn.Spec.Layer3 != nil {
+		udn.Spec.Layer3.Subnets = subnets
+	}
+	if udn.Spec.Layer2 != nil {
+		udn.Spec.Layer2.Subnets = nil
+		for _, subnet := range subnets {
+			udn.Spec.Layer2.Subnets = append(udn.Spec.Layer2.Subnets, subnet.CIDR)
+		}
+	}
+}
+
+// nadSubnets returns the subnets of the network rendered in the given NAD, as
+// rendered, i.e. with the host subnet of Layer3 subnets only if it was set.
+func nadSubnets(nad *netv1.NetworkAttachmentDefinition) ([]userdefinednetworkv1.Layer3Subnet, error) {
+	netConf := struct {
+		Type    string `json:"type"`
+		Subnets string `json:"subnets"`
+	}{}
+	if err := json.Unmarshal([]byte(nad.Spec.Config), &netConf); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal NetworkAttachmentDefinition [%s/%s] config: %w", nad.Namespace, nad.Name, err)
+	}
+	if netConf.Type != template.OvnK8sCNIOverlay {
+		return nil, fmt.Errorf("NetworkAttachmentDefinition [%s/%s] is not an OVN-Kubernetes network", nad.Namespace, nad.Name)
+	}
+	if netConf.Subnets == "" {
+		return nil, nil
+	}
+
+	var subnets []userdefinednetworkv1.Layer3Subnet
+	for _, entry := range strings.Split(netConf.Subnets, ",") {
+		entry = strings.TrimSpace(entry)
+		subnet := userdefinednetworkv1.Layer3Subnet{CIDR: userdefinednetworkv1.CIDR(entry)}
+		// Layer3 subnets may be rendered with their host subnet, e.g. 10.0.0.0/16/24
+		if parts := strings.Split(entry, "/"); len(parts) == 3 {
+			hostSubnet, err := strconv.Atoi(parts[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid subnet %q in NetworkAttachmentDefinition [%s/%s]: %w", entry, nad.Namespace, nad.Name, err)
+			}
+			subnet.CIDR = userdefinednetworkv1.CIDR(parts[0] + "/" + parts[1])
+			subnet.HostSubnet = int32(hostSubnet)
+		}
+		subnets = append(subnets, subnet)
+	}
+	return subnets, nil
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func lastIP(subnet *net.IPNet) *big.Int {
+	ones, bits := subnet.Mask.Size()
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	last := utilnet.BigForIP(subnet.IP)
+	return last.Add(last, size.Sub(size, big.NewInt(1)))
+}
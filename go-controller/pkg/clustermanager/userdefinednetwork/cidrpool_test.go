@@ -0,0 +1,39 @@
+package userdefinednetwork
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+)
+
+var _ = Describe("allocateSubnet", func() {
+	DescribeTable("should allocate the first subnet not overlapping with used subnets",
+		func(cidr string, prefixLength int, used []string, expected string) {
+			var usedSubnets []*net.IPNet
+			if len(used) > 0 {
+				usedSubnets = ovntest.MustParseIPNets(used...)
+			}
+			subnet := allocateSubnet(ovntest.MustParseIPNet(cidr), prefixLength, usedSubnets)
+			if expected == "" {
+				Expect(subnet).To(BeNil())
+				return
+			}
+			Expect(subnet).NotTo(BeNil())
+			Expect(subnet.String()).To(Equal(expected))
+		},
+		Entry("no used subnets", "10.100.0.0/16", 24, nil, "10.100.0.0/24"),
+		Entry("first subnet used", "10.100.0.0/16", 24, []string{"10.100.0.0/24"}, "10.100.1.0/24"),
+		Entry("smaller subnet used", "10.100.0.0/16", 24, []string{"10.100.0.128/25"}, "10.100.1.0/24"),
+		Entry("bigger subnet used", "10.100.0.0/16", 24, []string{"10.100.0.0/20"}, "10.100.16.0/24"),
+		Entry("unrelated subnets used", "10.100.0.0/16", 24, []string{"10.200.0.0/24", "fd00::/64"}, "10.100.0.0/24"),
+		Entry("whole CIDR used", "10.100.0.0/16", 24, []string{"10.0.0.0/8"}, ""),
+		Entry("all subnets used", "10.100.0.0/23", 24, []string{"10.100.0.0/24", "10.100.1.0/24"}, ""),
+		Entry("prefix length equal to the CIDR one", "10.100.0.0/24", 24, nil, "10.100.0.0/24"),
+		Entry("prefix length shorter than the CIDR one", "10.100.0.0/24", 16, nil, ""),
+		Entry("IPv6", "fd00:10::/48", 64, []string{"fd00:10::/64", "fd00:10:0:2::/63"}, "fd00:10:0:1::/64"),
+		Entry("IPv6 with bigger subnet used", "fd00:10::/32", 64, []string{"fd00:10::/33"}, "fd00:10:8000::/64"),
+	)
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"slices"
 	"strings"
 	"sync"
@@ -75,10 +76,18 @@ type Controller struct {
 	udnClient         userdefinednetworkclientset.Interface
 	udnLister         userdefinednetworklister.UserDefinedNetworkLister
 	cudnLister        userdefinednetworklister.ClusterUserDefinedNetworkLister
+	quotaLister       userdefinednetworklister.UserDefinedNetworkQuotaLister
+	cidrPoolLister    userdefinednetworklister.UserDefinedNetworkCIDRPoolLister
 	nadClient         netv1clientset.Interface
 	nadLister         netv1lister.NetworkAttachmentDefinitionLister
 	podInformer       corev1informer.PodInformer
 	namespaceInformer corev1informer.NamespaceInformer
+	nodeInformer      corev1informer.NodeInformer
+
+	// allocatedSubnets are the subnets allocated from the CIDR pools, keyed by
+	// UserDefinedNetwork namespace/name. Only accessed by the UserDefinedNetwork
+	// controller worker.
+	allocatedSubnets map[string][]*net.IPNet
 
 	networkInUseRequeueInterval time.Duration
 	eventRecorder               record.EventRecorder
@@ -92,9 +101,12 @@ func New(
 	udnClient userdefinednetworkclientset.Interface,
 	udnInformer userdefinednetworkinformer.UserDefinedNetworkInformer,
 	cudnInformer userdefinednetworkinformer.ClusterUserDefinedNetworkInformer,
+	quotaInformer userdefinednetworkinformer.UserDefinedNetworkQuotaInformer,
+	cidrPoolInformer userdefinednetworkinformer.UserDefinedNetworkCIDRPoolInformer,
 	renderNadFn RenderNetAttachDefManifest,
 	podInformer corev1informer.PodInformer,
 	namespaceInformer corev1informer.NamespaceInformer,
+	nodeInformer corev1informer.NodeInformer,
 	eventRecorder record.EventRecorder,
 ) *Controller {
 	udnLister := udnInformer.Lister()
@@ -108,10 +120,18 @@ func New(
 		renderNadFn:                 renderNadFn,
 		podInformer:                 podInformer,
 		namespaceInformer:           namespaceInformer,
+		nodeInformer:                nodeInformer,
 		networkInUseRequeueInterval: defaultNetworkInUseCheckInterval,
 		namespaceTracker:            map[string]sets.Set[string]{},
+		allocatedSubnets:            map[string][]*net.IPNet{},
 		eventRecorder:               eventRecorder,
 	}
+	if quotaInformer != nil {
+		c.quotaLister = quotaInformer.Lister()
+	}
+	if cidrPoolInformer != nil {
+		c.cidrPoolLister = cidrPoolInformer.Lister()
+	}
 	udnCfg := &controller.ControllerConfig[userdefinednetworkv1.UserDefinedNetwork]{
 		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
 		Reconcile:      c.reconcileUDN,
@@ -370,6 +390,10 @@ func (c *Controller) reconcileUDN(key string) error {
 		return fmt.Errorf("failed to get UserDefinedNetwork %q from cache: %v", key, err)
 	}
 
+	if udn == nil {
+		c.releaseAllocatedSubnets(key)
+	}
+
 	udnCopy := udn.DeepCopy()
 
 	nadCopy, syncErr := c.syncUserDefinedNetwork(udnCopy)
@@ -382,6 +406,14 @@ func (c *Controller) reconcileUDN(key string) error {
 		return updateStatusErr
 	}
 
+	// quotas and CIDR pools are not watched, check again later if the violation still stands
+	var quotaViolation *quotaViolationError
+	var cidrPoolViolation *cidrPoolError
+	if errors.As(syncErr, &quotaViolation) || errors.As(syncErr, &cidrPoolViolation) {
+		c.udnController.ReconcileAfter(key, c.networkInUseRequeueInterval)
+		return updateStatusErr
+	}
+
 	return errors.Join(syncErr, updateStatusErr)
 }
 
@@ -395,6 +427,7 @@ func (c *Controller) syncUserDefinedNetwork(udn *userdefinednetworkv1.UserDefine
 			if err := c.deleteNAD(udn, udn.Namespace); err != nil {
 				return nil, fmt.Errorf("failed to delete NetworkAttachmentDefinition [%s/%s]: %w", udn.Namespace, udn.Name, err)
 			}
+			c.releaseAllocatedSubnets(udn.Namespace + "/" + udn.Name)
 
 			controllerutil.RemoveFinalizer(udn, template.FinalizerUserDefinedNetwork)
 			udn, err := c.udnClient.K8sV1().UserDefinedNetworks(udn.Namespace).Update(context.Background(), udn, metav1.UpdateOptions{})
@@ -415,6 +448,14 @@ func (c *Controller) syncUserDefinedNetwork(udn *userdefinednetworkv1.UserDefine
 		klog.Infof("Added Finalizer to UserDefinedNetwork [%s/%s]", udn.Namespace, udn.Name)
 	}
 
+	if err := c.applyCIDRPools(udn); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkQuotas(udn); err != nil {
+		return nil, err
+	}
+
 	return c.updateNAD(udn, udn.Namespace)
 }
 
@@ -471,7 +512,17 @@ func newNetworkCreatedCondition(nad *netv1.NetworkAttachmentDefinition, syncErro
 		networkCreatedCondition.Reason = "NetworkAttachmentDefinitionDeleted"
 		networkCreatedCondition.Message = "NetworkAttachmentDefinition is being deleted"
 	}
-	if syncError != nil {
+	var quotaViolation *quotaViolationError
+	var cidrPoolViolation *cidrPoolError
+	if errors.As(syncError, &quotaViolation) {
+		networkCreatedCondition.Status = metav1.ConditionFalse
+		networkCreatedCondition.Reason = "QuotaViolation"
+		networkCreatedCondition.Message = syncError.Error()
+	} else if errors.As(syncError, &cidrPoolViolation) {
+		networkCreatedCondition.Status = metav1.ConditionFalse
+		networkCreatedCondition.Reason = "CIDRPoolViolation"
+		networkCreatedCondition.Message = syncError.Error()
+	} else if syncError != nil {
 		networkCreatedCondition.Status = metav1.ConditionFalse
 		networkCreatedCondition.Reason = "SyncError"
 		networkCreatedCondition.Message = syncError.Error()
@@ -30,6 +30,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
@@ -62,7 +63,7 @@ var _ = Describe("User Defined Network Controller", func() {
 
 		return New(cs.NetworkAttchDefClient, f.NADInformer(),
 			cs.UserDefinedNetworkClient, f.UserDefinedNetworkInformer(), f.ClusterUserDefinedNetworkInformer(),
-			renderNADStub, f.PodCoreInformer(), f.NamespaceInformer(), nil,
+			f.UserDefinedNetworkQuotaInformer(), f.UserDefinedNetworkCIDRPoolInformer(), renderNADStub, f.PodCoreInformer(), f.NamespaceInformer(), f.NodeCoreInformer(), nil,
 		)
 	}
 
@@ -140,6 +141,216 @@ var _ = Describe("User Defined Network Controller", func() {
 				Expect(nad).To(Equal(expectedNAD))
 			})
 
+			It("should fail when UDN topology is not allowed by a quota", func() {
+				udn := testPrimaryUDN()
+				quota := testUDNQuota("quota", udnv1.UserDefinedNetworkQuotaSpec{
+					AllowedTopologies: []udnv1.NetworkTopology{udnv1.NetworkTopologyLayer2},
+				})
+				c = newTestController(renderNadStub(testNAD()), udn, quota, testNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "False",
+					Reason:  "QuotaViolation",
+					Message: "UserDefinedNetworkQuota \"quota\" does not allow topology Layer3, allowed topologies: [Layer2]",
+				}}))
+
+				_, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should fail when quota max networks per namespace is reached, and create NAD once the quota is removed", func() {
+				existingUDN := testSecondaryUDN()
+				existingNAD := testNAD()
+				udn := testSecondaryUDN()
+				udn.Name = "test-2"
+				udn.UID = "2"
+				expectedNAD := testNAD()
+				expectedNAD.Name = udn.Name
+				expectedNAD.OwnerReferences[0].Name = udn.Name
+				expectedNAD.OwnerReferences[0].UID = udn.UID
+				quota := testUDNQuota("quota", udnv1.UserDefinedNetworkQuotaSpec{
+					MaxNetworksPerNamespace: pointer.Int32(1),
+				})
+				c = newTestController(renderNadStub(expectedNAD), existingUDN, existingNAD, udn, quota, invalidTestNamespace("test"))
+				c.networkInUseRequeueInterval = 50 * time.Millisecond
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "False",
+					Reason:  "QuotaViolation",
+					Message: "UserDefinedNetworkQuota \"quota\" allows at most 1 UserDefinedNetworks in namespace \"test\"",
+				}}))
+				_, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+
+				Expect(cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworkQuotas().Delete(context.Background(), quota.Name, metav1.DeleteOptions{})).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "True",
+					Reason:  "NetworkAttachmentDefinitionCreated",
+					Message: "NetworkAttachmentDefinition has been created",
+				}}))
+				nad, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nad).To(Equal(expectedNAD))
+			})
+
+			It("should create NAD when UDN subnets are allowed by a quota", func() {
+				udn := testSecondaryUDN()
+				udn.Spec.Topology = udnv1.NetworkTopologyLayer2
+				udn.Spec.Layer3 = nil
+				udn.Spec.Layer2 = &udnv1.Layer2Config{
+					Role:    udnv1.NetworkRoleSecondary,
+					Subnets: udnv1.DualStackCIDRs{"10.10.0.0/16"},
+				}
+				quota := testUDNQuota("quota", udnv1.UserDefinedNetworkQuotaSpec{
+					NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "test"}},
+					AllowedSubnets:    []udnv1.CIDR{"10.0.0.0/8"},
+				})
+				expectedNAD := testNAD()
+				c = newTestController(renderNadStub(expectedNAD), udn, quota, invalidTestNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "True",
+					Reason:  "NetworkAttachmentDefinitionCreated",
+					Message: "NetworkAttachmentDefinition has been created",
+				}}))
+
+				nad, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nad).To(Equal(expectedNAD))
+			})
+
+			It("should create NAD with subnets allocated from a CIDR pool when UDN subnets are not specified", func() {
+				config.IPv4Mode = true
+				udn := testLayer2SecondaryUDN()
+				pool := testCIDRPool("pool", udnv1.CIDRPoolRange{CIDR: "10.100.0.0/16", PrefixLength: 24})
+				otherNAD := &netv1.NetworkAttachmentDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"},
+					Spec: netv1.NetworkAttachmentDefinitionSpec{
+						Config: `{"type":"ovn-k8s-cni-overlay","topology":"layer2","subnets":"10.100.0.0/24"}`,
+					},
+				}
+				c = newTestController(template.RenderNetAttachDefManifest, udn, pool, otherNAD, invalidTestNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "True",
+					Reason:  "NetworkAttachmentDefinitionCreated",
+					Message: "NetworkAttachmentDefinition has been created",
+				}}))
+
+				nad, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				subnets, err := nadSubnets(nad)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subnets).To(Equal([]udnv1.Layer3Subnet{{CIDR: "10.100.1.0/24"}}))
+			})
+
+			It("should create NAD with subnets allocated from a CIDR pool not overlapping with the subnets reserved by the cluster", func() {
+				config.IPv4Mode = true
+				config.Kubernetes.ServiceCIDRs = ovntest.MustParseIPNets("10.100.1.0/24")
+				udn := testLayer2SecondaryUDN()
+				pool := testCIDRPool("pool", udnv1.CIDRPoolRange{CIDR: "10.100.0.0/16", PrefixLength: 24})
+				node := &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "node",
+						Annotations: map[string]string{"k8s.ovn.org/node-primary-ifaddr": `{"ipv4":"10.100.0.5/24"}`},
+					},
+				}
+				c = newTestController(template.RenderNetAttachDefManifest, udn, pool, node, invalidTestNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "True",
+					Reason:  "NetworkAttachmentDefinitionCreated",
+					Message: "NetworkAttachmentDefinition has been created",
+				}}))
+
+				nad, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				subnets, err := nadSubnets(nad)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subnets).To(Equal([]udnv1.Layer3Subnet{{CIDR: "10.100.2.0/24"}}))
+			})
+
+			It("should fail when UDN subnets are not specified and no CIDR pool selects its namespace", func() {
+				config.IPv4Mode = true
+				udn := testLayer2SecondaryUDN()
+				pool := testCIDRPool("pool", udnv1.CIDRPoolRange{CIDR: "10.100.0.0/16", PrefixLength: 24})
+				pool.Spec.NamespaceSelector = metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "other"}}
+				c = newTestController(template.RenderNetAttachDefManifest, udn, pool, invalidTestNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "False",
+					Reason:  "SyncError",
+					Message: "failed to generate NetworkAttachmentDefinition: failed to render CNI network config: subnets is required with ipam.mode is Enabled or unset",
+				}}))
+
+				_, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should fail when UDN subnets are not contained in the CIDR pools selecting its namespace", func() {
+				udn := testLayer2SecondaryUDN()
+				udn.Spec.Layer2.Subnets = udnv1.DualStackCIDRs{"192.168.0.0/24"}
+				pool := testCIDRPool("pool", udnv1.CIDRPoolRange{CIDR: "10.100.0.0/16", PrefixLength: 24})
+				c = newTestController(renderNadStub(testNAD()), udn, pool, invalidTestNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "False",
+					Reason:  "CIDRPoolViolation",
+					Message: "subnet 192.168.0.0/24 is not contained in the CIDRs of the UserDefinedNetworkCIDRPools [pool] selecting namespace \"test\"",
+				}}))
+
+				_, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+			})
+
 			It("should fail when NAD render fail", func() {
 				udn := testPrimaryUDN()
 				renderErr := errors.New("render NAD fails")
@@ -1537,3 +1748,25 @@ func newRenderNadStub(nad *netv1.NetworkAttachmentDefinition, err error) RenderN
 		return nad, err
 	}
 }
+
+func testUDNQuota(name string, spec udnv1.UserDefinedNetworkQuotaSpec) *udnv1.UserDefinedNetworkQuota {
+	return &udnv1.UserDefinedNetworkQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       spec,
+	}
+}
+
+func testLayer2SecondaryUDN() *udnv1.UserDefinedNetwork {
+	udn := testSecondaryUDN()
+	udn.Spec.Topology = udnv1.NetworkTopologyLayer2
+	udn.Spec.Layer3 = nil
+	udn.Spec.Layer2 = &udnv1.Layer2Config{Role: udnv1.NetworkRoleSecondary}
+	return udn
+}
+
+func testCIDRPool(name string, cidrs ...udnv1.CIDRPoolRange) *udnv1.UserDefinedNetworkCIDRPool {
+	return &udnv1.UserDefinedNetworkCIDRPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       udnv1.UserDefinedNetworkCIDRPoolSpec{CIDRs: cidrs},
+	}
+}
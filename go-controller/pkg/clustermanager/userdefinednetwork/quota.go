@@ -0,0 +1,237 @@
+package userdefinednetwork
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+type quotaViolationError struct {
+	err error
+}
+
+func (q *quotaViolationError) Error() string {
+	return q.err.Error()
+}
+
+// checkQuotas returns a quotaViolationError if the network of the given
+// UserDefinedNetwork can't be rendered without violating any of the quotas
+// that apply to its namespace. Networks that are already rendered are not
+// affected by the quotas, so that changing a quota doesn't tear down networks
+// in use.
+func (c *Controller) checkQuotas(udn *userdefinednetworkv1.UserDefinedNetwork) error {
+	if c.quotaLister == nil {
+		return nil
+	}
+
+	rendered, err := c.isRendered(udn)
+	if err != nil {
+		return err
+	}
+	if rendered {
+		return nil
+	}
+
+	quotas, err := c.quotaLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list UserDefinedNetworkQuotas: %w", err)
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+	// check the quotas in a stable order to report stable violations
+	slices.SortFunc(quotas, func(a, b *userdefinednetworkv1.UserDefinedNetworkQuota) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	namespace, err := c.namespaceInformer.Lister().Get(udn.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get namespace %q from cache: %w", udn.Namespace, err)
+	}
+
+	for _, quota := range quotas {
+		selector, err := metav1.LabelSelectorAsSelector(&quota.Spec.NamespaceSelector)
+		if err != nil {
+			return fmt.Errorf("failed to convert UserDefinedNetworkQuota %q namespace selector: %w", quota.Name, err)
+		}
+		if !selector.Matches(labels.Set(namespace.Labels)) {
+			continue
+		}
+		if err := c.checkQuota(quota, selector, udn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkQuota checks the given UserDefinedNetwork against a quota that applies
+// to its namespace.
+func (c *Controller) checkQuota(
+	quota *userdefinednetworkv1.UserDefinedNetworkQuota,
+	selector labels.Selector,
+	udn *userdefinednetworkv1.UserDefinedNetwork,
+) error {
+	if err := checkQuotaRestrictions(quota, udn); err != nil {
+		return &quotaViolationError{err: err}
+	}
+
+	maxPerNamespace := quota.Spec.MaxNetworksPerNamespace
+	maxTotal := quota.Spec.MaxNetworks
+	if maxPerNamespace == nil && maxTotal == nil {
+		return nil
+	}
+
+	udns, err := c.udnLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list UserDefinedNetworks: %w", err)
+	}
+	namespaceSelected := map[string]bool{udn.Namespace: true}
+	var countInNamespace, countTotal int32
+	for _, other := range udns {
+		if other.Namespace == udn.Namespace && other.Name == udn.Name {
+			continue
+		}
+		selected, ok := namespaceSelected[other.Namespace]
+		if !ok {
+			namespace, err := c.namespaceInformer.Lister().Get(other.Namespace)
+			if err != nil && !kerrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get namespace %q from cache: %w", other.Namespace, err)
+			}
+			selected = namespace != nil && selector.Matches(labels.Set(namespace.Labels))
+			namespaceSelected[other.Namespace] = selected
+		}
+		if !selected {
+			continue
+		}
+		counts, err := c.countsAgainstQuota(quota, other, udn)
+		if err != nil {
+			return err
+		}
+		if !counts {
+			continue
+		}
+		countTotal++
+		if other.Namespace == udn.Namespace {
+			countInNamespace++
+		}
+	}
+
+	if maxPerNamespace != nil && countInNamespace >= *maxPerNamespace {
+		return &quotaViolationError{
+			err: fmt.Errorf("UserDefinedNetworkQuota %q allows at most %d UserDefinedNetworks in namespace %q",
+				quota.Name, *maxPerNamespace, udn.Namespace),
+		}
+	}
+	if maxTotal != nil && countTotal >= *maxTotal {
+		return &quotaViolationError{
+			err: fmt.Errorf("UserDefinedNetworkQuota %q allows at most %d UserDefinedNetworks in the selected namespaces",
+				quota.Name, *maxTotal),
+		}
+	}
+
+	return nil
+}
+
+// countsAgainstQuota returns whether a UserDefinedNetwork counts against the
+// quota available to the given one: that is if its network is rendered, or if
+// it was created before and is not in violation of the quota restrictions, in
+// which case it has precedence. This keeps the outcome stable regardless of
+// the order the UserDefinedNetworks are reconciled in.
+func (c *Controller) countsAgainstQuota(
+	quota *userdefinednetworkv1.UserDefinedNetworkQuota,
+	other, udn *userdefinednetworkv1.UserDefinedNetwork,
+) (bool, error) {
+	if !other.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+	rendered, err := c.isRendered(other)
+	if err != nil || rendered {
+		return rendered, err
+	}
+	if !createdBefore(other, udn) {
+		return false, nil
+	}
+	return checkQuotaRestrictions(quota, other) == nil, nil
+}
+
+// isRendered returns whether the network of the given UserDefinedNetwork is
+// rendered, that is if it has its NAD.
+func (c *Controller) isRendered(udn *userdefinednetworkv1.UserDefinedNetwork) (bool, error) {
+	nad, err := c.nadLister.NetworkAttachmentDefinitions(udn.Namespace).Get(udn.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get NetworkAttachmentDefinition [%s/%s] from cache: %w", udn.Namespace, udn.Name, err)
+	}
+	return metav1.IsControlledBy(nad, udn), nil
+}
+
+func createdBefore(a, b *userdefinednetworkv1.UserDefinedNetwork) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+// checkQuotaRestrictions checks the topology and subnets of the given
+// UserDefinedNetwork against the ones allowed by the quota.
+func checkQuotaRestrictions(quota *userdefinednetworkv1.UserDefinedNetworkQuota, udn *userdefinednetworkv1.UserDefinedNetwork) error {
+	allowedTopologies := quota.Spec.AllowedTopologies
+	if len(allowedTopologies) > 0 && !slices.Contains(allowedTopologies, udn.Spec.Topology) {
+		return fmt.Errorf("UserDefinedNetworkQuota %q does not allow topology %s, allowed topologies: %v",
+			quota.Name, udn.Spec.Topology, allowedTopologies)
+	}
+
+	if len(quota.Spec.AllowedSubnets) == 0 {
+		return nil
+	}
+	allowedSubnets := make([]*net.IPNet, 0, len(quota.Spec.AllowedSubnets))
+	for _, cidr := range quota.Spec.AllowedSubnets {
+		_, allowedSubnet, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			return fmt.Errorf("UserDefinedNetworkQuota %q has an invalid allowed subnet: %w", quota.Name, err)
+		}
+		allowedSubnets = append(allowedSubnets, allowedSubnet)
+	}
+	for _, cidr := range udnSubnets(udn) {
+		_, subnet, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			return fmt.Errorf("invalid subnet %q: %w", cidr, err)
+		}
+		allowed := slices.ContainsFunc(allowedSubnets, func(allowedSubnet *net.IPNet) bool {
+			return util.ContainsCIDR(allowedSubnet, subnet)
+		})
+		if !allowed {
+			return fmt.Errorf("UserDefinedNetworkQuota %q does not allow subnet %s, allowed subnets: %v",
+				quota.Name, cidr, quota.Spec.AllowedSubnets)
+		}
+	}
+
+	return nil
+}
+
+func udnSubnets(udn *userdefinednetworkv1.UserDefinedNetwork) []userdefinednetworkv1.CIDR {
+	var subnets []userdefinednetworkv1.CIDR
+	if udn.Spec.Layer3 != nil {
+		for _, subnet := range udn.Spec.Layer3.Subnets {
+			subnets = append(subnets, subnet.CIDR)
+		}
+	}
+	if udn.Spec.Layer2 != nil {
+		subnets = append(subnets, udn.Spec.Layer2.Subnets...)
+	}
+	return subnets
+}
@@ -132,6 +132,8 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 		netConfSpec.MTU = int(cfg.MTU)
 		netConfSpec.Subnets = layer3SubnetsString(cfg.Subnets)
 		netConfSpec.JoinSubnet = cidrString(renderJoinSubnets(cfg.Role, cfg.JoinSubnets))
+		netConfSpec.HybridOverlaySubnets = cidrString(cfg.HybridOverlaySubnets)
+		netConfSpec.EnableMulticast = cfg.Multicast == userdefinednetworkv1.MulticastEnabled
 	case userdefinednetworkv1.NetworkTopologyLayer2:
 		cfg := spec.GetLayer2()
 		if err := validateIPAM(cfg.IPAM); err != nil {
@@ -148,6 +150,7 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 		}
 		netConfSpec.Subnets = cidrString(cfg.Subnets)
 		netConfSpec.JoinSubnet = cidrString(renderJoinSubnets(cfg.Role, cfg.JoinSubnets))
+		netConfSpec.EnableMulticast = cfg.Multicast == userdefinednetworkv1.MulticastEnabled
 	}
 
 	if err := util.ValidateNetConf(nadName, netConfSpec); err != nil {
@@ -183,6 +186,12 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 	if netConfSpec.AllowPersistentIPs {
 		cniNetConf["allowPersistentIPs"] = netConfSpec.AllowPersistentIPs
 	}
+	if len(netConfSpec.HybridOverlaySubnets) > 0 {
+		cniNetConf["hybridOverlaySubnets"] = netConfSpec.HybridOverlaySubnets
+	}
+	if netConfSpec.EnableMulticast {
+		cniNetConf["enableMulticast"] = netConfSpec.EnableMulticast
+	}
 
 	return cniNetConf, nil
 }
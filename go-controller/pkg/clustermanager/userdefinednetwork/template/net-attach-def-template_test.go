@@ -248,6 +248,48 @@ var _ = Describe("NetAttachDefTemplate", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	Context("with hybrid overlay subnets", func() {
+		spec := &udnv1.UserDefinedNetworkSpec{
+			Topology: udnv1.NetworkTopologyLayer3,
+			Layer3: &udnv1.Layer3Config{
+				Role:                 udnv1.NetworkRolePrimary,
+				Subnets:              []udnv1.Layer3Subnet{{CIDR: "192.168.100.0/16"}},
+				HybridOverlaySubnets: []udnv1.CIDR{"10.132.0.0/16"},
+			},
+		}
+
+		BeforeEach(func() {
+			config.IPv4Mode = true
+			config.IPv6Mode = false
+		})
+
+		AfterEach(func() {
+			config.HybridOverlay.Enabled = false
+		})
+
+		It("should render them given hybrid overlay is enabled", func() {
+			config.HybridOverlay.Enabled = true
+			nadSpec, err := RenderNADSpec("mynamespace.test-net", "mynamespace/test-net", spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nadSpec.Config).To(MatchJSON(`{
+				"cniVersion": "1.0.0",
+				"type": "ovn-k8s-cni-overlay",
+				"name": "mynamespace.test-net",
+				"netAttachDefName": "mynamespace/test-net",
+				"role": "primary",
+				"topology": "layer3",
+				"joinSubnets": "100.65.0.0/16,fd99::/64",
+				"subnets": "192.168.100.0/16",
+				"hybridOverlaySubnets": "10.132.0.0/16"
+			}`))
+		})
+
+		It("should fail given hybrid overlay is disabled", func() {
+			_, err := RenderNADSpec("mynamespace.test-net", "mynamespace/test-net", spec)
+			Expect(err).To(MatchError(ContainSubstring("hybrid overlay subnets require hybrid overlay to be enabled")))
+		})
+	})
+
 	DescribeTable("should create UDN NAD from spec",
 		func(testSpec udnv1.UserDefinedNetworkSpec, expectedNadNetConf string) {
 			testUdn := &udnv1.UserDefinedNetwork{
@@ -381,6 +423,28 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			  "allowPersistentIPs": true
 			}`,
 		),
+		Entry("secondary network, multicast enabled",
+			udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer3,
+				Layer3: &udnv1.Layer3Config{
+					Role: udnv1.NetworkRoleSecondary,
+					Subnets: []udnv1.Layer3Subnet{
+						{CIDR: "192.168.100.0/16"},
+					},
+					Multicast: udnv1.MulticastEnabled,
+				},
+			},
+			`{
+			  "cniVersion": "1.0.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "mynamespace.test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "secondary",
+			  "topology": "layer3",
+			  "subnets": "192.168.100.0/16",
+			  "enableMulticast": true
+			}`,
+		),
 	)
 
 	DescribeTable("should create CUDN NAD from spec",
@@ -22,7 +22,7 @@ import (
 
 const (
 	// Maximum node IDs that can be generated. Limited to maximum nodes supported by k8s.
-	maxNodeIDs = 5000
+	maxNodeIDs = config.MaxNodeIDs
 )
 
 // zoneClusterController is the cluster controller for managing all the zone(s) in the cluster.
@@ -48,7 +48,7 @@ type zoneClusterController struct {
 
 func newZoneClusterController(ovnClient *util.OVNClusterManagerClientset, wf *factory.WatchFactory) (*zoneClusterController, error) {
 	// Since we don't assign 0 to any node, create IDAllocator with one extra element in maxIds.
-	nodeIDAllocator := id.NewIDAllocator("NodeIDs", maxNodeIDs+1)
+	nodeIDAllocator := id.NewIDAllocator("NodeIDs", config.ClusterManager.NodeIDOffset+maxNodeIDs+1)
 	// Reserve the id 0. We don't want to assign this id to any of the nodes.
 	if err := nodeIDAllocator.ReserveID("zero", 0); err != nil {
 		return nil, fmt.Errorf("idAllocator failed to reserve id 0")
@@ -56,6 +56,13 @@ func newZoneClusterController(ovnClient *util.OVNClusterManagerClientset, wf *fa
 	if err := nodeIDAllocator.ReserveID("one", 1); err != nil {
 		return nil, fmt.Errorf("idAllocator failed to reserve id 1")
 	}
+	// Reserve the ids below the offset, which are allocated to the nodes of
+	// other clusters linked with this one.
+	for i := 2; i < config.ClusterManager.NodeIDOffset+2; i++ {
+		if err := nodeIDAllocator.ReserveID(fmt.Sprintf("offset-%d", i), i); err != nil {
+			return nil, fmt.Errorf("idAllocator failed to reserve id %d", i)
+		}
+	}
 
 	kube := &kube.Kube{
 		KClient: ovnClient.KubeClient,
@@ -4,8 +4,39 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+const (
+	// uplinkQoSExternalID tags the QoS and queues of the node uplink managed
+	// for the pods
+	uplinkQoSExternalID = "pod-bandwidth=uplink"
+	// uplinkSandboxExternalID is the external ID of the uplink queue of a pod
+	// holding its sandbox ID
+	uplinkSandboxExternalID = "uplink-sandbox"
+	// uplinkQueueExternalID is the external ID of the uplink queue of a pod
+	// holding its ID in the uplink QoS
+	uplinkQueueExternalID = "uplink-queue"
+	// maxUplinkQueueID is the highest queue ID supported by linux-htb
+	maxUplinkQueueID = 0xefff
+)
+
+var (
+	// podBandwidthUplink is the node uplink port of the gateway bridge the pod
+	// egress traffic with a minimum rate or a priority class is scheduled on
+	podBandwidthUplink string
+	// uplinkQoSLock serializes the updates of the QoS of the node uplink
+	uplinkQoSLock sync.Mutex
+)
+
+// SetPodBandwidthUplink sets the node uplink port the pod egress traffic with
+// a minimum rate or a priority class is scheduled on
+func SetPodBandwidthUplink(port string) {
+	uplinkQoSLock.Lock()
+	defer uplinkQoSLock.Unlock()
+	podBandwidthUplink = port
+}
+
 func clearPodBandwidth(sandboxID string) error {
 	// interfaces will have the same name as ports
 	portList, err := ovsFind("interface", "name", "external-ids:sandbox="+sandboxID)
@@ -35,14 +66,65 @@ func clearPodBandwidthForPorts(portList []string, sandboxID string) error {
 		}
 	}
 
+	// and the queues it was using
+	queueList, err := ovsFind("queue", "_uuid", "external-ids:sandbox="+sandboxID)
+	if err != nil {
+		return err
+	}
+	for _, queue := range queueList {
+		if err := ovsDestroy("queue", queue); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func setPodBandwidth(sandboxID, ifname string, ingressBPS, egressBPS int64) error {
+// hasBandwidth returns whether any bandwidth setting applies to the pod
+// interface
+func (ifInfo *PodInterfaceInfo) hasBandwidth() bool {
+	return ifInfo.Ingress > 0 || ifInfo.Egress > 0 || ifInfo.hasIngressQueue()
+}
+
+// hasIngressQueue returns whether the pod ingress traffic needs its own OVS
+// queue, to carry the burst size not supported by the QoS itself
+func (ifInfo *PodInterfaceInfo) hasIngressQueue() bool {
+	return ifInfo.IngressBurst > 0
+}
+
+// hasUplinkQueue returns whether the pod egress traffic needs its own queue
+// in the QoS of the node uplink, to share it with the other pods
+func (ifInfo *PodInterfaceInfo) hasUplinkQueue() bool {
+	return ifInfo.EgressMinRate > 0 || ifInfo.BandwidthPriority != ""
+}
+
+func setPodBandwidth(sandboxID, ifname string, ifInfo *PodInterfaceInfo) error {
 	// note pod ingress == OVS egress and vice versa
 
-	if ingressBPS > 0 {
-		qos, err := ovsCreate("qos", "type=linux-htb", fmt.Sprintf("other-config:max-rate=%d", ingressBPS), "external-ids=sandbox="+sandboxID)
+	if ifInfo.Ingress > 0 || ifInfo.hasIngressQueue() {
+		qosArgs := []string{"type=linux-htb"}
+		if ifInfo.Ingress > 0 {
+			qosArgs = append(qosArgs, fmt.Sprintf("other-config:max-rate=%d", ifInfo.Ingress))
+		}
+		if ifInfo.hasIngressQueue() {
+			// burst is only supported per queue, all of the pod ingress
+			// traffic goes to the default queue 0
+			queueArgs := []string{}
+			if ifInfo.Ingress > 0 {
+				queueArgs = append(queueArgs, fmt.Sprintf("other-config:max-rate=%d", ifInfo.Ingress))
+			}
+			if ifInfo.IngressBurst > 0 {
+				queueArgs = append(queueArgs, fmt.Sprintf("other-config:burst=%d", ifInfo.IngressBurst))
+			}
+			queueArgs = append(queueArgs, "external-ids=sandbox="+sandboxID)
+			queue, err := ovsCreate("queue", queueArgs...)
+			if err != nil {
+				return err
+			}
+			qosArgs = append(qosArgs, "queues:0="+queue)
+		}
+		qosArgs = append(qosArgs, "external-ids=sandbox="+sandboxID)
+		qos, err := ovsCreate("qos", qosArgs...)
 		if err != nil {
 			return err
 		}
@@ -51,16 +133,20 @@ func setPodBandwidth(sandboxID, ifname string, ingressBPS, egressBPS int64) erro
 			return err
 		}
 	}
-	if egressBPS > 0 {
+	if ifInfo.Egress > 0 {
 		// ingress_policing_rate is in Kbps
-		egressKBPS := egressBPS / 1000
+		egressKBPS := ifInfo.Egress / 1000
 		err := ovsSet("interface", ifname, fmt.Sprintf("ingress_policing_rate=%d", egressKBPS))
 		if err != nil {
 			return err
 		}
-		// Set the ingress_policing_burst too per recommendation in ovsdb schema, i.e
-		// 10% of the rate
-		err = ovsSet("interface", ifname, fmt.Sprintf("ingress_policing_burst=%d", (egressKBPS/10)))
+		// Set the ingress_policing_burst too, in kb, per recommendation in
+		// ovsdb schema, i.e 10% of the rate, unless the pod sets its own
+		egressBurstKB := egressKBPS / 10
+		if ifInfo.EgressBurst > 0 {
+			egressBurstKB = ifInfo.EgressBurst / 1000
+		}
+		err = ovsSet("interface", ifname, fmt.Sprintf("ingress_policing_burst=%d", egressBurstKB))
 		if err != nil {
 			return err
 		}
@@ -124,3 +210,151 @@ func getInterfaceEgressBandwith(ifname string) (int64, error) {
 
 	return egressValue * 1000, nil
 }
+
+// ensureUplinkQoS returns the linux-htb QoS of the node uplink shared by the
+// pods, creating it if needed. Its queue 0 carries the traffic not classified
+// to a pod queue, with the medium priority.
+func ensureUplinkQoS(uplink string) (string, error) {
+	qos, err := ovsGet("port", uplink, "qos", "")
+	if err != nil {
+		return "", fmt.Errorf("failed to get qos for uplink port %s: %w", uplink, err)
+	}
+	if qos != "" {
+		owner, err := ovsGet("qos", qos, "external-ids", "pod-bandwidth")
+		if err != nil {
+			return "", fmt.Errorf("failed to get external-ids of qos %s: %w", qos, err)
+		}
+		if owner != "uplink" {
+			return "", fmt.Errorf("uplink port %s already has a QoS %s not managed for the pods", uplink, qos)
+		}
+		return qos, nil
+	}
+	_, err = ovsExec(
+		"--", "--id=@default", "create", "queue",
+		fmt.Sprintf("other-config:priority=%d", bandwidthPriorityClasses["medium"]),
+		"external-ids:"+uplinkQoSExternalID,
+		"--", "--id=@qos", "create", "qos", "type=linux-htb", "queues:0=@default",
+		"external-ids:"+uplinkQoSExternalID,
+		"--", "set", "port", uplink, "qos=@qos")
+	if err != nil {
+		return "", fmt.Errorf("failed to create qos for uplink port %s: %w", uplink, err)
+	}
+	return ovsGet("port", uplink, "qos", "")
+}
+
+// getQoSQueueIDs returns the IDs of the queues of the given QoS
+func getQoSQueueIDs(qos string) (map[int]bool, error) {
+	out, err := ovsGet("qos", qos, "queues", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queues of qos %s: %w", qos, err)
+	}
+	ids := map[int]bool{}
+	out = strings.Trim(out, "{}")
+	if out == "" {
+		return ids, nil
+	}
+	for _, queue := range strings.Split(out, ", ") {
+		id, err := strconv.Atoi(strings.SplitN(queue, "=", 2)[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse queues %q of qos %s: %w", out, qos, err)
+		}
+		ids[id] = true
+	}
+	return ids, nil
+}
+
+// setPodUplinkQueue adds a queue to the QoS of the node uplink carrying the
+// pod egress traffic with its minimum rate and priority, and returns its ID.
+// The queue of a sandbox is shared by its interfaces.
+func setPodUplinkQueue(sandboxID string, ifInfo *PodInterfaceInfo) (int, error) {
+	uplinkQoSLock.Lock()
+	defer uplinkQoSLock.Unlock()
+
+	if podBandwidthUplink == "" {
+		return 0, fmt.Errorf("no node uplink to schedule the pod egress traffic with a minimum rate or priority class on")
+	}
+
+	queueArgs := []string{}
+	if ifInfo.EgressMinRate > 0 {
+		queueArgs = append(queueArgs, fmt.Sprintf("other-config:min-rate=%d", ifInfo.EgressMinRate))
+	}
+	if ifInfo.BandwidthPriority != "" {
+		queueArgs = append(queueArgs, fmt.Sprintf("other-config:priority=%d", bandwidthPriorityClasses[ifInfo.BandwidthPriority]))
+	}
+
+	queues, err := ovsFind("queue", "_uuid", fmt.Sprintf("external-ids:%s=%s", uplinkSandboxExternalID, sandboxID))
+	if err != nil {
+		return 0, err
+	}
+	if len(queues) > 0 {
+		id, err := ovsGet("queue", queues[0], "external-ids", uplinkQueueExternalID)
+		if err != nil {
+			return 0, err
+		}
+		return strconv.Atoi(id)
+	}
+
+	qos, err := ensureUplinkQoS(podBandwidthUplink)
+	if err != nil {
+		return 0, err
+	}
+	ids, err := getQoSQueueIDs(qos)
+	if err != nil {
+		return 0, err
+	}
+	id := 1
+	for ids[id] {
+		id++
+	}
+	if id > maxUplinkQueueID {
+		return 0, fmt.Errorf("no queue left in qos %s of uplink port %s", qos, podBandwidthUplink)
+	}
+
+	args := append([]string{"--", "--id=@queue", "create", "queue"}, queueArgs...)
+	args = append(args,
+		"external-ids:"+uplinkQoSExternalID,
+		fmt.Sprintf("external-ids:%s=%s", uplinkSandboxExternalID, sandboxID),
+		fmt.Sprintf("external-ids:%s=%d", uplinkQueueExternalID, id),
+		"--", "add", "qos", qos, "queues", fmt.Sprintf("%d=@queue", id))
+	if _, err := ovsExec(args...); err != nil {
+		return 0, fmt.Errorf("failed to add queue to qos %s of uplink port %s: %w", qos, podBandwidthUplink, err)
+	}
+	return id, nil
+}
+
+// clearPodUplinkQueue removes the queue of the pod from the QoS of the node
+// uplink, then destroys it as a queue can't be destroyed while referenced
+func clearPodUplinkQueue(sandboxID string) error {
+	uplinkQoSLock.Lock()
+	defer uplinkQoSLock.Unlock()
+
+	queues, err := ovsFind("queue", "_uuid", fmt.Sprintf("external-ids:%s=%s", uplinkSandboxExternalID, sandboxID))
+	if err != nil || len(queues) == 0 {
+		return err
+	}
+	qosList, err := ovsFind("qos", "_uuid", "external-ids:"+uplinkQoSExternalID)
+	if err != nil {
+		return err
+	}
+	for _, queue := range queues {
+		id, err := ovsGet("queue", queue, "external-ids", uplinkQueueExternalID)
+		if err != nil {
+			return err
+		}
+		args := []string{}
+		for _, qos := range qosList {
+			args = append(args, "--", "remove", "qos", qos, "queues", id)
+		}
+		args = append(args, "--", "--if-exists", "destroy", "queue", queue)
+		if _, err := ovsExec(args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getUplinkQueuePriority returns the skb priority classifying packets to the
+// given queue of a linux-htb QoS, whose classes are 1:(queue ID + 1)
+func getUplinkQueuePriority(queueID int) uint32 {
+	return 1<<16 | uint32(queueID+1)
+}
@@ -77,6 +77,27 @@ func TestClearPodBandwidth(t *testing.T) {
 			},
 			runnerInstance: mockKexecIface,
 		},
+		{
+			desc:        "Test code path when ovsDestroy returns an error for a queue",
+			expectedErr: true,
+			onRetArgsKexecIface: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+			},
+			onRetArgsCmdList: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte{1}, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte{1}, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte{1}, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, fmt.Errorf("mock: failed to run ovsDestroy")}},
+			},
+			runnerInstance: mockKexecIface,
+		},
 		{
 			desc: "Positive test code path",
 			onRetArgsKexecIface: []ovntest.TestifyMockHelper{
@@ -84,12 +105,34 @@ func TestClearPodBandwidth(t *testing.T) {
 				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
 				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
 				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+			},
+			onRetArgsCmdList: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte{1}, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte{1}, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+			},
+			runnerInstance: mockKexecIface,
+		},
+		{
+			desc: "Positive test code path with a queue",
+			onRetArgsKexecIface: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
 			},
 			onRetArgsCmdList: []ovntest.TestifyMockHelper{
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte{1}, nil}},
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte{1}, nil}},
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte{1}, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
 			},
 			runnerInstance: mockKexecIface,
 		},
@@ -129,7 +172,7 @@ func TestSetPodBandwidth(t *testing.T) {
 		onRetArgsKexecIface []ovntest.TestifyMockHelper
 		onRetArgsCmdList    []ovntest.TestifyMockHelper
 		runnerInstance      kexec.Interface
-		egressBPS           int64
+		ifInfo              *PodInterfaceInfo
 	}{
 		{
 			desc:        "Test code path when both ingressBPS is greater than zero and ovsCreate returns an error",
@@ -139,7 +182,7 @@ func TestSetPodBandwidth(t *testing.T) {
 			onRetArgsCmdList: []ovntest.TestifyMockHelper{
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, fmt.Errorf("mock: failed to run ovsCreate")}}},
 			runnerInstance: mockKexecIface,
-			egressBPS:      0,
+			ifInfo:         &PodInterfaceInfo{Ingress: 1},
 		},
 		{
 			desc:        "Test code path when inressBPS is greater than zero and ovsSet returns an error",
@@ -153,7 +196,7 @@ func TestSetPodBandwidth(t *testing.T) {
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, fmt.Errorf("mock: failed to run ovsSet")}},
 			},
 			runnerInstance: mockKexecIface,
-			egressBPS:      0,
+			ifInfo:         &PodInterfaceInfo{Ingress: 1},
 		},
 		{
 			desc: "Positive test code path when ingressBPS is greater than zero",
@@ -166,7 +209,7 @@ func TestSetPodBandwidth(t *testing.T) {
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
 			},
 			runnerInstance: mockKexecIface,
-			egressBPS:      0,
+			ifInfo:         &PodInterfaceInfo{Ingress: 1},
 		},
 		{
 			desc:        "Negative test code path when setting ingress_policing_rate",
@@ -182,7 +225,7 @@ func TestSetPodBandwidth(t *testing.T) {
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, fmt.Errorf("mock: failed to run ovsSet")}},
 			},
 			runnerInstance: mockKexecIface,
-			egressBPS:      3,
+			ifInfo:         &PodInterfaceInfo{Ingress: 1, Egress: 3},
 		},
 		{
 			desc:        "Negative test code path when setting ingress_policing_burst",
@@ -200,7 +243,7 @@ func TestSetPodBandwidth(t *testing.T) {
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, fmt.Errorf("mock: failed to run ovsSet")}},
 			},
 			runnerInstance: mockKexecIface,
-			egressBPS:      3,
+			ifInfo:         &PodInterfaceInfo{Ingress: 1, Egress: 3},
 		},
 		{
 			desc: "Positive test code path when both ingressBPS and egressBPS are greater than zero",
@@ -217,7 +260,53 @@ func TestSetPodBandwidth(t *testing.T) {
 				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
 			},
 			runnerInstance: mockKexecIface,
-			egressBPS:      3,
+			ifInfo:         &PodInterfaceInfo{Ingress: 1, Egress: 3},
+		},
+		{
+			desc:        "Negative test code path when ingress queue settings are set and ovsCreate returns an error for the queue",
+			expectedErr: true,
+			onRetArgsKexecIface: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+			},
+			onRetArgsCmdList: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, fmt.Errorf("mock: failed to run ovsCreate")}},
+			},
+			runnerInstance: mockKexecIface,
+			ifInfo:         &PodInterfaceInfo{Ingress: 1, IngressBurst: 1000},
+		},
+		{
+			desc: "Positive test code path when ingress queue settings and egress burst are set",
+			onRetArgsKexecIface: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+			},
+			onRetArgsCmdList: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte("queue-uuid"), nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+			},
+			runnerInstance: mockKexecIface,
+			ifInfo:         &PodInterfaceInfo{Ingress: 10000, IngressBurst: 1000, Egress: 10000, EgressBurst: 2000},
+		},
+		{
+			desc: "Positive test code path when only the ingress burst is set",
+			onRetArgsKexecIface: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+				{OnCallMethodName: "Command", OnCallMethodArgType: []string{"string", "string", "string", "string", "string", "string", "string", "string", "string"}, RetArgList: []interface{}{mockCmd}},
+			},
+			onRetArgsCmdList: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{[]byte("queue-uuid"), nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+				{OnCallMethodName: "CombinedOutput", OnCallMethodArgType: []string{}, RetArgList: []interface{}{nil, nil}},
+			},
+			runnerInstance: mockKexecIface,
+			ifInfo:         &PodInterfaceInfo{IngressBurst: 1000},
 		},
 	}
 	for i, tc := range tests {
@@ -232,7 +321,7 @@ func TestSetPodBandwidth(t *testing.T) {
 			// note runner is defined in pkg/cni/ovs.go file
 			runner = tc.runnerInstance
 
-			e := setPodBandwidth("sandboxID", "ifname", 1, tc.egressBPS)
+			e := setPodBandwidth("sandboxID", "ifname", tc.ifInfo)
 
 			if tc.expectedErr {
 				assert.Error(t, e)
@@ -492,3 +581,130 @@ func TestGetEgressPodBandwidth(t *testing.T) {
 		})
 	}
 }
+
+func TestSetPodUplinkQueue(t *testing.T) {
+	const (
+		findQueue    = "ovs-vsctl --timeout=30 --no-heading --format=csv --data=bare --columns=_uuid find queue external-ids:uplink-sandbox=sandboxID"
+		getUplinkQoS = "ovs-vsctl --timeout=30 --if-exists get port eth0 qos"
+	)
+
+	tests := []struct {
+		desc        string
+		uplink      string
+		ifInfo      *PodInterfaceInfo
+		cmds        []*ovntest.ExpectedCmd
+		expectedID  int
+		expectedErr bool
+	}{
+		{
+			desc:        "fails without a node uplink",
+			ifInfo:      &PodInterfaceInfo{BandwidthPriority: "high"},
+			expectedErr: true,
+		},
+		{
+			desc:   "creates the uplink QoS with the pod queue",
+			uplink: "eth0",
+			ifInfo: &PodInterfaceInfo{EgressMinRate: 5000000, BandwidthPriority: "high"},
+			cmds: []*ovntest.ExpectedCmd{
+				{Cmd: findQueue},
+				{Cmd: getUplinkQoS},
+				{Cmd: "ovs-vsctl --timeout=30 -- --id=@default create queue other-config:priority=4 external-ids:pod-bandwidth=uplink " +
+					"-- --id=@qos create qos type=linux-htb queues:0=@default external-ids:pod-bandwidth=uplink -- set port eth0 qos=@qos"},
+				{Cmd: getUplinkQoS, Output: "qos-uuid"},
+				{Cmd: "ovs-vsctl --timeout=30 --if-exists get qos qos-uuid queues", Output: "{0=default-uuid}"},
+				{Cmd: "ovs-vsctl --timeout=30 -- --id=@queue create queue other-config:min-rate=5000000 other-config:priority=0 " +
+					"external-ids:pod-bandwidth=uplink external-ids:uplink-sandbox=sandboxID external-ids:uplink-queue=1 " +
+					"-- add qos qos-uuid queues 1=@queue"},
+			},
+			expectedID: 1,
+		},
+		{
+			desc:   "adds the pod queue with the first free ID to the uplink QoS",
+			uplink: "eth0",
+			ifInfo: &PodInterfaceInfo{BandwidthPriority: "low"},
+			cmds: []*ovntest.ExpectedCmd{
+				{Cmd: findQueue},
+				{Cmd: getUplinkQoS, Output: "qos-uuid"},
+				{Cmd: "ovs-vsctl --timeout=30 --if-exists get qos qos-uuid external-ids:pod-bandwidth", Output: "uplink"},
+				{Cmd: "ovs-vsctl --timeout=30 --if-exists get qos qos-uuid queues", Output: "{0=default-uuid, 1=queue1-uuid, 3=queue3-uuid}"},
+				{Cmd: "ovs-vsctl --timeout=30 -- --id=@queue create queue other-config:priority=7 " +
+					"external-ids:pod-bandwidth=uplink external-ids:uplink-sandbox=sandboxID external-ids:uplink-queue=2 " +
+					"-- add qos qos-uuid queues 2=@queue"},
+			},
+			expectedID: 2,
+		},
+		{
+			desc:   "reuses the queue of the sandbox",
+			uplink: "eth0",
+			ifInfo: &PodInterfaceInfo{BandwidthPriority: "low"},
+			cmds: []*ovntest.ExpectedCmd{
+				{Cmd: findQueue, Output: "queue-uuid"},
+				{Cmd: "ovs-vsctl --timeout=30 --if-exists get queue queue-uuid external-ids:uplink-queue", Output: "\"2\""},
+			},
+			expectedID: 2,
+		},
+		{
+			desc:   "fails if the uplink has a QoS not managed for the pods",
+			uplink: "eth0",
+			ifInfo: &PodInterfaceInfo{EgressMinRate: 5000000},
+			cmds: []*ovntest.ExpectedCmd{
+				{Cmd: findQueue},
+				{Cmd: getUplinkQoS, Output: "qos-uuid"},
+				{Cmd: "ovs-vsctl --timeout=30 --if-exists get qos qos-uuid external-ids:pod-bandwidth"},
+			},
+			expectedErr: true,
+		},
+	}
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d:%s", i, tc.desc), func(t *testing.T) {
+			fexec := ovntest.NewFakeExec()
+			fexec.AddFakeCmds(tc.cmds)
+			assert.NoError(t, SetExec(fexec))
+			defer ResetRunner()
+			SetPodBandwidthUplink(tc.uplink)
+			defer SetPodBandwidthUplink("")
+
+			id, err := setPodUplinkQueue("sandboxID", tc.ifInfo)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedID, id)
+			}
+			assert.True(t, fexec.CalledMatchesExpected(), fexec.ErrorDesc())
+		})
+	}
+}
+
+func TestClearPodUplinkQueue(t *testing.T) {
+	fexec := ovntest.NewFakeExec()
+	fexec.AddFakeCmds([]*ovntest.ExpectedCmd{
+		{
+			Cmd:    "ovs-vsctl --timeout=30 --no-heading --format=csv --data=bare --columns=_uuid find queue external-ids:uplink-sandbox=sandboxID",
+			Output: "queue-uuid",
+		},
+		{
+			Cmd:    "ovs-vsctl --timeout=30 --no-heading --format=csv --data=bare --columns=_uuid find qos external-ids:pod-bandwidth=uplink",
+			Output: "qos-uuid",
+		},
+		{
+			Cmd:    "ovs-vsctl --timeout=30 --if-exists get queue queue-uuid external-ids:uplink-queue",
+			Output: "\"2\"",
+		},
+		{
+			// the queue is removed from the uplink QoS before it is destroyed
+			Cmd: "ovs-vsctl --timeout=30 -- remove qos qos-uuid queues 2 -- --if-exists destroy queue queue-uuid",
+		},
+	})
+	assert.NoError(t, SetExec(fexec))
+	defer ResetRunner()
+
+	assert.NoError(t, clearPodUplinkQueue("sandboxID"))
+	assert.True(t, fexec.CalledMatchesExpected(), fexec.ErrorDesc())
+}
+
+func TestGetUplinkQueuePriority(t *testing.T) {
+	// linux-htb classifies the packets with the skb priority 1:2 to queue 1
+	assert.Equal(t, uint32(0x10002), getUplinkQueuePriority(1))
+}
@@ -8,6 +8,8 @@ import (
 
 	v1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	kapi "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog/v2"
@@ -20,6 +22,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kubevirt"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/tracing"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
@@ -60,12 +63,66 @@ func validateBandwidthIsReasonable(rsrc *resource.Quantity) error {
 	return nil
 }
 
+const (
+	// IngressBandwidthBurstAnnotation is the burst size, in bits, of the pod
+	// ingress bandwidth limit
+	IngressBandwidthBurstAnnotation = "k8s.ovn.org/ingress-bandwidth-burst"
+	// EgressBandwidthBurstAnnotation is the burst size, in bits, of the pod
+	// egress bandwidth limit
+	EgressBandwidthBurstAnnotation = "k8s.ovn.org/egress-bandwidth-burst"
+	// EgressMinBandwidthAnnotation is the minimum rate, in bits per second,
+	// guaranteed to the pod egress traffic on the node uplink
+	EgressMinBandwidthAnnotation = "k8s.ovn.org/egress-min-bandwidth"
+	// BandwidthPriorityAnnotation is the name of the priority class of the pod
+	// egress traffic on the node uplink, one of bandwidthPriorityClasses
+	BandwidthPriorityAnnotation = "k8s.ovn.org/bandwidth-priority"
+)
+
+// bandwidthPriorityClasses maps the priority class names to the priority of
+// the linux-htb OVS queues of the node uplink, lower values being served first
+var bandwidthPriorityClasses = map[string]int{
+	"high":   0,
+	"medium": 4,
+	"low":    7,
+}
+
+// namespaceBandwidthAnnotations are the bandwidth annotations that can be set
+// on a namespace as a default for its pods
+var namespaceBandwidthAnnotations = []string{
+	IngressBandwidthBurstAnnotation,
+	EgressBandwidthBurstAnnotation,
+	EgressMinBandwidthAnnotation,
+	BandwidthPriorityAnnotation,
+}
+
 func extractPodBandwidth(podAnnotations map[string]string, dir direction) (int64, error) {
 	annotation := "kubernetes.io/ingress-bandwidth"
 	if dir == Egress {
 		annotation = "kubernetes.io/egress-bandwidth"
 	}
+	return extractBandwidthQuantity(podAnnotations, annotation)
+}
+
+func extractPodBandwidthBurst(podAnnotations map[string]string, dir direction) (int64, error) {
+	annotation := IngressBandwidthBurstAnnotation
+	if dir == Egress {
+		annotation = EgressBandwidthBurstAnnotation
+	}
+	return extractBandwidthQuantity(podAnnotations, annotation)
+}
 
+func extractPodBandwidthPriority(podAnnotations map[string]string) (string, error) {
+	class, found := podAnnotations[BandwidthPriorityAnnotation]
+	if !found {
+		return "", BandwidthNotFound
+	}
+	if _, ok := bandwidthPriorityClasses[class]; !ok {
+		return "", fmt.Errorf("unknown bandwidth priority class %q", class)
+	}
+	return class, nil
+}
+
+func extractBandwidthQuantity(podAnnotations map[string]string, annotation string) (int64, error) {
 	str, found := podAnnotations[annotation]
 	if !found {
 		return 0, BandwidthNotFound
@@ -115,7 +172,7 @@ func (pr *PodRequest) cmdAddWithGetCNIResultFunc(
 	clientset *ClientSet,
 	getCNIResultFn getCNIResultFunc,
 	networkManager networkmanager.Interface,
-) (*Response, error) {
+) (response *Response, err error) {
 	namespace := pr.PodNamespace
 	podName := pr.PodName
 	if namespace == "" || podName == "" {
@@ -126,8 +183,6 @@ func (pr *PodRequest) cmdAddWithGetCNIResultFunc(
 	annotCondFn := isOvnReady
 	netdevName := ""
 	if pr.CNIConf.DeviceID != "" {
-		var err error
-
 		if !pr.IsVFIO {
 			netdevName, err = util.GetNetdevNameFromDeviceId(pr.CNIConf.DeviceID, pr.deviceInfo)
 			if err != nil {
@@ -152,7 +207,19 @@ func (pr *PodRequest) cmdAddWithGetCNIResultFunc(
 	if util.IsNetworkSegmentationSupportEnabled() {
 		annotCondFn = primaryUDN.WaitForPrimaryAnnotationFn(namespace, annotCondFn)
 	}
+	waitStart := time.Now()
 	pod, annotations, podNADAnnotation, err := GetPodWithAnnotations(pr.ctx, clientset, namespace, podName, pr.nadName, annotCondFn)
+	// the pod UID may only be known once the pod is retrieved, so start the
+	// span afterwards to have it join the pod's trace
+	podUID := pr.PodUID
+	if podUID == "" && pod != nil {
+		podUID = string(pod.UID)
+	}
+	ctx, span := tracing.StartPodSpan(pr.ctx, namespace, podName, podUID, "cni add", trace.WithTimestamp(waitStart),
+		trace.WithAttributes(tracing.NADKey.String(pr.nadName), attribute.String("cni.sandbox_id", pr.SandboxID)))
+	defer func() { tracing.EndSpan(span, err) }()
+	_, waitSpan := tracing.StartSpan(ctx, "wait for pod annotation", trace.WithTimestamp(waitStart))
+	tracing.EndSpan(waitSpan, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pod annotation: %v", err)
 	}
@@ -160,6 +227,11 @@ func (pr *PodRequest) cmdAddWithGetCNIResultFunc(
 		return nil, err
 	}
 
+	annotations, err = clientset.withNamespaceBandwidthDefaults(namespace, annotations)
+	if err != nil {
+		return nil, err
+	}
+
 	podInterfaceInfo, err := pr.buildPodInterfaceInfo(annotations, podNADAnnotation, netdevName)
 	if err != nil {
 		return nil, err
@@ -167,11 +239,13 @@ func (pr *PodRequest) cmdAddWithGetCNIResultFunc(
 
 	podInterfaceInfo.SkipIPConfig = kubevirt.IsPodLiveMigratable(pod)
 
-	response := &Response{KubeAuth: kubeAuth}
+	response = &Response{KubeAuth: kubeAuth}
 	if !config.UnprivilegedMode {
 		//TODO: There is nothing technical to run this at unprivileged mode but
 		//      we will tackle that later on.
+		_, configureSpan := tracing.StartSpan(ctx, "configure interface")
 		response.Result, err = getCNIResultFn(pr, clientset, podInterfaceInfo)
+		tracing.EndSpan(configureSpan, err)
 		if err != nil {
 			return nil, err
 		}
@@ -181,7 +255,9 @@ func (pr *PodRequest) cmdAddWithGetCNIResultFunc(
 			if err != nil {
 				return nil, err
 			}
+			_, configureSpan = tracing.StartSpan(ctx, "configure primary UDN interface")
 			primaryUDNResult, err := getCNIResultFn(primaryUDNPodRequest, clientset, primaryUDNPodInfo)
+			tracing.EndSpan(configureSpan, err)
 			if err != nil {
 				return nil, err
 			}
@@ -65,8 +65,9 @@ func NewCNIServer(
 			Handler: router,
 		},
 		clientSet: &ClientSet{
-			podLister: corev1listers.NewPodLister(factory.LocalPodInformer().GetIndexer()),
-			kclient:   kclient,
+			podLister:       corev1listers.NewPodLister(factory.LocalPodInformer().GetIndexer()),
+			namespaceLister: factory.NamespaceInformer().Lister(),
+			kclient:         kclient,
 		},
 		kubeAuth: &KubeAPIAuth{
 			Kubeconfig:       config.Kubernetes.Kubeconfig,
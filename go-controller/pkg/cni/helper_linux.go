@@ -15,6 +15,7 @@ import (
 
 	"k8s.io/klog/v2"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/containernetworking/plugins/pkg/ns"
 	"github.com/safchain/ethtool"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 )
 
 type CNIPluginLibOps interface {
@@ -386,6 +388,44 @@ func setupSriovInterface(netns ns.NetNS, containerID, ifName string, ifInfo *Pod
 	return hostIface, contIface, nil
 }
 
+// getNetworkEncapIP returns the encap IP selected for the tunnels of the given
+// network through the Open_vSwitch table's `external_ids:ovn-network-encap-ip-mapping`.
+// The selected encap IP must be one of the node encap IPs.
+func getNetworkEncapIP(netName string) (string, error) {
+	stdout, err := ovsGet("Open_vSwitch", ".", "external_ids", "ovn-network-encap-ip-mapping")
+	if err != nil {
+		return "", fmt.Errorf("failed to get ovn-network-encap-ip-mapping, error: %v", err)
+	}
+
+	if len(stdout) == 0 {
+		return "", nil
+	}
+
+	encapIP := ""
+	mappings := strings.Split(stdout, ",")
+	for _, mapping := range mappings {
+		// IPv6 encap IPs contain colons, so only split on the first one
+		network, ip, found := strings.Cut(mapping, ":")
+		if !found || net.ParseIP(ip) == nil {
+			return "", fmt.Errorf("bad ovn-network-encap-ip-mapping config: %s", stdout)
+		}
+		if network == netName {
+			encapIP = ip
+		}
+	}
+	if encapIP == "" {
+		return "", nil
+	}
+
+	for _, nodeEncapIP := range strings.Split(config.Default.EffectiveEncapIP, ",") {
+		if strings.TrimSpace(nodeEncapIP) == encapIP {
+			return encapIP, nil
+		}
+	}
+	return "", fmt.Errorf("encap IP %s selected for network %s is not one of the node encap IPs %q",
+		encapIP, netName, config.Default.EffectiveEncapIP)
+}
+
 func getPfEncapIP(deviceID string) (string, error) {
 	stdout, err := ovsGet("Open_vSwitch", ".", "external_ids", "ovn-pf-encap-ip-mapping")
 	if err != nil {
@@ -419,6 +459,45 @@ func getPfEncapIP(deviceID string) (string, error) {
 	return encapIP, nil
 }
 
+// setPodEgressPriority classifies the egress traffic of the pod interface to
+// the given queue of the node uplink QoS, setting its skb priority which is
+// kept through the veth and the OVS datapath up to the uplink
+func setPodEgressPriority(netns ns.NetNS, ifName string, queueID int) error {
+	return netns.Do(func(hostNS ns.NetNS) error {
+		link, err := netlink.LinkByName(ifName)
+		if err != nil {
+			return fmt.Errorf("failed to find pod interface %s: %v", ifName, err)
+		}
+		qdisc := &netlink.GenericQdisc{
+			QdiscAttrs: netlink.QdiscAttrs{
+				LinkIndex: link.Attrs().Index,
+				Handle:    netlink.MakeHandle(0xffff, 0),
+				Parent:    netlink.HANDLE_CLSACT,
+			},
+			QdiscType: "clsact",
+		}
+		if err := netlink.QdiscReplace(qdisc); err != nil {
+			return fmt.Errorf("failed to add clsact qdisc to pod interface %s: %v", ifName, err)
+		}
+		priority := getUplinkQueuePriority(queueID)
+		skbedit := netlink.NewSkbEditAction()
+		skbedit.Priority = &priority
+		filter := &netlink.MatchAll{
+			FilterAttrs: netlink.FilterAttrs{
+				LinkIndex: link.Attrs().Index,
+				Parent:    netlink.HANDLE_MIN_EGRESS,
+				Priority:  1,
+				Protocol:  unix.ETH_P_ALL,
+			},
+			Actions: []netlink.Action{skbedit},
+		}
+		if err := netlink.FilterReplace(filter); err != nil {
+			return fmt.Errorf("failed to add priority filter to pod interface %s: %v", ifName, err)
+		}
+		return nil
+	})
+}
+
 // ConfigureOVS performs OVS configurations in order to set up Pod networking
 func ConfigureOVS(ctx context.Context, namespace, podName, hostIfaceName string,
 	ifInfo *PodInterfaceInfo, sandboxID, deviceID string, getter PodInfoGetter) error {
@@ -491,15 +570,27 @@ func ConfigureOVS(ctx context.Context, namespace, podName, hostIfaceName string,
 	// the value's format is:
 	//   enp1s0f0:<vtep-ip1>,enp193s0f0:<vtep-ip2>,enp197s0f0:<vtep-ip3>
 	// Here configure the OVS Interface's encap-ip according to the mapping.
+	var encapIP string
 	if deviceID != "" {
-		encapIP, err := getPfEncapIP(deviceID)
+		encapIP, err = getPfEncapIP(deviceID)
 		if err != nil {
 			return err
 		}
-		if len(encapIP) > 0 {
-			ovsArgs = append(ovsArgs, fmt.Sprintf("external_ids:encap-ip=%s", encapIP))
+	}
+	// When the node has multiple encap IPs and the interface is not bound to the
+	// VTEP of a PF, the encap IP used by the tunnels of each network may be selected
+	// through Open_vSwitch table's `external_ids:ovn-network-encap-ip-mapping`,
+	// the value's format is:
+	//   <network-name1>:<encap-ip1>,<network-name2>:<encap-ip2>
+	if len(encapIP) == 0 && strings.Contains(config.Default.EffectiveEncapIP, ",") {
+		encapIP, err = getNetworkEncapIP(ifInfo.NetName)
+		if err != nil {
+			return err
 		}
 	}
+	if len(encapIP) > 0 {
+		ovsArgs = append(ovsArgs, fmt.Sprintf("external_ids:encap-ip=%s", encapIP))
+	}
 
 	// IPAM is optional for secondary flatL2 networks; thus, the ifaces may not
 	// have IP addresses.
@@ -544,7 +635,7 @@ func ConfigureOVS(ctx context.Context, namespace, podName, hostIfaceName string,
 		return err
 	}
 
-	if ifInfo.Ingress > 0 || ifInfo.Egress > 0 {
+	if ifInfo.hasBandwidth() {
 		l, err := netlink.LinkByName(hostIfaceName)
 		if err != nil {
 			return fmt.Errorf("failed to find host veth interface %s: %v", hostIfaceName, err)
@@ -554,7 +645,7 @@ func ConfigureOVS(ctx context.Context, namespace, podName, hostIfaceName string,
 			return fmt.Errorf("failed to set host veth txqlen: %v", err)
 		}
 
-		if err := setPodBandwidth(sandboxID, hostIfaceName, ifInfo.Ingress, ifInfo.Egress); err != nil {
+		if err := setPodBandwidth(sandboxID, hostIfaceName, ifInfo); err != nil {
 			return err
 		}
 	}
@@ -612,6 +703,19 @@ func (*defaultPodRequestInterfaceOps) ConfigureInterface(pr *PodRequest, getter
 		}
 	}
 
+	// The minimum rate and priority class of the pod egress traffic are
+	// honored by its queue on the node uplink. VFs bypass the uplink QoS.
+	if ifInfo.hasUplinkQueue() && pr.CNIConf.DeviceID == "" {
+		queueID, err := setPodUplinkQueue(pr.SandboxID, ifInfo)
+		if err == nil {
+			err = setPodEgressPriority(netns, contIface.Name, queueID)
+		}
+		if err != nil {
+			pr.deletePort(hostIface.Name, pr.PodNamespace, pr.PodName)
+			return nil, err
+		}
+	}
+
 	// Only configure IPv6 specific stuff and wait for addresses to become usable
 	// if there are any IPv6 addresses to assign. v4 doesn't have the concept
 	// of tentative addresses so it doesn't need any of this.
@@ -765,6 +869,11 @@ func (*defaultPodRequestInterfaceOps) UnconfigureInterface(pr *PodRequest, ifInf
 		if err != nil {
 			klog.Errorf("Failed to clearPodBandwidth sandbox %v %s: %v", pr.SandboxID, podDesc, err)
 		}
+		if !isSecondary {
+			if err = clearPodUplinkQueue(pr.SandboxID); err != nil {
+				klog.Errorf("Failed to clear the uplink queue of sandbox %v %s: %v", pr.SandboxID, podDesc, err)
+			}
+		}
 		pr.deletePodConntrack()
 	}
 	return nil
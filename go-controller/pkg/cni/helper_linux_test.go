@@ -15,6 +15,7 @@ import (
 	"github.com/k8snetworkplumbingwg/sriovnet"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/mocks"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	cni_type_mocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/mocks/github.com/containernetworking/cni/pkg/types"
 	cni_ns_mocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/mocks/github.com/containernetworking/plugins/pkg/ns"
@@ -1491,6 +1492,10 @@ func TestConfigureOVS(t *testing.T) {
 				Cmd: genOVSFindCmd("30", "qos", "_uuid",
 					fmt.Sprintf("external-ids:sandbox=%s", sandboxID)),
 			})
+			tc.execMock.AddFakeCmd(&ovntest.ExpectedCmd{
+				Cmd: genOVSFindCmd("30", "queue", "_uuid",
+					fmt.Sprintf("external-ids:sandbox=%s", sandboxID)),
+			})
 
 			// waitForPodInterface()
 			tc.execMock.AddFakeCmd(&ovntest.ExpectedCmd{
@@ -1699,3 +1704,83 @@ func genIfaceID(podNamespace, podName string) string {
 func genOfctlDumpFlowsCmd(queryStr string) string {
 	return fmt.Sprintf("ovs-ofctl --timeout=10 --no-stats --strict dump-flows br-int %s", queryStr)
 }
+
+func TestGetNetworkEncapIP(t *testing.T) {
+	ovnNetworkEncapIpMapping := "default:10.0.0.1,tenant-blue:10.0.0.2,tenant-red:fd00::2"
+
+	tests := []struct {
+		desc                     string
+		netName                  string
+		nodeEncapIPs             string
+		ovnNetworkEncapIpMapping string
+		expectedEncapIP          string
+		expectErr                bool
+	}{
+		{
+			desc:                     "network has matching external_ids:ovn-network-encap-ip-mapping",
+			netName:                  "tenant-blue",
+			nodeEncapIPs:             "10.0.0.1,10.0.0.2",
+			ovnNetworkEncapIpMapping: ovnNetworkEncapIpMapping,
+			expectedEncapIP:          "10.0.0.2",
+		},
+		{
+			desc:                     "network has matching IPv6 external_ids:ovn-network-encap-ip-mapping",
+			netName:                  "tenant-red",
+			nodeEncapIPs:             "fd00::1,fd00::2",
+			ovnNetworkEncapIpMapping: ovnNetworkEncapIpMapping,
+			expectedEncapIP:          "fd00::2",
+		},
+		{
+			desc:                     "network has no matching external_ids:ovn-network-encap-ip-mapping",
+			netName:                  "tenant-green",
+			nodeEncapIPs:             "10.0.0.1,10.0.0.2",
+			ovnNetworkEncapIpMapping: ovnNetworkEncapIpMapping,
+			expectedEncapIP:          "",
+		},
+		{
+			desc:                     "empty external_ids:ovn-network-encap-ip-mapping",
+			netName:                  "tenant-blue",
+			nodeEncapIPs:             "10.0.0.1,10.0.0.2",
+			ovnNetworkEncapIpMapping: "",
+			expectedEncapIP:          "",
+		},
+		{
+			desc:                     "selected encap IP is not a node encap IP",
+			netName:                  "tenant-blue",
+			nodeEncapIPs:             "10.0.0.1,10.0.0.3",
+			ovnNetworkEncapIpMapping: ovnNetworkEncapIpMapping,
+			expectErr:                true,
+		},
+		{
+			desc:                     "bad external_ids:ovn-network-encap-ip-mapping",
+			netName:                  "tenant-blue",
+			nodeEncapIPs:             "10.0.0.1,10.0.0.2",
+			ovnNetworkEncapIpMapping: "tenant-blue=10.0.0.2",
+			expectErr:                true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d:%s", i, tc.desc), func(t *testing.T) {
+			config.Default.EffectiveEncapIP = tc.nodeEncapIPs
+			defer func() { config.Default.EffectiveEncapIP = "" }()
+
+			execMock := ovntest.NewFakeExec()
+			err := SetExec(execMock)
+			assert.Nil(t, err)
+			execMock.AddFakeCmd(&ovntest.ExpectedCmd{
+				Cmd:    genOVSGetCmd("Open_vSwitch", ".", "external_ids", "ovn-network-encap-ip-mapping"),
+				Output: tc.ovnNetworkEncapIpMapping,
+			})
+
+			encapIP, err := getNetworkEncapIP(tc.netName)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedEncapIP, encapIP)
+			}
+			assert.True(t, execMock.CalledMatchesExpected(), execMock.ErrorDesc)
+		})
+	}
+}
@@ -48,6 +48,10 @@ type PodInterfaceInfo struct {
 	RoutableMTU          int    `json:"routable-mtu"`
 	Ingress              int64  `json:"ingress"`
 	Egress               int64  `json:"egress"`
+	IngressBurst         int64  `json:"ingress-burst"`
+	EgressBurst          int64  `json:"egress-burst"`
+	EgressMinRate        int64  `json:"egress-min-rate"`
+	BandwidthPriority    string `json:"bandwidth-priority"`
 	IsDPUHostMode        bool   `json:"is-dpu-host-mode"`
 	SkipIPConfig         bool   `json:"skip-ip-config"`
 	PodUID               string `json:"pod-uid"`
@@ -179,6 +183,9 @@ type ClientSet struct {
 	PodInfoGetter
 	kclient   kubernetes.Interface
 	podLister corev1listers.PodLister
+	// namespaceLister is optional, it is used to get the namespace defaults
+	// of the pod bandwidth settings
+	namespaceLister corev1listers.NamespaceLister
 }
 
 func NewClientSet(kclient kubernetes.Interface, podLister corev1listers.PodLister) *ClientSet {
@@ -58,6 +58,25 @@ type NetConf struct {
 	// network mapping in the hosts.
 	PhysicalNetworkName string `json:"physicalNetworkName,omitempty"`
 
+	// IPAMProvider is the name of the external IPAM, as configured in cluster
+	// manager, that allocates the IPs of the pods of the network instead of
+	// the built-in IPAM. Only applies to `layer2` and `localnet` topologies
+	// with subnets.
+	IPAMProvider string `json:"ipamProvider,omitempty"`
+
+	// HybridOverlaySubnets is a comma-separated list of the hybrid overlay
+	// subnets reachable from this network, through the hybrid overlay ports
+	// of its nodes. Only applies to `layer3` topologies, and requires hybrid
+	// overlay to be enabled.
+	HybridOverlaySubnets string `json:"hybridOverlaySubnets,omitempty"`
+
+	// EnableMulticast allows multicast on this network, between the pods of
+	// the namespaces annotated with `k8s.ovn.org/multicast-enabled`. Only
+	// applies to secondary networks with subnets, primary networks allow
+	// multicast like the default network does. Requires multicast to be
+	// enabled in the cluster.
+	EnableMulticast bool `json:"enableMulticast,omitempty"`
+
 	// PciAddrs in case of using sriov or Auxiliry device name in case of SF
 	DeviceID string `json:"deviceID,omitempty"`
 	// LogFile to log all the messages from cni shim binary to
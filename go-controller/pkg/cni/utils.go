@@ -59,6 +59,43 @@ func (c *ClientSet) getPod(namespace, name string) (*kapi.Pod, error) {
 	return pod, err
 }
 
+// withNamespaceBandwidthDefaults returns the pod annotations completed with
+// the bandwidth settings of the pod namespace that the pod doesn't set itself.
+// The given annotations are not modified.
+func (c *ClientSet) withNamespaceBandwidthDefaults(namespace string, podAnnotations map[string]string) (map[string]string, error) {
+	if c.namespaceLister == nil {
+		return podAnnotations, nil
+	}
+	ns, err := c.namespaceLister.Get(namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return podAnnotations, nil
+		}
+		return nil, fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+	var annotations map[string]string
+	for _, annotation := range namespaceBandwidthAnnotations {
+		value, ok := ns.Annotations[annotation]
+		if !ok {
+			continue
+		}
+		if _, ok := podAnnotations[annotation]; ok {
+			continue
+		}
+		if annotations == nil {
+			annotations = make(map[string]string, len(podAnnotations)+len(namespaceBandwidthAnnotations))
+			for k, v := range podAnnotations {
+				annotations[k] = v
+			}
+		}
+		annotations[annotation] = value
+	}
+	if annotations == nil {
+		return podAnnotations, nil
+	}
+	return annotations, nil
+}
+
 // GetPodAnnotations obtains the pod UID and annotation from the cache or apiserver
 func GetPodWithAnnotations(ctx context.Context, getter PodInfoGetter,
 	namespace, name, nadName string, annotCond podAnnotWaitCond) (*kapi.Pod, map[string]string, *util.PodAnnotation, error) {
@@ -116,6 +153,25 @@ func PodAnnotation2PodInfo(podAnnotation map[string]string, podNADAnnotation *ut
 	if err != nil && !errors.Is(err, BandwidthNotFound) {
 		return nil, err
 	}
+	ingressBurst, err := extractPodBandwidthBurst(podAnnotation, Ingress)
+	if err != nil && !errors.Is(err, BandwidthNotFound) {
+		return nil, err
+	}
+	egressBurst, err := extractPodBandwidthBurst(podAnnotation, Egress)
+	if err != nil && !errors.Is(err, BandwidthNotFound) {
+		return nil, err
+	}
+	egressMinRate, err := extractBandwidthQuantity(podAnnotation, EgressMinBandwidthAnnotation)
+	if err != nil && !errors.Is(err, BandwidthNotFound) {
+		return nil, err
+	}
+	if egress > 0 && egressMinRate > egress {
+		return nil, fmt.Errorf("pod egress minimum bandwidth %d is greater than its egress bandwidth %d", egressMinRate, egress)
+	}
+	priority, err := extractPodBandwidthPriority(podAnnotation)
+	if err != nil && !errors.Is(err, BandwidthNotFound) {
+		return nil, err
+	}
 
 	podInterfaceInfo := &PodInterfaceInfo{
 		PodAnnotation:        *podNADAnnotation,
@@ -123,6 +179,10 @@ func PodAnnotation2PodInfo(podAnnotation map[string]string, podNADAnnotation *ut
 		RoutableMTU:          config.Default.RoutableMTU, // TBD, configurable for secondary network?
 		Ingress:              ingress,
 		Egress:               egress,
+		IngressBurst:         ingressBurst,
+		EgressBurst:          egressBurst,
+		EgressMinRate:        egressMinRate,
+		BandwidthPriority:    priority,
 		IsDPUHostMode:        config.OvnKubeNode.Mode == types.NodeModeDPUHost,
 		PodUID:               podUID,
 		NetdevName:           netdevname,
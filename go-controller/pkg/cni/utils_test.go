@@ -19,6 +19,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 )
 
 func newPod(namespace, name string, annotations map[string]string) *v1.Pod {
@@ -279,5 +281,86 @@ var _ = Describe("CNI Utils tests", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pif.EnableUDPAggregation).To(BeFalse())
 		})
+
+		It("Creates PodInterfaceInfo with bandwidth settings", func() {
+			annotations := map[string]string{
+				"kubernetes.io/ingress-bandwidth": "10M",
+				"kubernetes.io/egress-bandwidth":  "20M",
+				IngressBandwidthBurstAnnotation:   "1M",
+				EgressBandwidthBurstAnnotation:    "2M",
+				EgressMinBandwidthAnnotation:      "5M",
+				BandwidthPriorityAnnotation:       "high",
+			}
+			for k, v := range podAnnot {
+				annotations[k] = v
+			}
+			pif, err := PodAnnotation2PodInfo(annotations, nil, podUID, "", ovntypes.DefaultNetworkName, ovntypes.DefaultNetworkName, config.Default.MTU)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pif.Ingress).To(Equal(int64(10000000)))
+			Expect(pif.Egress).To(Equal(int64(20000000)))
+			Expect(pif.IngressBurst).To(Equal(int64(1000000)))
+			Expect(pif.EgressBurst).To(Equal(int64(2000000)))
+			Expect(pif.EgressMinRate).To(Equal(int64(5000000)))
+			Expect(pif.BandwidthPriority).To(Equal("high"))
+		})
+
+		It("Fails with an unknown bandwidth priority class", func() {
+			annotations := map[string]string{BandwidthPriorityAnnotation: "urgent"}
+			for k, v := range podAnnot {
+				annotations[k] = v
+			}
+			_, err := PodAnnotation2PodInfo(annotations, nil, podUID, "", ovntypes.DefaultNetworkName, ovntypes.DefaultNetworkName, config.Default.MTU)
+			Expect(err).To(MatchError(ContainSubstring("unknown bandwidth priority class")))
+		})
+
+		It("Fails with a minimum egress bandwidth greater than the egress bandwidth", func() {
+			annotations := map[string]string{
+				"kubernetes.io/egress-bandwidth": "10M",
+				EgressMinBandwidthAnnotation:     "20M",
+			}
+			for k, v := range podAnnot {
+				annotations[k] = v
+			}
+			_, err := PodAnnotation2PodInfo(annotations, nil, podUID, "", ovntypes.DefaultNetworkName, ovntypes.DefaultNetworkName, config.Default.MTU)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("withNamespaceBandwidthDefaults", func() {
+		var clientSet *ClientSet
+
+		BeforeEach(func() {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			Expect(indexer.Add(&v1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+					Annotations: map[string]string{
+						BandwidthPriorityAnnotation:      "low",
+						IngressBandwidthBurstAnnotation:  "1M",
+						"kubernetes.io/egress-bandwidth": "1M",
+					},
+				},
+			})).To(Succeed())
+			clientSet = &ClientSet{namespaceLister: corev1listers.NewNamespaceLister(indexer)}
+		})
+
+		It("Uses the namespace settings the pod doesn't set", func() {
+			podAnnotations := map[string]string{BandwidthPriorityAnnotation: "high"}
+			annotations, err := clientSet.withNamespaceBandwidthDefaults("test", podAnnotations)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(annotations).To(Equal(map[string]string{
+				BandwidthPriorityAnnotation:     "high",
+				IngressBandwidthBurstAnnotation: "1M",
+			}))
+			// the pod annotations are left untouched
+			Expect(podAnnotations).To(HaveLen(1))
+		})
+
+		It("Returns the pod annotations if the namespace doesn't exist", func() {
+			podAnnotations := map[string]string{BandwidthPriorityAnnotation: "high"}
+			annotations, err := clientSet.withNamespaceBandwidthDefaults("other", podAnnotations)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(annotations).To(Equal(podAnnotations))
+		})
 	})
 })
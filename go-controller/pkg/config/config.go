@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/cpuset"
 	kexec "k8s.io/utils/exec"
 	utilnet "k8s.io/utils/net"
 
@@ -37,6 +38,13 @@ const DefaultVXLANPort = 4789
 
 const DefaultDBTxnTimeout = time.Second * 100
 
+// MaxNodeIDs is the maximum number of node IDs allocated in a cluster
+const MaxNodeIDs = 5000
+
+// MaxNodeIDOffset is the maximum offset of the node IDs. Node IDs are used as
+// transit switch port tunnel keys which can't exceed 32767.
+const MaxNodeIDOffset = 32767 - MaxNodeIDs
+
 // The following are global config parameters that other modules may access directly
 var (
 	// Build information. Populated at build-time.
@@ -124,12 +132,16 @@ var (
 		CertDuration: 10 * time.Minute,
 	}
 
-	// Metrics holds Prometheus metrics-related parameters.
-	Metrics MetricsConfig
+	// Metrics holds Prometheus metrics and tracing related parameters.
+	Metrics = MetricsConfig{
+		OTLPTraceSampleRatio: 1,
+	}
 
 	// OVNKubernetesFeatureConfig holds OVN-Kubernetes feature enhancement config file parameters and command-line overrides
 	OVNKubernetesFeature = OVNKubernetesFeatureConfig{
 		EgressIPReachabiltyTotalTimeout: 1,
+		IPsecCertDir:                    "/etc/openvswitch/keys",
+		IPsecCertDuration:               30 * 24 * time.Hour,
 	}
 
 	// OvnNorth holds northbound OVN database client and server authentication and location details
@@ -380,6 +392,10 @@ type KubernetesConfig struct {
 	PlatformType            string `gcfg:"platform-type"`
 	HealthzBindAddress      string `gcfg:"healthz-bind-address"`
 
+	// ServiceIdleTimeout is the period without traffic after which a service
+	// is signalled as idle. Zero disables idle detection.
+	ServiceIdleTimeout time.Duration `gcfg:"service-idle-timeout"`
+
 	// CompatMetricsBindAddress is overridden by the corresponding option in MetricsConfig
 	CompatMetricsBindAddress string `gcfg:"metrics-bind-address"`
 	// CompatOVNMetricsBindAddress is overridden by the corresponding option in MetricsConfig
@@ -403,6 +419,13 @@ type MetricsConfig struct {
 	// configuration duration and optionally, its application to all nodes
 	EnableConfigDuration bool `gcfg:"enable-config-duration"`
 	EnableScaleMetrics   bool `gcfg:"enable-scale-metrics"`
+	// OTLPTraceEndpoint is the host:port of the OTLP gRPC collector traces
+	// are exported to. Tracing is disabled if not set.
+	OTLPTraceEndpoint string `gcfg:"otlp-trace-endpoint"`
+	// OTLPTraceInsecure disables TLS when exporting traces
+	OTLPTraceInsecure bool `gcfg:"otlp-trace-insecure"`
+	// OTLPTraceSampleRatio is the ratio of pods whose network setup is traced
+	OTLPTraceSampleRatio float64 `gcfg:"otlp-trace-sample-ratio"`
 }
 
 // OVNKubernetesFeatureConfig holds OVN-Kubernetes feature enhancement config file parameters and command-line overrides
@@ -415,6 +438,7 @@ type OVNKubernetesFeatureConfig struct {
 	EgressIPReachabiltyTotalTimeout int  `gcfg:"egressip-reachability-total-timeout"`
 	EnableEgressFirewall            bool `gcfg:"enable-egress-firewall"`
 	EnableEgressQoS                 bool `gcfg:"enable-egress-qos"`
+	EnableNetworkQoS                bool `gcfg:"enable-network-qos"`
 	EnableEgressService             bool `gcfg:"enable-egress-service"`
 	EgressIPNodeHealthCheckPort     int  `gcfg:"egressip-node-healthcheck-port"`
 	EnableMultiNetwork              bool `gcfg:"enable-multi-network"`
@@ -431,6 +455,20 @@ type OVNKubernetesFeatureConfig struct {
 	EnableDNSNameResolver        bool `gcfg:"enable-dns-name-resolver"`
 	EnableServiceTemplateSupport bool `gcfg:"enable-svc-template-support"`
 	EnableObservability          bool `gcfg:"enable-observability"`
+	// EgressIP node reachability is derived from OVN BFD sessions instead of periodic probes
+	EgressIPReachabilityBFD bool `gcfg:"egressip-reachability-bfd"`
+	// IPsec encryption of east-west traffic is managed by ovnkube, including
+	// the node certificates
+	EnableIPsec bool `gcfg:"enable-ipsec"`
+	// Directory where the node IPsec certificate and private key are stored
+	IPsecCertDir string `gcfg:"ipsec-cert-dir"`
+	// Requested lifetime of the node IPsec certificate
+	IPsecCertDuration time.Duration `gcfg:"ipsec-cert-duration"`
+	// CA bundle used to authenticate the certificates of IPsec peers. The node
+	// certificates are managed by ovnkube only if set.
+	IPsecCACert string `gcfg:"ipsec-ca-cert"`
+	// ClusterLink peering of the cluster pod network with other clusters is enabled
+	EnableClusterLink bool `gcfg:"enable-cluster-link"`
 }
 
 // GatewayMode holds the node gateway mode
@@ -534,6 +572,13 @@ type OvnKubeNodeConfig struct {
 	DPResourceDeviceIdsMap map[string][]string
 	MgmtPortNetdev         string `gcfg:"mgmt-port-netdev"`
 	MgmtPortDPResourceName string `gcfg:"mgmt-port-dp-resource-name"`
+
+	// OVSCPUSet is the list of CPUs, in linux CPU list format, ovs-vswitchd
+	// threads other than the PMD threads and ovsdb-server are pinned to
+	OVSCPUSet string `gcfg:"ovs-cpu-set"`
+	// OVSPMDCPUSet is the list of CPUs, in linux CPU list format, the PMD
+	// threads of the userspace datapath run on
+	OVSPMDCPUSet string `gcfg:"ovs-pmd-cpu-set"`
 }
 
 // ClusterManagerConfig holds configuration for ovnkube-cluster-manager
@@ -542,6 +587,24 @@ type ClusterManagerConfig struct {
 	V4TransitSwitchSubnet string `gcfg:"v4-transit-switch-subnet"`
 	// V6TransitSwitchSubnet to be used in the cluster for interconnecting multiple zones
 	V6TransitSwitchSubnet string `gcfg:"v6-transit-switch-subnet"`
+	// RawIPAMProviders is a comma separated list of name=target pairs of the
+	// external IPAM providers networks can use, where target is the gRPC
+	// target the provider plugin listens on.
+	RawIPAMProviders string `gcfg:"ipam-providers"`
+	// IPAMProviders maps the names of the external IPAM providers to their
+	// gRPC target, parsed from RawIPAMProviders
+	IPAMProviders map[string]string
+	// IPAMProviderCACert is the CA certificate the certificates of the
+	// external IPAM providers are verified with. Connections to the providers
+	// are not secured if not set.
+	IPAMProviderCACert string `gcfg:"ipam-provider-ca-cert"`
+	// IPAMProviderClientCert and IPAMProviderClientKey are the optional client
+	// certificate and private key presented to the external IPAM providers
+	IPAMProviderClientCert string `gcfg:"ipam-provider-client-cert"`
+	IPAMProviderClientKey  string `gcfg:"ipam-provider-client-key"`
+	// NodeIDOffset is added to the IDs allocated to the nodes, so that clusters
+	// linked with each other can allocate node IDs from disjoint ranges
+	NodeIDOffset int `gcfg:"node-id-offset"`
 }
 
 // OvnDBScheme describes the OVN database connection transport method
@@ -1052,11 +1115,24 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableEgressQoS,
 		Value:       OVNKubernetesFeature.EnableEgressQoS,
 	},
+	&cli.BoolFlag{
+		Name:        "enable-network-qos",
+		Usage:       "Configure to use NetworkQoS CRD feature with ovn-kubernetes.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableNetworkQoS,
+		Value:       OVNKubernetesFeature.EnableNetworkQoS,
+	},
 	&cli.IntFlag{
 		Name:        "egressip-node-healthcheck-port",
 		Usage:       "Configure EgressIP node reachability using gRPC on this TCP port.",
 		Destination: &cliConfig.OVNKubernetesFeature.EgressIPNodeHealthCheckPort,
 	},
+	&cli.BoolFlag{
+		Name: "egressip-reachability-bfd",
+		Usage: "Configure EgressIP node reachability using OVN BFD sessions between the node gateway routers " +
+			"instead of periodic probes. Requires interconnect.",
+		Destination: &cliConfig.OVNKubernetesFeature.EgressIPReachabilityBFD,
+		Value:       OVNKubernetesFeature.EgressIPReachabilityBFD,
+	},
 	&cli.BoolFlag{
 		Name:        "enable-multi-network",
 		Usage:       "Configure to use multiple NetworkAttachmentDefinition CRD feature with ovn-kubernetes.",
@@ -1135,6 +1211,39 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableObservability,
 		Value:       OVNKubernetesFeature.EnableObservability,
 	},
+	&cli.BoolFlag{
+		Name: "enable-ipsec",
+		Usage: "Configure to enable IPsec encryption of east-west traffic managed by ovn-kubernetes. " +
+			"When not set, IPsec is disabled in the OVN northbound database.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableIPsec,
+		Value:       OVNKubernetesFeature.EnableIPsec,
+	},
+	&cli.BoolFlag{
+		Name:        "enable-cluster-link",
+		Usage:       "Configure to enable linking the cluster pod network with other clusters through ClusterLinks.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableClusterLink,
+		Value:       OVNKubernetesFeature.EnableClusterLink,
+	},
+	&cli.StringFlag{
+		Name:        "ipsec-cert-dir",
+		Usage:       "The directory where the node IPsec certificate and private key are stored.",
+		Destination: &cliConfig.OVNKubernetesFeature.IPsecCertDir,
+		Value:       OVNKubernetesFeature.IPsecCertDir,
+	},
+	&cli.DurationFlag{
+		Name:        "ipsec-cert-duration",
+		Usage:       "The requested lifetime of the node IPsec certificate.",
+		Destination: &cliConfig.OVNKubernetesFeature.IPsecCertDuration,
+		Value:       OVNKubernetesFeature.IPsecCertDuration,
+	},
+	&cli.StringFlag{
+		Name: "ipsec-ca-cert",
+		Usage: "The CA bundle used to authenticate the IPsec certificates of other nodes. When set with enable-ipsec, " +
+			"node certificates are requested through CertificateSigningRequests for the k8s.ovn.org/ipsec signer, " +
+			"otherwise they must be provisioned externally.",
+		Destination: &cliConfig.OVNKubernetesFeature.IPsecCACert,
+		Value:       OVNKubernetesFeature.IPsecCACert,
+	},
 }
 
 // K8sFlags capture Kubernetes-related options
@@ -1214,6 +1323,15 @@ var K8sFlags = []cli.Flag{
 			"will spin up pods for the load balancer to send traffic to.",
 		Destination: &cliConfig.Kubernetes.OVNEmptyLbEvents,
 	},
+	&cli.DurationFlag{
+		Name: "service-idle-timeout",
+		Usage: "If set, ovnkube-node tracks the connections to the services in conntrack and " +
+			"ovnkube-cluster-manager annotates services that had no traffic for this period with " +
+			"k8s.ovn.org/idle-since and emits a ServiceIdle event, so that they can be scaled to zero. " +
+			"Must be at least 1m. Disabled by default.",
+		Destination: &cliConfig.Kubernetes.ServiceIdleTimeout,
+		Value:       Kubernetes.ServiceIdleTimeout,
+	},
 	&cli.StringFlag{
 		Name:  "pod-ip",
 		Usage: "UNUSED",
@@ -1304,6 +1422,22 @@ var MetricsFlags = []cli.Flag{
 		Usage:       "Enables metrics related to scaling",
 		Destination: &cliConfig.Metrics.EnableScaleMetrics,
 	},
+	&cli.StringFlag{
+		Name:        "otlp-trace-endpoint",
+		Usage:       "The host:port of the OTLP gRPC collector to export traces to. Tracing is disabled if not set",
+		Destination: &cliConfig.Metrics.OTLPTraceEndpoint,
+	},
+	&cli.BoolFlag{
+		Name:        "otlp-trace-insecure",
+		Usage:       "Disables TLS when exporting traces to the OTLP collector",
+		Destination: &cliConfig.Metrics.OTLPTraceInsecure,
+	},
+	&cli.Float64Flag{
+		Name:        "otlp-trace-sample-ratio",
+		Usage:       "The ratio, between 0 and 1, of pods whose network setup is traced",
+		Destination: &cliConfig.Metrics.OTLPTraceSampleRatio,
+		Value:       Metrics.OTLPTraceSampleRatio,
+	},
 }
 
 // OvnNBFlags capture OVN northbound database options
@@ -1595,6 +1729,19 @@ var OvnKubeNodeFlags = []cli.Flag{
 		Value:       OvnKubeNode.MgmtPortDPResourceName,
 		Destination: &cliConfig.OvnKubeNode.MgmtPortDPResourceName,
 	},
+	&cli.StringFlag{
+		Name: "ovnkube-node-ovs-cpu-set",
+		Usage: "The list of CPUs, in linux CPU list format (e.g. 0-1,4), to pin ovs-vswitchd threads other " +
+			"than the PMD threads and ovsdb-server to. Can be overridden per node with the " +
+			"k8s.ovn.org/ovs-cpu-set node annotation",
+		Destination: &cliConfig.OvnKubeNode.OVSCPUSet,
+	},
+	&cli.StringFlag{
+		Name: "ovnkube-node-ovs-pmd-cpu-set",
+		Usage: "The list of CPUs, in linux CPU list format (e.g. 2-3), to run the PMD threads of the OVS " +
+			"userspace datapath on. Can be overridden per node with the k8s.ovn.org/ovs-pmd-cpu-set node annotation",
+		Destination: &cliConfig.OvnKubeNode.OVSPMDCPUSet,
+	},
 	&cli.BoolFlag{
 		Name:        "disable-ovn-iface-id-ver",
 		Usage:       "Deprecated; iface-id-ver is always enabled",
@@ -1616,6 +1763,36 @@ var ClusterManagerFlags = []cli.Flag{
 		Destination: &cliConfig.ClusterManager.V6TransitSwitchSubnet,
 		Value:       ClusterManager.V6TransitSwitchSubnet,
 	},
+	&cli.StringFlag{
+		Name: "cluster-manager-ipam-providers",
+		Usage: "A comma separated list of name=target pairs of external IPAM providers networks can " +
+			"refer to with their ipamProvider attribute to have their pod IPs allocated by it, where target is the " +
+			"gRPC target the provider plugin listens on (e.g. netbox=unix:///var/run/ovn-kubernetes/ipam/netbox.sock)",
+		Destination: &cliConfig.ClusterManager.RawIPAMProviders,
+	},
+	&cli.StringFlag{
+		Name: "cluster-manager-ipam-provider-ca-cert",
+		Usage: "The CA certificate to verify the certificates of the external IPAM providers with, securing " +
+			"the connections to the providers with TLS. Connections are not secured if not set.",
+		Destination: &cliConfig.ClusterManager.IPAMProviderCACert,
+	},
+	&cli.StringFlag{
+		Name:        "cluster-manager-ipam-provider-client-cert",
+		Usage:       "The client certificate to present to the external IPAM providers (optional)",
+		Destination: &cliConfig.ClusterManager.IPAMProviderClientCert,
+	},
+	&cli.StringFlag{
+		Name:        "cluster-manager-ipam-provider-client-key",
+		Usage:       "The private key of the client certificate to present to the external IPAM providers (optional)",
+		Destination: &cliConfig.ClusterManager.IPAMProviderClientKey,
+	},
+	&cli.IntFlag{
+		Name: "cluster-manager-node-id-offset",
+		Usage: "The offset added to the IDs allocated to the nodes. Clusters linked with a ClusterLink must " +
+			"use offsets at least 5000 apart so that their node IDs don't overlap",
+		Destination: &cliConfig.ClusterManager.NodeIDOffset,
+		Value:       ClusterManager.NodeIDOffset,
+	},
 }
 
 // Flags are general command-line flags. Apps should add these flags to their
@@ -1805,6 +1982,10 @@ func buildKubernetesConfig(exec kexec.Interface, cli, file *config, saPath strin
 		return fmt.Errorf("kubernetes service-cidrs is required")
 	}
 
+	if Kubernetes.ServiceIdleTimeout != 0 && Kubernetes.ServiceIdleTimeout < time.Minute {
+		return fmt.Errorf("kubernetes service-idle-timeout %v invalid: must be at least 1m", Kubernetes.ServiceIdleTimeout)
+	}
+
 	return nil
 }
 
@@ -1861,6 +2042,10 @@ func buildMetricsConfig(cli, file *config) error {
 		return err
 	}
 
+	if Metrics.OTLPTraceSampleRatio < 0 || Metrics.OTLPTraceSampleRatio > 1 {
+		return fmt.Errorf("invalid otlp-trace-sample-ratio %v: must be between 0 and 1", Metrics.OTLPTraceSampleRatio)
+	}
+
 	return nil
 }
 
@@ -1962,6 +2147,12 @@ func buildOVNKubernetesFeatureConfig(ctx *cli.Context, cli, file *config) error
 	if err := overrideFields(&OVNKubernetesFeature, &cli.OVNKubernetesFeature, &savedOVNKubernetesFeature); err != nil {
 		return err
 	}
+	if OVNKubernetesFeature.EgressIPReachabilityBFD && !OVNKubernetesFeature.EnableInterconnect {
+		return fmt.Errorf("egressip-reachability-bfd requires interconnect to be enabled")
+	}
+	if OVNKubernetesFeature.EnableClusterLink && !OVNKubernetesFeature.EnableInterconnect {
+		return fmt.Errorf("enable-cluster-link requires interconnect to be enabled")
+	}
 	return nil
 }
 
@@ -2124,9 +2315,43 @@ func completeClusterManagerConfig(allSubnets *ConfigSubnets) error {
 	}
 	allSubnets.Append(ConfigSubnetTransit, v4TransitCIDR)
 	allSubnets.Append(ConfigSubnetTransit, v6TransitCIDR)
+
+	ClusterManager.IPAMProviders, err = parseIPAMProviders(ClusterManager.RawIPAMProviders)
+	if err != nil {
+		return err
+	}
+	if (ClusterManager.IPAMProviderClientCert == "") != (ClusterManager.IPAMProviderClientKey == "") {
+		return fmt.Errorf("both the client certificate and key of the IPAM providers must be provided")
+	}
+	if ClusterManager.IPAMProviderClientCert != "" && ClusterManager.IPAMProviderCACert == "" {
+		return fmt.Errorf("the CA certificate of the IPAM providers must be provided to use a client certificate")
+	}
+
+	if ClusterManager.NodeIDOffset < 0 || ClusterManager.NodeIDOffset > MaxNodeIDOffset {
+		return fmt.Errorf("invalid node ID offset %d: must be between 0 and %d", ClusterManager.NodeIDOffset, MaxNodeIDOffset)
+	}
 	return nil
 }
 
+// parseIPAMProviders parses a comma separated list of name=target pairs
+func parseIPAMProviders(raw string) (map[string]string, error) {
+	providers := map[string]string{}
+	if raw == "" {
+		return providers, nil
+	}
+	for _, provider := range strings.Split(raw, ",") {
+		name, target, found := strings.Cut(strings.TrimSpace(provider), "=")
+		if !found || name == "" || target == "" {
+			return nil, fmt.Errorf("invalid IPAM provider %q, expected name=target", provider)
+		}
+		if _, ok := providers[name]; ok {
+			return nil, fmt.Errorf("duplicate IPAM provider %q", name)
+		}
+		providers[name] = target
+	}
+	return providers, nil
+}
+
 func buildDefaultConfig(cli, file *config) error {
 	if err := overrideFields(&Default, &file.Default, &savedDefault); err != nil {
 		return err
@@ -2735,5 +2960,12 @@ func buildOvnKubeNodeConfig(ctx *cli.Context, cli, file *config) error {
 	if OvnKubeNode.Mode == types.NodeModeDPUHost && OvnKubeNode.MgmtPortNetdev == "" && OvnKubeNode.MgmtPortDPResourceName == "" {
 		return fmt.Errorf("ovnkube-node-mgmt-port-netdev or ovnkube-node-mgmt-port-dp-resource-name must be provided")
 	}
+
+	if _, err := cpuset.Parse(OvnKubeNode.OVSCPUSet); err != nil {
+		return fmt.Errorf("invalid ovnkube-node-ovs-cpu-set %q: %w", OvnKubeNode.OVSCPUSet, err)
+	}
+	if _, err := cpuset.Parse(OvnKubeNode.OVSPMDCPUSet); err != nil {
+		return fmt.Errorf("invalid ovnkube-node-ovs-pmd-cpu-set %q: %w", OvnKubeNode.OVSPMDCPUSet, err)
+	}
 	return nil
 }
@@ -166,6 +166,9 @@ node-server-privkey=/path/to/node-metrics-private.key
 node-server-cert=/path/to/node-metrics.crt
 enable-config-duration=true
 enable-scale-metrics=true
+otlp-trace-endpoint=collector:4317
+otlp-trace-insecure=true
+otlp-trace-sample-ratio=0.5
 
 [logging]
 loglevel=5
@@ -653,6 +656,9 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Metrics.NodeServerCert).To(gomega.Equal("/path/to/node-metrics.crt"))
 			gomega.Expect(Metrics.EnableConfigDuration).To(gomega.Equal(true))
 			gomega.Expect(Metrics.EnableScaleMetrics).To(gomega.Equal(true))
+			gomega.Expect(Metrics.OTLPTraceEndpoint).To(gomega.Equal("collector:4317"))
+			gomega.Expect(Metrics.OTLPTraceInsecure).To(gomega.BeTrue())
+			gomega.Expect(Metrics.OTLPTraceSampleRatio).To(gomega.Equal(0.5))
 
 			gomega.Expect(OvnNorth.Scheme).To(gomega.Equal(OvnDBSchemeSSL))
 			gomega.Expect(OvnNorth.PrivKey).To(gomega.Equal("/path/to/nb-client-private.key"))
@@ -1246,6 +1252,51 @@ enable-pprof=true
 		gomega.Expect(ClusterManager.V4TransitSwitchSubnet).To(gomega.Equal("100.89.0.0/16"))
 		gomega.Expect(ClusterManager.V6TransitSwitchSubnet).To(gomega.Equal("fd99::/64"))
 	})
+	It("parses the external IPAM providers", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-cluster-manager-ipam-providers=netbox=unix:///var/run/netbox.sock, infoblox=dns:///infoblox:9000",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(ClusterManager.IPAMProviders).To(gomega.Equal(map[string]string{
+			"netbox":   "unix:///var/run/netbox.sock",
+			"infoblox": "dns:///infoblox:9000",
+		}))
+	})
+	It("returns an error when an external IPAM provider is invalid", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("invalid IPAM provider \"netbox\", expected name=target"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-cluster-manager-ipam-providers=netbox",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+	It("returns an error when the client certificate of the IPAM providers has no key", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("both the client certificate and key of the IPAM providers must be provided"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-cluster-manager-ipam-providers=netbox=dns:///netbox:9000",
+			"-cluster-manager-ipam-provider-ca-cert=/etc/ipam/ca.crt",
+			"-cluster-manager-ipam-provider-client-cert=/etc/ipam/tls.crt",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
 	It("overrides config file and defaults with CLI options (multi-master)", func() {
 		kubeconfigFile, _, err := createTempFile("kubeconfig")
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
@@ -1465,6 +1516,36 @@ enable-pprof=true
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	It("returns an error when egressip-reachability-bfd is set without interconnect", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("egressip-reachability-bfd requires interconnect to be enabled"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-egressip-reachability-bfd",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("allows enable-ipsec without a CA certificate for externally provisioned certificates", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(OVNKubernetesFeature.EnableIPsec).To(gomega.BeTrue())
+			gomega.Expect(OVNKubernetesFeature.IPsecCACert).To(gomega.BeEmpty())
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-enable-ipsec",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	It("rejects a cluster with IPv4 pods and IPv6 services", func() {
 		app.Action = func(ctx *cli.Context) error {
 			_, err := InitConfig(ctx, kexec.New(), nil)
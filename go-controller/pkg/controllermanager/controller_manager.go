@@ -21,6 +21,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/networkqos"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/udnenabledsvc"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/routeimport"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -62,6 +63,10 @@ type ControllerManager struct {
 
 	// eIPController programs OVN to support EgressIP
 	eIPController *ovn.EgressIPController
+
+	// networkQoSHandler handles the pod and node events for the NetworkQoS
+	// controllers of all the networks
+	networkQoSHandler *networkqos.PodNodeHandler
 }
 
 func (cm *ControllerManager) NewNetworkController(nInfo util.NetInfo) (networkmanager.NetworkController, error) {
@@ -209,6 +214,7 @@ func NewControllerManager(ovnClient *util.OVNClientset, wf *factory.WatchFactory
 			APBRouteClient:       ovnClient.AdminPolicyRouteClient,
 			EgressQoSClient:      ovnClient.EgressQoSClient,
 			IPAMClaimsClient:     ovnClient.IPAMClaimsClient,
+			NetworkQoSClient:     ovnClient.NetworkQoSClient,
 		},
 		stopChan:         stopCh,
 		watchFactory:     wf,
@@ -267,6 +273,17 @@ func (cm *ControllerManager) configureSvcTemplateSupport() {
 	}
 }
 
+// configureIPsec enables or disables IPsec encryption of east-west traffic as
+// configured, so that disabling it in the configuration disables it in OVN too.
+func (cm *ControllerManager) configureIPsec() error {
+	enabled := config.OVNKubernetesFeature.EnableIPsec
+	if err := libovsdbops.UpdateNBGlobalIPsec(cm.nbClient, enabled); err != nil {
+		return fmt.Errorf("failed to configure IPsec: %w", err)
+	}
+	klog.Infof("IPsec encryption enabled: %t", enabled)
+	return nil
+}
+
 func (cm *ControllerManager) configureMetrics(stopChan <-chan struct{}) {
 	metrics.RegisterOVNKubeControllerPerformance(cm.nbClient)
 	metrics.RegisterOVNKubeControllerFunctional(stopChan)
@@ -307,7 +324,7 @@ func (cm *ControllerManager) createACLLoggingMeter() error {
 // newCommonNetworkControllerInfo creates and returns the common networkController info
 func (cm *ControllerManager) newCommonNetworkControllerInfo(wf *factory.WatchFactory) (*ovn.CommonNetworkControllerInfo, error) {
 	return ovn.NewCommonNetworkControllerInfo(cm.client, cm.kube, wf, cm.recorder, cm.nbClient,
-		cm.sbClient, cm.podRecorder, cm.SCTPSupport, cm.multicastSupport, cm.svcTemplateSupport)
+		cm.sbClient, cm.podRecorder, cm.SCTPSupport, cm.multicastSupport, cm.svcTemplateSupport, cm.networkQoSHandler)
 }
 
 // initDefaultNetworkController creates the controller for default network
@@ -399,6 +416,11 @@ func (cm *ControllerManager) Start(ctx context.Context) error {
 
 	cm.configureSvcTemplateSupport()
 
+	err = cm.configureIPsec()
+	if err != nil {
+		return err
+	}
+
 	err = cm.createACLLoggingMeter()
 	if err != nil {
 		return nil
@@ -421,6 +443,13 @@ func (cm *ControllerManager) Start(ctx context.Context) error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableNetworkQoS {
+		cm.networkQoSHandler = networkqos.NewPodNodeHandler(zone, cm.watchFactory.PodCoreInformer(), cm.watchFactory.NodeCoreInformer())
+		if err = cm.networkQoSHandler.Start(); err != nil {
+			return fmt.Errorf("failed to start NetworkQoS pod and node handler: %w", err)
+		}
+	}
+
 	var observabilityManager *observability.Manager
 	if config.OVNKubernetesFeature.EnableObservability {
 		observabilityManager = observability.NewManager(cm.nbClient)
@@ -488,6 +517,10 @@ func (cm *ControllerManager) Stop() {
 	if cm.routeImportManager != nil {
 		cm.routeImportManager.Stop()
 	}
+
+	if cm.networkQoSHandler != nil {
+		cm.networkQoSHandler.Stop()
+	}
 }
 
 func (cm *ControllerManager) Reconcile(name string, old, new util.NetInfo) error {
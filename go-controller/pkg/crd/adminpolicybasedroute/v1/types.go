@@ -48,10 +48,14 @@ type AdminPolicyBasedExternalRouteSpec struct {
 	NextHops ExternalNextHops `json:"nextHops"`
 }
 
-// ExternalNetworkSource contains the selectors used to determine the namespaces where the policy will be applied to
+// ExternalNetworkSource contains the selectors used to determine the namespaces and pods where the policy will be applied to
 type ExternalNetworkSource struct {
 	// NamespaceSelector defines a selector to be used to determine which namespaces will be targeted by this CR
 	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// PodSelector defines a selector to filter the pods in the selected namespaces that will be targeted by this CR.
+	// When not set, all the pods in the selected namespaces are targeted.
+	// +optional
+	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
 }
 
 // +kubebuilder:validation:MinProperties:=1
@@ -76,6 +80,22 @@ type StaticHop struct {
 	// +kubebuilder:default:=false
 	// +default=false
 	BFDEnabled bool `json:"bfdEnabled,omitempty"`
+	// Weight defines the share of the traffic sent through this hop relative to the other hops with the same priority.
+	// A hop with weight 2 receives twice as much traffic as a hop with weight 1. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// +kubebuilder:default:=1
+	// +default=1
+	Weight int32 `json:"weight,omitempty"`
+	// Priority defines the priority of this hop. Only the hops with the highest priority are used as next hops, hops
+	// with a lower priority are only used when BFD reports all the hops with a higher priority as down. The hops with
+	// a higher priority need to have BFD enabled for this to take effect. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default:=0
+	// +default=0
+	Priority int32 `json:"priority,omitempty"`
 	// SkipHostSNAT determines whether to disable Source NAT to the host IP. Defaults to false.
 	// +optional
 	// +kubebuilder:default:=false
@@ -107,6 +127,22 @@ type DynamicHop struct {
 	// +kubebuilder:default:=false
 	// +default=false
 	BFDEnabled bool `json:"bfdEnabled,omitempty"`
+	// Weight defines the share of the traffic sent through this hop relative to the other hops with the same priority.
+	// A hop with weight 2 receives twice as much traffic as a hop with weight 1. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// +kubebuilder:default:=1
+	// +default=1
+	Weight int32 `json:"weight,omitempty"`
+	// Priority defines the priority of this hop. Only the hops with the highest priority are used as next hops, hops
+	// with a lower priority are only used when BFD reports all the hops with a higher priority as down. The hops with
+	// a higher priority need to have BFD enabled for this to take effect. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default:=0
+	// +default=0
+	Priority int32 `json:"priority,omitempty"`
 	// SkipHostSNAT determines whether to disable Source NAT to the host IP. Defaults to false
 	// +optional
 	// +kubebuilder:default:=false
@@ -147,6 +147,7 @@ func (in *DynamicHop) DeepCopy() *DynamicHop {
 func (in *ExternalNetworkSource) DeepCopyInto(out *ExternalNetworkSource) {
 	*out = *in
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
 	return
 }
 
@@ -0,0 +1,70 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// ClusterLinkStatusApplyConfiguration represents a declarative configuration of the ClusterLinkStatus type for use
+// with apply.
+type ClusterLinkStatusApplyConfiguration struct {
+	Status      *string                          `json:"status,omitempty"`
+	Conditions  []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	RemoteNodes []RemoteNodeApplyConfiguration   `json:"remoteNodes,omitempty"`
+}
+
+// ClusterLinkStatusApplyConfiguration constructs a declarative configuration of the ClusterLinkStatus type for use with
+// apply.
+func ClusterLinkStatus() *ClusterLinkStatusApplyConfiguration {
+	return &ClusterLinkStatusApplyConfiguration{}
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *ClusterLinkStatusApplyConfiguration) WithStatus(value string) *ClusterLinkStatusApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *ClusterLinkStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *ClusterLinkStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithRemoteNodes adds the given value to the RemoteNodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RemoteNodes field.
+func (b *ClusterLinkStatusApplyConfiguration) WithRemoteNodes(values ...*RemoteNodeApplyConfiguration) *ClusterLinkStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRemoteNodes")
+		}
+		b.RemoteNodes = append(b.RemoteNodes, *values[i])
+	}
+	return b
+}
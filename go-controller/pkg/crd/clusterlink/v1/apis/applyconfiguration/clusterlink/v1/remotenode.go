@@ -0,0 +1,89 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RemoteNodeApplyConfiguration represents a declarative configuration of the RemoteNode type for use
+// with apply.
+type RemoteNodeApplyConfiguration struct {
+	Name             *string  `json:"name,omitempty"`
+	NodeID           *int32   `json:"nodeID,omitempty"`
+	ChassisID        *string  `json:"chassisID,omitempty"`
+	EncapIPs         []string `json:"encapIPs,omitempty"`
+	TransitSwitchIPs []string `json:"transitSwitchIPs,omitempty"`
+	Subnets          []string `json:"subnets,omitempty"`
+}
+
+// RemoteNodeApplyConfiguration constructs a declarative configuration of the RemoteNode type for use with
+// apply.
+func RemoteNode() *RemoteNodeApplyConfiguration {
+	return &RemoteNodeApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RemoteNodeApplyConfiguration) WithName(value string) *RemoteNodeApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNodeID sets the NodeID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeID field is set to the value of the last call.
+func (b *RemoteNodeApplyConfiguration) WithNodeID(value int32) *RemoteNodeApplyConfiguration {
+	b.NodeID = &value
+	return b
+}
+
+// WithChassisID sets the ChassisID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ChassisID field is set to the value of the last call.
+func (b *RemoteNodeApplyConfiguration) WithChassisID(value string) *RemoteNodeApplyConfiguration {
+	b.ChassisID = &value
+	return b
+}
+
+// WithEncapIPs adds the given value to the EncapIPs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EncapIPs field.
+func (b *RemoteNodeApplyConfiguration) WithEncapIPs(values ...string) *RemoteNodeApplyConfiguration {
+	for i := range values {
+		b.EncapIPs = append(b.EncapIPs, values[i])
+	}
+	return b
+}
+
+// WithTransitSwitchIPs adds the given value to the TransitSwitchIPs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TransitSwitchIPs field.
+func (b *RemoteNodeApplyConfiguration) WithTransitSwitchIPs(values ...string) *RemoteNodeApplyConfiguration {
+	for i := range values {
+		b.TransitSwitchIPs = append(b.TransitSwitchIPs, values[i])
+	}
+	return b
+}
+
+// WithSubnets adds the given value to the Subnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Subnets field.
+func (b *RemoteNodeApplyConfiguration) WithSubnets(values ...string) *RemoteNodeApplyConfiguration {
+	for i := range values {
+		b.Subnets = append(b.Subnets, values[i])
+	}
+	return b
+}
@@ -0,0 +1,49 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	clusterlinkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/applyconfiguration/clusterlink/v1"
+	internal "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/applyconfiguration/internal"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("ClusterLink"):
+		return &clusterlinkv1.ClusterLinkApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterLinkSpec"):
+		return &clusterlinkv1.ClusterLinkSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterLinkStatus"):
+		return &clusterlinkv1.ClusterLinkStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RemoteNode"):
+		return &clusterlinkv1.RemoteNodeApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) *testing.TypeConverter {
+	return &testing.TypeConverter{Scheme: scheme, TypeResolver: internal.Parser()}
+}
@@ -0,0 +1,72 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	clusterlinkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/applyconfiguration/clusterlink/v1"
+	scheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ClusterLinksGetter has a method to return a ClusterLinkInterface.
+// A group's client should implement this interface.
+type ClusterLinksGetter interface {
+	ClusterLinks() ClusterLinkInterface
+}
+
+// ClusterLinkInterface has methods to work with ClusterLink resources.
+type ClusterLinkInterface interface {
+	Create(ctx context.Context, clusterLink *v1.ClusterLink, opts metav1.CreateOptions) (*v1.ClusterLink, error)
+	Update(ctx context.Context, clusterLink *v1.ClusterLink, opts metav1.UpdateOptions) (*v1.ClusterLink, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, clusterLink *v1.ClusterLink, opts metav1.UpdateOptions) (*v1.ClusterLink, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.ClusterLink, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.ClusterLinkList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.ClusterLink, err error)
+	Apply(ctx context.Context, clusterLink *clusterlinkv1.ClusterLinkApplyConfiguration, opts metav1.ApplyOptions) (result *v1.ClusterLink, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, clusterLink *clusterlinkv1.ClusterLinkApplyConfiguration, opts metav1.ApplyOptions) (result *v1.ClusterLink, err error)
+	ClusterLinkExpansion
+}
+
+// clusterLinks implements ClusterLinkInterface
+type clusterLinks struct {
+	*gentype.ClientWithListAndApply[*v1.ClusterLink, *v1.ClusterLinkList, *clusterlinkv1.ClusterLinkApplyConfiguration]
+}
+
+// newClusterLinks returns a ClusterLinks
+func newClusterLinks(c *K8sV1Client) *clusterLinks {
+	return &clusterLinks{
+		gentype.NewClientWithListAndApply[*v1.ClusterLink, *v1.ClusterLinkList, *clusterlinkv1.ClusterLinkApplyConfiguration](
+			"clusterlinks",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v1.ClusterLink { return &v1.ClusterLink{} },
+			func() *v1.ClusterLinkList { return &v1.ClusterLinkList{} }),
+	}
+}
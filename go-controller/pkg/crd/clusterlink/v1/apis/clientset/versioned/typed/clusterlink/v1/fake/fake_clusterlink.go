@@ -0,0 +1,185 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	clusterlinkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/applyconfiguration/clusterlink/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterLinks implements ClusterLinkInterface
+type FakeClusterLinks struct {
+	Fake *FakeK8sV1
+}
+
+var clusterlinksResource = v1.SchemeGroupVersion.WithResource("clusterlinks")
+
+var clusterlinksKind = v1.SchemeGroupVersion.WithKind("ClusterLink")
+
+// Get takes name of the clusterLink, and returns the corresponding clusterLink object, and an error if there is any.
+func (c *FakeClusterLinks) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.ClusterLink, err error) {
+	emptyResult := &v1.ClusterLink{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetActionWithOptions(clusterlinksResource, name, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.ClusterLink), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterLinks that match those selectors.
+func (c *FakeClusterLinks) List(ctx context.Context, opts metav1.ListOptions) (result *v1.ClusterLinkList, err error) {
+	emptyResult := &v1.ClusterLinkList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListActionWithOptions(clusterlinksResource, clusterlinksKind, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.ClusterLinkList{ListMeta: obj.(*v1.ClusterLinkList).ListMeta}
+	for _, item := range obj.(*v1.ClusterLinkList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterLinks.
+func (c *FakeClusterLinks) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchActionWithOptions(clusterlinksResource, opts))
+}
+
+// Create takes the representation of a clusterLink and creates it.  Returns the server's representation of the clusterLink, and an error, if there is any.
+func (c *FakeClusterLinks) Create(ctx context.Context, clusterLink *v1.ClusterLink, opts metav1.CreateOptions) (result *v1.ClusterLink, err error) {
+	emptyResult := &v1.ClusterLink{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateActionWithOptions(clusterlinksResource, clusterLink, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.ClusterLink), err
+}
+
+// Update takes the representation of a clusterLink and updates it. Returns the server's representation of the clusterLink, and an error, if there is any.
+func (c *FakeClusterLinks) Update(ctx context.Context, clusterLink *v1.ClusterLink, opts metav1.UpdateOptions) (result *v1.ClusterLink, err error) {
+	emptyResult := &v1.ClusterLink{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateActionWithOptions(clusterlinksResource, clusterLink, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.ClusterLink), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeClusterLinks) UpdateStatus(ctx context.Context, clusterLink *v1.ClusterLink, opts metav1.UpdateOptions) (result *v1.ClusterLink, err error) {
+	emptyResult := &v1.ClusterLink{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceActionWithOptions(clusterlinksResource, "status", clusterLink, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.ClusterLink), err
+}
+
+// Delete takes name of the clusterLink and deletes it. Returns an error if one occurs.
+func (c *FakeClusterLinks) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(clusterlinksResource, name, opts), &v1.ClusterLink{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterLinks) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionActionWithOptions(clusterlinksResource, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.ClusterLinkList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterLink.
+func (c *FakeClusterLinks) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.ClusterLink, err error) {
+	emptyResult := &v1.ClusterLink{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(clusterlinksResource, name, pt, data, opts, subresources...), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.ClusterLink), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied clusterLink.
+func (c *FakeClusterLinks) Apply(ctx context.Context, clusterLink *clusterlinkv1.ClusterLinkApplyConfiguration, opts metav1.ApplyOptions) (result *v1.ClusterLink, err error) {
+	if clusterLink == nil {
+		return nil, fmt.Errorf("clusterLink provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(clusterLink)
+	if err != nil {
+		return nil, err
+	}
+	name := clusterLink.Name
+	if name == nil {
+		return nil, fmt.Errorf("clusterLink.Name must be provided to Apply")
+	}
+	emptyResult := &v1.ClusterLink{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(clusterlinksResource, *name, types.ApplyPatchType, data, opts.ToPatchOptions()), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.ClusterLink), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeClusterLinks) ApplyStatus(ctx context.Context, clusterLink *clusterlinkv1.ClusterLinkApplyConfiguration, opts metav1.ApplyOptions) (result *v1.ClusterLink, err error) {
+	if clusterLink == nil {
+		return nil, fmt.Errorf("clusterLink provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(clusterLink)
+	if err != nil {
+		return nil, err
+	}
+	name := clusterLink.Name
+	if name == nil {
+		return nil, fmt.Errorf("clusterLink.Name must be provided to Apply")
+	}
+	emptyResult := &v1.ClusterLink{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(clusterlinksResource, *name, types.ApplyPatchType, data, opts.ToPatchOptions(), "status"), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.ClusterLink), err
+}
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusterlinks,scope=Cluster,shortName=cl,singular=clusterlink
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.status"
+// ClusterLink peers the pod network of the cluster with the pod network of a
+// remote ovn-kubernetes cluster over OVN interconnect. Both clusters must have
+// a ClusterLink to each other.
+type ClusterLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterLinkSpec   `json:"spec,omitempty"`
+	Status ClusterLinkStatus `json:"status,omitempty"`
+}
+
+// ClusterLinkSpec defines the desired state of ClusterLink
+type ClusterLinkSpec struct {
+	// kubeconfigSecret is the name of the Secret, in the namespace
+	// ovn-kubernetes runs in, holding in its 'kubeconfig' key the kubeconfig
+	// used to read the nodes of the remote cluster.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="kubeconfigSecret is immutable"
+	KubeconfigSecret string `json:"kubeconfigSecret"`
+}
+
+// ClusterLinkStatus defines the observed state of ClusterLink.
+type ClusterLinkStatus struct {
+	// status is a concise indication of whether the ClusterLink resource is
+	// applied with success.
+	// +kubebuilder:validation:Optional
+	Status string `json:"status,omitempty"`
+
+	// conditions is an array of condition objects indicating details about
+	// status of ClusterLink object.
+	// +kubebuilder:validation:Optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// remoteNodes are the nodes of the remote cluster the pod network of the
+	// cluster is connected to.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	RemoteNodes []RemoteNode `json:"remoteNodes,omitempty"`
+}
+
+// RemoteNode holds the interconnect information of a node of the remote
+// cluster.
+type RemoteNode struct {
+	// name is the name of the node in the remote cluster.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// nodeID is the ID allocated to the node in the remote cluster.
+	// +kubebuilder:validation:Required
+	NodeID int32 `json:"nodeID"`
+
+	// chassisID is the ID of the OVN chassis of the node.
+	// +kubebuilder:validation:Required
+	ChassisID string `json:"chassisID"`
+
+	// encapIPs are the tunnel endpoint IPs of the node.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	EncapIPs []string `json:"encapIPs"`
+
+	// transitSwitchIPs are the IPs of the node port on the transit switch.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	TransitSwitchIPs []string `json:"transitSwitchIPs"`
+
+	// subnets are the pod subnets of the node.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Subnets []string `json:"subnets"`
+}
+
+// ClusterLinkList contains a list of ClusterLink
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterLink `json:"items"`
+}
@@ -0,0 +1,51 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// EgressIPNodePreferenceApplyConfiguration represents a declarative configuration of the EgressIPNodePreference type for use
+// with apply.
+type EgressIPNodePreferenceApplyConfiguration struct {
+	EgressIPs []string `json:"egressIPs,omitempty"`
+	Nodes     []string `json:"nodes,omitempty"`
+}
+
+// EgressIPNodePreferenceApplyConfiguration constructs a declarative configuration of the EgressIPNodePreference type for use with
+// apply.
+func EgressIPNodePreference() *EgressIPNodePreferenceApplyConfiguration {
+	return &EgressIPNodePreferenceApplyConfiguration{}
+}
+
+// WithEgressIPs adds the given value to the EgressIPs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EgressIPs field.
+func (b *EgressIPNodePreferenceApplyConfiguration) WithEgressIPs(values ...string) *EgressIPNodePreferenceApplyConfiguration {
+	for i := range values {
+		b.EgressIPs = append(b.EgressIPs, values[i])
+	}
+	return b
+}
+
+// WithNodes adds the given value to the Nodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Nodes field.
+func (b *EgressIPNodePreferenceApplyConfiguration) WithNodes(values ...string) *EgressIPNodePreferenceApplyConfiguration {
+	for i := range values {
+		b.Nodes = append(b.Nodes, values[i])
+	}
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+)
+
+// EgressIPPreferenceStatusItemApplyConfiguration represents a declarative configuration of the EgressIPPreferenceStatusItem type for use
+// with apply.
+type EgressIPPreferenceStatusItemApplyConfiguration struct {
+	EgressIP *string                     `json:"egressIP,omitempty"`
+	State    *v1.EgressIPPreferenceState `json:"state,omitempty"`
+}
+
+// EgressIPPreferenceStatusItemApplyConfiguration constructs a declarative configuration of the EgressIPPreferenceStatusItem type for use with
+// apply.
+func EgressIPPreferenceStatusItem() *EgressIPPreferenceStatusItemApplyConfiguration {
+	return &EgressIPPreferenceStatusItemApplyConfiguration{}
+}
+
+// WithEgressIP sets the EgressIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EgressIP field is set to the value of the last call.
+func (b *EgressIPPreferenceStatusItemApplyConfiguration) WithEgressIP(value string) *EgressIPPreferenceStatusItemApplyConfiguration {
+	b.EgressIP = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *EgressIPPreferenceStatusItemApplyConfiguration) WithState(value v1.EgressIPPreferenceState) *EgressIPPreferenceStatusItemApplyConfiguration {
+	b.State = &value
+	return b
+}
@@ -18,15 +18,19 @@ limitations under the License.
 package v1
 
 import (
+	crdegressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // EgressIPSpecApplyConfiguration represents a declarative configuration of the EgressIPSpec type for use
 // with apply.
 type EgressIPSpecApplyConfiguration struct {
-	EgressIPs         []string                            `json:"egressIPs,omitempty"`
-	NamespaceSelector *v1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
-	PodSelector       *v1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	EgressIPs         []string                                   `json:"egressIPs,omitempty"`
+	NamespaceSelector *v1.LabelSelectorApplyConfiguration        `json:"namespaceSelector,omitempty"`
+	PodSelector       *v1.LabelSelectorApplyConfiguration        `json:"podSelector,omitempty"`
+	DestinationCIDRs  []string                                   `json:"destinationCIDRs,omitempty"`
+	NodePreferences   []EgressIPNodePreferenceApplyConfiguration `json:"nodePreferences,omitempty"`
+	FailoverPolicy    *crdegressipv1.EgressIPFailoverPolicy      `json:"failoverPolicy,omitempty"`
 }
 
 // EgressIPSpecApplyConfiguration constructs a declarative configuration of the EgressIPSpec type for use with
@@ -60,3 +64,34 @@ func (b *EgressIPSpecApplyConfiguration) WithPodSelector(value *v1.LabelSelector
 	b.PodSelector = value
 	return b
 }
+
+// WithDestinationCIDRs adds the given value to the DestinationCIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DestinationCIDRs field.
+func (b *EgressIPSpecApplyConfiguration) WithDestinationCIDRs(values ...string) *EgressIPSpecApplyConfiguration {
+	for i := range values {
+		b.DestinationCIDRs = append(b.DestinationCIDRs, values[i])
+	}
+	return b
+}
+
+// WithNodePreferences adds the given value to the NodePreferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodePreferences field.
+func (b *EgressIPSpecApplyConfiguration) WithNodePreferences(values ...*EgressIPNodePreferenceApplyConfiguration) *EgressIPSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodePreferences")
+		}
+		b.NodePreferences = append(b.NodePreferences, *values[i])
+	}
+	return b
+}
+
+// WithFailoverPolicy sets the FailoverPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailoverPolicy field is set to the value of the last call.
+func (b *EgressIPSpecApplyConfiguration) WithFailoverPolicy(value crdegressipv1.EgressIPFailoverPolicy) *EgressIPSpecApplyConfiguration {
+	b.FailoverPolicy = &value
+	return b
+}
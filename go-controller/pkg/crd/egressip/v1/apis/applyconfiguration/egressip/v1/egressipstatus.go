@@ -17,10 +17,16 @@ limitations under the License.
 
 package v1
 
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
 // EgressIPStatusApplyConfiguration represents a declarative configuration of the EgressIPStatus type for use
 // with apply.
 type EgressIPStatusApplyConfiguration struct {
-	Items []EgressIPStatusItemApplyConfiguration `json:"items,omitempty"`
+	Items       []EgressIPStatusItemApplyConfiguration           `json:"items,omitempty"`
+	Preferences []EgressIPPreferenceStatusItemApplyConfiguration `json:"preferences,omitempty"`
+	Conditions  []metav1.ConditionApplyConfiguration             `json:"conditions,omitempty"`
 }
 
 // EgressIPStatusApplyConfiguration constructs a declarative configuration of the EgressIPStatus type for use with
@@ -41,3 +47,29 @@ func (b *EgressIPStatusApplyConfiguration) WithItems(values ...*EgressIPStatusIt
 	}
 	return b
 }
+
+// WithPreferences adds the given value to the Preferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Preferences field.
+func (b *EgressIPStatusApplyConfiguration) WithPreferences(values ...*EgressIPPreferenceStatusItemApplyConfiguration) *EgressIPStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPreferences")
+		}
+		b.Preferences = append(b.Preferences, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *EgressIPStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *EgressIPStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -33,6 +33,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=k8s.ovn.org, Version=v1
 	case v1.SchemeGroupVersion.WithKind("EgressIP"):
 		return &egressipv1.EgressIPApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPNodePreference"):
+		return &egressipv1.EgressIPNodePreferenceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPPreferenceStatusItem"):
+		return &egressipv1.EgressIPPreferenceStatusItemApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressIPSpec"):
 		return &egressipv1.EgressIPSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressIPStatus"):
@@ -33,8 +33,34 @@ type EgressIP struct {
 type EgressIPStatus struct {
 	// The list of assigned egress IPs and their corresponding node assignment.
 	Items []EgressIPStatusItem `json:"items"`
+	// The preference state of the egress IPs which have preferred nodes.
+	// +optional
+	Preferences []EgressIPPreferenceStatusItem `json:"preferences,omitempty"`
+	// An array of condition objects indicating details about status of EgressIP object.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
+const (
+	// EgressIPAssignedCondition is true when all the egress IPs are assigned to
+	// a node. When false, its message lists the reason each unassigned egress
+	// IP could not be assigned.
+	EgressIPAssignedCondition = "Assigned"
+	// EgressIPReachableCondition is true when all the nodes the egress IPs are
+	// assigned to are reachable.
+	EgressIPReachableCondition = "Reachable"
+	// EgressIPProgrammedCondition is true when the egress IPs are programmed
+	// in all the zones.
+	EgressIPProgrammedCondition = "Programmed"
+	// EgressIPProgrammedInZoneConditionPrefix is the prefix of the per zone
+	// programmed conditions, followed by the zone name.
+	EgressIPProgrammedInZoneConditionPrefix = "Programmed-In-Zone-"
+)
+
 // The per node status, for those egress IPs who have been assigned.
 type EgressIPStatusItem struct {
 	// Assigned node name
@@ -43,6 +69,59 @@ type EgressIPStatusItem struct {
 	EgressIP string `json:"egressIP"`
 }
 
+// EgressIPPreferenceState is the preference state of an egress IP.
+type EgressIPPreferenceState string
+
+const (
+	// EgressIPPreferred is the state of an egress IP assigned to one of its
+	// preferred nodes.
+	EgressIPPreferred EgressIPPreferenceState = "Preferred"
+	// EgressIPFallback is the state of an egress IP assigned to a node which is
+	// not one of its preferred nodes.
+	EgressIPFallback EgressIPPreferenceState = "Fallback"
+	// EgressIPUnassigned is the state of an egress IP which is not assigned to
+	// any node.
+	EgressIPUnassigned EgressIPPreferenceState = "Unassigned"
+)
+
+// The per egress IP preference status, for those egress IPs who have preferred nodes.
+type EgressIPPreferenceStatusItem struct {
+	// Egress IP
+	EgressIP string `json:"egressIP"`
+	// Preference state of the egress IP
+	// +kubebuilder:validation:Enum=Preferred;Fallback;Unassigned
+	State EgressIPPreferenceState `json:"state"`
+}
+
+// EgressIPFailoverPolicy defines how egress IPs with preferred nodes are
+// assigned when none of their preferred nodes can host them.
+// +kubebuilder:validation:Enum=Fallback;NoFallback
+type EgressIPFailoverPolicy string
+
+const (
+	// EgressIPFailoverPolicyFallback assigns the egress IP to any other
+	// egress assignable node.
+	EgressIPFailoverPolicyFallback EgressIPFailoverPolicy = "Fallback"
+	// EgressIPFailoverPolicyNoFallback leaves the egress IP unassigned until
+	// one of its preferred nodes can host it.
+	EgressIPFailoverPolicyNoFallback EgressIPFailoverPolicy = "NoFallback"
+)
+
+// EgressIPNodePreference is an ordered list of preferred nodes for egress IPs.
+type EgressIPNodePreference struct {
+	// EgressIPs is the list of egress IP addresses this preference applies to.
+	// When not set, the preference applies to all the egress IPs of the
+	// EgressIP that no other preference applies to.
+	// +optional
+	EgressIPs []string `json:"egressIPs,omitempty"`
+	// Nodes is the list of preferred node names, in decreasing order of
+	// priority. An egress IP is assigned to the first node of the list which
+	// can host it, and is moved back to a node of higher priority as soon as
+	// that node can host it again.
+	// +kubebuilder:validation:MinItems=1
+	Nodes []string `json:"nodes"`
+}
+
 // EgressIPSpec is a desired state description of EgressIP.
 type EgressIPSpec struct {
 	// EgressIPs is the list of egress IP addresses requested. Can be IPv4 and/or IPv6.
@@ -60,6 +139,24 @@ type EgressIPSpec struct {
 	// match this pod selector.
 	// +optional
 	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+	// DestinationCIDRs restricts the egress IPs to the traffic destined to
+	// these CIDRs, any other traffic leaves the cluster with the IP of the node
+	// the pod runs on. When not set, the egress IPs apply to all the traffic
+	// leaving the cluster. Can be IPv4 and/or IPv6, an egress IP only applies
+	// to the destination CIDRs of its IP family.
+	// +kubebuilder:validation:items:Format=cidr
+	// +optional
+	DestinationCIDRs []string `json:"destinationCIDRs,omitempty"`
+	// NodePreferences specifies the preferred nodes of the egress IPs. Egress
+	// IPs without preferred nodes are assigned to the egress assignable node
+	// with the least egress IPs assigned.
+	// +optional
+	NodePreferences []EgressIPNodePreference `json:"nodePreferences,omitempty"`
+	// FailoverPolicy defines how egress IPs with preferred nodes are assigned
+	// when none of their preferred nodes can host them. When not set, defaults
+	// to Fallback.
+	// +optional
+	FailoverPolicy EgressIPFailoverPolicy `json:"failoverPolicy,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -28,6 +28,7 @@ type EgressServiceSpecApplyConfiguration struct {
 	SourceIPBy   *v1.SourceIPMode                        `json:"sourceIPBy,omitempty"`
 	NodeSelector *metav1.LabelSelectorApplyConfiguration `json:"nodeSelector,omitempty"`
 	Network      *string                                 `json:"network,omitempty"`
+	HostCount    *int32                                  `json:"hostCount,omitempty"`
 }
 
 // EgressServiceSpecApplyConfiguration constructs a declarative configuration of the EgressServiceSpec type for use with
@@ -59,3 +60,11 @@ func (b *EgressServiceSpecApplyConfiguration) WithNetwork(value string) *EgressS
 	b.Network = &value
 	return b
 }
+
+// WithHostCount sets the HostCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HostCount field is set to the value of the last call.
+func (b *EgressServiceSpecApplyConfiguration) WithHostCount(value int32) *EgressServiceSpecApplyConfiguration {
+	b.HostCount = &value
+	return b
+}
@@ -20,7 +20,8 @@ package v1
 // EgressServiceStatusApplyConfiguration represents a declarative configuration of the EgressServiceStatus type for use
 // with apply.
 type EgressServiceStatusApplyConfiguration struct {
-	Host *string `json:"host,omitempty"`
+	Host  *string  `json:"host,omitempty"`
+	Hosts []string `json:"hosts,omitempty"`
 }
 
 // EgressServiceStatusApplyConfiguration constructs a declarative configuration of the EgressServiceStatus type for use with
@@ -36,3 +37,13 @@ func (b *EgressServiceStatusApplyConfiguration) WithHost(value string) *EgressSe
 	b.Host = &value
 	return b
 }
+
+// WithHosts adds the given value to the Hosts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Hosts field.
+func (b *EgressServiceStatusApplyConfiguration) WithHosts(values ...string) *EgressServiceStatusApplyConfiguration {
+	for i := range values {
+		b.Hosts = append(b.Hosts, values[i])
+	}
+	return b
+}
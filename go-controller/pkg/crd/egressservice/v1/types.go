@@ -62,6 +62,15 @@ type EgressServiceSpec struct {
 	// of a routing table which by omission uses the default host routing.
 	// +optional
 	Network string `json:"network,omitempty"`
+
+	// The number of nodes selected to handle the service's traffic when sourceIPBy=LoadBalancerIP.
+	// When greater than 1, the egress traffic of the service is spread with ECMP across
+	// all of the selected nodes, each of them using the LoadBalancer ingress IP as the source IP.
+	// Fewer nodes are selected when not enough nodes match the nodeSelector.
+	// When it is not specified a single node is selected.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	HostCount int32 `json:"hostCount,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=LoadBalancerIP;Network
@@ -80,6 +89,12 @@ type EgressServiceStatus struct {
 	// The name of the node selected to handle the service's traffic.
 	// In case sourceIPBy=Network the field will be set to "ALL".
 	Host string `json:"host"`
+
+	// The names of all of the nodes selected to handle the service's traffic,
+	// host being the first of them.
+	// Empty when no node is selected or sourceIPBy=Network.
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,56 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// NetworkQoSRuleApplyConfiguration represents a declarative configuration of the NetworkQoSRule type for use
+// with apply.
+type NetworkQoSRuleApplyConfiguration struct {
+	DSCP       *int                          `json:"dscp,omitempty"`
+	Bandwidth  *BandwidthApplyConfiguration  `json:"bandwidth,omitempty"`
+	Classifier *ClassifierApplyConfiguration `json:"classifier,omitempty"`
+}
+
+// NetworkQoSRuleApplyConfiguration constructs a declarative configuration of the NetworkQoSRule type for use with
+// apply.
+func NetworkQoSRule() *NetworkQoSRuleApplyConfiguration {
+	return &NetworkQoSRuleApplyConfiguration{}
+}
+
+// WithDSCP sets the DSCP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DSCP field is set to the value of the last call.
+func (b *NetworkQoSRuleApplyConfiguration) WithDSCP(value int) *NetworkQoSRuleApplyConfiguration {
+	b.DSCP = &value
+	return b
+}
+
+// WithBandwidth sets the Bandwidth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bandwidth field is set to the value of the last call.
+func (b *NetworkQoSRuleApplyConfiguration) WithBandwidth(value *BandwidthApplyConfiguration) *NetworkQoSRuleApplyConfiguration {
+	b.Bandwidth = value
+	return b
+}
+
+// WithClassifier sets the Classifier field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Classifier field is set to the value of the last call.
+func (b *NetworkQoSRuleApplyConfiguration) WithClassifier(value *ClassifierApplyConfiguration) *NetworkQoSRuleApplyConfiguration {
+	b.Classifier = value
+	return b
+}
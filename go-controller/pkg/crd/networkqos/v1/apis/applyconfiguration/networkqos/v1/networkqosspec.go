@@ -0,0 +1,74 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// NetworkQoSSpecApplyConfiguration represents a declarative configuration of the NetworkQoSSpec type for use
+// with apply.
+type NetworkQoSSpecApplyConfiguration struct {
+	NetworkAttachmentName *string                             `json:"networkAttachmentName,omitempty"`
+	PodSelector           *v1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	Priority              *int                                `json:"priority,omitempty"`
+	Egress                []NetworkQoSRuleApplyConfiguration  `json:"egress,omitempty"`
+}
+
+// NetworkQoSSpecApplyConfiguration constructs a declarative configuration of the NetworkQoSSpec type for use with
+// apply.
+func NetworkQoSSpec() *NetworkQoSSpecApplyConfiguration {
+	return &NetworkQoSSpecApplyConfiguration{}
+}
+
+// WithNetworkAttachmentName sets the NetworkAttachmentName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkAttachmentName field is set to the value of the last call.
+func (b *NetworkQoSSpecApplyConfiguration) WithNetworkAttachmentName(value string) *NetworkQoSSpecApplyConfiguration {
+	b.NetworkAttachmentName = &value
+	return b
+}
+
+// WithPodSelector sets the PodSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSelector field is set to the value of the last call.
+func (b *NetworkQoSSpecApplyConfiguration) WithPodSelector(value *v1.LabelSelectorApplyConfiguration) *NetworkQoSSpecApplyConfiguration {
+	b.PodSelector = value
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *NetworkQoSSpecApplyConfiguration) WithPriority(value int) *NetworkQoSSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
+// WithEgress adds the given value to the Egress field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Egress field.
+func (b *NetworkQoSSpecApplyConfiguration) WithEgress(values ...*NetworkQoSRuleApplyConfiguration) *NetworkQoSSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEgress")
+		}
+		b.Egress = append(b.Egress, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1"
+	internal "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/applyconfiguration/internal"
+	networkqosv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/applyconfiguration/networkqos/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("Bandwidth"):
+		return &networkqosv1.BandwidthApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Classifier"):
+		return &networkqosv1.ClassifierApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Destination"):
+		return &networkqosv1.DestinationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkQoS"):
+		return &networkqosv1.NetworkQoSApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkQoSRule"):
+		return &networkqosv1.NetworkQoSRuleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkQoSSpec"):
+		return &networkqosv1.NetworkQoSSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkQoSStatus"):
+		return &networkqosv1.NetworkQoSStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Port"):
+		return &networkqosv1.PortApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) *testing.TypeConverter {
+	return &testing.TypeConverter{Scheme: scheme, TypeResolver: internal.Parser()}
+}
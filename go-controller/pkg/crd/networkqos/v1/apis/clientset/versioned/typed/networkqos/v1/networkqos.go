@@ -0,0 +1,72 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1"
+	networkqosv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/applyconfiguration/networkqos/v1"
+	scheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// NetworkQoSesGetter has a method to return a NetworkQoSInterface.
+// A group's client should implement this interface.
+type NetworkQoSesGetter interface {
+	NetworkQoSes(namespace string) NetworkQoSInterface
+}
+
+// NetworkQoSInterface has methods to work with NetworkQoS resources.
+type NetworkQoSInterface interface {
+	Create(ctx context.Context, networkQoS *v1.NetworkQoS, opts metav1.CreateOptions) (*v1.NetworkQoS, error)
+	Update(ctx context.Context, networkQoS *v1.NetworkQoS, opts metav1.UpdateOptions) (*v1.NetworkQoS, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, networkQoS *v1.NetworkQoS, opts metav1.UpdateOptions) (*v1.NetworkQoS, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.NetworkQoS, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.NetworkQoSList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.NetworkQoS, err error)
+	Apply(ctx context.Context, networkQoS *networkqosv1.NetworkQoSApplyConfiguration, opts metav1.ApplyOptions) (result *v1.NetworkQoS, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, networkQoS *networkqosv1.NetworkQoSApplyConfiguration, opts metav1.ApplyOptions) (result *v1.NetworkQoS, err error)
+	NetworkQoSExpansion
+}
+
+// networkQoSes implements NetworkQoSInterface
+type networkQoSes struct {
+	*gentype.ClientWithListAndApply[*v1.NetworkQoS, *v1.NetworkQoSList, *networkqosv1.NetworkQoSApplyConfiguration]
+}
+
+// newNetworkQoSes returns a NetworkQoSes
+func newNetworkQoSes(c *K8sV1Client, namespace string) *networkQoSes {
+	return &networkQoSes{
+		gentype.NewClientWithListAndApply[*v1.NetworkQoS, *v1.NetworkQoSList, *networkqosv1.NetworkQoSApplyConfiguration](
+			"networkqoses",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1.NetworkQoS { return &v1.NetworkQoS{} },
+			func() *v1.NetworkQoSList { return &v1.NetworkQoSList{} }),
+	}
+}
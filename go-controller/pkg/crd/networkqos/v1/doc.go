@@ -0,0 +1,4 @@
+// Package v1 contains API Schema definitions for the NetworkQoS v1 API group
+// +k8s:deepcopy-gen=package
+// +groupName=k8s.ovn.org
+package v1
@@ -0,0 +1,166 @@
+package v1
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=networkqoses,shortName=nqos,singular=networkqos
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.status"
+// +kubebuilder:subresource:status
+// NetworkQoS marks with a DSCP value and/or rate limits the egress traffic
+// of the pods of its namespace, on the cluster default network or on a user
+// defined network. Traffic from the selected pods is checked against each rule
+// of the NetworkQoS in order, and the first matching rule applies.
+type NetworkQoS struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkQoSSpec   `json:"spec,omitempty"`
+	Status NetworkQoSStatus `json:"status,omitempty"`
+}
+
+// NetworkQoSSpec defines the desired state of NetworkQoS
+type NetworkQoSSpec struct {
+	// networkAttachmentName is the name of the NetworkAttachmentDefinition,
+	// in the namespace of the NetworkQoS, of the network the rules apply on.
+	// For a UserDefinedNetwork or a ClusterUserDefinedNetwork, it is the name
+	// of the network.
+	// This field is optional, and in case it is not set the rules apply on
+	// the primary network of the namespace: its primary UserDefinedNetwork if
+	// it has one, the cluster default network otherwise.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	NetworkAttachmentName string `json:"networkAttachmentName,omitempty"`
+
+	// podSelector applies the NetworkQoS only to the pods in the namespace
+	// whose label matches this definition. This field is optional, and in case
+	// it is not set results in the NetworkQoS being applied to all pods in the
+	// namespace.
+	// +optional
+	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// priority of the NetworkQoS. When the rules of several NetworkQoSes match
+	// the same traffic, the rules of the NetworkQoS with the highest priority
+	// apply. Which rule applies between NetworkQoSes of the same priority is
+	// undefined.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=100
+	Priority int `json:"priority"`
+
+	// egress is the ordered list of rules applied to the egress traffic of
+	// the selected pods.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	Egress []NetworkQoSRule `json:"egress"`
+}
+
+// NetworkQoSRule classifies traffic and sets the actions applied to it
+// +kubebuilder:validation:XValidation:rule="has(self.dscp) || has(self.bandwidth)",message="at least one of dscp or bandwidth must be set"
+type NetworkQoSRule struct {
+	// dscp marking value for the matching traffic.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=63
+	DSCP *int `json:"dscp,omitempty"`
+
+	// bandwidth limits the rate of the matching traffic.
+	// +optional
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+
+	// classifier selects the traffic the rule applies to. This field is
+	// optional, and in case it is not set the rule applies to all the egress
+	// traffic of the selected pods.
+	// +optional
+	Classifier Classifier `json:"classifier,omitempty"`
+}
+
+// Bandwidth is the rate limit of the traffic matching a rule. The limit is
+// enforced on each node for the aggregated traffic of the selected pods
+// running on that node.
+type Bandwidth struct {
+	// rate is the maximum rate of the traffic, in kbps.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=4294967295
+	Rate int64 `json:"rate"`
+
+	// burst is the maximum burst size of the traffic, in kilobits.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=4294967295
+	Burst int64 `json:"burst,omitempty"`
+}
+
+// Classifier selects traffic by destination
+type Classifier struct {
+	// to is the list of destinations of the traffic. Traffic to any of the
+	// destinations matches. This field is optional, and in case it is not set
+	// traffic to any destination matches.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	To []Destination `json:"to,omitempty"`
+
+	// ports is the list of destination protocols and ports of the traffic.
+	// Traffic to any of the ports matches. This field is optional, and in case
+	// it is not set traffic to any protocol and port matches.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	Ports []Port `json:"ports,omitempty"`
+}
+
+// Destination of the traffic matching a rule
+type Destination struct {
+	// ipBlock is the CIDR the destination IP of the traffic must belong to,
+	// with optional exceptions.
+	IPBlock networkingv1.IPBlock `json:"ipBlock"`
+}
+
+// Port is a destination protocol and port of the traffic matching a rule
+type Port struct {
+	// protocol (TCP, UDP, SCTP) of the traffic.
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP
+	Protocol string `json:"protocol"`
+
+	// port is the destination port of the traffic. This field is optional,
+	// and in case it is not set traffic to any port of the protocol matches.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	Port *int32 `json:"port,omitempty"`
+}
+
+// NetworkQoSStatus defines the observed state of NetworkQoS
+type NetworkQoSStatus struct {
+	// A concise indication of whether the NetworkQoS resource is applied with
+	// success in all the zones.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// An array of condition objects indicating details about the status of the
+	// NetworkQoS in each zone.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=networkqoses
+// +kubebuilder::singular=networkqos
+// NetworkQoSList contains a list of NetworkQoS
+type NetworkQoSList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkQoS `json:"items"`
+}
+
+const (
+	// NetworkQoSReadyInZoneConditionPrefix is the prefix of the type of the
+	// conditions reporting whether the NetworkQoS is applied in a zone
+	NetworkQoSReadyInZoneConditionPrefix = "Ready-In-Zone-"
+)
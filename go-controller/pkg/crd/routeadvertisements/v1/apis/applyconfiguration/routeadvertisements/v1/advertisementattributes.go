@@ -0,0 +1,73 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+)
+
+// AdvertisementAttributesApplyConfiguration represents a declarative configuration of the AdvertisementAttributes type for use
+// with apply.
+type AdvertisementAttributesApplyConfiguration struct {
+	Advertisement    *v1.AdvertisementType `json:"advertisement,omitempty"`
+	Communities      []string              `json:"communities,omitempty"`
+	LargeCommunities []string              `json:"largeCommunities,omitempty"`
+	LocalPreference  *int64                `json:"localPreference,omitempty"`
+}
+
+// AdvertisementAttributesApplyConfiguration constructs a declarative configuration of the AdvertisementAttributes type for use with
+// apply.
+func AdvertisementAttributes() *AdvertisementAttributesApplyConfiguration {
+	return &AdvertisementAttributesApplyConfiguration{}
+}
+
+// WithAdvertisement sets the Advertisement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Advertisement field is set to the value of the last call.
+func (b *AdvertisementAttributesApplyConfiguration) WithAdvertisement(value v1.AdvertisementType) *AdvertisementAttributesApplyConfiguration {
+	b.Advertisement = &value
+	return b
+}
+
+// WithCommunities adds the given value to the Communities field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Communities field.
+func (b *AdvertisementAttributesApplyConfiguration) WithCommunities(values ...string) *AdvertisementAttributesApplyConfiguration {
+	for i := range values {
+		b.Communities = append(b.Communities, values[i])
+	}
+	return b
+}
+
+// WithLargeCommunities adds the given value to the LargeCommunities field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the LargeCommunities field.
+func (b *AdvertisementAttributesApplyConfiguration) WithLargeCommunities(values ...string) *AdvertisementAttributesApplyConfiguration {
+	for i := range values {
+		b.LargeCommunities = append(b.LargeCommunities, values[i])
+	}
+	return b
+}
+
+// WithLocalPreference sets the LocalPreference field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LocalPreference field is set to the value of the last call.
+func (b *AdvertisementAttributesApplyConfiguration) WithLocalPreference(value int64) *AdvertisementAttributesApplyConfiguration {
+	b.LocalPreference = &value
+	return b
+}
@@ -0,0 +1,73 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+)
+
+// ImportPolicyApplyConfiguration represents a declarative configuration of the ImportPolicy type for use
+// with apply.
+type ImportPolicyApplyConfiguration struct {
+	AllowedPrefixes  []v1.CIDR `json:"allowedPrefixes,omitempty"`
+	DeniedPrefixes   []v1.CIDR `json:"deniedPrefixes,omitempty"`
+	MaxRoutes        *int32    `json:"maxRoutes,omitempty"`
+	DefaultRouteOnly *bool     `json:"defaultRouteOnly,omitempty"`
+}
+
+// ImportPolicyApplyConfiguration constructs a declarative configuration of the ImportPolicy type for use with
+// apply.
+func ImportPolicy() *ImportPolicyApplyConfiguration {
+	return &ImportPolicyApplyConfiguration{}
+}
+
+// WithAllowedPrefixes adds the given value to the AllowedPrefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedPrefixes field.
+func (b *ImportPolicyApplyConfiguration) WithAllowedPrefixes(values ...v1.CIDR) *ImportPolicyApplyConfiguration {
+	for i := range values {
+		b.AllowedPrefixes = append(b.AllowedPrefixes, values[i])
+	}
+	return b
+}
+
+// WithDeniedPrefixes adds the given value to the DeniedPrefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DeniedPrefixes field.
+func (b *ImportPolicyApplyConfiguration) WithDeniedPrefixes(values ...v1.CIDR) *ImportPolicyApplyConfiguration {
+	for i := range values {
+		b.DeniedPrefixes = append(b.DeniedPrefixes, values[i])
+	}
+	return b
+}
+
+// WithMaxRoutes sets the MaxRoutes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRoutes field is set to the value of the last call.
+func (b *ImportPolicyApplyConfiguration) WithMaxRoutes(value int32) *ImportPolicyApplyConfiguration {
+	b.MaxRoutes = &value
+	return b
+}
+
+// WithDefaultRouteOnly sets the DefaultRouteOnly field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultRouteOnly field is set to the value of the last call.
+func (b *ImportPolicyApplyConfiguration) WithDefaultRouteOnly(value bool) *ImportPolicyApplyConfiguration {
+	b.DefaultRouteOnly = &value
+	return b
+}
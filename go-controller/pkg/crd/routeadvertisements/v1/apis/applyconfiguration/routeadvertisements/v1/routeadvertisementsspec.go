@@ -25,11 +25,13 @@ import (
 // RouteAdvertisementsSpecApplyConfiguration represents a declarative configuration of the RouteAdvertisementsSpec type for use
 // with apply.
 type RouteAdvertisementsSpecApplyConfiguration struct {
-	TargetVRF                *string                                   `json:"targetVRF,omitempty"`
-	NetworkSelector          *v1.LabelSelectorApplyConfiguration       `json:"networkSelector,omitempty"`
-	NodeSelector             *v1.LabelSelectorApplyConfiguration       `json:"nodeSelector,omitempty"`
-	FRRConfigurationSelector *v1.LabelSelectorApplyConfiguration       `json:"frrConfigurationSelector,omitempty"`
-	Advertisements           []routeadvertisementsv1.AdvertisementType `json:"advertisements,omitempty"`
+	TargetVRF                *string                                     `json:"targetVRF,omitempty"`
+	NetworkSelector          *v1.LabelSelectorApplyConfiguration         `json:"networkSelector,omitempty"`
+	NodeSelector             *v1.LabelSelectorApplyConfiguration         `json:"nodeSelector,omitempty"`
+	FRRConfigurationSelector *v1.LabelSelectorApplyConfiguration         `json:"frrConfigurationSelector,omitempty"`
+	Advertisements           []routeadvertisementsv1.AdvertisementType   `json:"advertisements,omitempty"`
+	AdvertisementAttributes  []AdvertisementAttributesApplyConfiguration `json:"advertisementAttributes,omitempty"`
+	ImportPolicy             *ImportPolicyApplyConfiguration             `json:"importPolicy,omitempty"`
 }
 
 // RouteAdvertisementsSpecApplyConfiguration constructs a declarative configuration of the RouteAdvertisementsSpec type for use with
@@ -79,3 +81,24 @@ func (b *RouteAdvertisementsSpecApplyConfiguration) WithAdvertisements(values ..
 	}
 	return b
 }
+
+// WithAdvertisementAttributes adds the given value to the AdvertisementAttributes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdvertisementAttributes field.
+func (b *RouteAdvertisementsSpecApplyConfiguration) WithAdvertisementAttributes(values ...*AdvertisementAttributesApplyConfiguration) *RouteAdvertisementsSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdvertisementAttributes")
+		}
+		b.AdvertisementAttributes = append(b.AdvertisementAttributes, *values[i])
+	}
+	return b
+}
+
+// WithImportPolicy sets the ImportPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImportPolicy field is set to the value of the last call.
+func (b *RouteAdvertisementsSpecApplyConfiguration) WithImportPolicy(value *ImportPolicyApplyConfiguration) *RouteAdvertisementsSpecApplyConfiguration {
+	b.ImportPolicy = value
+	return b
+}
@@ -31,6 +31,10 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("AdvertisementAttributes"):
+		return &routeadvertisementsv1.AdvertisementAttributesApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ImportPolicy"):
+		return &routeadvertisementsv1.ImportPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RouteAdvertisements"):
 		return &routeadvertisementsv1.RouteAdvertisementsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RouteAdvertisementsSpec"):
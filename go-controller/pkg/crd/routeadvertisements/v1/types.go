@@ -24,6 +24,7 @@ type RouteAdvertisements struct {
 
 // RouteAdvertisementsSpec defines the desired state of RouteAdvertisements
 // +kubebuilder:validation:XValidation:rule="!has(self.nodeSelector) || !('PodNetwork' in self.advertisements)",message="If 'PodNetwork' is selected for advertisement, a 'nodeSelector' can't be specified as it needs to be advertised on all nodes"
+// +kubebuilder:validation:XValidation:rule="!has(self.advertisementAttributes) || self.advertisementAttributes.all(x, x.advertisement in self.advertisements)",message="'advertisementAttributes' can only be specified for selected advertisements"
 type RouteAdvertisementsSpec struct {
 	// targetVRF determines which VRF the routes should be advertised in.
 	// +kubebuilder:validation:Optional
@@ -45,13 +46,102 @@ type RouteAdvertisementsSpec struct {
 	// advertisements determines what is advertised.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:MaxItems=5
 	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, x == y))"
 	Advertisements []AdvertisementType `json:"advertisements,omitempty"`
+
+	// advertisementAttributes determines the BGP attributes set on the
+	// prefixes advertised for each advertisement type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=5
+	// +listType=map
+	// +listMapKey=advertisement
+	AdvertisementAttributes []AdvertisementAttributes `json:"advertisementAttributes,omitempty"`
+
+	// importPolicy determines which of the routes learned through BGP on the
+	// target VRF are imported into the selected networks. Only applies if
+	// 'PodNetwork' is selected for advertisement. When omitted, all learned
+	// routes are imported, unless other RouteAdvertisements selecting the same
+	// networks specify an import policy, in which case their policies are
+	// merged and apply.
+	// +kubebuilder:validation:Optional
+	ImportPolicy *ImportPolicy `json:"importPolicy,omitempty"`
+}
+
+// ImportPolicy determines which of the routes learned through BGP are
+// imported into a network. The policy is not selected per network: when
+// multiple RouteAdvertisements selecting the same network on a node specify an
+// import policy, their policies are merged for that network. A route allowed
+// by any of them is allowed, and a policy without allowed prefixes allows all
+// routes; a route denied by any of them is denied. The lowest maxRoutes
+// applies and so does defaultRouteOnly if any of them sets it.
+// RouteAdvertisements that don't specify an import policy do not take part in
+// the merge.
+type ImportPolicy struct {
+	// allowedPrefixes restricts the imported routes to those with a
+	// destination contained in any of these prefixes. When omitted, routes with
+	// any destination are allowed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	AllowedPrefixes []CIDR `json:"allowedPrefixes,omitempty"`
+
+	// deniedPrefixes excludes from import the routes with a destination
+	// contained in any of these prefixes, even if allowed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	DeniedPrefixes []CIDR `json:"deniedPrefixes,omitempty"`
+
+	// maxRoutes is the maximum number of routes imported into a network on each
+	// node. While exceeded, no additional routes are imported but routes that
+	// are no longer learned are still removed. When omitted, the number of
+	// imported routes is not limited.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRoutes *int32 `json:"maxRoutes,omitempty"`
+
+	// defaultRouteOnly restricts the imported routes to default routes.
+	// +kubebuilder:validation:Optional
+	DefaultRouteOnly bool `json:"defaultRouteOnly,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="isCIDR(self)", message="CIDR is invalid"
+// +kubebuilder:validation:MaxLength=43
+type CIDR string
+
+// AdvertisementAttributes determines the BGP attributes set on the prefixes
+// advertised for an advertisement type.
+type AdvertisementAttributes struct {
+	// advertisement is the advertisement type these attributes apply to. It
+	// must be one of the selected advertisements.
+	// +kubebuilder:validation:Required
+	Advertisement AdvertisementType `json:"advertisement"`
+
+	// communities are the standard BGP communities, in the
+	// '<AS number>:<community value>' format, set on the advertised prefixes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^[0-9]{1,5}:[0-9]{1,5}$`
+	Communities []string `json:"communities,omitempty"`
+
+	// largeCommunities are the large BGP communities, in the
+	// '<global administrator>:<local data part 1>:<local data part 2>' format,
+	// set on the advertised prefixes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^[0-9]{1,10}:[0-9]{1,10}:[0-9]{1,10}$`
+	LargeCommunities []string `json:"largeCommunities,omitempty"`
+
+	// localPreference is the BGP local preference set on the advertised
+	// prefixes. Prefixes advertised for multiple advertisement types can't be
+	// set different local preferences.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	LocalPreference *int64 `json:"localPreference,omitempty"`
 }
 
 // AdvertisementType determines the type of advertisement.
-// +kubebuilder:validation:Enum=PodNetwork;EgressIP
+// +kubebuilder:validation:Enum=PodNetwork;EgressIP;LoadBalancerIP;ExternalIP;ClusterIP
 type AdvertisementType string
 
 const (
@@ -60,6 +150,21 @@ const (
 
 	// EgressIP determines that egress IPs are being advertised.
 	EgressIP AdvertisementType = "EgressIP"
+
+	// LoadBalancerIP determines that the load balancer ingress IPs of
+	// LoadBalancer services are advertised. If the service has
+	// externalTrafficPolicy set to Local, they are only advertised from the
+	// nodes that have local endpoints for it.
+	LoadBalancerIP AdvertisementType = "LoadBalancerIP"
+
+	// ExternalIP determines that the external IPs of services are advertised.
+	// If the service has externalTrafficPolicy set to Local, they are only
+	// advertised from the nodes that have local endpoints for it.
+	ExternalIP AdvertisementType = "ExternalIP"
+
+	// ClusterIP determines that the cluster IPs of services are advertised
+	// from all the selected nodes.
+	ClusterIP AdvertisementType = "ClusterIP"
 )
 
 // RouteAdvertisementsStatus defines the observed state of RouteAdvertisements.
@@ -25,6 +25,68 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvertisementAttributes) DeepCopyInto(out *AdvertisementAttributes) {
+	*out = *in
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LargeCommunities != nil {
+		in, out := &in.LargeCommunities, &out.LargeCommunities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LocalPreference != nil {
+		in, out := &in.LocalPreference, &out.LocalPreference
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvertisementAttributes.
+func (in *AdvertisementAttributes) DeepCopy() *AdvertisementAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvertisementAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportPolicy) DeepCopyInto(out *ImportPolicy) {
+	*out = *in
+	if in.AllowedPrefixes != nil {
+		in, out := &in.AllowedPrefixes, &out.AllowedPrefixes
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedPrefixes != nil {
+		in, out := &in.DeniedPrefixes, &out.DeniedPrefixes
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxRoutes != nil {
+		in, out := &in.MaxRoutes, &out.MaxRoutes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportPolicy.
+func (in *ImportPolicy) DeepCopy() *ImportPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteAdvertisements) DeepCopyInto(out *RouteAdvertisements) {
 	*out = *in
@@ -97,6 +159,18 @@ func (in *RouteAdvertisementsSpec) DeepCopyInto(out *RouteAdvertisementsSpec) {
 		*out = make([]AdvertisementType, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdvertisementAttributes != nil {
+		in, out := &in.AdvertisementAttributes, &out.AdvertisementAttributes
+		*out = make([]AdvertisementAttributes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImportPolicy != nil {
+		in, out := &in.ImportPolicy, &out.ImportPolicy
+		*out = new(ImportPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -0,0 +1,60 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+)
+
+// CIDRPoolRangeApplyConfiguration represents a declarative configuration of the CIDRPoolRange type for use
+// with apply.
+type CIDRPoolRangeApplyConfiguration struct {
+	CIDR         *v1.CIDR `json:"cidr,omitempty"`
+	PrefixLength *int32   `json:"prefixLength,omitempty"`
+	HostSubnet   *int32   `json:"hostSubnet,omitempty"`
+}
+
+// CIDRPoolRangeApplyConfiguration constructs a declarative configuration of the CIDRPoolRange type for use with
+// apply.
+func CIDRPoolRange() *CIDRPoolRangeApplyConfiguration {
+	return &CIDRPoolRangeApplyConfiguration{}
+}
+
+// WithCIDR sets the CIDR field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CIDR field is set to the value of the last call.
+func (b *CIDRPoolRangeApplyConfiguration) WithCIDR(value v1.CIDR) *CIDRPoolRangeApplyConfiguration {
+	b.CIDR = &value
+	return b
+}
+
+// WithPrefixLength sets the PrefixLength field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrefixLength field is set to the value of the last call.
+func (b *CIDRPoolRangeApplyConfiguration) WithPrefixLength(value int32) *CIDRPoolRangeApplyConfiguration {
+	b.PrefixLength = &value
+	return b
+}
+
+// WithHostSubnet sets the HostSubnet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HostSubnet field is set to the value of the last call.
+func (b *CIDRPoolRangeApplyConfiguration) WithHostSubnet(value int32) *CIDRPoolRangeApplyConfiguration {
+	b.HostSubnet = &value
+	return b
+}
@@ -29,6 +29,7 @@ type Layer2ConfigApplyConfiguration struct {
 	Subnets     *v1.DualStackCIDRs            `json:"subnets,omitempty"`
 	JoinSubnets *v1.DualStackCIDRs            `json:"joinSubnets,omitempty"`
 	IPAM        *IPAMConfigApplyConfiguration `json:"ipam,omitempty"`
+	Multicast   *v1.MulticastMode             `json:"multicast,omitempty"`
 }
 
 // Layer2ConfigApplyConfiguration constructs a declarative configuration of the Layer2Config type for use with
@@ -76,3 +77,11 @@ func (b *Layer2ConfigApplyConfiguration) WithIPAM(value *IPAMConfigApplyConfigur
 	b.IPAM = value
 	return b
 }
+
+// WithMulticast sets the Multicast field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Multicast field is set to the value of the last call.
+func (b *Layer2ConfigApplyConfiguration) WithMulticast(value v1.MulticastMode) *Layer2ConfigApplyConfiguration {
+	b.Multicast = &value
+	return b
+}
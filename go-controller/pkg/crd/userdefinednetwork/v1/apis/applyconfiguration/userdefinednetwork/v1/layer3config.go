@@ -24,10 +24,12 @@ import (
 // Layer3ConfigApplyConfiguration represents a declarative configuration of the Layer3Config type for use
 // with apply.
 type Layer3ConfigApplyConfiguration struct {
-	Role        *v1.NetworkRole                  `json:"role,omitempty"`
-	MTU         *int32                           `json:"mtu,omitempty"`
-	Subnets     []Layer3SubnetApplyConfiguration `json:"subnets,omitempty"`
-	JoinSubnets *v1.DualStackCIDRs               `json:"joinSubnets,omitempty"`
+	Role                 *v1.NetworkRole                  `json:"role,omitempty"`
+	MTU                  *int32                           `json:"mtu,omitempty"`
+	Subnets              []Layer3SubnetApplyConfiguration `json:"subnets,omitempty"`
+	JoinSubnets          *v1.DualStackCIDRs               `json:"joinSubnets,omitempty"`
+	HybridOverlaySubnets []v1.CIDR                        `json:"hybridOverlaySubnets,omitempty"`
+	Multicast            *v1.MulticastMode                `json:"multicast,omitempty"`
 }
 
 // Layer3ConfigApplyConfiguration constructs a declarative configuration of the Layer3Config type for use with
@@ -72,3 +74,21 @@ func (b *Layer3ConfigApplyConfiguration) WithJoinSubnets(value v1.DualStackCIDRs
 	b.JoinSubnets = &value
 	return b
 }
+
+// WithHybridOverlaySubnets adds the given value to the HybridOverlaySubnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HybridOverlaySubnets field.
+func (b *Layer3ConfigApplyConfiguration) WithHybridOverlaySubnets(values ...v1.CIDR) *Layer3ConfigApplyConfiguration {
+	for i := range values {
+		b.HybridOverlaySubnets = append(b.HybridOverlaySubnets, values[i])
+	}
+	return b
+}
+
+// WithMulticast sets the Multicast field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Multicast field is set to the value of the last call.
+func (b *Layer3ConfigApplyConfiguration) WithMulticast(value v1.MulticastMode) *Layer3ConfigApplyConfiguration {
+	b.Multicast = &value
+	return b
+}
@@ -0,0 +1,56 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// UserDefinedNetworkCIDRPoolSpecApplyConfiguration represents a declarative configuration of the UserDefinedNetworkCIDRPoolSpec type for use
+// with apply.
+type UserDefinedNetworkCIDRPoolSpecApplyConfiguration struct {
+	NamespaceSelector *v1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+	CIDRs             []CIDRPoolRangeApplyConfiguration   `json:"cidrs,omitempty"`
+}
+
+// UserDefinedNetworkCIDRPoolSpecApplyConfiguration constructs a declarative configuration of the UserDefinedNetworkCIDRPoolSpec type for use with
+// apply.
+func UserDefinedNetworkCIDRPoolSpec() *UserDefinedNetworkCIDRPoolSpecApplyConfiguration {
+	return &UserDefinedNetworkCIDRPoolSpecApplyConfiguration{}
+}
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *UserDefinedNetworkCIDRPoolSpecApplyConfiguration) WithNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *UserDefinedNetworkCIDRPoolSpecApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
+
+// WithCIDRs adds the given value to the CIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CIDRs field.
+func (b *UserDefinedNetworkCIDRPoolSpecApplyConfiguration) WithCIDRs(values ...*CIDRPoolRangeApplyConfiguration) *UserDefinedNetworkCIDRPoolSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCIDRs")
+		}
+		b.CIDRs = append(b.CIDRs, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,83 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// UserDefinedNetworkQuotaSpecApplyConfiguration represents a declarative configuration of the UserDefinedNetworkQuotaSpec type for use
+// with apply.
+type UserDefinedNetworkQuotaSpecApplyConfiguration struct {
+	NamespaceSelector       *v1.LabelSelectorApplyConfiguration    `json:"namespaceSelector,omitempty"`
+	MaxNetworksPerNamespace *int32                                 `json:"maxNetworksPerNamespace,omitempty"`
+	MaxNetworks             *int32                                 `json:"maxNetworks,omitempty"`
+	AllowedTopologies       []userdefinednetworkv1.NetworkTopology `json:"allowedTopologies,omitempty"`
+	AllowedSubnets          []userdefinednetworkv1.CIDR            `json:"allowedSubnets,omitempty"`
+}
+
+// UserDefinedNetworkQuotaSpecApplyConfiguration constructs a declarative configuration of the UserDefinedNetworkQuotaSpec type for use with
+// apply.
+func UserDefinedNetworkQuotaSpec() *UserDefinedNetworkQuotaSpecApplyConfiguration {
+	return &UserDefinedNetworkQuotaSpecApplyConfiguration{}
+}
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *UserDefinedNetworkQuotaSpecApplyConfiguration) WithNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *UserDefinedNetworkQuotaSpecApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
+
+// WithMaxNetworksPerNamespace sets the MaxNetworksPerNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxNetworksPerNamespace field is set to the value of the last call.
+func (b *UserDefinedNetworkQuotaSpecApplyConfiguration) WithMaxNetworksPerNamespace(value int32) *UserDefinedNetworkQuotaSpecApplyConfiguration {
+	b.MaxNetworksPerNamespace = &value
+	return b
+}
+
+// WithMaxNetworks sets the MaxNetworks field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxNetworks field is set to the value of the last call.
+func (b *UserDefinedNetworkQuotaSpecApplyConfiguration) WithMaxNetworks(value int32) *UserDefinedNetworkQuotaSpecApplyConfiguration {
+	b.MaxNetworks = &value
+	return b
+}
+
+// WithAllowedTopologies adds the given value to the AllowedTopologies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedTopologies field.
+func (b *UserDefinedNetworkQuotaSpecApplyConfiguration) WithAllowedTopologies(values ...userdefinednetworkv1.NetworkTopology) *UserDefinedNetworkQuotaSpecApplyConfiguration {
+	for i := range values {
+		b.AllowedTopologies = append(b.AllowedTopologies, values[i])
+	}
+	return b
+}
+
+// WithAllowedSubnets adds the given value to the AllowedSubnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedSubnets field.
+func (b *UserDefinedNetworkQuotaSpecApplyConfiguration) WithAllowedSubnets(values ...userdefinednetworkv1.CIDR) *UserDefinedNetworkQuotaSpecApplyConfiguration {
+	for i := range values {
+		b.AllowedSubnets = append(b.AllowedSubnets, values[i])
+	}
+	return b
+}
@@ -31,6 +31,8 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("CIDRPoolRange"):
+		return &userdefinednetworkv1.CIDRPoolRangeApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetwork"):
 		return &userdefinednetworkv1.ClusterUserDefinedNetworkApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetworkSpec"):
@@ -49,6 +51,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &userdefinednetworkv1.NetworkSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetwork"):
 		return &userdefinednetworkv1.UserDefinedNetworkApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkCIDRPool"):
+		return &userdefinednetworkv1.UserDefinedNetworkCIDRPoolApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkCIDRPoolSpec"):
+		return &userdefinednetworkv1.UserDefinedNetworkCIDRPoolSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkQuota"):
+		return &userdefinednetworkv1.UserDefinedNetworkQuotaApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkQuotaSpec"):
+		return &userdefinednetworkv1.UserDefinedNetworkQuotaSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkSpec"):
 		return &userdefinednetworkv1.UserDefinedNetworkSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkStatus"):
@@ -35,6 +35,14 @@ func (c *FakeK8sV1) UserDefinedNetworks(namespace string) v1.UserDefinedNetworkI
 	return &FakeUserDefinedNetworks{c, namespace}
 }
 
+func (c *FakeK8sV1) UserDefinedNetworkCIDRPools() v1.UserDefinedNetworkCIDRPoolInterface {
+	return &FakeUserDefinedNetworkCIDRPools{c}
+}
+
+func (c *FakeK8sV1) UserDefinedNetworkQuotas() v1.UserDefinedNetworkQuotaInterface {
+	return &FakeUserDefinedNetworkQuotas{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeK8sV1) RESTClient() rest.Interface {
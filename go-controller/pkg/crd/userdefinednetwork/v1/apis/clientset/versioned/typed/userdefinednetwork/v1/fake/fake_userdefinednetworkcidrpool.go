@@ -0,0 +1,150 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/applyconfiguration/userdefinednetwork/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeUserDefinedNetworkCIDRPools implements UserDefinedNetworkCIDRPoolInterface
+type FakeUserDefinedNetworkCIDRPools struct {
+	Fake *FakeK8sV1
+}
+
+var userdefinednetworkcidrpoolsResource = v1.SchemeGroupVersion.WithResource("userdefinednetworkcidrpools")
+
+var userdefinednetworkcidrpoolsKind = v1.SchemeGroupVersion.WithKind("UserDefinedNetworkCIDRPool")
+
+// Get takes name of the userDefinedNetworkCIDRPool, and returns the corresponding userDefinedNetworkCIDRPool object, and an error if there is any.
+func (c *FakeUserDefinedNetworkCIDRPools) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.UserDefinedNetworkCIDRPool, err error) {
+	emptyResult := &v1.UserDefinedNetworkCIDRPool{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetActionWithOptions(userdefinednetworkcidrpoolsResource, name, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkCIDRPool), err
+}
+
+// List takes label and field selectors, and returns the list of UserDefinedNetworkCIDRPools that match those selectors.
+func (c *FakeUserDefinedNetworkCIDRPools) List(ctx context.Context, opts metav1.ListOptions) (result *v1.UserDefinedNetworkCIDRPoolList, err error) {
+	emptyResult := &v1.UserDefinedNetworkCIDRPoolList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListActionWithOptions(userdefinednetworkcidrpoolsResource, userdefinednetworkcidrpoolsKind, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.UserDefinedNetworkCIDRPoolList{ListMeta: obj.(*v1.UserDefinedNetworkCIDRPoolList).ListMeta}
+	for _, item := range obj.(*v1.UserDefinedNetworkCIDRPoolList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested userDefinedNetworkCIDRPools.
+func (c *FakeUserDefinedNetworkCIDRPools) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchActionWithOptions(userdefinednetworkcidrpoolsResource, opts))
+}
+
+// Create takes the representation of a userDefinedNetworkCIDRPool and creates it.  Returns the server's representation of the userDefinedNetworkCIDRPool, and an error, if there is any.
+func (c *FakeUserDefinedNetworkCIDRPools) Create(ctx context.Context, userDefinedNetworkCIDRPool *v1.UserDefinedNetworkCIDRPool, opts metav1.CreateOptions) (result *v1.UserDefinedNetworkCIDRPool, err error) {
+	emptyResult := &v1.UserDefinedNetworkCIDRPool{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateActionWithOptions(userdefinednetworkcidrpoolsResource, userDefinedNetworkCIDRPool, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkCIDRPool), err
+}
+
+// Update takes the representation of a userDefinedNetworkCIDRPool and updates it. Returns the server's representation of the userDefinedNetworkCIDRPool, and an error, if there is any.
+func (c *FakeUserDefinedNetworkCIDRPools) Update(ctx context.Context, userDefinedNetworkCIDRPool *v1.UserDefinedNetworkCIDRPool, opts metav1.UpdateOptions) (result *v1.UserDefinedNetworkCIDRPool, err error) {
+	emptyResult := &v1.UserDefinedNetworkCIDRPool{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateActionWithOptions(userdefinednetworkcidrpoolsResource, userDefinedNetworkCIDRPool, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkCIDRPool), err
+}
+
+// Delete takes name of the userDefinedNetworkCIDRPool and deletes it. Returns an error if one occurs.
+func (c *FakeUserDefinedNetworkCIDRPools) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(userdefinednetworkcidrpoolsResource, name, opts), &v1.UserDefinedNetworkCIDRPool{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeUserDefinedNetworkCIDRPools) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionActionWithOptions(userdefinednetworkcidrpoolsResource, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.UserDefinedNetworkCIDRPoolList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched userDefinedNetworkCIDRPool.
+func (c *FakeUserDefinedNetworkCIDRPools) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.UserDefinedNetworkCIDRPool, err error) {
+	emptyResult := &v1.UserDefinedNetworkCIDRPool{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(userdefinednetworkcidrpoolsResource, name, pt, data, opts, subresources...), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkCIDRPool), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied userDefinedNetworkCIDRPool.
+func (c *FakeUserDefinedNetworkCIDRPools) Apply(ctx context.Context, userDefinedNetworkCIDRPool *userdefinednetworkv1.UserDefinedNetworkCIDRPoolApplyConfiguration, opts metav1.ApplyOptions) (result *v1.UserDefinedNetworkCIDRPool, err error) {
+	if userDefinedNetworkCIDRPool == nil {
+		return nil, fmt.Errorf("userDefinedNetworkCIDRPool provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(userDefinedNetworkCIDRPool)
+	if err != nil {
+		return nil, err
+	}
+	name := userDefinedNetworkCIDRPool.Name
+	if name == nil {
+		return nil, fmt.Errorf("userDefinedNetworkCIDRPool.Name must be provided to Apply")
+	}
+	emptyResult := &v1.UserDefinedNetworkCIDRPool{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(userdefinednetworkcidrpoolsResource, *name, types.ApplyPatchType, data, opts.ToPatchOptions()), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkCIDRPool), err
+}
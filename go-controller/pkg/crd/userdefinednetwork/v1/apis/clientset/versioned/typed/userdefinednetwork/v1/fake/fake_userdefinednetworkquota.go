@@ -0,0 +1,150 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/applyconfiguration/userdefinednetwork/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeUserDefinedNetworkQuotas implements UserDefinedNetworkQuotaInterface
+type FakeUserDefinedNetworkQuotas struct {
+	Fake *FakeK8sV1
+}
+
+var userdefinednetworkquotasResource = v1.SchemeGroupVersion.WithResource("userdefinednetworkquotas")
+
+var userdefinednetworkquotasKind = v1.SchemeGroupVersion.WithKind("UserDefinedNetworkQuota")
+
+// Get takes name of the userDefinedNetworkQuota, and returns the corresponding userDefinedNetworkQuota object, and an error if there is any.
+func (c *FakeUserDefinedNetworkQuotas) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.UserDefinedNetworkQuota, err error) {
+	emptyResult := &v1.UserDefinedNetworkQuota{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetActionWithOptions(userdefinednetworkquotasResource, name, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkQuota), err
+}
+
+// List takes label and field selectors, and returns the list of UserDefinedNetworkQuotas that match those selectors.
+func (c *FakeUserDefinedNetworkQuotas) List(ctx context.Context, opts metav1.ListOptions) (result *v1.UserDefinedNetworkQuotaList, err error) {
+	emptyResult := &v1.UserDefinedNetworkQuotaList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListActionWithOptions(userdefinednetworkquotasResource, userdefinednetworkquotasKind, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.UserDefinedNetworkQuotaList{ListMeta: obj.(*v1.UserDefinedNetworkQuotaList).ListMeta}
+	for _, item := range obj.(*v1.UserDefinedNetworkQuotaList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested userDefinedNetworkQuotas.
+func (c *FakeUserDefinedNetworkQuotas) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchActionWithOptions(userdefinednetworkquotasResource, opts))
+}
+
+// Create takes the representation of a userDefinedNetworkQuota and creates it.  Returns the server's representation of the userDefinedNetworkQuota, and an error, if there is any.
+func (c *FakeUserDefinedNetworkQuotas) Create(ctx context.Context, userDefinedNetworkQuota *v1.UserDefinedNetworkQuota, opts metav1.CreateOptions) (result *v1.UserDefinedNetworkQuota, err error) {
+	emptyResult := &v1.UserDefinedNetworkQuota{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateActionWithOptions(userdefinednetworkquotasResource, userDefinedNetworkQuota, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkQuota), err
+}
+
+// Update takes the representation of a userDefinedNetworkQuota and updates it. Returns the server's representation of the userDefinedNetworkQuota, and an error, if there is any.
+func (c *FakeUserDefinedNetworkQuotas) Update(ctx context.Context, userDefinedNetworkQuota *v1.UserDefinedNetworkQuota, opts metav1.UpdateOptions) (result *v1.UserDefinedNetworkQuota, err error) {
+	emptyResult := &v1.UserDefinedNetworkQuota{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateActionWithOptions(userdefinednetworkquotasResource, userDefinedNetworkQuota, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkQuota), err
+}
+
+// Delete takes name of the userDefinedNetworkQuota and deletes it. Returns an error if one occurs.
+func (c *FakeUserDefinedNetworkQuotas) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(userdefinednetworkquotasResource, name, opts), &v1.UserDefinedNetworkQuota{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeUserDefinedNetworkQuotas) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionActionWithOptions(userdefinednetworkquotasResource, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.UserDefinedNetworkQuotaList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched userDefinedNetworkQuota.
+func (c *FakeUserDefinedNetworkQuotas) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.UserDefinedNetworkQuota, err error) {
+	emptyResult := &v1.UserDefinedNetworkQuota{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(userdefinednetworkquotasResource, name, pt, data, opts, subresources...), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkQuota), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied userDefinedNetworkQuota.
+func (c *FakeUserDefinedNetworkQuotas) Apply(ctx context.Context, userDefinedNetworkQuota *userdefinednetworkv1.UserDefinedNetworkQuotaApplyConfiguration, opts metav1.ApplyOptions) (result *v1.UserDefinedNetworkQuota, err error) {
+	if userDefinedNetworkQuota == nil {
+		return nil, fmt.Errorf("userDefinedNetworkQuota provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(userDefinedNetworkQuota)
+	if err != nil {
+		return nil, err
+	}
+	name := userDefinedNetworkQuota.Name
+	if name == nil {
+		return nil, fmt.Errorf("userDefinedNetworkQuota.Name must be provided to Apply")
+	}
+	emptyResult := &v1.UserDefinedNetworkQuota{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(userdefinednetworkquotasResource, *name, types.ApplyPatchType, data, opts.ToPatchOptions()), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.UserDefinedNetworkQuota), err
+}
@@ -20,3 +20,7 @@ package v1
 type ClusterUserDefinedNetworkExpansion interface{}
 
 type UserDefinedNetworkExpansion interface{}
+
+type UserDefinedNetworkCIDRPoolExpansion interface{}
+
+type UserDefinedNetworkQuotaExpansion interface{}
@@ -29,6 +29,8 @@ type K8sV1Interface interface {
 	RESTClient() rest.Interface
 	ClusterUserDefinedNetworksGetter
 	UserDefinedNetworksGetter
+	UserDefinedNetworkCIDRPoolsGetter
+	UserDefinedNetworkQuotasGetter
 }
 
 // K8sV1Client is used to interact with features provided by the k8s.ovn.org group.
@@ -44,6 +46,14 @@ func (c *K8sV1Client) UserDefinedNetworks(namespace string) UserDefinedNetworkIn
 	return newUserDefinedNetworks(c, namespace)
 }
 
+func (c *K8sV1Client) UserDefinedNetworkCIDRPools() UserDefinedNetworkCIDRPoolInterface {
+	return newUserDefinedNetworkCIDRPools(c)
+}
+
+func (c *K8sV1Client) UserDefinedNetworkQuotas() UserDefinedNetworkQuotaInterface {
+	return newUserDefinedNetworkQuotas(c)
+}
+
 // NewForConfig creates a new K8sV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,68 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/applyconfiguration/userdefinednetwork/v1"
+	scheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// UserDefinedNetworkQuotasGetter has a method to return a UserDefinedNetworkQuotaInterface.
+// A group's client should implement this interface.
+type UserDefinedNetworkQuotasGetter interface {
+	UserDefinedNetworkQuotas() UserDefinedNetworkQuotaInterface
+}
+
+// UserDefinedNetworkQuotaInterface has methods to work with UserDefinedNetworkQuota resources.
+type UserDefinedNetworkQuotaInterface interface {
+	Create(ctx context.Context, userDefinedNetworkQuota *v1.UserDefinedNetworkQuota, opts metav1.CreateOptions) (*v1.UserDefinedNetworkQuota, error)
+	Update(ctx context.Context, userDefinedNetworkQuota *v1.UserDefinedNetworkQuota, opts metav1.UpdateOptions) (*v1.UserDefinedNetworkQuota, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.UserDefinedNetworkQuota, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.UserDefinedNetworkQuotaList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.UserDefinedNetworkQuota, err error)
+	Apply(ctx context.Context, userDefinedNetworkQuota *userdefinednetworkv1.UserDefinedNetworkQuotaApplyConfiguration, opts metav1.ApplyOptions) (result *v1.UserDefinedNetworkQuota, err error)
+	UserDefinedNetworkQuotaExpansion
+}
+
+// userDefinedNetworkQuotas implements UserDefinedNetworkQuotaInterface
+type userDefinedNetworkQuotas struct {
+	*gentype.ClientWithListAndApply[*v1.UserDefinedNetworkQuota, *v1.UserDefinedNetworkQuotaList, *userdefinednetworkv1.UserDefinedNetworkQuotaApplyConfiguration]
+}
+
+// newUserDefinedNetworkQuotas returns a UserDefinedNetworkQuotas
+func newUserDefinedNetworkQuotas(c *K8sV1Client) *userDefinedNetworkQuotas {
+	return &userDefinedNetworkQuotas{
+		gentype.NewClientWithListAndApply[*v1.UserDefinedNetworkQuota, *v1.UserDefinedNetworkQuotaList, *userdefinednetworkv1.UserDefinedNetworkQuotaApplyConfiguration](
+			"userdefinednetworkquotas",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v1.UserDefinedNetworkQuota { return &v1.UserDefinedNetworkQuota{} },
+			func() *v1.UserDefinedNetworkQuotaList { return &v1.UserDefinedNetworkQuotaList{} }),
+	}
+}
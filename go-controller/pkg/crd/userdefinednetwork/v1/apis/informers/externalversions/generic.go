@@ -56,6 +56,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().ClusterUserDefinedNetworks().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("userdefinednetworks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().UserDefinedNetworks().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("userdefinednetworkcidrpools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().UserDefinedNetworkCIDRPools().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("userdefinednetworkquotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().UserDefinedNetworkQuotas().Informer()}, nil
 
 	}
 
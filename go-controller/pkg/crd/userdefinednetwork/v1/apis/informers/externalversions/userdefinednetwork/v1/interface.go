@@ -27,6 +27,10 @@ type Interface interface {
 	ClusterUserDefinedNetworks() ClusterUserDefinedNetworkInformer
 	// UserDefinedNetworks returns a UserDefinedNetworkInformer.
 	UserDefinedNetworks() UserDefinedNetworkInformer
+	// UserDefinedNetworkCIDRPools returns a UserDefinedNetworkCIDRPoolInformer.
+	UserDefinedNetworkCIDRPools() UserDefinedNetworkCIDRPoolInformer
+	// UserDefinedNetworkQuotas returns a UserDefinedNetworkQuotaInformer.
+	UserDefinedNetworkQuotas() UserDefinedNetworkQuotaInformer
 }
 
 type version struct {
@@ -49,3 +53,13 @@ func (v *version) ClusterUserDefinedNetworks() ClusterUserDefinedNetworkInformer
 func (v *version) UserDefinedNetworks() UserDefinedNetworkInformer {
 	return &userDefinedNetworkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// UserDefinedNetworkCIDRPools returns a UserDefinedNetworkCIDRPoolInformer.
+func (v *version) UserDefinedNetworkCIDRPools() UserDefinedNetworkCIDRPoolInformer {
+	return &userDefinedNetworkCIDRPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// UserDefinedNetworkQuotas returns a UserDefinedNetworkQuotaInformer.
+func (v *version) UserDefinedNetworkQuotas() UserDefinedNetworkQuotaInformer {
+	return &userDefinedNetworkQuotaInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
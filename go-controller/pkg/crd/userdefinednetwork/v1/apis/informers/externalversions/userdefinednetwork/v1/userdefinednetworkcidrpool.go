@@ -0,0 +1,88 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	versioned "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned"
+	internalinterfaces "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/informers/externalversions/internalinterfaces"
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/listers/userdefinednetwork/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// UserDefinedNetworkCIDRPoolInformer provides access to a shared informer and lister for
+// UserDefinedNetworkCIDRPools.
+type UserDefinedNetworkCIDRPoolInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.UserDefinedNetworkCIDRPoolLister
+}
+
+type userDefinedNetworkCIDRPoolInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewUserDefinedNetworkCIDRPoolInformer constructs a new informer for UserDefinedNetworkCIDRPool type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewUserDefinedNetworkCIDRPoolInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredUserDefinedNetworkCIDRPoolInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredUserDefinedNetworkCIDRPoolInformer constructs a new informer for UserDefinedNetworkCIDRPool type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredUserDefinedNetworkCIDRPoolInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.K8sV1().UserDefinedNetworkCIDRPools().List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.K8sV1().UserDefinedNetworkCIDRPools().Watch(context.TODO(), options)
+			},
+		},
+		&userdefinednetworkv1.UserDefinedNetworkCIDRPool{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *userDefinedNetworkCIDRPoolInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredUserDefinedNetworkCIDRPoolInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *userDefinedNetworkCIDRPoolInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&userdefinednetworkv1.UserDefinedNetworkCIDRPool{}, f.defaultInformer)
+}
+
+func (f *userDefinedNetworkCIDRPoolInformer) Lister() v1.UserDefinedNetworkCIDRPoolLister {
+	return v1.NewUserDefinedNetworkCIDRPoolLister(f.Informer().GetIndexer())
+}
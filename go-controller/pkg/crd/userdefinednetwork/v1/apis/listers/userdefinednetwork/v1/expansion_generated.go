@@ -28,3 +28,11 @@ type UserDefinedNetworkListerExpansion interface{}
 // UserDefinedNetworkNamespaceListerExpansion allows custom methods to be added to
 // UserDefinedNetworkNamespaceLister.
 type UserDefinedNetworkNamespaceListerExpansion interface{}
+
+// UserDefinedNetworkCIDRPoolListerExpansion allows custom methods to be added to
+// UserDefinedNetworkCIDRPoolLister.
+type UserDefinedNetworkCIDRPoolListerExpansion interface{}
+
+// UserDefinedNetworkQuotaListerExpansion allows custom methods to be added to
+// UserDefinedNetworkQuotaLister.
+type UserDefinedNetworkQuotaListerExpansion interface{}
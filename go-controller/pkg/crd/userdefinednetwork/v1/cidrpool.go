@@ -0,0 +1,81 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// UserDefinedNetworkCIDRPool defines the CIDRs the UserDefinedNetworks of a
+// group of namespaces may use.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=userdefinednetworkcidrpools,scope=Cluster
+// +kubebuilder:singular=userdefinednetworkcidrpool
+// +kubebuilder:object:root=true
+type UserDefinedNetworkCIDRPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Required
+	// +required
+	Spec UserDefinedNetworkCIDRPoolSpec `json:"spec"`
+}
+
+// UserDefinedNetworkCIDRPoolSpec defines the CIDRs of the pool and the
+// namespaces it applies to.
+//
+// The subnets of the UserDefinedNetworks of the selected namespaces must be
+// contained in the CIDRs of any of the pools selecting the namespace.
+// UserDefinedNetworks of the selected namespaces that don't specify subnets
+// get subnets allocated from the pools, which don't overlap with the subnets
+// of any other network.
+type UserDefinedNetworkCIDRPoolSpec struct {
+	// NamespaceSelector selects the namespaces the pool applies to.
+	// +kubebuilder:validation:Required
+	// +required
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// CIDRs are the ranges of the pool.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	// +required
+	CIDRs []CIDRPoolRange `json:"cidrs"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!isCIDR(self.cidr) || self.prefixLength >= cidr(self.cidr).prefixLength()", message="PrefixLength must be greater than or equal to the CIDR prefix length"
+// +kubebuilder:validation:XValidation:rule="!isCIDR(self.cidr) || cidr(self.cidr).ip().family() != 4 || self.prefixLength <= 32", message="PrefixLength must be <= 32 for ipv4 CIDR"
+// +kubebuilder:validation:XValidation:rule="!has(self.hostSubnet) || self.hostSubnet > self.prefixLength", message="HostSubnet must be greater than PrefixLength"
+// +kubebuilder:validation:XValidation:rule="!has(self.hostSubnet) || !isCIDR(self.cidr) || cidr(self.cidr).ip().family() != 4 || self.hostSubnet < 32", message="HostSubnet must < 32 for ipv4 CIDR"
+type CIDRPoolRange struct {
+	// CIDR is the range subnets are allocated from.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	CIDR CIDR `json:"cidr"`
+
+	// PrefixLength is the prefix length of the subnets allocated from the CIDR.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:validation:Required
+	// +required
+	PrefixLength int32 `json:"prefixLength"`
+
+	// HostSubnet is the subnet size for every node of the Layer3
+	// networks the subnets are allocated to.
+	//
+	// When not set, it will be assigned automatically.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=127
+	// +optional
+	HostSubnet int32 `json:"hostSubnet,omitempty"`
+}
+
+// UserDefinedNetworkCIDRPoolList contains a list of UserDefinedNetworkCIDRPool.
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type UserDefinedNetworkCIDRPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserDefinedNetworkCIDRPool `json:"items"`
+}
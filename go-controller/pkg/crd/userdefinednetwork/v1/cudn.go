@@ -37,6 +37,8 @@ type ClusterUserDefinedNetworkSpec struct {
 
 // NetworkSpec defines the desired state of UserDefinedNetworkSpec.
 // +union
+// +kubebuilder:validation:XValidation:rule="!has(self.layer3) || has(self.layer3.subnets)", message="Subnets is required for Layer3 topology"
+// +kubebuilder:validation:XValidation:rule="!has(self.layer2) || has(self.layer2.ipam) && has(self.layer2.ipam.mode) && self.layer2.ipam.mode != 'Enabled' || has(self.layer2.subnets)", message="Subnets is required with ipam.mode is Enabled or unset"
 type NetworkSpec struct {
 	// Topology describes network configuration.
 	//
@@ -0,0 +1,70 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// UserDefinedNetworkQuota limits the UserDefinedNetworks that can be created in
+// a group of namespaces.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=userdefinednetworkquotas,scope=Cluster
+// +kubebuilder:singular=userdefinednetworkquota
+// +kubebuilder:object:root=true
+type UserDefinedNetworkQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Required
+	// +required
+	Spec UserDefinedNetworkQuotaSpec `json:"spec"`
+}
+
+// UserDefinedNetworkQuotaSpec defines the limits of the UserDefinedNetworks
+// of the selected namespaces.
+//
+// UserDefinedNetworks violating the quota are not rendered into a network,
+// which is reported in their status conditions. Networks already rendered are
+// not affected by later changes of the quota.
+type UserDefinedNetworkQuotaSpec struct {
+	// NamespaceSelector selects the namespaces the quota applies to.
+	// +kubebuilder:validation:Required
+	// +required
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// MaxNetworksPerNamespace is the maximum number of UserDefinedNetworks each of
+	// the selected namespaces may have.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxNetworksPerNamespace *int32 `json:"maxNetworksPerNamespace,omitempty"`
+
+	// MaxNetworks is the maximum number of UserDefinedNetworks all the selected
+	// namespaces may have together.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxNetworks *int32 `json:"maxNetworks,omitempty"`
+
+	// AllowedTopologies are the topologies the UserDefinedNetworks of the
+	// selected namespaces may use. When omitted, all topologies are allowed.
+	//
+	// +listType=set
+	// +optional
+	AllowedTopologies []NetworkTopology `json:"allowedTopologies,omitempty"`
+
+	// AllowedSubnets are the CIDRs the subnets of the UserDefinedNetworks of the
+	// selected namespaces must be contained in. When omitted, any subnet is
+	// allowed.
+	//
+	// +optional
+	AllowedSubnets []CIDR `json:"allowedSubnets,omitempty"`
+}
+
+// UserDefinedNetworkQuotaList contains a list of UserDefinedNetworkQuota.
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type UserDefinedNetworkQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserDefinedNetworkQuota `json:"items"`
+}
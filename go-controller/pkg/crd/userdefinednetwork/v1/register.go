@@ -30,6 +30,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&UserDefinedNetworkList{},
 		&ClusterUserDefinedNetwork{},
 		&ClusterUserDefinedNetworkList{},
+		&UserDefinedNetworkQuota{},
+		&UserDefinedNetworkQuotaList{},
+		&UserDefinedNetworkCIDRPool{},
+		&UserDefinedNetworkCIDRPoolList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -26,6 +26,8 @@ const (
 
 // +kubebuilder:validation:XValidation:rule="!has(self.joinSubnets) || has(self.role) && self.role == 'Primary'", message="JoinSubnets is only supported for Primary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i.cidr) && cidr(i.cidr).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subent is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.hybridOverlaySubnets) || has(self.role) && self.role == 'Primary'", message="HybridOverlaySubnets is only supported for Primary network"
+// +kubebuilder:validation:XValidation:rule="!has(self.multicast) || self.multicast != 'Enabled' || self.role == 'Secondary'", message="Enabled multicast is only supported for Secondary network"
 type Layer3Config struct {
 	// Role describes the network role in the pod.
 	//
@@ -50,10 +52,12 @@ type Layer3Config struct {
 	//
 	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
 	// Given subnet is split into smaller subnets for every node.
+	// This field is required for ClusterUserDefinedNetworks. When omitted for a UserDefinedNetwork, subnets are
+	// allocated from the UserDefinedNetworkCIDRPools selecting its namespace.
 	//
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=2
-	// +required
+	// +optional
 	// +kubebuilder:validation:XValidation:rule="size(self) != 2 || !isCIDR(self[0].cidr) || !isCIDR(self[1].cidr) || cidr(self[0].cidr).ip().family() != cidr(self[1].cidr).ip().family()", message="When 2 CIDRs are set, they must be from different IP families"
 	Subnets []Layer3Subnet `json:"subnets,omitempty"`
 
@@ -66,6 +70,28 @@ type Layer3Config struct {
 	//
 	// +optional
 	JoinSubnets DualStackCIDRs `json:"joinSubnets,omitempty"`
+
+	// HybridOverlaySubnets are the subnets of the hybrid overlay nodes (e.g. Windows nodes) the network pods
+	// can reach through the hybrid overlay VXLAN tunnels.
+	//
+	// Only IPv4 subnets are supported.
+	// This field is only allowed for "Primary" network and requires the hybrid overlay to be enabled in the cluster.
+	// The subnets must not overlap with the subnets of any other network.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:XValidation:rule="self.all(x, !isCIDR(x) || cidr(x).ip().family() == 4)", message="HybridOverlaySubnets must be IPv4 CIDRs"
+	// +optional
+	HybridOverlaySubnets []CIDR `json:"hybridOverlaySubnets,omitempty"`
+
+	// Multicast controls whether multicast is allowed on the network, between the pods of the namespaces
+	// annotated with `k8s.ovn.org/multicast-enabled`.
+	// `Enabled` is only available for Secondary networks and requires multicast to be enabled in the cluster.
+	// Primary networks allow multicast whenever it is enabled in the cluster, like the default network does.
+	// Defaults to `Disabled`.
+	//
+	// +optional
+	Multicast MulticastMode `json:"multicast,omitempty"`
 }
 
 // +kubebuilder:validation:XValidation:rule="!has(self.hostSubnet) || !isCIDR(self.cidr) || self.hostSubnet > cidr(self.cidr).prefixLength()", message="HostSubnet must be smaller than CIDR subnet"
@@ -86,11 +112,12 @@ type Layer3Subnet struct {
 	HostSubnet int32 `json:"hostSubnet,omitempty"`
 }
 
-// +kubebuilder:validation:XValidation:rule="has(self.ipam) && has(self.ipam.mode) && self.ipam.mode != 'Enabled' || has(self.subnets)", message="Subnets is required with ipam.mode is Enabled or unset"
 // +kubebuilder:validation:XValidation:rule="!has(self.ipam) || !has(self.ipam.mode) || self.ipam.mode != 'Disabled' || !has(self.subnets)", message="Subnets must be unset when ipam.mode is Disabled"
 // +kubebuilder:validation:XValidation:rule="!has(self.ipam) || !has(self.ipam.mode) || self.ipam.mode != 'Disabled' || self.role == 'Secondary'", message="Disabled ipam.mode is only supported for Secondary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.joinSubnets) || has(self.role) && self.role == 'Primary'", message="JoinSubnets is only supported for Primary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i) && cidr(i).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subent is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.multicast) || self.multicast != 'Enabled' || self.role == 'Secondary'", message="Enabled multicast is only supported for Secondary network"
+// +kubebuilder:validation:XValidation:rule="!has(self.multicast) || self.multicast != 'Enabled' || !has(self.ipam) || !has(self.ipam.mode) || self.ipam.mode != 'Disabled'", message="Enabled multicast is only supported when ipam.mode is Enabled"
 type Layer2Config struct {
 	// Role describes the network role in the pod.
 	//
@@ -114,6 +141,9 @@ type Layer2Config struct {
 	//
 	// The format should match standard CIDR notation (for example, "10.128.0.0/16").
 	// This field must be omitted if `ipam.mode` is `Disabled`.
+	// This field is required for ClusterUserDefinedNetworks with `ipam.mode` `Enabled` or unset. When omitted
+	// for a UserDefinedNetwork with `ipam.mode` `Enabled` or unset, subnets are allocated from the
+	// UserDefinedNetworkCIDRPools selecting its namespace.
 	//
 	// +optional
 	Subnets DualStackCIDRs `json:"subnets,omitempty"`
@@ -131,6 +161,16 @@ type Layer2Config struct {
 	// IPAM section contains IPAM-related configuration for the network.
 	// +optional
 	IPAM *IPAMConfig `json:"ipam,omitempty"`
+
+	// Multicast controls whether multicast is allowed on the network, between the pods of the namespaces
+	// annotated with `k8s.ovn.org/multicast-enabled`.
+	// `Enabled` is only available for Secondary networks with `ipam.mode` `Enabled`, and requires multicast to be
+	// enabled in the cluster.
+	// Primary networks allow multicast whenever it is enabled in the cluster, like the default network does.
+	// Defaults to `Disabled`.
+	//
+	// +optional
+	Multicast MulticastMode `json:"multicast,omitempty"`
 }
 
 // +kubebuilder:validation:XValidation:rule="!has(self.lifecycle) || self.lifecycle != 'Persistent' || !has(self.mode) || self.mode == 'Enabled'", message="lifecycle Persistent is only supported when ipam.mode is Enabled"
@@ -166,6 +206,14 @@ const (
 	IPAMDisabled IPAMMode = "Disabled"
 )
 
+// +kubebuilder:validation:Enum=Enabled;Disabled
+type MulticastMode string
+
+const (
+	MulticastEnabled  MulticastMode = "Enabled"
+	MulticastDisabled MulticastMode = "Disabled"
+)
+
 // +kubebuilder:validation:Enum=Primary;Secondary
 type NetworkRole string
 
@@ -25,6 +25,22 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CIDRPoolRange) DeepCopyInto(out *CIDRPoolRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CIDRPoolRange.
+func (in *CIDRPoolRange) DeepCopy() *CIDRPoolRange {
+	if in == nil {
+		return nil
+	}
+	out := new(CIDRPoolRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterUserDefinedNetwork) DeepCopyInto(out *ClusterUserDefinedNetwork) {
 	*out = *in
@@ -207,6 +223,11 @@ func (in *Layer3Config) DeepCopyInto(out *Layer3Config) {
 		*out = make(DualStackCIDRs, len(*in))
 		copy(*out, *in)
 	}
+	if in.HybridOverlaySubnets != nil {
+		in, out := &in.HybridOverlaySubnets, &out.HybridOverlaySubnets
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -290,6 +311,88 @@ func (in *UserDefinedNetwork) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserDefinedNetworkCIDRPool) DeepCopyInto(out *UserDefinedNetworkCIDRPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserDefinedNetworkCIDRPool.
+func (in *UserDefinedNetworkCIDRPool) DeepCopy() *UserDefinedNetworkCIDRPool {
+	if in == nil {
+		return nil
+	}
+	out := new(UserDefinedNetworkCIDRPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserDefinedNetworkCIDRPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserDefinedNetworkCIDRPoolList) DeepCopyInto(out *UserDefinedNetworkCIDRPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserDefinedNetworkCIDRPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserDefinedNetworkCIDRPoolList.
+func (in *UserDefinedNetworkCIDRPoolList) DeepCopy() *UserDefinedNetworkCIDRPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserDefinedNetworkCIDRPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserDefinedNetworkCIDRPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserDefinedNetworkCIDRPoolSpec) DeepCopyInto(out *UserDefinedNetworkCIDRPoolSpec) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]CIDRPoolRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserDefinedNetworkCIDRPoolSpec.
+func (in *UserDefinedNetworkCIDRPoolSpec) DeepCopy() *UserDefinedNetworkCIDRPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserDefinedNetworkCIDRPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserDefinedNetworkList) DeepCopyInto(out *UserDefinedNetworkList) {
 	*out = *in
@@ -323,6 +426,103 @@ func (in *UserDefinedNetworkList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserDefinedNetworkQuota) DeepCopyInto(out *UserDefinedNetworkQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserDefinedNetworkQuota.
+func (in *UserDefinedNetworkQuota) DeepCopy() *UserDefinedNetworkQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(UserDefinedNetworkQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserDefinedNetworkQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserDefinedNetworkQuotaList) DeepCopyInto(out *UserDefinedNetworkQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserDefinedNetworkQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserDefinedNetworkQuotaList.
+func (in *UserDefinedNetworkQuotaList) DeepCopy() *UserDefinedNetworkQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserDefinedNetworkQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserDefinedNetworkQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserDefinedNetworkQuotaSpec) DeepCopyInto(out *UserDefinedNetworkQuotaSpec) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.MaxNetworksPerNamespace != nil {
+		in, out := &in.MaxNetworksPerNamespace, &out.MaxNetworksPerNamespace
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNetworks != nil {
+		in, out := &in.MaxNetworks, &out.MaxNetworks
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowedTopologies != nil {
+		in, out := &in.AllowedTopologies, &out.AllowedTopologies
+		*out = make([]NetworkTopology, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSubnets != nil {
+		in, out := &in.AllowedSubnets, &out.AllowedSubnets
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserDefinedNetworkQuotaSpec.
+func (in *UserDefinedNetworkQuotaSpec) DeepCopy() *UserDefinedNetworkQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserDefinedNetworkQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserDefinedNetworkSpec) DeepCopyInto(out *UserDefinedNetworkSpec) {
 	*out = *in
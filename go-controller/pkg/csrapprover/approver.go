@@ -23,12 +23,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 const (
 	ControllerName = "ovnkube-csr-approver-controller"
 	NamePrefix     = "system:ovn-node"
 	MaxDuration    = time.Hour * 24 * 365
+	// IPsecSignerName is the dedicated signer of the certificates nodes use to
+	// authenticate to their IPsec peers
+	IPsecSignerName = "k8s.ovn.org/ipsec"
 )
 
 // CSRAcceptanceCondition specifies conditions which CSRs are approved by csrapprover.
@@ -43,6 +48,9 @@ const (
 // - The parsed CSR in .Spec.Request has a .Subject.Organization equal to "organization"
 // - The parsed CSR in .Spec.Request has a .Subject.CommonName in the format of "<commonNamePrefix>:<nodeName>",
 // where the nodeName value is extracted from .Spec.Username.
+// CSRs with .Spec.SignerName equal to IPsecSignerName are not subject to these
+// conditions but validated against the default condition and the chassis ID of
+// the requesting node instead.
 type CSRAcceptanceCondition struct {
 	// CommonNamePrefix specifies common name in target CSRs
 	CommonNamePrefix string `json:"commonNamePrefix"`
@@ -95,6 +103,12 @@ var (
 	Usages = sets.New[certificatesv1.KeyUsage](
 		certificatesv1.UsageDigitalSignature,
 		certificatesv1.UsageClientAuth)
+	// IPsecUsages are the usages expected in CSRs for the IPsec signer. IKE
+	// peers act both as clients and servers.
+	IPsecUsages = sets.New[certificatesv1.KeyUsage](
+		certificatesv1.UsageDigitalSignature,
+		certificatesv1.UsageClientAuth,
+		certificatesv1.UsageServerAuth)
 )
 
 // OVNKubeCSRController approves certificate signing requests (CSRs) by applying the conditions, which is defined
@@ -149,6 +163,9 @@ func NewController(client crclient.Client,
 }
 
 func (c *OVNKubeCSRController) filterCSR(csr *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest) bool {
+	if csr.Spec.SignerName == IPsecSignerName {
+		return true
+	}
 	for _, v := range c.commonNamePrefixes {
 		if strings.HasPrefix(x509CSR.Subject.CommonName, v) {
 			return csr.Spec.SignerName == certificatesv1.KubeAPIServerClientSignerName
@@ -240,27 +257,34 @@ func (c *OVNKubeCSRController) Reconcile(ctx context.Context, request reconcile.
 		return reconcile.Result{}, nil
 	}
 
-	// expected common name format: userPrefix:nodeName
-	// example: system:ovn-node:ovn-worker2
-	i := strings.LastIndex(x509CSR.Subject.CommonName, ":")
-	if i == -1 || i == len(x509CSR.Subject.CommonName)-1 {
-		return reconcile.Result{}, fmt.Errorf("failed to parse the common name: %s", x509CSR.Subject.CommonName)
-	}
-
-	matched := false
-	prefix := x509CSR.Subject.CommonName[:i]
-	nodeName = x509CSR.Subject.CommonName[i+1:]
-	for _, v := range c.csrAcceptanceConditions {
-		if prefix == v.CommonNamePrefix {
-			matched = true
-			if err := v.validateCSR(req, x509CSR, c.usages); err != nil {
-				return reconcile.Result{}, c.denyCSR(ctx, req, err)
+	if req.Spec.SignerName == IPsecSignerName {
+		nodeName, err = c.validateIPsecCSR(ctx, req, x509CSR)
+		if err != nil {
+			return reconcile.Result{}, c.denyCSR(ctx, req, err)
+		}
+	} else {
+		// expected common name format: userPrefix:nodeName
+		// example: system:ovn-node:ovn-worker2
+		i := strings.LastIndex(x509CSR.Subject.CommonName, ":")
+		if i == -1 || i == len(x509CSR.Subject.CommonName)-1 {
+			return reconcile.Result{}, fmt.Errorf("failed to parse the common name: %s", x509CSR.Subject.CommonName)
+		}
+
+		matched := false
+		prefix := x509CSR.Subject.CommonName[:i]
+		nodeName = x509CSR.Subject.CommonName[i+1:]
+		for _, v := range c.csrAcceptanceConditions {
+			if prefix == v.CommonNamePrefix {
+				matched = true
+				if err := v.validateCSR(req, x509CSR, c.usages); err != nil {
+					return reconcile.Result{}, c.denyCSR(ctx, req, err)
+				}
 			}
 		}
-	}
 
-	if !matched {
-		return reconcile.Result{}, c.denyCSR(ctx, req, fmt.Errorf("CSR %q was created with unexpected common name: %q", req.Name, x509CSR.Subject.CommonName))
+		if !matched {
+			return reconcile.Result{}, c.denyCSR(ctx, req, fmt.Errorf("CSR %q was created with unexpected common name: %q", req.Name, x509CSR.Subject.CommonName))
+		}
 	}
 
 	if req.Spec.ExpirationSeconds == nil {
@@ -274,6 +298,51 @@ func (c *OVNKubeCSRController) Reconcile(ctx context.Context, request reconcile.
 	return reconcile.Result{}, c.approveCSR(ctx, req)
 }
 
+// validateIPsecCSR validates a CSR for the IPsec signer and returns the name of
+// the node that requested it. The CSR must be created by a node with the same
+// user, groups and organization as the default acceptance condition, and must
+// have a .Subject.CommonName equal to the node chassis ID, which is how OVN
+// identifies IPsec peers.
+func (c *OVNKubeCSRController) validateIPsecCSR(ctx context.Context, req *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest) (string, error) {
+	condition := DefaultCSRAcceptanceCondition
+	i := strings.LastIndex(req.Spec.Username, ":")
+	if i == -1 || i == len(req.Spec.Username)-1 {
+		return "", fmt.Errorf("failed to parse the username: %s", req.Spec.Username)
+	}
+	prefix := req.Spec.Username[:i]
+	nodeName := req.Spec.Username[i+1:]
+	if !sets.New(condition.UserPrefixes...).Has(prefix) {
+		return "", fmt.Errorf("CSR %q was created by an unexpected user: %q", req.Name, req.Spec.Username)
+	}
+
+	if errs := validation.IsDNS1123Subdomain(nodeName); len(errs) != 0 {
+		return "", fmt.Errorf("extracted node name %q is not a valid DNS subdomain %v", nodeName, errs)
+	}
+
+	if usages := sets.New[certificatesv1.KeyUsage](req.Spec.Usages...); !usages.Equal(IPsecUsages) {
+		return "", fmt.Errorf("CSR %q was created with unexpected usages: %v", req.Name, usages.UnsortedList())
+	}
+
+	if !sets.New(condition.Groups...).HasAll(req.Spec.Groups...) {
+		return "", fmt.Errorf("CSR %q was created by a user with unexpected groups: %v", req.Name, req.Spec.Groups)
+	}
+
+	node := &corev1.Node{}
+	err := c.client.Get(ctx, crclient.ObjectKey{Name: nodeName}, node)
+	if err != nil {
+		return "", fmt.Errorf("failed to get node %q for CSR %q: %w", nodeName, req.Name, err)
+	}
+	chassisID := node.Annotations[util.OvnNodeChassisID]
+	if chassisID == "" || x509CSR.Subject.CommonName != chassisID {
+		return "", fmt.Errorf("expected the CSR's commonName to be the chassis ID %q of node %q, but it is %q", chassisID, nodeName, x509CSR.Subject.CommonName)
+	}
+
+	if !reflect.DeepEqual(x509CSR.Subject.Organization, condition.Organizations) {
+		return "", fmt.Errorf("expected the CSR's organization to be %v, but it is %v", condition.Organizations, x509CSR.Subject.Organization)
+	}
+	return nodeName, nil
+}
+
 func (c *CSRAcceptanceCondition) validateCSR(req *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest, acceptUsages sets.Set[certificatesv1.KeyUsage]) error {
 
 	// expected username format: userPrefix:nodeName
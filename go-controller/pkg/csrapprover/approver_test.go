@@ -13,6 +13,7 @@ import (
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
@@ -20,6 +21,8 @@ import (
 	"k8s.io/client-go/util/certificate/csr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 const csrName = "testCSR"
@@ -391,3 +394,177 @@ func TestOVNKubeCSRController(t *testing.T) {
 		})
 	}
 }
+
+func TestOVNKubeCSRControllerIPsec(t *testing.T) {
+	const nodeName = "test.node"
+	const chassisID = "8a3f2cbb-5b2a-4a7b-9d55-7e1f0b4b2c11"
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        nodeName,
+			Annotations: map[string]string{util.OvnNodeChassisID: chassisID},
+		},
+	}
+	otherNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "other.node",
+			Annotations: map[string]string{util.OvnNodeChassisID: "other-chassis"},
+		},
+	}
+	tests := []struct {
+		name              string
+		expectedCondition certificatesv1.CertificateSigningRequestCondition
+		expectedEvent     string
+
+		csrUserName  string
+		commonName   string
+		organization []string
+		usages       sets.Set[certificatesv1.KeyUsage]
+		noNode       bool
+	}{
+		{
+			name: "CSR with a CommonName that is not the node chassis ID is denied",
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CSRDenied",
+				Message: fmt.Sprintf("expected the CSR's commonName to be the chassis ID %q of node %q, but it is %q", chassisID, nodeName, "other"),
+			},
+			expectedEvent: fmt.Sprintf("Warning CSRDenied The CSR %q has been denied: expected the CSR's commonName to be the chassis ID %q of node %q, but it is %q", csrName, chassisID, nodeName, "other"),
+			csrUserName:   "system:ovn-node:" + nodeName,
+			commonName:    "other",
+			organization:  DefaultCSRAcceptanceCondition.Organizations,
+		},
+		{
+			name: "CSR for an unknown node is denied",
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CSRDenied",
+				Message: fmt.Sprintf("failed to get node %q for CSR %q: nodes %q not found", nodeName, csrName, nodeName),
+			},
+			expectedEvent: fmt.Sprintf("Warning CSRDenied The CSR %q has been denied: failed to get node %q for CSR %q: nodes %q not found", csrName, nodeName, csrName, nodeName),
+			csrUserName:   "system:ovn-node:" + nodeName,
+			commonName:    chassisID,
+			organization:  DefaultCSRAcceptanceCondition.Organizations,
+			noNode:        true,
+		},
+		{
+			name: "CSR with unexpected usages is denied",
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CSRDenied",
+				Message: fmt.Sprintf("CSR %q was created with unexpected usages: %v", csrName, []certificatesv1.KeyUsage{certificatesv1.UsageClientAuth}),
+			},
+			expectedEvent: fmt.Sprintf("Warning CSRDenied The CSR %q has been denied: CSR %q was created with unexpected usages: %v", csrName, csrName, []certificatesv1.KeyUsage{certificatesv1.UsageClientAuth}),
+			csrUserName:   "system:ovn-node:" + nodeName,
+			commonName:    chassisID,
+			organization:  DefaultCSRAcceptanceCondition.Organizations,
+			usages:        sets.New[certificatesv1.KeyUsage](certificatesv1.UsageClientAuth),
+		},
+		{
+			name: "CSR created by another node is denied",
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CSRDenied",
+				Message: fmt.Sprintf("expected the CSR's commonName to be the chassis ID %q of node %q, but it is %q", "other-chassis", "other.node", chassisID),
+			},
+			expectedEvent: fmt.Sprintf("Warning CSRDenied The CSR %q has been denied: expected the CSR's commonName to be the chassis ID %q of node %q, but it is %q", csrName, "other-chassis", "other.node", chassisID),
+			csrUserName:   "system:ovn-node:other.node",
+			commonName:    chassisID,
+			organization:  DefaultCSRAcceptanceCondition.Organizations,
+		},
+		{
+			name: "Valid CSR is approved",
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateApproved,
+				Status:  corev1.ConditionTrue,
+				Reason:  "AutoApproved",
+				Message: fmt.Sprintf("Auto-approved CSR %q", csrName),
+			},
+			expectedEvent: fmt.Sprintf("Normal CSRApproved CSR %q has been approved", csrName),
+			csrUserName:   "system:ovn-node:" + nodeName,
+			commonName:    chassisID,
+			organization:  DefaultCSRAcceptanceCondition.Organizations,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrPEM, err := cert.MakeCSR(privateKey, &pkix.Name{
+				CommonName:   tt.commonName,
+				Organization: tt.organization,
+			}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			usages := IPsecUsages
+			if len(tt.usages) != 0 {
+				usages = tt.usages
+			}
+			csrObj := &certificatesv1.CertificateSigningRequest{
+				TypeMeta: metav1.TypeMeta{Kind: "CertificateSigningRequest"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: csrName,
+				},
+				Spec: certificatesv1.CertificateSigningRequestSpec{
+					Request:           csrPEM,
+					Usages:            sets.List(usages),
+					SignerName:        IPsecSignerName,
+					Username:          tt.csrUserName,
+					Groups:            []string{"system:ovn-nodes", "system:authenticated"},
+					ExpirationSeconds: csr.DurationToExpirationSeconds(time.Hour),
+				},
+			}
+
+			objects := []runtime.Object{csrObj, otherNode}
+			if !tt.noNode {
+				objects = append(objects, node)
+			}
+			client := fake.NewClientBuilder().WithRuntimeObjects(objects...).Build()
+			recorder := record.NewFakeRecorder(10)
+
+			conditions, err := InitCSRAcceptanceConditions("")
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrCtrl := NewController(client, conditions, Usages, MaxDuration, recorder)
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: csrName,
+				},
+			}
+			_, err = csrCtrl.Reconcile(context.Background(), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			csrObj = &certificatesv1.CertificateSigningRequest{}
+			err = client.Get(context.TODO(), req.NamespacedName, csrObj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(csrObj.Status.Conditions) != 1 {
+				t.Fatal(fmt.Errorf("invalid conditions: %v", csrObj.Status.Conditions))
+			}
+			if csrObj.Status.Conditions[0] != tt.expectedCondition {
+				t.Fatal(fmt.Errorf("expected:\n%v\ngot:\n%v", tt.expectedCondition, csrObj.Status.Conditions[0]))
+			}
+
+			if len(recorder.Events) != 1 {
+				t.Fatal(fmt.Errorf("invalid number of events recorded: %d", len(recorder.Events)))
+			}
+			event := <-recorder.Events
+			if event != tt.expectedEvent {
+				t.Fatal(fmt.Errorf("expected event:\n%s\ngot\n%s", tt.expectedEvent, event))
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package csrapprover
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/certificate/csr"
+	"k8s.io/klog/v2"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	IPsecSignerControllerName = "ovnkube-ipsec-signer-controller"
+	// signerBackdate is how far back in time the certificates are valid from,
+	// to tolerate clock skew between the nodes
+	signerBackdate = 5 * time.Minute
+)
+
+// IPsecSigner signs the approved CSRs for the IPsec signer with a configured
+// CA, filling their .Status.Certificate.
+type IPsecSigner struct {
+	client      crclient.Client
+	caCert      *x509.Certificate
+	caKey       crypto.Signer
+	maxDuration time.Duration
+	recorder    record.EventRecorder
+}
+
+// NewIPsecSigner creates a new IPsecSigner signing with the CA certificate
+// and private key stored in the provided PEM files. The lifetime of the
+// signed certificates is bounded by maxDuration and by the expiration of the
+// CA certificate.
+func NewIPsecSigner(client crclient.Client, caCertFile, caKeyFile string, maxDuration time.Duration,
+	recorder record.EventRecorder) (*IPsecSigner, error) {
+	certPEM, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read IPsec signer CA certificate: %w", err)
+	}
+	keyPEM, err := os.ReadFile(caKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read IPsec signer CA private key: %w", err)
+	}
+	keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IPsec signer CA: %w", err)
+	}
+	caCert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse IPsec signer CA certificate: %w", err)
+	}
+	if !caCert.IsCA {
+		return nil, fmt.Errorf("IPsec signer certificate %s is not a CA", caCertFile)
+	}
+	caKey, ok := keyPair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("IPsec signer CA private key %s can't be used to sign", caKeyFile)
+	}
+	return &IPsecSigner{
+		client:      client,
+		caCert:      caCert,
+		caKey:       caKey,
+		maxDuration: maxDuration,
+		recorder:    recorder,
+	}, nil
+}
+
+func isApproved(status *certificatesv1.CertificateSigningRequestStatus) bool {
+	approved := false
+	for _, c := range status.Conditions {
+		switch c.Type {
+		case certificatesv1.CertificateApproved:
+			approved = true
+		case certificatesv1.CertificateDenied, certificatesv1.CertificateFailed:
+			return false
+		}
+	}
+	return approved
+}
+
+func (s *IPsecSigner) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	req := &certificatesv1.CertificateSigningRequest{}
+	err := s.client.Get(ctx, request.NamespacedName, req)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if req.Spec.SignerName != IPsecSignerName || len(req.Status.Certificate) > 0 || !isApproved(&req.Status) {
+		return reconcile.Result{}, nil
+	}
+
+	certPEM, err := s.sign(req)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to sign CSR %q: %w", req.Name, err)
+	}
+	req.Status.Certificate = certPEM
+	if err := s.client.Status().Update(ctx, req); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update the certificate of CSR %q: %w", req.Name, err)
+	}
+
+	s.recorder.Eventf(&corev1.ObjectReference{
+		Kind: "CertificateSigningRequest",
+		Name: req.Name,
+	}, corev1.EventTypeNormal, "CSRSigned", "CSR %q has been signed", req.Name)
+	klog.Infof("Signed IPsec CSR %s", req.Name)
+	return reconcile.Result{}, nil
+}
+
+// sign returns the PEM encoded certificate for the CSR, signed by the CA
+func (s *IPsecSigner) sign(req *certificatesv1.CertificateSigningRequest) ([]byte, error) {
+	csrPEM, _ := pem.Decode(req.Spec.Request)
+	if csrPEM == nil {
+		return nil, fmt.Errorf("failed to decode PEM block in .spec.request: no CSRs were found")
+	}
+	x509CSR, err := x509.ParseCertificateRequest(csrPEM.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PEM bytes: %w", err)
+	}
+	if err := x509CSR.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	duration := s.maxDuration
+	if req.Spec.ExpirationSeconds != nil {
+		duration = min(duration, csr.ExpirationSecondsToDuration(*req.Spec.ExpirationSeconds))
+	}
+	now := time.Now()
+	notAfter := now.Add(duration)
+	if notAfter.After(s.caCert.NotAfter) {
+		notAfter = s.caCert.NotAfter
+	}
+
+	var extKeyUsages []x509.ExtKeyUsage
+	for _, usage := range req.Spec.Usages {
+		switch usage {
+		case certificatesv1.UsageClientAuth:
+			extKeyUsages = append(extKeyUsages, x509.ExtKeyUsageClientAuth)
+		case certificatesv1.UsageServerAuth:
+			extKeyUsages = append(extKeyUsages, x509.ExtKeyUsageServerAuth)
+		}
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               x509CSR.Subject,
+		NotBefore:             now.Add(-signerBackdate),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           extKeyUsages,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, x509CSR.PublicKey, s.caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
@@ -0,0 +1,185 @@
+package csrapprover
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/certificate/csr"
+	"k8s.io/client-go/util/keyutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestIPsecSigner(t *testing.T) {
+	approved := []certificatesv1.CertificateSigningRequestCondition{
+		{
+			Type:   certificatesv1.CertificateApproved,
+			Status: corev1.ConditionTrue,
+		},
+	}
+	tests := []struct {
+		name        string
+		signerName  string
+		conditions  []certificatesv1.CertificateSigningRequestCondition
+		duration    time.Duration
+		expectSign  bool
+		expectedTTL time.Duration
+	}{
+		{
+			name:        "approved CSR is signed with the requested duration",
+			signerName:  IPsecSignerName,
+			conditions:  approved,
+			duration:    time.Hour,
+			expectSign:  true,
+			expectedTTL: time.Hour,
+		},
+		{
+			name:        "approved CSR duration is bounded by the maximum duration",
+			signerName:  IPsecSignerName,
+			conditions:  approved,
+			duration:    48 * time.Hour,
+			expectSign:  true,
+			expectedTTL: 24 * time.Hour,
+		},
+		{
+			name:       "pending CSR is not signed",
+			signerName: IPsecSignerName,
+			duration:   time.Hour,
+		},
+		{
+			name:       "denied CSR is not signed",
+			signerName: IPsecSignerName,
+			conditions: []certificatesv1.CertificateSigningRequestCondition{
+				{
+					Type:   certificatesv1.CertificateDenied,
+					Status: corev1.ConditionTrue,
+				},
+			},
+			duration: time.Hour,
+		},
+		{
+			name:       "CSR for another signer is not signed",
+			signerName: certificatesv1.KubeAPIServerClientSignerName,
+			conditions: approved,
+			duration:   time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			caCert, err := cert.NewSelfSignedCACert(cert.Config{CommonName: "ipsec-ca"}, caKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			caKeyPEM, err := keyutil.MarshalPrivateKeyToPEM(caKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dir := t.TempDir()
+			caCertFile := filepath.Join(dir, "ca.crt")
+			caKeyFile := filepath.Join(dir, "ca.key")
+			if err := os.WriteFile(caCertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(caKeyFile, caKeyPEM, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrPEM, err := cert.MakeCSR(privateKey, &pkix.Name{
+				CommonName:   "chassis-id",
+				Organization: DefaultCSRAcceptanceCondition.Organizations,
+			}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrObj := &certificatesv1.CertificateSigningRequest{
+				TypeMeta: metav1.TypeMeta{Kind: "CertificateSigningRequest"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: csrName,
+				},
+				Spec: certificatesv1.CertificateSigningRequestSpec{
+					Request:           csrPEM,
+					Usages:            sets.List(IPsecUsages),
+					SignerName:        tt.signerName,
+					ExpirationSeconds: csr.DurationToExpirationSeconds(tt.duration),
+				},
+				Status: certificatesv1.CertificateSigningRequestStatus{
+					Conditions: tt.conditions,
+				},
+			}
+
+			client := fake.NewClientBuilder().
+				WithRuntimeObjects(csrObj).
+				WithStatusSubresource(&certificatesv1.CertificateSigningRequest{}).
+				Build()
+			signer, err := NewIPsecSigner(client, caCertFile, caKeyFile, 24*time.Hour, record.NewFakeRecorder(10))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: csrName,
+				},
+			}
+			if _, err := signer.Reconcile(context.Background(), req); err != nil {
+				t.Fatal(err)
+			}
+
+			csrObj = &certificatesv1.CertificateSigningRequest{}
+			if err := client.Get(context.TODO(), req.NamespacedName, csrObj); err != nil {
+				t.Fatal(err)
+			}
+			if !tt.expectSign {
+				if len(csrObj.Status.Certificate) != 0 {
+					t.Fatalf("expected CSR not to be signed")
+				}
+				return
+			}
+
+			certs, err := cert.ParseCertsPEM(csrObj.Status.Certificate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(certs) != 1 {
+				t.Fatalf("expected one certificate, got %d", len(certs))
+			}
+			signed := certs[0]
+			if err := signed.CheckSignatureFrom(caCert); err != nil {
+				t.Fatalf("certificate not signed by the CA: %v", err)
+			}
+			if signed.Subject.CommonName != "chassis-id" {
+				t.Fatalf("unexpected common name %q", signed.Subject.CommonName)
+			}
+			if !sets.New(signed.ExtKeyUsage...).Equal(sets.New(x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth)) {
+				t.Fatalf("unexpected extended key usages %v", signed.ExtKeyUsage)
+			}
+			if ttl := signed.NotAfter.Sub(signed.NotBefore) - signerBackdate; ttl > tt.expectedTTL || ttl < tt.expectedTTL-time.Minute {
+				t.Fatalf("expected a certificate valid for %v, got %v", tt.expectedTTL, ttl)
+			}
+		})
+	}
+}
@@ -81,6 +81,16 @@ import (
 	routeadvertisementsinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions"
 	routeadvertisementsinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions/routeadvertisements/v1"
 
+	clusterlinkapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	clusterlinkscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/clientset/versioned/scheme"
+	clusterlinkinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/informers/externalversions"
+	clusterlinkinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/informers/externalversions/clusterlink/v1"
+
+	networkqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1"
+	networkqosscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/clientset/versioned/scheme"
+	networkqosinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/informers/externalversions"
+	networkqosinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/informers/externalversions/networkqos/v1"
+
 	frrapi "github.com/metallb/frr-k8s/api/v1beta1"
 	frrscheme "github.com/metallb/frr-k8s/pkg/client/clientset/versioned/scheme"
 	frrinformerfactory "github.com/metallb/frr-k8s/pkg/client/informers/externalversions"
@@ -131,6 +141,8 @@ type WatchFactory struct {
 	udnFactory           userdefinednetworkapiinformerfactory.SharedInformerFactory
 	raFactory            routeadvertisementsinformerfactory.SharedInformerFactory
 	frrFactory           frrinformerfactory.SharedInformerFactory
+	clFactory            clusterlinkinformerfactory.SharedInformerFactory
+	networkQoSFactory    networkqosinformerfactory.SharedInformerFactory
 	informers            map[reflect.Type]*informer
 
 	stopChan chan struct{}
@@ -158,6 +170,8 @@ func (wf *WatchFactory) ShallowClone() *WatchFactory {
 		udnFactory:           wf.udnFactory,
 		raFactory:            wf.raFactory,
 		frrFactory:           wf.frrFactory,
+		clFactory:            wf.clFactory,
+		networkQoSFactory:    wf.networkQoSFactory,
 		informers:            wf.informers,
 		stopChan:             wf.stopChan,
 
@@ -344,6 +358,12 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 	if err := routeadvertisementsapi.AddToScheme(routeadvertisementsscheme.Scheme); err != nil {
 		return nil, err
 	}
+	if err := clusterlinkapi.AddToScheme(clusterlinkscheme.Scheme); err != nil {
+		return nil, err
+	}
+	if err := networkqosapi.AddToScheme(networkqosscheme.Scheme); err != nil {
+		return nil, err
+	}
 
 	if err := nadapi.AddToScheme(nadscheme.Scheme); err != nil {
 		return nil, err
@@ -510,6 +530,18 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		wf.raFactory.K8s().V1().RouteAdvertisements().Informer()
 	}
 
+	if config.OVNKubernetesFeature.EnableClusterLink {
+		wf.clFactory = clusterlinkinformerfactory.NewSharedInformerFactory(ovnClientset.ClusterLinkClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.clFactory.Start() it is initialized and caches are synced.
+		wf.clFactory.K8s().V1().ClusterLinks().Informer()
+	}
+
+	if config.OVNKubernetesFeature.EnableNetworkQoS {
+		wf.networkQoSFactory = networkqosinformerfactory.NewSharedInformerFactory(ovnClientset.NetworkQoSClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.networkQoSFactory.Start() it is initialized and caches are synced.
+		wf.networkQoSFactory.K8s().V1().NetworkQoSes().Informer()
+	}
+
 	return wf, nil
 }
 
@@ -643,6 +675,22 @@ func (wf *WatchFactory) Start() error {
 			}
 		}
 	}
+	if wf.clFactory != nil {
+		wf.clFactory.Start(wf.stopChan)
+		for oType, synced := range waitForCacheSyncWithTimeout(wf.clFactory, wf.stopChan) {
+			if !synced {
+				return fmt.Errorf("error in syncing cache for %v informer", oType)
+			}
+		}
+	}
+	if wf.networkQoSFactory != nil {
+		wf.networkQoSFactory.Start(wf.stopChan)
+		for oType, synced := range waitForCacheSyncWithTimeout(wf.networkQoSFactory, wf.stopChan) {
+			if !synced {
+				return fmt.Errorf("error in syncing cache for %v informer", oType)
+			}
+		}
+	}
 
 	return nil
 }
@@ -692,6 +740,12 @@ func (wf *WatchFactory) Stop() {
 	if wf.frrFactory != nil {
 		wf.frrFactory.Shutdown()
 	}
+	if wf.clFactory != nil {
+		wf.clFactory.Shutdown()
+	}
+	if wf.networkQoSFactory != nil {
+		wf.networkQoSFactory.Shutdown()
+	}
 }
 
 // NewNodeWatchFactory initializes a watch factory with significantly fewer
@@ -907,6 +961,12 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 	if err := routeadvertisementsapi.AddToScheme(routeadvertisementsscheme.Scheme); err != nil {
 		return nil, err
 	}
+	if err := clusterlinkapi.AddToScheme(clusterlinkscheme.Scheme); err != nil {
+		return nil, err
+	}
+	if err := networkqosapi.AddToScheme(networkqosscheme.Scheme); err != nil {
+		return nil, err
+	}
 	if err := frrapi.AddToScheme(frrscheme.Scheme); err != nil {
 		return nil, err
 	}
@@ -1044,6 +1104,10 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 		if err != nil {
 			return nil, err
 		}
+		// make sure UDN quota informer cache is initialized and synced on Start().
+		wf.udnFactory.K8s().V1().UserDefinedNetworkQuotas().Informer()
+		// make sure UDN CIDR pool informer cache is initialized and synced on Start().
+		wf.udnFactory.K8s().V1().UserDefinedNetworkCIDRPools().Informer()
 
 		// make sure namespace informer cache is initialized and synced on Start().
 		wf.iFactory.Core().V1().Namespaces().Informer()
@@ -1062,6 +1126,18 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 		wf.frrFactory.Api().V1beta1().FRRConfigurations().Informer()
 	}
 
+	if config.OVNKubernetesFeature.EnableClusterLink {
+		wf.clFactory = clusterlinkinformerfactory.NewSharedInformerFactory(ovnClientset.ClusterLinkClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.clFactory.Start() it is initialized and caches are synced.
+		wf.clFactory.K8s().V1().ClusterLinks().Informer()
+	}
+
+	if config.OVNKubernetesFeature.EnableNetworkQoS {
+		wf.networkQoSFactory = networkqosinformerfactory.NewSharedInformerFactory(ovnClientset.NetworkQoSClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.networkQoSFactory.Start() it is initialized and caches are synced.
+		wf.networkQoSFactory.K8s().V1().NetworkQoSes().Informer()
+	}
+
 	return wf, nil
 }
 
@@ -1753,6 +1829,14 @@ func (wf *WatchFactory) ClusterUserDefinedNetworkInformer() userdefinednetworkin
 	return wf.udnFactory.K8s().V1().ClusterUserDefinedNetworks()
 }
 
+func (wf *WatchFactory) UserDefinedNetworkQuotaInformer() userdefinednetworkinformer.UserDefinedNetworkQuotaInformer {
+	return wf.udnFactory.K8s().V1().UserDefinedNetworkQuotas()
+}
+
+func (wf *WatchFactory) UserDefinedNetworkCIDRPoolInformer() userdefinednetworkinformer.UserDefinedNetworkCIDRPoolInformer {
+	return wf.udnFactory.K8s().V1().UserDefinedNetworkCIDRPools()
+}
+
 func (wf *WatchFactory) DNSNameResolverInformer() ocpnetworkinformerv1alpha1.DNSNameResolverInformer {
 	return wf.dnsFactory.Network().V1alpha1().DNSNameResolvers()
 }
@@ -1765,6 +1849,14 @@ func (wf *WatchFactory) FRRConfigurationsInformer() frrinformer.FRRConfiguration
 	return wf.frrFactory.Api().V1beta1().FRRConfigurations()
 }
 
+func (wf *WatchFactory) ClusterLinkInformer() clusterlinkinformer.ClusterLinkInformer {
+	return wf.clFactory.K8s().V1().ClusterLinks()
+}
+
+func (wf *WatchFactory) NetworkQoSInformer() networkqosinformer.NetworkQoSInformer {
+	return wf.networkQoSFactory.K8s().V1().NetworkQoSes()
+}
+
 // withServiceNameAndNoHeadlessServiceSelector returns a LabelSelector (added to the
 // watcher for EndpointSlices) that will only choose EndpointSlices with a non-empty
 // "kubernetes.io/service-name" label and without "service.kubernetes.io/headless"
@@ -589,17 +589,17 @@ func (_m *InterfaceOVN) UpdateEgressIP(eIP *egressipv1.EgressIP) error {
 	return r0
 }
 
-// UpdateEgressServiceStatus provides a mock function with given fields: namespace, name, host
-func (_m *InterfaceOVN) UpdateEgressServiceStatus(namespace string, name string, host string) error {
-	ret := _m.Called(namespace, name, host)
+// UpdateEgressServiceStatus provides a mock function with given fields: namespace, name, host, hosts
+func (_m *InterfaceOVN) UpdateEgressServiceStatus(namespace string, name string, host string, hosts []string) error {
+	ret := _m.Called(namespace, name, host, hosts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateEgressServiceStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
-		r0 = rf(namespace, name, host)
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) error); ok {
+		r0 = rf(namespace, name, host, hosts)
 	} else {
 		r0 = ret.Error(0)
 	}
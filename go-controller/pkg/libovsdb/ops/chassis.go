@@ -136,27 +136,31 @@ func DeleteChassisWithPredicate(sbClient libovsdbclient.Client, p chassisPredica
 	return err
 }
 
-// CreateOrUpdateChassis creates or updates the chassis record along with the encap record
-func CreateOrUpdateChassis(sbClient libovsdbclient.Client, chassis *sbdb.Chassis, encap *sbdb.Encap) error {
+// CreateOrUpdateChassis creates or updates the chassis record along with its encap
+// records. The chassis encaps are set to exactly the provided encaps.
+func CreateOrUpdateChassis(sbClient libovsdbclient.Client, chassis *sbdb.Chassis, encaps ...*sbdb.Encap) error {
 	m := newModelClient(sbClient)
-	opModels := []operationModel{
-		{
+	opModels := make([]operationModel, 0, len(encaps)+1)
+	chassis.Encaps = make([]string, 0, len(encaps))
+	for i := range encaps {
+		encap := encaps[i]
+		opModels = append(opModels, operationModel{
 			Model: encap,
 			DoAfter: func() {
-				chassis.Encaps = []string{encap.UUID}
+				chassis.Encaps = append(chassis.Encaps, encap.UUID)
 			},
 			OnModelUpdates: onModelUpdatesAllNonDefault(),
 			ErrNotFound:    false,
 			BulkOp:         false,
-		},
-		{
-			Model:            chassis,
-			OnModelMutations: []interface{}{&chassis.OtherConfig},
-			OnModelUpdates:   []interface{}{&chassis.Encaps},
-			ErrNotFound:      false,
-			BulkOp:           false,
-		},
+		})
 	}
+	opModels = append(opModels, operationModel{
+		Model:            chassis,
+		OnModelMutations: []interface{}{&chassis.OtherConfig},
+		OnModelUpdates:   []interface{}{&chassis.Encaps},
+		ErrNotFound:      false,
+		BulkOp:           false,
+	})
 
 	if _, err := m.CreateOrUpdate(opModels...); err != nil {
 		return err
@@ -10,6 +10,7 @@ const (
 	logicalRouterPolicy
 	qos
 	nat
+	bfd
 )
 
 const (
@@ -17,6 +18,7 @@ const (
 	EgressFirewallDNSOwnerType          ownerType = "EgressFirewallDNS"
 	EgressFirewallOwnerType             ownerType = "EgressFirewall"
 	EgressQoSOwnerType                  ownerType = "EgressQoS"
+	NetworkQoSOwnerType                 ownerType = "NetworkQoS"
 	AdminNetworkPolicyOwnerType         ownerType = "AdminNetworkPolicy"
 	BaselineAdminNetworkPolicyOwnerType ownerType = "BaselineAdminNetworkPolicy"
 	// NetworkPolicyOwnerType is deprecated for address sets, should only be used for sync.
@@ -99,6 +101,12 @@ var AddressSetEgressQoS = newObjectIDsType(addressSet, EgressQoSOwnerType, []Ext
 	IPFamilyKey,
 })
 
+var AddressSetNetworkQoS = newObjectIDsType(addressSet, NetworkQoSOwnerType, []ExternalIDKey{
+	// namespace/name of the NetworkQoS
+	ObjectNameKey,
+	IPFamilyKey,
+})
+
 var AddressSetPodSelector = newObjectIDsType(addressSet, PodSelectorOwnerType, []ExternalIDKey{
 	// pod selector string representation
 	ObjectNameKey,
@@ -336,6 +344,13 @@ var QoSEgressQoS = newObjectIDsType(qos, EgressQoSOwnerType, []ExternalIDKey{
 	ObjectNameKey,
 })
 
+var QoSNetworkQoS = newObjectIDsType(qos, NetworkQoSOwnerType, []ExternalIDKey{
+	// namespace/name of the NetworkQoS
+	ObjectNameKey,
+	// the index of the rule in the NetworkQoS
+	RuleIndex,
+})
+
 var QoSRuleEgressIP = newObjectIDsType(qos, EgressIPOwnerType, []ExternalIDKey{
 	// the priority of the QoSRule
 	PriorityKey,
@@ -344,3 +359,10 @@ var QoSRuleEgressIP = newObjectIDsType(qos, EgressIPOwnerType, []ExternalIDKey{
 	// the IP Family for this policy, ip4 or ip6 or ip(dualstack)
 	IPFamilyKey,
 })
+
+var BFDEgressIP = newObjectIDsType(bfd, EgressIPOwnerType, []ExternalIDKey{
+	// the name of the egress node the BFD session is monitoring
+	ObjectNameKey,
+	// the IP Family for this session, ip4 or ip6
+	IPFamilyKey,
+})
@@ -62,3 +62,23 @@ func UpdateNBGlobalSetOptions(nbClient libovsdbclient.Client, nbGlobal *nbdb.NBG
 	_, err = m.CreateOrUpdate(opModel)
 	return err
 }
+
+// UpdateNBGlobalIPsec sets whether IPsec is enabled on the NB Global entry
+func UpdateNBGlobalIPsec(nbClient libovsdbclient.Client, ipsec bool) error {
+	nbGlobal, err := GetNBGlobal(nbClient, &nbdb.NBGlobal{})
+	if err != nil {
+		return err
+	}
+
+	nbGlobal.Ipsec = ipsec
+	opModel := operationModel{
+		Model:          nbGlobal,
+		OnModelUpdates: []interface{}{&nbGlobal.Ipsec},
+		ErrNotFound:    true,
+		BulkOp:         false,
+	}
+
+	m := newModelClient(nbClient)
+	_, err = m.CreateOrUpdate(opModel)
+	return err
+}
@@ -819,6 +819,17 @@ func CreateOrUpdateBFDOps(nbClient libovsdbclient.Client, ops []libovsdb.Operati
 	return m.CreateOrUpdateOps(ops, opModels...)
 }
 
+type bfdPredicate func(*nbdb.BFD) bool
+
+// FindBFDsWithPredicate looks up BFDs from the cache based on a given predicate
+func FindBFDsWithPredicate(nbClient libovsdbclient.Client, p bfdPredicate) ([]*nbdb.BFD, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
+	defer cancel()
+	found := []*nbdb.BFD{}
+	err := nbClient.WhereCache(p).List(ctx, &found)
+	return found, err
+}
+
 // DeleteBFDs deletes the provided BFDs
 func DeleteBFDs(nbClient libovsdbclient.Client, bfds ...*nbdb.BFD) error {
 	opModels := make([]operationModel, 0, len(bfds))
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/ovn-org/libovsdb/model"
 	"github.com/ovn-org/libovsdb/ovsdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/tracing"
 )
 
 // TransactWithRetry will attempt a transaction several times if it receives an error indicating that the client
@@ -35,16 +37,32 @@ func TransactWithRetry(ctx context.Context, c client.Client, ops []ovsdb.Operati
 }
 
 func TransactAndCheck(c client.Client, ops []ovsdb.Operation) ([]ovsdb.OperationResult, error) {
+	return TransactAndCheckContext(context.TODO(), c, ops)
+}
+
+// TransactAndCheckContext is like TransactAndCheck but traces the transaction
+// as a child of the span carried by ctx, if any.
+func TransactAndCheckContext(ctx context.Context, c client.Client, ops []ovsdb.Operation) (results []ovsdb.OperationResult, err error) {
 	if len(ops) <= 0 {
 		return []ovsdb.OperationResult{{}}, nil
 	}
 
 	klog.V(5).Infof("Configuring OVN: %+v", ops)
 
-	ctx, cancel := context.WithTimeout(context.TODO(), config.Default.OVSDBTxnTimeout)
+	ctx, span := tracing.StartSpan(ctx, "ovsdb transact")
+	if span.IsRecording() {
+		span.SetAttributes(
+			attribute.String("db.system", "ovsdb"),
+			attribute.String("db.name", c.Schema().Name),
+			attribute.Int("ovsdb.operations", len(ops)),
+		)
+	}
+	defer func() { tracing.EndSpan(span, err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, config.Default.OVSDBTxnTimeout)
 	defer cancel()
 
-	results, err := TransactWithRetry(ctx, c, ops)
+	results, err = TransactWithRetry(ctx, c, ops)
 	if err != nil {
 		return nil, fmt.Errorf("error in transact with ops %+v: %v", ops, err)
 	}
@@ -62,7 +80,13 @@ func TransactAndCheck(c client.Client, ops []ovsdb.Operation) ([]ovsdb.Operation
 // the passed models if they were inserted and have a named-uuid (as built by
 // BuildNamedUUID)
 func TransactAndCheckAndSetUUIDs(client client.Client, models interface{}, ops []ovsdb.Operation) ([]ovsdb.OperationResult, error) {
-	results, err := TransactAndCheck(client, ops)
+	return TransactAndCheckAndSetUUIDsContext(context.TODO(), client, models, ops)
+}
+
+// TransactAndCheckAndSetUUIDsContext is like TransactAndCheckAndSetUUIDs but
+// traces the transaction as a child of the span carried by ctx, if any.
+func TransactAndCheckAndSetUUIDsContext(ctx context.Context, client client.Client, models interface{}, ops []ovsdb.Operation) ([]ovsdb.OperationResult, error) {
+	results, err := TransactAndCheckContext(ctx, client, ops)
 	if err != nil {
 		return nil, err
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 var registerClusterManagerBaseMetrics sync.Once
@@ -90,6 +91,32 @@ var metricEgressIPRebalanceCount = prometheus.NewCounter(prometheus.CounterOpts{
 
 /** EgressIP metrics recorded from cluster-manager ends**/
 
+// metricNetworkPodCount is the number of pods with resources allocated on
+// each network.
+var metricNetworkPodCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemClusterManager,
+	Name:      "network_pods",
+	Help:      "The number of pods with resources allocated on a network"},
+	networkLabelNames,
+)
+
+var metricNetworkAllocatedIPs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemClusterManager,
+	Name:      "network_allocated_ips",
+	Help:      "The number of IPs allocated from the IP pool of a network"},
+	append(networkLabelNames, "ip_family"),
+)
+
+var metricNetworkAvailableIPs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemClusterManager,
+	Name:      "network_available_ips",
+	Help:      "The number of IPs available in the IP pool of a network"},
+	append(networkLabelNames, "ip_family"),
+)
+
 // RegisterClusterManagerBase registers ovnkube cluster manager base metrics with the Prometheus registry.
 // This function should only be called once.
 func RegisterClusterManagerBase() {
@@ -124,6 +151,9 @@ func RegisterClusterManagerFunctional() {
 	prometheus.MustRegister(metricV6HostSubnetCount)
 	prometheus.MustRegister(metricV4AllocatedHostSubnetCount)
 	prometheus.MustRegister(metricV6AllocatedHostSubnetCount)
+	prometheus.MustRegister(metricNetworkPodCount)
+	prometheus.MustRegister(metricNetworkAllocatedIPs)
+	prometheus.MustRegister(metricNetworkAvailableIPs)
 	if config.OVNKubernetesFeature.EnableEgressIP {
 		prometheus.MustRegister(metricEgressIPNodeUnreacheableCount)
 		prometheus.MustRegister(metricEgressIPRebalanceCount)
@@ -149,6 +179,33 @@ func RecordSubnetCount(v4SubnetCount, v6SubnetCount float64, networkName string)
 	metricV6HostSubnetCount.WithLabelValues(networkName).Set(v6SubnetCount)
 }
 
+// RecordNetworkPodCount records the number of pods with resources allocated
+// on a network
+func RecordNetworkPodCount(netInfo util.NetInfo, count int) {
+	metricNetworkPodCount.WithLabelValues(networkLabelValues(netInfo)...).Set(float64(count))
+}
+
+// RecordNetworkIPPoolUsage records the number of allocated and available IPs
+// of the given IP family in the IP pool of a network
+func RecordNetworkIPPoolUsage(netInfo util.NetInfo, ipFamily string, allocated, available int) {
+	labels := append(networkLabelValues(netInfo), ipFamily)
+	metricNetworkAllocatedIPs.WithLabelValues(labels...).Set(float64(allocated))
+	metricNetworkAvailableIPs.WithLabelValues(labels...).Set(float64(available))
+}
+
+// DeleteNetworkClusterManagerMetrics removes the metrics recorded for a
+// network that no longer exists
+func DeleteNetworkClusterManagerMetrics(networkName string) {
+	metricV4HostSubnetCount.DeleteLabelValues(networkName)
+	metricV6HostSubnetCount.DeleteLabelValues(networkName)
+	metricV4AllocatedHostSubnetCount.DeleteLabelValues(networkName)
+	metricV6AllocatedHostSubnetCount.DeleteLabelValues(networkName)
+	labels := prometheus.Labels{networkLabel: networkName}
+	metricNetworkPodCount.DeletePartialMatch(labels)
+	metricNetworkAllocatedIPs.DeletePartialMatch(labels)
+	metricNetworkAvailableIPs.DeletePartialMatch(labels)
+}
+
 // RecordEgressIPReachableNode records how many times EgressIP detected an unuseable node.
 func RecordEgressIPUnreachableNode() {
 	metricEgressIPNodeUnreacheableCount.Inc()
@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"testing"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func TestDeleteNetworkClusterManagerMetrics(t *testing.T) {
+	newNetInfo := func(name string) util.NetInfo {
+		netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+			NetConf:  cnitypes.NetConf{Name: name},
+			Topology: types.Layer3Topology,
+			Subnets:  "10.128.0.0/16/24",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return netInfo
+	}
+	for _, netInfo := range []util.NetInfo{newNetInfo("blue"), newNetInfo("red")} {
+		RecordSubnetCount(256, 0, netInfo.GetNetworkName())
+		RecordSubnetUsage(2, 0, netInfo.GetNetworkName())
+		RecordNetworkPodCount(netInfo, 3)
+		RecordNetworkIPPoolUsage(netInfo, "ipv4", 3, 250)
+	}
+
+	DeleteNetworkClusterManagerMetrics("blue")
+
+	for name, count := range map[string]int{
+		"num_v4_host_subnets":       testutil.CollectAndCount(metricV4HostSubnetCount),
+		"allocated_v4_host_subnets": testutil.CollectAndCount(metricV4AllocatedHostSubnetCount),
+		"network_pods":              testutil.CollectAndCount(metricNetworkPodCount),
+		"network_allocated_ips":     testutil.CollectAndCount(metricNetworkAllocatedIPs),
+		"network_available_ips":     testutil.CollectAndCount(metricNetworkAvailableIPs),
+	} {
+		if count != 1 {
+			t.Errorf("expected only the metric of network red in %s, got %d", name, count)
+		}
+	}
+	if value := testutil.ToFloat64(metricV4HostSubnetCount.WithLabelValues("red")); value != 256 {
+		t.Errorf("expected 256 v4 host subnets on network red, got %v", value)
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -45,8 +46,26 @@ const (
 	ovsVswitchd   = "ovs-vswitchd"
 
 	metricsUpdateInterval = 5 * time.Minute
+
+	// labels used to break down metrics per network
+	networkLabel         = "network"
+	networkTopologyLabel = "topology"
+	networkRoleLabel     = "role"
 )
 
+// networkLabelNames are the labels of metrics that are recorded per network
+var networkLabelNames = []string{networkLabel, networkTopologyLabel, networkRoleLabel}
+
+// networkLabelValues returns the values of networkLabelNames for the given
+// network.
+func networkLabelValues(netInfo util.NetInfo) []string {
+	role := types.NetworkRoleDefault
+	if !netInfo.IsDefault() {
+		role = util.GetUserDefinedNetworkRole(netInfo.IsPrimaryNetwork())
+	}
+	return []string{netInfo.GetNetworkName(), netInfo.TopologyType(), role}
+}
+
 type metricDetails struct {
 	srcName       string
 	aggregateFrom []string
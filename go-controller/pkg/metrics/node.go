@@ -45,6 +45,20 @@ var metricOvnKubeNodeLogFileSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	},
 )
 
+// MetricOvsCPUAffinity reports the CPUs the OVS daemon threads are pinned to
+// by ovnkube-node
+var MetricOvsCPUAffinity = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemNode,
+	Name:      "ovs_cpu_affinity_info",
+	Help: "A metric with a constant '1' value labeled by the OVS threads, 'daemons' for ovs-vswitchd and " +
+		"ovsdb-server or 'pmd' for the PMD threads, and the list of CPUs they are pinned to."},
+	[]string{
+		"threads",
+		"cpus",
+	},
+)
+
 var registerNodeMetricsOnce sync.Once
 
 func RegisterNodeMetrics(stopChan <-chan struct{}) {
@@ -78,6 +92,7 @@ func RegisterNodeMetrics(stopChan <-chan struct{}) {
 			}
 		}
 		prometheus.MustRegister(metricOvnKubeNodeLogFileSize)
+		prometheus.MustRegister(MetricOvsCPUAffinity)
 		go ovnKubeLogFileSizeMetricsUpdater(metricOvnKubeNodeLogFileSize, stopChan)
 	})
 }
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"hash/fnv"
@@ -20,9 +21,13 @@ import (
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/tracing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	kapi "k8s.io/api/core/v1"
 	kapimtypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -54,13 +59,14 @@ var metricDbTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 
 // metricPodCreationLatency is the time between a pod being scheduled and
 // completing its logical switch port configuration.
-var metricPodCreationLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
+var metricPodCreationLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 	Namespace: MetricOvnkubeNamespace,
 	Subsystem: MetricOvnkubeSubsystemController,
 	Name:      "pod_creation_latency_seconds",
 	Help:      "The duration between a pod being scheduled and completing its logical switch port configuration",
-	Buckets:   prometheus.ExponentialBuckets(.1, 2, 15),
-})
+	Buckets:   prometheus.ExponentialBuckets(.1, 2, 15)},
+	networkLabelNames,
+)
 
 // MetricResourceUpdateCount is the number of times a particular resource's UpdateFunc has been called.
 var MetricResourceUpdateCount = prometheus.NewCounterVec(prometheus.CounterOpts{
@@ -74,14 +80,17 @@ var MetricResourceUpdateCount = prometheus.NewCounterVec(prometheus.CounterOpts{
 	},
 )
 
-// MetricResourceAddLatency is the time taken to complete resource update by an handler.
-// This measures the latency for all of the handlers for a given resource.
-var MetricResourceAddLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
+// MetricResourceAddLatency is the time taken to complete resource add by the handler
+// of a network.
+var MetricResourceAddLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 	Namespace: MetricOvnkubeNamespace,
 	Subsystem: MetricOvnkubeSubsystemController,
 	Name:      "resource_add_latency_seconds",
-	Help:      "The duration to process all handlers for a given resource event - add.",
+	Help:      "The duration to process the handler of a network for a given resource event - add.",
 	Buckets:   prometheus.ExponentialBuckets(.1, 2, 15)},
+	[]string{
+		networkLabel,
+	},
 )
 
 // MetricResourceUpdateLatency is the time taken to complete resource update by an handler.
@@ -241,11 +250,24 @@ var metricPodEventLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		"event",
 	})
 
-var metricEgressFirewallRuleCount = prometheus.NewGauge(prometheus.GaugeOpts{
+var metricEgressFirewallRuleCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	Namespace: MetricOvnkubeNamespace,
 	Subsystem: MetricOvnkubeSubsystemController,
 	Name:      "num_egress_firewall_rules",
 	Help:      "The number of egress firewall rules defined"},
+	[]string{
+		networkLabel,
+	},
+)
+
+// metricNetworkNADCount is the number of network attachment definitions
+// referencing each network.
+var metricNetworkNADCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemController,
+	Name:      "network_nads",
+	Help:      "The number of network attachment definitions referencing a network"},
+	networkLabelNames,
 )
 
 var metricIPsecEnabled = prometheus.NewGauge(prometheus.GaugeOpts{
@@ -307,37 +329,49 @@ var metricBANPDBObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 /** AdminNetworkPolicyMetrics End**/
 
 // metricFirstSeenLSPLatency is the time between a pod first seen in OVN-Kubernetes and its Logical Switch Port is created
-var metricFirstSeenLSPLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
+var metricFirstSeenLSPLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 	Namespace: MetricOvnkubeNamespace,
 	Subsystem: MetricOvnkubeSubsystemController,
 	Name:      "pod_first_seen_lsp_created_duration_seconds",
 	Help:      "The duration between a pod first observed in OVN-Kubernetes and Logical Switch Port created",
-	Buckets:   prometheus.ExponentialBuckets(.01, 2, 15),
-})
+	Buckets:   prometheus.ExponentialBuckets(.01, 2, 15)},
+	[]string{
+		networkLabel,
+	},
+)
 
-var metricLSPPortBindingLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
+var metricLSPPortBindingLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 	Namespace: MetricOvnkubeNamespace,
 	Subsystem: MetricOvnkubeSubsystemController,
 	Name:      "pod_lsp_created_port_binding_duration_seconds",
 	Help:      "The duration between a pods Logical Switch Port created and port binding observed in cache",
-	Buckets:   prometheus.ExponentialBuckets(.01, 2, 15),
-})
+	Buckets:   prometheus.ExponentialBuckets(.01, 2, 15)},
+	[]string{
+		networkLabel,
+	},
+)
 
-var metricPortBindingChassisLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
+var metricPortBindingChassisLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 	Namespace: MetricOvnkubeNamespace,
 	Subsystem: MetricOvnkubeSubsystemController,
 	Name:      "pod_port_binding_port_binding_chassis_duration_seconds",
 	Help:      "The duration between a pods port binding observed and port binding chassis update observed in cache",
-	Buckets:   prometheus.ExponentialBuckets(.01, 2, 15),
-})
+	Buckets:   prometheus.ExponentialBuckets(.01, 2, 15)},
+	[]string{
+		networkLabel,
+	},
+)
 
-var metricPortBindingUpLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
+var metricPortBindingUpLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 	Namespace: MetricOvnkubeNamespace,
 	Subsystem: MetricOvnkubeSubsystemController,
 	Name:      "pod_port_binding_chassis_port_binding_up_duration_seconds",
 	Help:      "The duration between a pods port binding chassis update and port binding up observed in cache",
-	Buckets:   prometheus.ExponentialBuckets(.01, 2, 15),
-})
+	Buckets:   prometheus.ExponentialBuckets(.01, 2, 15)},
+	[]string{
+		networkLabel,
+	},
+)
 
 var metricNetworkProgramming prometheus.ObserverVec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 	Namespace: MetricOvnkubeNamespace,
@@ -439,6 +473,7 @@ func RegisterOVNKubeControllerFunctional(stopChan <-chan struct{}) {
 	}
 	prometheus.MustRegister(metricEgressFirewallRuleCount)
 	prometheus.MustRegister(metricEgressFirewallCount)
+	prometheus.MustRegister(metricNetworkNADCount)
 	prometheus.MustRegister(metricEgressRoutingViaHost)
 	prometheus.MustRegister(metricANPCount)
 	prometheus.MustRegister(metricBANPCount)
@@ -540,10 +575,6 @@ func RunTimestamp(stopChan <-chan struct{}, sbClient, nbClient libovsdbclient.Cl
 // RecordPodCreated extracts the scheduled timestamp and records how long it took
 // us to notice this and set up the pod's scheduling.
 func RecordPodCreated(pod *kapi.Pod, netInfo util.NetInfo) {
-	if netInfo.IsSecondary() {
-		// TBD: no op for secondary network for now, TBD
-		return
-	}
 	t := time.Now()
 
 	// Find the scheduled timestamp
@@ -555,7 +586,7 @@ func RecordPodCreated(pod *kapi.Pod, netInfo util.NetInfo) {
 			return
 		}
 		creationLatency := t.Sub(cond.LastTransitionTime.Time).Seconds()
-		metricPodCreationLatency.Observe(creationLatency)
+		metricPodCreationLatency.WithLabelValues(networkLabelValues(netInfo)...).Observe(creationLatency)
 		return
 	}
 }
@@ -594,9 +625,24 @@ func RecordPodEvent(eventName string, duration time.Duration) {
 	metricPodEventLatency.WithLabelValues(eventName).Observe(duration.Seconds())
 }
 
-// UpdateEgressFirewallRuleCount records the number of Egress firewall rules.
-func UpdateEgressFirewallRuleCount(count float64) {
-	metricEgressFirewallRuleCount.Add(count)
+// UpdateEgressFirewallRuleCount records the number of Egress firewall rules
+// of the given network.
+func UpdateEgressFirewallRuleCount(count float64, networkName string) {
+	metricEgressFirewallRuleCount.WithLabelValues(networkName).Add(count)
+}
+
+// RecordNetworkNADCount records the number of network attachment definitions
+// referencing the given network.
+func RecordNetworkNADCount(netInfo util.NetInfo, count int) {
+	metricNetworkNADCount.WithLabelValues(networkLabelValues(netInfo)...).Set(float64(count))
+}
+
+// DeleteNetworkControllerMetrics removes the number of network attachment
+// definitions and the resource add latency recorded for a network that no
+// longer exists.
+func DeleteNetworkControllerMetrics(networkName string) {
+	metricNetworkNADCount.DeletePartialMatch(prometheus.Labels{networkLabel: networkName})
+	MetricResourceAddLatency.DeleteLabelValues(networkName)
 }
 
 // RecordEgressRoutingViaHost records the egress gateway mode of the cluster
@@ -691,6 +737,8 @@ const (
 	portBinding
 	// port binding with updated chassis seen in OVN-Kubernetes control plane southbound database libovsdb cache
 	portBindingChassis
+	// port binding up seen in OVN-Kubernetes control plane southbound database libovsdb cache
+	portBindingUp
 	// queue operations
 	addPortBinding operation = iota
 	updatePortBinding
@@ -706,6 +754,19 @@ const (
 type record struct {
 	timestamp time.Time
 	timestampType
+	// times of the intermediate steps of the port binding, used to trace it
+	// once complete
+	lspCreated         time.Time
+	portBindingCreated time.Time
+	chassisBound       time.Time
+}
+
+// podRecord tracks the setup of a pod on each of the networks it is attached
+// to, starting from the time the pod was first seen.
+type podRecord struct {
+	firstSeen time.Time
+	// records of the pod setup, keyed by network name
+	networks map[string]*record
 }
 
 type item struct {
@@ -714,10 +775,11 @@ type item struct {
 	old       model.Model
 	new       model.Model
 	uid       kapimtypes.UID
+	network   string
 }
 
 type PodRecorder struct {
-	records map[kapimtypes.UID]*record
+	records map[kapimtypes.UID]*podRecord
 	queue   workqueue.TypedInterface[*item]
 }
 
@@ -737,7 +799,7 @@ func (pr *PodRecorder) Run(sbClient libovsdbclient.Client, stop <-chan struct{})
 	})
 
 	pr.queue = workqueue.NewTyped[*item]()
-	pr.records = make(map[kapimtypes.UID]*record)
+	pr.records = make(map[kapimtypes.UID]*podRecord)
 
 	sbClient.Cache().AddEventHandler(&cache.EventHandlerFuncs{
 		AddFunc: func(table string, model model.Model) {
@@ -789,76 +851,87 @@ func (pr *PodRecorder) CleanPod(podUID kapimtypes.UID) {
 }
 
 func (pr *PodRecorder) AddLSP(podUID kapimtypes.UID, netInfo util.NetInfo) {
-	if netInfo.IsSecondary() {
-		// TBD: no op for secondary network for now, TBD
-		return
-	}
 	if pr.queue != nil && !pr.queueFull() {
-		pr.queue.Add(&item{op: addLogicalSwitchPort, uid: podUID, timestamp: time.Now()})
+		pr.queue.Add(&item{op: addLogicalSwitchPort, uid: podUID, network: netInfo.GetNetworkName(), timestamp: time.Now()})
 	}
 }
 
-func (pr *PodRecorder) addLSP(podUID kapimtypes.UID, t time.Time) {
-	var r *record
-	if r = pr.getRecord(podUID); r == nil {
+func (pr *PodRecorder) addLSP(podUID kapimtypes.UID, network string, t time.Time) {
+	var p *podRecord
+	if p = pr.getRecord(podUID); p == nil {
 		klog.V(5).Infof("Add Logical Switch Port event expected pod with UID %q in cache", podUID)
 		return
 	}
-	if r.timestampType != firstSeen {
-		klog.V(5).Infof("Unexpected last event type (%d) in cache for pod with UID %q", r.timestampType, podUID)
+	if r, ok := p.networks[network]; ok {
+		klog.V(5).Infof("Unexpected last event type (%d) in cache for pod with UID %q on network %s", r.timestampType, podUID, network)
 		return
 	}
-	metricFirstSeenLSPLatency.Observe(t.Sub(r.timestamp).Seconds())
-	r.timestamp = t
-	r.timestampType = logicalSwitchPort
+	metricFirstSeenLSPLatency.WithLabelValues(network).Observe(t.Sub(p.firstSeen).Seconds())
+	p.networks[network] = &record{timestamp: t, timestampType: logicalSwitchPort, lspCreated: t}
 }
 
 func (pr *PodRecorder) addPortBinding(m model.Model, t time.Time) {
 	var r *record
 	row := m.(*sbdb.PortBinding)
-	podUID := getPodUIDFromPortBinding(row)
+	podUID, network := getPodFromPortBinding(row)
 	if podUID == "" {
 		return
 	}
-	if r = pr.getRecord(podUID); r == nil {
-		klog.V(5).Infof("Add port binding event expected pod with UID %q in cache", podUID)
+	if r = pr.getNetworkRecord(podUID, network); r == nil {
+		klog.V(5).Infof("Add port binding event expected pod with UID %q on network %s in cache", podUID, network)
 		return
 	}
 	if r.timestampType != logicalSwitchPort {
-		klog.V(5).Infof("Unexpected last event entry (%d) in cache for pod with UID %q", r.timestampType, podUID)
+		klog.V(5).Infof("Unexpected last event entry (%d) in cache for pod with UID %q on network %s", r.timestampType, podUID, network)
 		return
 	}
-	metricLSPPortBindingLatency.Observe(t.Sub(r.timestamp).Seconds())
+	metricLSPPortBindingLatency.WithLabelValues(network).Observe(t.Sub(r.timestamp).Seconds())
 	r.timestamp = t
 	r.timestampType = portBinding
+	r.portBindingCreated = t
 }
 
 func (pr *PodRecorder) updatePortBinding(old, new model.Model, t time.Time) {
 	var r *record
 	oldRow := old.(*sbdb.PortBinding)
 	newRow := new.(*sbdb.PortBinding)
-	podUID := getPodUIDFromPortBinding(newRow)
+	podUID, network := getPodFromPortBinding(newRow)
 	if podUID == "" {
 		return
 	}
-	if r = pr.getRecord(podUID); r == nil {
-		klog.V(5).Infof("Port binding update expected pod with UID %q in cache", podUID)
+	if r = pr.getNetworkRecord(podUID, network); r == nil {
+		klog.V(5).Infof("Port binding update expected pod with UID %q on network %s in cache", podUID, network)
 		return
 	}
 
 	if oldRow.Chassis == nil && newRow.Chassis != nil && r.timestampType == portBinding {
-		metricPortBindingChassisLatency.Observe(t.Sub(r.timestamp).Seconds())
+		metricPortBindingChassisLatency.WithLabelValues(network).Observe(t.Sub(r.timestamp).Seconds())
 		r.timestamp = t
 		r.timestampType = portBindingChassis
-
+		r.chassisBound = t
 	}
 
 	if oldRow.Up != nil && !*oldRow.Up && newRow.Up != nil && *newRow.Up && r.timestampType == portBindingChassis {
-		metricPortBindingUpLatency.Observe(t.Sub(r.timestamp).Seconds())
-		delete(pr.records, podUID)
+		metricPortBindingUpLatency.WithLabelValues(network).Observe(t.Sub(r.timestamp).Seconds())
+		// keep the record until the pod is cleaned so that further events
+		// for this network are not accounted again
+		r.timestamp = t
+		r.timestampType = portBindingUp
+		tracePortBinding(podUID, newRow.ExternalIDs["namespace"], network, newRow.LogicalPort, r)
 	}
 }
 
+// tracePortBinding records a span for the binding of the pod's logical switch
+// port by ovn-controller, from the creation of the port until it is up.
+func tracePortBinding(podUID kapimtypes.UID, namespace, network, logicalPort string, r *record) {
+	_, span := tracing.StartPodSpan(context.Background(), namespace, "", string(podUID), "ovn-controller port binding",
+		trace.WithTimestamp(r.lspCreated),
+		trace.WithAttributes(tracing.NetworkKey.String(network), attribute.String("ovn.logical_port", logicalPort)))
+	span.AddEvent("port binding created", trace.WithTimestamp(r.portBindingCreated))
+	span.AddEvent("chassis bound", trace.WithTimestamp(r.chassisBound))
+	span.End(trace.WithTimestamp(r.timestamp))
+}
+
 func (pr *PodRecorder) queueFull() bool {
 	return pr.queue.Len() >= queueLimit
 }
@@ -885,16 +958,16 @@ func (pr *PodRecorder) processItem(i *item) {
 	case updatePortBinding:
 		pr.updatePortBinding(i.old, i.new, i.timestamp)
 	case addPod:
-		pr.records[i.uid] = &record{timestamp: i.timestamp, timestampType: firstSeen}
+		pr.records[i.uid] = &podRecord{firstSeen: i.timestamp, networks: map[string]*record{}}
 	case cleanPod:
 		delete(pr.records, i.uid)
 	case addLogicalSwitchPort:
-		pr.addLSP(i.uid, i.timestamp)
+		pr.addLSP(i.uid, i.network, i.timestamp)
 	}
 }
 
 // getRecord returns record from map with func argument as the key
-func (pr *PodRecorder) getRecord(podUID kapimtypes.UID) *record {
+func (pr *PodRecorder) getRecord(podUID kapimtypes.UID) *podRecord {
 	r, ok := pr.records[podUID]
 	if !ok {
 		klog.V(5).Infof("Cache entry expected pod with UID %q but failed to find it", podUID)
@@ -903,15 +976,30 @@ func (pr *PodRecorder) getRecord(podUID kapimtypes.UID) *record {
 	return r
 }
 
-func getPodUIDFromPortBinding(row *sbdb.PortBinding) kapimtypes.UID {
+// getNetworkRecord returns the record of the pod setup on the given network
+func (pr *PodRecorder) getNetworkRecord(podUID kapimtypes.UID, network string) *record {
+	p := pr.getRecord(podUID)
+	if p == nil {
+		return nil
+	}
+	return p.networks[network]
+}
+
+// getPodFromPortBinding returns the UID of the pod and the name of the network
+// the port binding belongs to
+func getPodFromPortBinding(row *sbdb.PortBinding) (kapimtypes.UID, string) {
 	if isPod, ok := row.ExternalIDs["pod"]; !ok || isPod != "true" {
-		return ""
+		return "", ""
 	}
 	podUID, ok := row.Options["iface-id-ver"]
 	if !ok {
-		return ""
+		return "", ""
+	}
+	network, ok := row.ExternalIDs[types.NetworkExternalID]
+	if !ok {
+		network = types.DefaultNetworkName
 	}
-	return kapimtypes.UID(podUID)
+	return kapimtypes.UID(podUID), network
 }
 
 const (
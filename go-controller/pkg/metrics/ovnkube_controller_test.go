@@ -10,9 +10,13 @@ import (
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics/mocks"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kapimtypes "k8s.io/apimachinery/pkg/types"
 	fakeclientgo "k8s.io/client-go/kubernetes/fake"
 
 	"github.com/onsi/ginkgo/v2"
@@ -234,3 +238,44 @@ var _ = ginkgo.Describe("Config Duration Operations", func() {
 		})
 	})
 })
+
+var _ = ginkgo.Describe("Pod recorder", func() {
+	ginkgo.It("records pod setup latency per network", func() {
+		const podUID = kapimtypes.UID("pod-uid")
+		const udn = "udn"
+		start := time.Now()
+		pr := &PodRecorder{records: map[kapimtypes.UID]*podRecord{}}
+		portBinding := func(network string, up bool, chassis *string) *sbdb.PortBinding {
+			pb := &sbdb.PortBinding{
+				ExternalIDs: map[string]string{"pod": "true"},
+				Options:     map[string]string{"iface-id-ver": string(podUID)},
+				Chassis:     chassis,
+				Up:          &up,
+			}
+			if network != types.DefaultNetworkName {
+				pb.ExternalIDs[types.NetworkExternalID] = network
+			}
+			return pb
+		}
+		chassis := "chassis"
+
+		pr.processItem(&item{op: addPod, uid: podUID, timestamp: start})
+		for _, network := range []string{types.DefaultNetworkName, udn} {
+			pr.processItem(&item{op: addLogicalSwitchPort, uid: podUID, network: network, timestamp: start.Add(time.Second)})
+		}
+		// the port binding is only seen on the user defined network
+		pr.processItem(&item{op: addPortBinding, old: portBinding(udn, false, nil), timestamp: start.Add(2 * time.Second)})
+		pr.processItem(&item{op: updatePortBinding, old: portBinding(udn, false, nil), new: portBinding(udn, false, &chassis), timestamp: start.Add(3 * time.Second)})
+		pr.processItem(&item{op: updatePortBinding, old: portBinding(udn, false, &chassis), new: portBinding(udn, true, &chassis), timestamp: start.Add(4 * time.Second)})
+
+		gomega.Expect(testutil.CollectAndCount(metricFirstSeenLSPLatency)).To(gomega.Equal(2))
+		gomega.Expect(testutil.CollectAndCount(metricLSPPortBindingLatency)).To(gomega.Equal(1))
+		gomega.Expect(testutil.CollectAndCount(metricPortBindingChassisLatency)).To(gomega.Equal(1))
+		gomega.Expect(testutil.CollectAndCount(metricPortBindingUpLatency)).To(gomega.Equal(1))
+		gomega.Expect(pr.getNetworkRecord(podUID, udn).timestampType).To(gomega.Equal(portBindingUp))
+		gomega.Expect(pr.getNetworkRecord(podUID, types.DefaultNetworkName).timestampType).To(gomega.Equal(logicalSwitchPort))
+
+		pr.processItem(&item{op: cleanPod, uid: podUID})
+		gomega.Expect(pr.getRecord(podUID)).To(gomega.BeNil())
+	})
+})
@@ -22,6 +22,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
 	ratypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
 	ralisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/listers/routeadvertisements/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
@@ -313,6 +314,9 @@ func (c *networkController) syncNetwork(network string) error {
 	if err != nil {
 		return fmt.Errorf("%s: failed to ensure network %s: %w", c.name, network, err)
 	}
+	if want != nil {
+		metrics.RecordNetworkNADCount(want, len(want.GetNADs()))
+	}
 
 	return nil
 }
@@ -366,6 +370,7 @@ func (c *networkController) deleteNetwork(network string) error {
 	}
 
 	c.setNetworkState(network, nil)
+	metrics.DeleteNetworkControllerMetrics(network)
 	return nil
 }
 
@@ -409,6 +414,7 @@ func (c *networkController) setAdvertisements(network util.MutableNetInfo) error
 
 	podAdvertisements := map[string][]string{}
 	eipAdvertisements := map[string][]string{}
+	var importPolicy *util.RouteImportPolicy
 	for raName := range raNames {
 		ra, err := c.raLister.Get(raName)
 		if err != nil {
@@ -446,10 +452,12 @@ func (c *networkController) setAdvertisements(network util.MutableNetInfo) error
 			vrf = types.DefaultNetworkName
 		}
 
+		managed := false
 		for _, node := range nodes {
 			if !c.isNodeManaged(node) {
 				continue
 			}
+			managed = true
 			if advertisements.Has(ratypes.PodNetwork) {
 				podAdvertisements[node.Name] = append(podAdvertisements[node.Name], vrf)
 			}
@@ -457,12 +465,51 @@ func (c *networkController) setAdvertisements(network util.MutableNetInfo) error
 				eipAdvertisements[node.Name] = append(eipAdvertisements[node.Name], vrf)
 			}
 		}
+
+		if managed && ra.Spec.ImportPolicy != nil {
+			importPolicy = mergeRouteImportPolicy(importPolicy, ra.Spec.ImportPolicy)
+		}
 	}
 	network.SetPodNetworkAdvertisedVRFs(podAdvertisements)
 	network.SetEgressIPAdvertisedVRFs(eipAdvertisements)
+	network.SetRouteImportPolicy(importPolicy)
 	return nil
 }
 
+// mergeRouteImportPolicy merges the import policy of a RouteAdvertisements
+// into the policy merged so far from the other RouteAdvertisements selecting
+// the same network. The allowed prefixes are combined, so a route allowed by
+// any of the policies is allowed, and a policy without allowed prefixes allows
+// all routes. The denied prefixes are combined too, so a route denied by any of
+// the policies is denied. The lowest maxRoutes applies and so does
+// defaultRouteOnly if any of the policies sets it.
+func mergeRouteImportPolicy(policy *util.RouteImportPolicy, raPolicy *ratypes.ImportPolicy) *util.RouteImportPolicy {
+	allowed := sets.New[string]()
+	for _, prefix := range raPolicy.AllowedPrefixes {
+		allowed.Insert(string(prefix))
+	}
+	denied := sets.New[string]()
+	for _, prefix := range raPolicy.DeniedPrefixes {
+		denied.Insert(string(prefix))
+	}
+	if policy == nil {
+		policy = &util.RouteImportPolicy{}
+	} else if len(policy.AllowedPrefixes) == 0 || allowed.Len() == 0 {
+		// either policy allows all routes
+		allowed.Clear()
+	} else {
+		allowed.Insert(policy.AllowedPrefixes...)
+	}
+	denied.Insert(policy.DeniedPrefixes...)
+	policy.AllowedPrefixes = sets.List(allowed)
+	policy.DeniedPrefixes = sets.List(denied)
+	if raPolicy.MaxRoutes != nil && (policy.MaxRoutes == 0 || int(*raPolicy.MaxRoutes) < policy.MaxRoutes) {
+		policy.MaxRoutes = int(*raPolicy.MaxRoutes)
+	}
+	policy.DefaultRouteOnly = policy.DefaultRouteOnly || raPolicy.DefaultRouteOnly
+	return policy
+}
+
 func (c *networkController) hasRouteAdvertisements() bool {
 	return util.IsRouteAdvertisementsEnabled()
 }
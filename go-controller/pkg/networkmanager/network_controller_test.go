@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
 
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
@@ -85,6 +86,12 @@ func TestSetAdvertisements(t *testing.T) {
 	podNetworkRARejected.Status.Conditions[0].Status = v1.ConditionFalse
 	podNetworkRAOutdated := podNetworkRA
 	podNetworkRAOutdated.Generation = 1
+	podNetworkRAWithImportPolicy := *podNetworkRA.DeepCopy()
+	podNetworkRAWithImportPolicy.Spec.ImportPolicy = &ratypes.ImportPolicy{
+		AllowedPrefixes: []ratypes.CIDR{"10.0.0.0/8", "0.0.0.0/0", "10.0.0.0/8"},
+		DeniedPrefixes:  []ratypes.CIDR{"10.1.0.0/16"},
+		MaxRoutes:       ptr.To[int32](100),
+	}
 
 	testNode := corev1.Node{
 		ObjectMeta: v1.ObjectMeta{
@@ -106,12 +113,13 @@ func TestSetAdvertisements(t *testing.T) {
 	}
 
 	tests := []struct {
-		name            string
-		network         *ovncnitypes.NetConf
-		ra              *ratypes.RouteAdvertisements
-		node            corev1.Node
-		expectNoNetwork bool
-		expected        map[string][]string
+		name                 string
+		network              *ovncnitypes.NetConf
+		ra                   *ratypes.RouteAdvertisements
+		node                 corev1.Node
+		expectNoNetwork      bool
+		expected             map[string][]string
+		expectedImportPolicy *util.RouteImportPolicy
 	}{
 		{
 			name:    "reconciles VRF advertisements for selected node of default node network controller",
@@ -131,6 +139,26 @@ func TestSetAdvertisements(t *testing.T) {
 				testNodeOnZoneName: {testVRFName},
 			},
 		},
+		{
+			name:    "reconciles import policy for selected node",
+			network: primaryNetwork,
+			ra:      &podNetworkRAWithImportPolicy,
+			node:    testNodeOnZone,
+			expected: map[string][]string{
+				testNodeOnZoneName: {testVRFName},
+			},
+			expectedImportPolicy: &util.RouteImportPolicy{
+				AllowedPrefixes: []string{"0.0.0.0/0", "10.0.0.0/8"},
+				DeniedPrefixes:  []string{"10.1.0.0/16"},
+				MaxRoutes:       100,
+			},
+		},
+		{
+			name:    "ignores import policy that is not for applicable node",
+			network: defaultNetwork,
+			ra:      &podNetworkRAWithImportPolicy,
+			node:    otherNode,
+		},
 		{
 			name:    "ignores advertisements that are not for the pod network",
 			network: defaultNetwork,
@@ -231,6 +259,7 @@ func TestSetAdvertisements(t *testing.T) {
 					tt.expected = map[string][]string{}
 				}
 				g.Expect(reconcilable.GetPodNetworkAdvertisedVRFs()).To(gomega.Equal(tt.expected))
+				g.Expect(reconcilable.GetRouteImportPolicy()).To(gomega.Equal(tt.expectedImportPolicy))
 			}
 
 			g.Eventually(meetsExpectations).Should(gomega.Succeed())
@@ -238,3 +267,77 @@ func TestSetAdvertisements(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeRouteImportPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []*ratypes.ImportPolicy
+		expected *util.RouteImportPolicy
+	}{
+		{
+			name: "single policy",
+			policies: []*ratypes.ImportPolicy{
+				{
+					AllowedPrefixes: []ratypes.CIDR{"10.0.0.0/8"},
+					DeniedPrefixes:  []ratypes.CIDR{"10.1.0.0/16"},
+					MaxRoutes:       ptr.To[int32](100),
+				},
+			},
+			expected: &util.RouteImportPolicy{
+				AllowedPrefixes: []string{"10.0.0.0/8"},
+				DeniedPrefixes:  []string{"10.1.0.0/16"},
+				MaxRoutes:       100,
+			},
+		},
+		{
+			name: "allows routes allowed by any policy and denies routes denied by any policy",
+			policies: []*ratypes.ImportPolicy{
+				{
+					AllowedPrefixes: []ratypes.CIDR{"10.0.0.0/8"},
+					DeniedPrefixes:  []ratypes.CIDR{"10.1.0.0/16"},
+					MaxRoutes:       ptr.To[int32](100),
+				},
+				{
+					AllowedPrefixes:  []ratypes.CIDR{"192.168.0.0/16"},
+					DeniedPrefixes:   []ratypes.CIDR{"192.168.1.0/24"},
+					MaxRoutes:        ptr.To[int32](10),
+					DefaultRouteOnly: true,
+				},
+			},
+			expected: &util.RouteImportPolicy{
+				AllowedPrefixes:  []string{"10.0.0.0/8", "192.168.0.0/16"},
+				DeniedPrefixes:   []string{"10.1.0.0/16", "192.168.1.0/24"},
+				MaxRoutes:        10,
+				DefaultRouteOnly: true,
+			},
+		},
+		{
+			name: "allows all routes if any policy has no allowed prefixes",
+			policies: []*ratypes.ImportPolicy{
+				{
+					AllowedPrefixes: []ratypes.CIDR{"10.0.0.0/8"},
+				},
+				{
+					DeniedPrefixes: []ratypes.CIDR{"192.168.1.0/24"},
+				},
+				{
+					AllowedPrefixes: []ratypes.CIDR{"192.168.0.0/16"},
+				},
+			},
+			expected: &util.RouteImportPolicy{
+				AllowedPrefixes: []string{},
+				DeniedPrefixes:  []string{"192.168.1.0/24"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var policy *util.RouteImportPolicy
+			for _, raPolicy := range tt.policies {
+				policy = mergeRouteImportPolicy(policy, raPolicy)
+			}
+			g.Expect(policy).To(gomega.Equal(tt.expected))
+		})
+	}
+}
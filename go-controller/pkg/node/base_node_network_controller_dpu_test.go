@@ -298,6 +298,10 @@ var _ = Describe("Node DPU tests", func() {
 					Cmd: genOVSFindCmd("30", "qos", "_uuid",
 						"external-ids:sandbox=a8d09931"),
 				})
+				execMock.AddFakeCmd(&ovntest.ExpectedCmd{
+					Cmd: genOVSFindCmd("30", "queue", "_uuid",
+						"external-ids:sandbox=a8d09931"),
+				})
 				// getIfaceOFPort
 				execMock.AddFakeCmd(&ovntest.ExpectedCmd{
 					Cmd:    genOVSGetCmd("Interface", "pf0vf9", "ofport", ""),
@@ -593,7 +593,8 @@ func (c *Controller) processEIP(eip *eipv1.EgressIP) (*eIPConfig, sets.Set[strin
 				if selectedNamespacesPodIPs[namespace.Name] == nil {
 					selectedNamespacesPodIPs[namespace.Name] = make(map[ktypes.NamespacedName]*podIPConfigList)
 				}
-				selectedNamespacesPodIPs[namespace.Name][podNamespaceName] = generatePodConfig(ips, link, eIPNet, isEIPV6)
+				selectedNamespacesPodIPs[namespace.Name][podNamespaceName] = generatePodConfig(ips, link, eIPNet, isEIPV6,
+					getDestinationNets(eip.Spec.DestinationCIDRs, isEIPV6))
 				selectedPods.Insert(podNamespaceName)
 			}
 		}
@@ -611,22 +612,48 @@ func (c *Controller) processEIP(eip *eipv1.EgressIP) (*eIPConfig, sets.Set[strin
 	return eipSpecificConfig, selectedNamespaces, selectedPods, selectedNamespacesPodIPs, nil
 }
 
-func generatePodConfig(podIPs []net.IP, link netlink.Link, eIPNet *net.IPNet, isEIPV6 bool) *podIPConfigList {
+// generatePodConfig generates the configuration of the pod IPs for each of the
+// destinations the EIP applies to
+func generatePodConfig(podIPs []net.IP, link netlink.Link, eIPNet *net.IPNet, isEIPV6 bool, dstNets []*net.IPNet) *podIPConfigList {
 	newPodIPConfigs := newPodIPConfigList()
 	for _, podIP := range podIPs {
 		isPodIPv6 := utilnet.IsIPv6(podIP)
 		if isPodIPv6 != isEIPV6 {
 			continue
 		}
-		ipConfig := newPodIPConfig()
-		ipConfig.ipTableRule = generateIPTablesSNATRuleArg(podIP, isPodIPv6, link.Attrs().Name, eIPNet.IP.String())
-		ipConfig.ipRule = generateIPRule(podIP, isPodIPv6, link.Attrs().Index)
-		ipConfig.v6 = isPodIPv6
-		newPodIPConfigs.elems = append(newPodIPConfigs.elems, ipConfig)
+		for _, dstNet := range dstNets {
+			ipConfig := newPodIPConfig()
+			ipConfig.ipTableRule = generateIPTablesSNATRuleArg(podIP, isPodIPv6, link.Attrs().Name, eIPNet.IP.String(), dstNet)
+			ipConfig.ipRule = generateIPRule(podIP, isPodIPv6, link.Attrs().Index, dstNet)
+			ipConfig.v6 = isPodIPv6
+			newPodIPConfigs.elems = append(newPodIPConfigs.elems, ipConfig)
+		}
 	}
 	return newPodIPConfigs
 }
 
+// getDestinationNets returns the destination CIDRs of the provided IP family
+// the EIP is restricted to. A single nil entry is returned if the EIP is not
+// restricted to any destination, and none if it is restricted to destinations
+// of the other IP family only.
+func getDestinationNets(dstCIDRs []string, isIPv6 bool) []*net.IPNet {
+	if len(dstCIDRs) == 0 {
+		return []*net.IPNet{nil}
+	}
+	dstNets := make([]*net.IPNet, 0, len(dstCIDRs))
+	for _, dstCIDR := range dstCIDRs {
+		_, dstNet, err := net.ParseCIDR(dstCIDR)
+		if err != nil {
+			klog.Warningf("Ignoring invalid EgressIP destination CIDR %q: %v", dstCIDR, err)
+			continue
+		}
+		if utilnet.IsIPv6CIDR(dstNet) == isIPv6 {
+			dstNets = append(dstNets, dstNet)
+		}
+	}
+	return dstNets
+}
+
 // generateEIPConfig generates configuration that isn't related to any pod EIPs to support config of a single EIP
 func generateEIPConfig(link netlink.Link, eIPNet *net.IPNet, isEIPV6 bool) (*eIPConfig, error) {
 	eipConfig := newEIPConfig()
@@ -1015,6 +1042,7 @@ func (c *Controller) repairNode() error {
 				expectedIPRoutes.Insert(route.String())
 			}
 			expectedAddrs.Insert(addrLink{eIPNet.String(), linkIdx})
+			dstNets := getDestinationNets(egressIP.Spec.DestinationCIDRs, isEIPV6)
 			namespaceSelector, err := metav1.LabelSelectorAsSelector(&egressIP.Spec.NamespaceSelector)
 			if err != nil {
 				return fmt.Errorf("invalid namespaceSelector for egress IP %s: %v", egressIP.Name, err)
@@ -1060,13 +1088,15 @@ func (c *Controller) repairNode() error {
 							if !c.isIPSupported(isPodIPV6) {
 								continue
 							}
-							ipTableRule := strings.Join(generateIPTablesSNATRuleArg(podIP, isPodIPV6, linkName, status.EgressIP).Args, " ")
-							if isPodIPV6 {
-								expectedIPTableV6Rules.Insert(ipTableRule)
-							} else {
-								expectedIPTableV4Rules.Insert(ipTableRule)
+							for _, dstNet := range dstNets {
+								ipTableRule := strings.Join(generateIPTablesSNATRuleArg(podIP, isPodIPV6, linkName, status.EgressIP, dstNet).Args, " ")
+								if isPodIPV6 {
+									expectedIPTableV6Rules.Insert(ipTableRule)
+								} else {
+									expectedIPTableV4Rules.Insert(ipTableRule)
+								}
+								expectedIPRules.Insert(generateIPRule(podIP, isPodIPV6, link.Attrs().Index, dstNet).String())
 							}
-							expectedIPRules.Insert(generateIPRule(podIP, isPodIPV6, link.Attrs().Index).String())
 						}
 					}
 				}
@@ -1491,8 +1521,8 @@ func getNetlinkAddress(addr *net.IPNet, ifindex int) *netlink.Addr {
 }
 
 // generateIPRules generates IP rules at a predefined priority for each pod IP with a custom routing table based
-// from the links 'ifindex'
-func generateIPRule(srcIP net.IP, isIPv6 bool, ifIndex int) netlink.Rule {
+// from the links 'ifindex', optionally restricted to a destination
+func generateIPRule(srcIP net.IP, isIPv6 bool, ifIndex int, dstNet *net.IPNet) netlink.Rule {
 	r := *netlink.NewRule()
 	r.Table = util.CalculateRouteTableID(ifIndex)
 	r.Priority = rulePriority
@@ -1506,6 +1536,7 @@ func generateIPRule(srcIP net.IP, isIPv6 bool, ifIndex int) netlink.Rule {
 	}
 	_, ipNet, _ := net.ParseCIDR(ipFullMask)
 	r.Src = ipNet
+	r.Dst = dstNet
 	return r
 }
 
@@ -1528,13 +1559,16 @@ func getPodNamespacedName(pod *corev1.Pod) ktypes.NamespacedName {
 	return ktypes.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
 }
 
-func generateIPTablesSNATRuleArg(srcIP net.IP, isIPv6 bool, infName, snatIP string) iptables.RuleArg {
+func generateIPTablesSNATRuleArg(srcIP net.IP, isIPv6 bool, infName, snatIP string, dstNet *net.IPNet) iptables.RuleArg {
 	var srcIPFullMask string
 	if isIPv6 {
 		srcIPFullMask = fmt.Sprintf("%s/128", srcIP.String())
 	} else {
 		srcIPFullMask = fmt.Sprintf("%s/32", srcIP.String())
 	}
+	if dstNet != nil {
+		return iptables.RuleArg{Args: []string{"-s", srcIPFullMask, "-d", dstNet.String(), "-o", infName, "-j", "SNAT", "--to-source", snatIP}}
+	}
 	return iptables.RuleArg{Args: []string{"-s", srcIPFullMask, "-o", infName, "-j", "SNAT", "--to-source", snatIP}}
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -269,7 +270,7 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		if !c.shouldConfigureEgressSVC(svc, es.Status.Host) {
+		if !c.shouldConfigureEgressSVC(svc, es) {
 			continue
 		}
 
@@ -687,7 +688,7 @@ func (c *Controller) syncEgressService(key string) error {
 	}
 
 	// At this point both the svc and es are not nil
-	shouldConfigure := c.shouldConfigureEgressSVC(svc, es.Status.Host)
+	shouldConfigure := c.shouldConfigureEgressSVC(svc, es)
 	if cachedState == nil && !shouldConfigure {
 		return nil
 	}
@@ -1051,8 +1052,8 @@ func (c *Controller) clearServiceRulesAndRequeue(key string, state *svcState) er
 }
 
 // Returns true if the controller should configure the given service as an "Egress Service"
-func (c *Controller) shouldConfigureEgressSVC(svc *corev1.Service, svcHost string) bool {
-	return (svcHost == c.thisNode || svcHost == types.EgressServiceNoSNATHost) &&
+func (c *Controller) shouldConfigureEgressSVC(svc *corev1.Service, es *egressserviceapi.EgressService) bool {
+	return (slices.Contains(util.GetEgressServiceHosts(es), c.thisNode) || es.Status.Host == types.EgressServiceNoSNATHost) &&
 		svc.Spec.Type == corev1.ServiceTypeLoadBalancer &&
 		len(svc.Status.LoadBalancer.Ingress) > 0
 }
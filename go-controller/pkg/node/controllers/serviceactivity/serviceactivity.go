@@ -0,0 +1,328 @@
+package serviceactivity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kapi "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/unidling"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// Tracker periodically reads the conntrack entries of the node and reports
+// the last time traffic to a service was seen from the node in the node's
+// service activity lease, in the ovn-kubernetes namespace. Cluster manager
+// aggregates the leases of all the nodes to signal the services that have
+// been idle for the configured timeout.
+//
+// OVN load balancing is distributed, so the connections to a service are
+// tracked on the nodes of the clients. A service is active on a node if,
+// since the previous poll, there is a new conntrack entry towards any of its
+// cluster, external or load balancer IPs, or node ports of any node, or if
+// the packet counters of an existing entry increased. Counters are only
+// available with conntrack accounting enabled (net.netfilter.nf_conntrack_acct),
+// otherwise only new connections are accounted for.
+type Tracker struct {
+	client        kubernetes.Interface
+	nodeName      string
+	serviceLister corelisters.ServiceLister
+	nodeLister    corelisters.NodeLister
+	interval      time.Duration
+	idleTimeout   time.Duration
+
+	// flows holds the packet counters of the conntrack entries towards
+	// services seen in the previous poll
+	flows map[flowKey]uint64
+	// lastActive holds the last time traffic was seen to the services that
+	// were active within the idle timeout, indexed by service key
+	lastActive map[string]time.Time
+	// reported holds the service activity reported in the lease
+	reported map[string]time.Time
+}
+
+// vipKey identifies a service VIP. The IP is empty for node ports, which are
+// matched on any node IP.
+type vipKey struct {
+	protocol uint8
+	ip       string
+	port     uint16
+}
+
+type flowKey struct {
+	protocol uint8
+	srcIP    string
+	srcPort  uint16
+	dstIP    string
+	dstPort  uint16
+}
+
+// NewTracker returns a Tracker that polls conntrack often enough for the
+// activity of the services to be recorded well within idleTimeout.
+func NewTracker(client kubernetes.Interface, nodeName string, serviceLister corelisters.ServiceLister, nodeLister corelisters.NodeLister,
+	idleTimeout time.Duration) *Tracker {
+	return &Tracker{
+		client:        client,
+		nodeName:      nodeName,
+		serviceLister: serviceLister,
+		nodeLister:    nodeLister,
+		interval:      idleTimeout / 4,
+		idleTimeout:   idleTimeout,
+		lastActive:    map[string]time.Time{},
+	}
+}
+
+// Run polls conntrack until stopCh is closed
+func (t *Tracker) Run(stopCh <-chan struct{}, wg *sync.WaitGroup) {
+	klog.Infof("Starting service activity tracker, polling conntrack every %v", t.interval)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		wait.Until(t.sync, t.interval, stopCh)
+		klog.Infof("Stopped service activity tracker")
+	}()
+}
+
+func (t *Tracker) sync() {
+	active, err := t.getActiveServices()
+	if err != nil {
+		klog.Errorf("Failed to get the active services: %v", err)
+		return
+	}
+	now := time.Now()
+	for key := range active {
+		t.lastActive[key.String()] = now
+	}
+	// cluster manager has recorded the activity on the services by now
+	for key, lastActive := range t.lastActive {
+		if now.Sub(lastActive) > t.idleTimeout {
+			delete(t.lastActive, key)
+		}
+	}
+	if maps.EqualFunc(t.lastActive, t.reported, time.Time.Equal) {
+		return
+	}
+	if err := t.updateLease(now); err != nil {
+		klog.Errorf("Failed to report the service activity of node %s: %v", t.nodeName, err)
+		return
+	}
+	t.reported = maps.Clone(t.lastActive)
+}
+
+// updateLease reports the activity of the services in the node's service
+// activity lease, creating it if needed. The lease is owned by the node so
+// that it is removed with the node.
+func (t *Tracker) updateLease(now time.Time) error {
+	times := make(map[string]string, len(t.lastActive))
+	for key, lastActive := range t.lastActive {
+		times[key] = lastActive.Format(time.RFC3339)
+	}
+	activity, err := json.Marshal(times)
+	if err != nil {
+		return fmt.Errorf("failed to marshal service activity: %w", err)
+	}
+
+	leases := t.client.CoordinationV1().Leases(config.Kubernetes.OVNConfigNamespace)
+	name := unidling.GetServiceActivityLeaseName(t.nodeName)
+	lease, err := leases.Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		node, err := t.nodeLister.Get(t.nodeName)
+		if err != nil {
+			return fmt.Errorf("failed to get node %s: %w", t.nodeName, err)
+		}
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: config.Kubernetes.OVNConfigNamespace,
+				Labels:    map[string]string{unidling.ServiceActivityLabel: ""},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(node, kapi.SchemeGroupVersion.WithKind("Node")),
+				},
+				Annotations: map[string]string{unidling.ServiceActivityAnnotation: string(activity)},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity: ptr.To(t.nodeName),
+				RenewTime:      &metav1.MicroTime{Time: now},
+			},
+		}
+		_, err = leases.Create(context.TODO(), lease, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if lease.Annotations == nil {
+		lease.Annotations = map[string]string{}
+	}
+	lease.Annotations[unidling.ServiceActivityAnnotation] = string(activity)
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+	_, err = leases.Update(context.TODO(), lease, metav1.UpdateOptions{})
+	return err
+}
+
+// getActiveServices returns the services with traffic since the previous
+// poll. Nothing is reported on the first poll, as there is no previous state
+// to compare to.
+func (t *Tracker) getActiveServices() (sets.Set[ktypes.NamespacedName], error) {
+	vips, err := t.getServiceVIPs()
+	if err != nil {
+		return nil, err
+	}
+	nodeIPs, err := t.getNodeIPs()
+	if err != nil {
+		return nil, err
+	}
+	conntrackFlows, err := listConntrackFlows()
+	if err != nil {
+		return nil, err
+	}
+
+	flows := make(map[flowKey]uint64)
+	flowServices := make(map[flowKey]ktypes.NamespacedName)
+	for _, flow := range conntrackFlows {
+		dstIP := flow.Forward.DstIP.String()
+		svc, ok := vips[vipKey{protocol: flow.Forward.Protocol, ip: dstIP, port: flow.Forward.DstPort}]
+		if !ok && nodeIPs.Has(dstIP) {
+			svc, ok = vips[vipKey{protocol: flow.Forward.Protocol, port: flow.Forward.DstPort}]
+		}
+		if !ok {
+			continue
+		}
+		key := flowKey{
+			protocol: flow.Forward.Protocol,
+			srcIP:    flow.Forward.SrcIP.String(),
+			srcPort:  flow.Forward.SrcPort,
+			dstIP:    dstIP,
+			dstPort:  flow.Forward.DstPort,
+		}
+		// the same connection can be tracked in several conntrack zones
+		flows[key] += flow.Forward.Packets + flow.Reverse.Packets
+		flowServices[key] = svc
+	}
+
+	active := sets.New[ktypes.NamespacedName]()
+	if t.flows != nil {
+		for key, packets := range flows {
+			if previous, ok := t.flows[key]; ok && packets <= previous {
+				continue
+			}
+			active.Insert(flowServices[key])
+		}
+	}
+	t.flows = flows
+	return active, nil
+}
+
+// getServiceVIPs returns the services indexed by their VIPs
+func (t *Tracker) getServiceVIPs() (map[vipKey]ktypes.NamespacedName, error) {
+	services, err := t.serviceLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+	vips := make(map[vipKey]ktypes.NamespacedName)
+	for _, svc := range services {
+		if !util.ServiceTypeHasClusterIP(svc) || !util.IsClusterIPSet(svc) {
+			continue
+		}
+		name := ktypes.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}
+		ips := append(util.GetClusterIPs(svc), util.GetExternalAndLBIPs(svc)...)
+		for _, svcPort := range svc.Spec.Ports {
+			protocol, ok := protocolNumber(svcPort.Protocol)
+			if !ok {
+				continue
+			}
+			for _, ip := range ips {
+				parsedIP := net.ParseIP(ip)
+				if parsedIP == nil {
+					continue
+				}
+				vips[vipKey{protocol: protocol, ip: parsedIP.String(), port: uint16(svcPort.Port)}] = name
+			}
+			if util.ServiceTypeHasNodePort(svc) && svcPort.NodePort != 0 {
+				vips[vipKey{protocol: protocol, port: uint16(svcPort.NodePort)}] = name
+			}
+		}
+	}
+	return vips, nil
+}
+
+// getNodeIPs returns the IPs of all the nodes, the node ports of the
+// services can be reached on
+func (t *Tracker) getNodeIPs() (sets.Set[string], error) {
+	nodes, err := t.nodeLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	nodeIPs := sets.New[string]()
+	for _, node := range nodes {
+		for _, address := range node.Status.Addresses {
+			if address.Type != kapi.NodeInternalIP && address.Type != kapi.NodeExternalIP {
+				continue
+			}
+			if ip := net.ParseIP(address.Address); ip != nil {
+				nodeIPs.Insert(ip.String())
+			}
+		}
+		hostIPs, err := util.ParseNodeHostCIDRsDropNetMask(node)
+		if err != nil {
+			// the node may not be annotated yet
+			continue
+		}
+		for ip := range hostIPs {
+			if parsedIP := net.ParseIP(ip); parsedIP != nil {
+				nodeIPs.Insert(parsedIP.String())
+			}
+		}
+	}
+	return nodeIPs, nil
+}
+
+func listConntrackFlows() ([]*netlink.ConntrackFlow, error) {
+	var families []netlink.InetFamily
+	if config.IPv4Mode {
+		families = append(families, netlink.FAMILY_V4)
+	}
+	if config.IPv6Mode {
+		families = append(families, netlink.FAMILY_V6)
+	}
+	var flows []*netlink.ConntrackFlow
+	for _, family := range families {
+		familyFlows, err := util.GetNetLinkOps().ConntrackTableList(netlink.ConntrackTable, family)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list conntrack entries: %w", err)
+		}
+		flows = append(flows, familyFlows...)
+	}
+	return flows, nil
+}
+
+func protocolNumber(protocol kapi.Protocol) (uint8, bool) {
+	switch protocol {
+	case kapi.ProtocolTCP:
+		return unix.IPPROTO_TCP, true
+	case kapi.ProtocolUDP:
+		return unix.IPPROTO_UDP, true
+	case kapi.ProtocolSCTP:
+		return unix.IPPROTO_SCTP, true
+	}
+	return 0, false
+}
@@ -0,0 +1,166 @@
+package serviceactivity
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kapi "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/unidling"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilMocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/mocks"
+)
+
+func newFlow(protocol uint8, src, dst string, srcPort, dstPort uint16, packets uint64) *netlink.ConntrackFlow {
+	flow := &netlink.ConntrackFlow{FamilyType: netlink.FAMILY_V4}
+	flow.Forward.Protocol = protocol
+	flow.Forward.SrcIP = net.ParseIP(src)
+	flow.Forward.DstIP = net.ParseIP(dst)
+	flow.Forward.SrcPort = srcPort
+	flow.Forward.DstPort = dstPort
+	flow.Forward.Packets = packets
+	return flow
+}
+
+func TestTracker_sync(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+	config.IPv4Mode = true
+
+	services := []*kapi.Service{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "clusterip"},
+			Spec: kapi.ServiceSpec{
+				Type:       kapi.ServiceTypeClusterIP,
+				ClusterIP:  "172.30.0.10",
+				ClusterIPs: []string{"172.30.0.10"},
+				Ports:      []kapi.ServicePort{{Port: 80, Protocol: kapi.ProtocolTCP}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nodeport"},
+			Spec: kapi.ServiceSpec{
+				Type:       kapi.ServiceTypeNodePort,
+				ClusterIP:  "172.30.0.11",
+				ClusterIPs: []string{"172.30.0.11"},
+				Ports:      []kapi.ServicePort{{Port: 53, NodePort: 30053, Protocol: kapi.ProtocolUDP}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "remote-nodeport"},
+			Spec: kapi.ServiceSpec{
+				Type:       kapi.ServiceTypeNodePort,
+				ClusterIP:  "172.30.0.12",
+				ClusterIPs: []string{"172.30.0.12"},
+				Ports:      []kapi.ServicePort{{Port: 80, NodePort: 30080, Protocol: kapi.ProtocolTCP}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "idle"},
+			Spec: kapi.ServiceSpec{
+				Type:       kapi.ServiceTypeNodePort,
+				ClusterIP:  "172.30.0.13",
+				ClusterIPs: []string{"172.30.0.13"},
+				Ports:      []kapi.ServicePort{{Port: 80, NodePort: 30081, Protocol: kapi.ProtocolTCP}},
+			},
+		},
+	}
+	nodes := []*kapi.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node1", UID: "node1-uid"},
+			Status: kapi.NodeStatus{
+				Addresses: []kapi.NodeAddress{{Type: kapi.NodeInternalIP, Address: "10.0.0.4"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node2", Annotations: map[string]string{
+				util.OVNNodeHostCIDRs: `["10.0.0.5/24"]`,
+			}},
+		},
+	}
+
+	client := fake.NewSimpleClientset()
+	serviceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, svc := range services {
+		g.Expect(serviceIndexer.Add(svc)).To(gomega.Succeed())
+	}
+	nodeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, node := range nodes {
+		g.Expect(nodeIndexer.Add(node)).To(gomega.Succeed())
+	}
+
+	// flows towards the services of an existing connection, a connection
+	// with no new packets and a connection to a port of another service
+	existing := newFlow(unix.IPPROTO_TCP, "10.128.0.5", "172.30.0.10", 40000, 80, 10)
+	existingIdle := newFlow(unix.IPPROTO_TCP, "10.128.0.5", "172.30.0.13", 40001, 80, 10)
+	otherPort := newFlow(unix.IPPROTO_TCP, "10.128.0.5", "172.30.0.13", 40002, 8080, 1)
+	polls := [][]*netlink.ConntrackFlow{
+		{existing, existingIdle},
+		{
+			newFlow(unix.IPPROTO_TCP, "10.128.0.5", "172.30.0.10", 40000, 80, 11),
+			existingIdle,
+			otherPort,
+			newFlow(unix.IPPROTO_UDP, "192.168.0.1", "10.0.0.4", 40003, 30053, 1),
+			newFlow(unix.IPPROTO_TCP, "10.128.0.5", "10.0.0.5", 40004, 30080, 1),
+			// the node port of a service on an IP that is not a node IP
+			newFlow(unix.IPPROTO_TCP, "10.128.0.5", "192.168.0.10", 40005, 30081, 1),
+		},
+		{existing},
+	}
+
+	netlinkMock := &utilMocks.NetLinkOps{}
+	util.SetNetLinkOpMockInst(netlinkMock)
+	defer util.ResetNetLinkOpMockInst()
+	for _, poll := range polls {
+		netlinkMock.On("ConntrackTableList", netlink.ConntrackTableType(netlink.ConntrackTable), netlink.InetFamily(netlink.FAMILY_V4)).Return(poll, nil).Once()
+	}
+
+	tracker := NewTracker(client, "node1", corelisters.NewServiceLister(serviceIndexer), corelisters.NewNodeLister(nodeIndexer), time.Hour)
+
+	getLease := func() *coordinationv1.Lease {
+		lease, err := client.CoordinationV1().Leases(config.Kubernetes.OVNConfigNamespace).Get(context.Background(),
+			unidling.GetServiceActivityLeaseName("node1"), metav1.GetOptions{})
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		return lease
+	}
+
+	// the first poll only records the existing flows
+	tracker.sync()
+	_, err := client.CoordinationV1().Leases(config.Kubernetes.OVNConfigNamespace).Get(context.Background(),
+		unidling.GetServiceActivityLeaseName("node1"), metav1.GetOptions{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+
+	tracker.sync()
+	lease := getLease()
+	g.Expect(lease.Labels).To(gomega.HaveKey(unidling.ServiceActivityLabel))
+	g.Expect(lease.OwnerReferences).To(gomega.HaveLen(1))
+	g.Expect(lease.OwnerReferences[0].UID).To(gomega.BeEquivalentTo("node1-uid"))
+	activity, err := unidling.ParseServiceActivity(lease)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	// more packets on an existing connection, new connections to node ports
+	// of the local and a remote node, but not to a port not of the service
+	// nor to a node port on an IP that is not a node IP
+	g.Expect(activity).To(gomega.HaveLen(3))
+	g.Expect(activity).To(gomega.HaveKey("default/clusterip"))
+	g.Expect(activity).To(gomega.HaveKey("default/nodeport"))
+	g.Expect(activity).To(gomega.HaveKey("default/remote-nodeport"))
+
+	// no new activity, the lease is not updated
+	actions := len(client.Actions())
+	tracker.sync()
+	g.Expect(client.Actions()).To(gomega.HaveLen(actions))
+
+	netlinkMock.AssertExpectations(t)
+}
@@ -36,6 +36,8 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/controllers/egressip"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/controllers/egressservice"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/controllers/serviceactivity"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/ipsec"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/linkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/ovspinning"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routemanager"
@@ -362,6 +364,18 @@ func validateEncapIP(encapIP string) (bool, error) {
 	return false, nil
 }
 
+// getEffectiveEncapIPs returns the list of encap IPs configured in
+// config.Default.EffectiveEncapIP, which may be a comma separated list.
+func getEffectiveEncapIPs() []string {
+	var encapIPs []string
+	for _, encapIP := range strings.Split(config.Default.EffectiveEncapIP, ",") {
+		if encapIP = strings.TrimSpace(encapIP); encapIP != "" {
+			encapIPs = append(encapIPs, encapIP)
+		}
+	}
+	return encapIPs
+}
+
 func setupOVNNode(node *kapi.Node) error {
 	var err error
 
@@ -949,6 +963,9 @@ func (nc *DefaultNodeNetworkController) PreStart(ctx context.Context) error {
 	if err := util.SetNodeZone(nodeAnnotator, sbZone); err != nil {
 		return fmt.Errorf("failed to set node zone annotation for node %s: %w", nc.name, err)
 	}
+	if err := util.SetNodeEncapIPs(nodeAnnotator, getEffectiveEncapIPs()); err != nil {
+		return fmt.Errorf("failed to set node encap IPs annotation for node %s: %w", nc.name, err)
+	}
 	if err := nodeAnnotator.Run(); err != nil {
 		return fmt.Errorf("failed to set node %s annotations: %w", nc.name, err)
 	}
@@ -1316,14 +1333,29 @@ func (nc *DefaultNodeNetworkController) Start(ctx context.Context) error {
 		klog.Infof("Egress IP for secondary host network is disabled")
 	}
 
+	if config.Kubernetes.ServiceIdleTimeout > 0 && config.OvnKubeNode.Mode != types.NodeModeDPUHost {
+		wf := nc.watchFactory.(*factory.WatchFactory)
+		serviceactivity.NewTracker(nc.client, nc.name, wf.ServiceCoreInformer().Lister(), wf.NodeCoreInformer().Lister(),
+			config.Kubernetes.ServiceIdleTimeout).Run(nc.stopChan, nc.wg)
+	}
+
 	nc.linkManager.Run(nc.stopChan, nc.wg)
 
 	nc.wg.Add(1)
 	go func() {
 		defer nc.wg.Done()
-		ovspinning.Run(nc.stopChan)
+		ovspinning.Run(nc.stopChan, nc.name, nc.watchFactory.NodeCoreInformer().Lister())
 	}()
 
+	if config.OVNKubernetesFeature.EnableIPsec && config.OVNKubernetesFeature.IPsecCACert != "" &&
+		config.OvnKubeNode.Mode != types.NodeModeDPUHost {
+		ipsecManager, err := ipsec.NewManager(nc.name, nc.client, nc.Kube)
+		if err != nil {
+			return fmt.Errorf("failed to create IPsec manager: %w", err)
+		}
+		ipsecManager.Run(nc.stopChan, nc.wg)
+	}
+
 	klog.Infof("Default node network controller initialized and ready.")
 	return nil
 }
@@ -0,0 +1,180 @@
+package ipsec
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/certificate"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/csrapprover"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// certNamePrefix is the prefix of the files where the node IPsec
+	// certificate and private key are stored
+	certNamePrefix = "ovn-ipsec"
+	// syncPeriod is how often the certificate configured in OVS is checked
+	// against the current certificate
+	syncPeriod = 10 * time.Second
+)
+
+// Manager requests a certificate for the node through a CSR for the dedicated
+// IPsec signer, rotates it before it expires and configures OVS to use it to
+// authenticate to its IPsec peers.
+type Manager struct {
+	nodeName    string
+	kube        kube.Interface
+	certDir     string
+	certManager certificate.Manager
+
+	// serial number of the certificate currently configured in OVS
+	configured string
+	// files of the certificate currently configured in OVS
+	configuredFiles []string
+}
+
+// NewManager creates a Manager for the provided node. The certificate is
+// requested with the node chassis ID as common name as that is how OVN
+// identifies IPsec peers.
+func NewManager(nodeName string, client kubernetes.Interface, kube kube.Interface) (*Manager, error) {
+	chassisID, err := util.GetNodeChassisID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chassis ID: %w", err)
+	}
+
+	certDir := config.OVNKubernetesFeature.IPsecCertDir
+	certificateStore, err := certificate.NewFileStore(certNamePrefix, certDir, certDir, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize the IPsec certificate store: %w", err)
+	}
+
+	certManager, err := certificate.NewManager(&certificate.Config{
+		ClientsetFn: func(*tls.Certificate) (kubernetes.Interface, error) {
+			return client, nil
+		},
+		Template: &x509.CertificateRequest{
+			Subject: pkix.Name{
+				CommonName:   chassisID,
+				Organization: csrapprover.DefaultCSRAcceptanceCondition.Organizations,
+			},
+		},
+		RequestedCertificateLifetime: &config.OVNKubernetesFeature.IPsecCertDuration,
+		SignerName:                   csrapprover.IPsecSignerName,
+		Usages:                       csrapprover.IPsecUsages.UnsortedList(),
+		CertificateStore:             certificateStore,
+		Logf:                         klog.Infof,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize the IPsec certificate manager: %w", err)
+	}
+
+	return &Manager{
+		nodeName:    nodeName,
+		kube:        kube,
+		certDir:     certDir,
+		certManager: certManager,
+	}, nil
+}
+
+// Run starts requesting and rotating the node IPsec certificate and
+// configuring OVS with it until stopCh is closed.
+func (m *Manager) Run(stopCh <-chan struct{}, wg *sync.WaitGroup) {
+	klog.Info("Starting IPsec certificate manager")
+	m.certManager.Start()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer m.certManager.Stop()
+		wait.Until(func() {
+			if err := m.sync(); err != nil {
+				klog.Errorf("Failed to sync IPsec certificate: %v", err)
+			}
+		}, syncPeriod, stopCh)
+		klog.Info("Stopped IPsec certificate manager")
+	}()
+}
+
+// sync configures OVS with the current certificate if it changed. Each
+// certificate is written to its own files so that a rotation changes the OVS
+// configuration and is picked up by ovs-monitor-ipsec.
+func (m *Manager) sync() error {
+	current := m.certManager.Current()
+	if current == nil || current.Leaf == nil {
+		// not signed yet or expired, the certificate manager keeps requesting
+		// a new one
+		return nil
+	}
+
+	serial := current.Leaf.SerialNumber.Text(16)
+	if serial == m.configured {
+		return nil
+	}
+
+	certFile, keyFile, err := m.writeCertificate(serial, current)
+	if err != nil {
+		return err
+	}
+
+	_, stderr, err := util.RunOVSVsctl("set", "Open_vSwitch", ".",
+		"other_config:certificate="+certFile,
+		"other_config:private_key="+keyFile,
+		"other_config:ca_cert="+config.OVNKubernetesFeature.IPsecCACert,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to configure IPsec certificate in OVS, stderr: %q: %w", stderr, err)
+	}
+
+	annotation, err := util.CreateNodeIPsecStatusAnnotation(&util.IPsecStatus{CertificateExpiry: current.Leaf.NotAfter.UTC()})
+	if err != nil {
+		return err
+	}
+	if err := m.kube.SetAnnotationsOnNode(m.nodeName, annotation); err != nil {
+		return fmt.Errorf("failed to set IPsec status on node %s: %w", m.nodeName, err)
+	}
+
+	for _, file := range m.configuredFiles {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			klog.Warningf("Failed to remove old IPsec certificate file %s: %v", file, err)
+		}
+	}
+	m.configured = serial
+	m.configuredFiles = []string{certFile, keyFile}
+
+	klog.Infof("Configured IPsec certificate with serial number %s expiring at %s", serial, current.Leaf.NotAfter)
+	return nil
+}
+
+func (m *Manager) writeCertificate(serial string, cert *tls.Certificate) (string, string, error) {
+	var certPEM []byte
+	for _, der := range cert.Certificate {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(cert.PrivateKey)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode IPsec private key: %w", err)
+	}
+
+	certFile := filepath.Join(m.certDir, fmt.Sprintf("%s-cert-%s.pem", certNamePrefix, serial))
+	keyFile := filepath.Join(m.certDir, fmt.Sprintf("%s-privkey-%s.pem", certNamePrefix, serial))
+	if err := os.WriteFile(certFile, certPEM, 0644); err != nil {
+		return "", "", fmt.Errorf("failed to write IPsec certificate: %w", err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		return "", "", fmt.Errorf("failed to write IPsec private key: %w", err)
+	}
+	return certFile, keyFile, nil
+}
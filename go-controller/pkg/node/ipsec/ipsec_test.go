@@ -0,0 +1,128 @@
+package ipsec
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+type fakeCertManager struct {
+	current *tls.Certificate
+}
+
+func (f *fakeCertManager) Start()                       {}
+func (f *fakeCertManager) Stop()                        {}
+func (f *fakeCertManager) Current() *tls.Certificate    { return f.current }
+func (f *fakeCertManager) ServerHealthy() bool          { return true }
+func (f *fakeCertManager) Set(current *tls.Certificate) { f.current = current }
+
+func newTestCertificate(t *testing.T, serial int64, notAfter time.Time) *tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "chassis"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestManager_sync(t *testing.T) {
+	g := gomega.NewWithT(t)
+	const nodeName = "node"
+	const caCert = "/ca/ca-bundle.crt"
+	certDir := t.TempDir()
+	g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+	config.OVNKubernetesFeature.IPsecCACert = caCert
+
+	client := fake.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}})
+	certManager := &fakeCertManager{}
+	m := &Manager{
+		nodeName:    nodeName,
+		kube:        &kube.Kube{KClient: client},
+		certDir:     certDir,
+		certManager: certManager,
+	}
+
+	expectOVSConfig := func(fexec *ovntest.FakeExec, serial string) {
+		fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+			Cmd: fmt.Sprintf("ovs-vsctl --timeout=15 set Open_vSwitch . "+
+				"other_config:certificate=%s "+
+				"other_config:private_key=%s "+
+				"other_config:ca_cert=%s",
+				filepath.Join(certDir, "ovn-ipsec-cert-"+serial+".pem"),
+				filepath.Join(certDir, "ovn-ipsec-privkey-"+serial+".pem"),
+				caCert,
+			),
+		})
+	}
+	expectStatus := func(expiry time.Time) {
+		node, err := client.CoreV1().Nodes().Get(context.Background(), nodeName, metav1.GetOptions{})
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		status, err := util.ParseNodeIPsecStatusAnnotation(node)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(status.CertificateExpiry.Equal(expiry)).To(gomega.BeTrue())
+	}
+
+	// no certificate yet, nothing to configure
+	fexec := ovntest.NewFakeExec()
+	g.Expect(util.SetExec(fexec)).To(gomega.Succeed())
+	g.Expect(m.sync()).To(gomega.Succeed())
+	g.Expect(fexec.CalledMatchesExpected()).To(gomega.BeTrue(), fexec.ErrorDesc)
+
+	// first certificate is configured
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	certManager.Set(newTestCertificate(t, 10, expiry))
+	expectOVSConfig(fexec, "a")
+	g.Expect(m.sync()).To(gomega.Succeed())
+	g.Expect(fexec.CalledMatchesExpected()).To(gomega.BeTrue(), fexec.ErrorDesc)
+	g.Expect(filepath.Join(certDir, "ovn-ipsec-cert-a.pem")).To(gomega.BeAnExistingFile())
+	g.Expect(filepath.Join(certDir, "ovn-ipsec-privkey-a.pem")).To(gomega.BeAnExistingFile())
+	expectStatus(expiry)
+
+	// same certificate is not configured again
+	g.Expect(m.sync()).To(gomega.Succeed())
+	g.Expect(fexec.CalledMatchesExpected()).To(gomega.BeTrue(), fexec.ErrorDesc)
+
+	// rotated certificate is configured and old files removed
+	expiry = time.Now().Add(2 * time.Hour).Truncate(time.Second).UTC()
+	certManager.Set(newTestCertificate(t, 11, expiry))
+	expectOVSConfig(fexec, "b")
+	g.Expect(m.sync()).To(gomega.Succeed())
+	g.Expect(fexec.CalledMatchesExpected()).To(gomega.BeTrue(), fexec.ErrorDesc)
+	g.Expect(filepath.Join(certDir, "ovn-ipsec-cert-b.pem")).To(gomega.BeAnExistingFile())
+	g.Expect(filepath.Join(certDir, "ovn-ipsec-cert-a.pem")).ToNot(gomega.BeAnExistingFile())
+	g.Expect(filepath.Join(certDir, "ovn-ipsec-privkey-a.pem")).ToNot(gomega.BeAnExistingFile())
+	expectStatus(expiry)
+}
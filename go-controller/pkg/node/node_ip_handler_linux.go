@@ -258,6 +258,13 @@ func (c *addressManager) handleNodePrimaryAddrChange() {
 	if nodePrimaryAddrChanged && config.Default.EncapIP == "" {
 		klog.Infof("Node primary address changed to %v. Updating OVN encap IP.", c.nodePrimaryAddr)
 		updateOVNEncapIPAndReconnect(c.nodePrimaryAddr)
+		if err := util.SetNodeEncapIPs(c.nodeAnnotator, getEffectiveEncapIPs()); err != nil {
+			klog.Errorf("Address Manager failed to set node encap IPs annotation: %v", err)
+			return
+		}
+		if err := c.nodeAnnotator.Run(); err != nil {
+			klog.Errorf("Address Manager failed to update node encap IPs annotation: %v", err)
+		}
 	}
 }
 
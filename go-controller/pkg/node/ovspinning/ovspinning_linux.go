@@ -5,55 +5,73 @@ package ovspinning
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/fsnotify/fsnotify"
-	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sys/unix"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/cpuset"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// Node annotations overriding the ovnkube-node configuration of the CPUs the
+// OVS threads are pinned to
+const (
+	// OVSCPUSetAnnotation overrides config.OvnKubeNode.OVSCPUSet
+	OVSCPUSetAnnotation = "k8s.ovn.org/ovs-cpu-set"
+	// OVSPMDCPUSetAnnotation overrides config.OvnKubeNode.OVSPMDCPUSet
+	OVSPMDCPUSetAnnotation = "k8s.ovn.org/ovs-pmd-cpu-set"
+)
+
+const (
+	// pinnedCPUSetKey and pinnedPMDCPUSetKey are the Open_vSwitch external_ids
+	// recording the CPUs the OVS threads were pinned to, so that the pinning
+	// can be reverted after ovnkube-node restarts with it disabled
+	pinnedCPUSetKey    = "ovn-pinned-cpu-set"
+	pinnedPMDCPUSetKey = "ovn-pinned-pmd-cpu-set"
+
+	daemonsThreads = "daemons"
+	pmdThreads     = "pmd"
 )
 
 // These variables are meant to be used in unit tests
 var tickDuration time.Duration = 1 * time.Second
 var getOvsVSwitchdPIDFn func() (string, error) = util.GetOvsVSwitchdPID
 var getOvsDBServerPIDFn func() (string, error) = util.GetOvsDBServerPID
+var getOvsExternalIDFn func(key string) (string, error) = getOvsExternalID
+var setOvsExternalIDFn func(key, value string) error = setOvsExternalID
+var setOvsPMDCPUMaskFn func(mask string) error = setOvsPMDCPUMask
+var defaultAffinityPID = 1
 var featureEnablerFile string = "/etc/openvswitch/enable_dynamic_cpu_affinity"
 
-// Run monitors OVS daemon's processes (ovs-vswitchd and ovsdb-server) and sets their CPU affinity
-// masks to that of the current process.
-// This feature is enabled by the presence of a non-empty file in the path `/etc/openvswitch/enable_dynamic_cpu_affinity`
-func Run(stopCh <-chan struct{}) {
-
-	// The file must be present at startup to enable the feature
-	isFeatureEnabled, err := isFileNotEmpty(featureEnablerFile)
-	if err != nil {
-		klog.Warningf("Can't start OVS CPU affinity pinning: %v", err)
-		return
-	}
-
-	if !isFeatureEnabled {
-		klog.Info("OVS CPU affinity pinning disabled")
-		return
-	}
-
+// Run monitors OVS daemon's processes (ovs-vswitchd and ovsdb-server) and sets their CPU affinity.
+// The CPUs are, in order of precedence:
+//   - the CPUs of the `k8s.ovn.org/ovs-cpu-set` annotation of the node
+//   - the CPUs of the ovnkube-node `ovs-cpu-set` configuration
+//   - the CPUs of the current process, if the file `/etc/openvswitch/enable_dynamic_cpu_affinity`
+//     is not empty
+//
+// The PMD threads of the userspace datapath are left out, they are pinned by ovs-vswitchd itself to
+// the CPUs of the `k8s.ovn.org/ovs-pmd-cpu-set` node annotation or of the `ovs-pmd-cpu-set`
+// configuration. When pinning is disabled, the CPU affinity of the OVS daemons is restored to the
+// default affinity of the system services, and the PMD threads CPU mask is removed.
+func Run(stopCh <-chan struct{}, nodeName string, nodeLister corelisters.NodeLister) {
 	klog.Infof("Starting OVS daemon CPU pinning")
 	defer klog.Infof("Stopping OVS daemon CPU pinning")
 
-	var fsnotifyEvents chan fsnotify.Event
-	var fsnotifyErrors chan error
-	fileWatcher, err := createFileWatcherFor(featureEnablerFile)
-	if err != nil {
-		klog.Warningf("Can't create a watcher for %s. Pinning will not stop by deleting it: %v", featureEnablerFile, err)
-		fsnotifyEvents = make(chan fsnotify.Event)
-		fsnotifyErrors = make(chan error)
-	} else {
-		fsnotifyEvents = fileWatcher.Events
-		fsnotifyErrors = fileWatcher.Errors
-		defer fileWatcher.Close()
+	p := &pinner{
+		nodeName:   nodeName,
+		nodeLister: nodeLister,
 	}
 
 	ticker := time.NewTicker(tickDuration)
@@ -61,65 +79,203 @@ func Run(stopCh <-chan struct{}) {
 
 	for {
 		select {
-		case event, ok := <-fsnotifyEvents:
-			if !ok {
-				continue
-			}
-
-			if event.Op.Has(fsnotify.Remove) {
-				klog.Infof("File [%s] has been removed. To re-enable the feature, restart ovnkube-node", featureEnablerFile)
-				return
-			}
-
-			isFeatureEnabled, err = isFileNotEmpty(featureEnablerFile)
-			if err != nil {
-				klog.Warningf("Error while reading [%s]: %v", featureEnablerFile, err)
-				return
-			}
-
-			if !isFeatureEnabled {
-				klog.Infof("File [%s] is empty or missing. To re-enable the feature, restart ovnkube-node", featureEnablerFile)
-				return
-			}
-
-		case err, ok := <-fsnotifyErrors:
-			if ok {
-				klog.Errorf("Error watching for file [%s] changes: %s", featureEnablerFile, err)
-			}
-
 		case <-stopCh:
 			return
 
 		case <-ticker.C:
-			if !isFeatureEnabled {
-				continue
-			}
-
-			err := setOvsVSwitchdCPUAffinity()
-			if err != nil {
-				klog.Warningf("Error while aligning ovs-vswitchd CPUs to current process: %v", err)
-			}
-
-			err = setOvsDBServerCPUAffinity()
-			if err != nil {
-				klog.Warningf("Error while aligning ovsdb-server CPUs to current process: %v", err)
-			}
+			p.sync()
 		}
 	}
 }
 
-func createFileWatcherFor(filename string) (*fsnotify.Watcher, error) {
-	fileWatcher, err := fsnotify.NewWatcher()
+type pinner struct {
+	nodeName   string
+	nodeLister corelisters.NodeLister
+
+	initialized bool
+	// pinnedCPUs and pinnedPMDCPUs are the CPU lists the OVS threads are
+	// pinned to, empty if they are not pinned
+	pinnedCPUs    string
+	pinnedPMDCPUs string
+}
+
+func (p *pinner) sync() {
+	if !p.initialized {
+		if err := p.init(); err != nil {
+			klog.Warningf("Can't get the current OVS CPU pinning: %v", err)
+			return
+		}
+	}
+
+	cpus, err := p.getOVSCPUs()
+	if err != nil {
+		klog.Warningf("Can't get the CPUs to pin OVS daemons to: %v", err)
+	} else if err = p.syncDaemons(cpus); err != nil {
+		klog.Warningf("Error while setting OVS daemons CPU affinity: %v", err)
+	}
+
+	pmdCPUs, err := p.getPMDCPUs()
+	if err != nil {
+		klog.Warningf("Can't get the CPUs to pin OVS PMD threads to: %v", err)
+	} else if err = p.syncPMD(pmdCPUs); err != nil {
+		klog.Warningf("Error while setting OVS PMD threads CPU mask: %v", err)
+	}
+}
+
+// init reads the pinning applied before ovnkube-node (re)started
+func (p *pinner) init() error {
+	var err error
+	p.pinnedCPUs, err = getOvsExternalIDFn(pinnedCPUSetKey)
+	if err != nil {
+		return err
+	}
+	p.pinnedPMDCPUs, err = getOvsExternalIDFn(pinnedPMDCPUSetKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create filesystem watcher: %w", err)
+		return err
+	}
+	setAffinityMetric(daemonsThreads, p.pinnedCPUs)
+	setAffinityMetric(pmdThreads, p.pinnedPMDCPUs)
+	p.initialized = true
+	return nil
+}
+
+// getOVSCPUs returns the CPUs to pin the OVS daemons to, or nil if they must
+// not be pinned
+func (p *pinner) getOVSCPUs() (*unix.CPUSet, error) {
+	cpuList := p.getCPUList(OVSCPUSetAnnotation, config.OvnKubeNode.OVSCPUSet)
+	if cpuList != "" {
+		cpus, err := parseCPUSet(cpuList)
+		if err != nil {
+			return nil, err
+		}
+		return &cpus, nil
 	}
 
-	err = fileWatcher.Add(filename)
+	isFeatureEnabled, err := isFileNotEmpty(featureEnablerFile)
 	if err != nil {
-		return nil, fmt.Errorf("unable to watch [%s] file: %w", filename, err)
+		return nil, err
 	}
+	if !isFeatureEnabled {
+		return nil, nil
+	}
+	var cpus unix.CPUSet
+	if err := unix.SchedGetaffinity(os.Getpid(), &cpus); err != nil {
+		return nil, fmt.Errorf("can't get own CPU affinity: %w", err)
+	}
+	return &cpus, nil
+}
 
-	return fileWatcher, nil
+// getPMDCPUs returns the CPUs to run the PMD threads on, or nil if
+// ovs-vswitchd chooses them
+func (p *pinner) getPMDCPUs() (*unix.CPUSet, error) {
+	cpuList := p.getCPUList(OVSPMDCPUSetAnnotation, config.OvnKubeNode.OVSPMDCPUSet)
+	if cpuList == "" {
+		return nil, nil
+	}
+	cpus, err := parseCPUSet(cpuList)
+	if err != nil {
+		return nil, err
+	}
+	return &cpus, nil
+}
+
+// getCPUList returns the value of the given node annotation if it is set, or
+// the configured value otherwise
+func (p *pinner) getCPUList(annotation, configured string) string {
+	if p.nodeLister == nil {
+		return configured
+	}
+	node, err := p.nodeLister.Get(p.nodeName)
+	if err != nil {
+		klog.Warningf("Can't get node %s: %v", p.nodeName, err)
+		return configured
+	}
+	if cpuList := node.Annotations[annotation]; cpuList != "" {
+		return cpuList
+	}
+	return configured
+}
+
+func (p *pinner) syncDaemons(cpus *unix.CPUSet) error {
+	if cpus == nil {
+		if p.pinnedCPUs == "" {
+			return nil
+		}
+		var defaultCPUs unix.CPUSet
+		if err := unix.SchedGetaffinity(defaultAffinityPID, &defaultCPUs); err != nil {
+			return fmt.Errorf("can't get the default CPU affinity: %w", err)
+		}
+		klog.Infof("OVS CPU affinity pinning disabled, restoring OVS daemons CPU affinity to %s", printCPUSet(defaultCPUs))
+		if err := setOvsDaemonsCPUAffinity(defaultCPUs); err != nil {
+			return err
+		}
+		if err := setOvsExternalIDFn(pinnedCPUSetKey, ""); err != nil {
+			return err
+		}
+		p.pinnedCPUs = ""
+		setAffinityMetric(daemonsThreads, "")
+		return nil
+	}
+
+	if err := setOvsDaemonsCPUAffinity(*cpus); err != nil {
+		return err
+	}
+	cpuList := printCPUSet(*cpus)
+	if cpuList == p.pinnedCPUs {
+		return nil
+	}
+	if err := setOvsExternalIDFn(pinnedCPUSetKey, cpuList); err != nil {
+		return err
+	}
+	p.pinnedCPUs = cpuList
+	setAffinityMetric(daemonsThreads, cpuList)
+	return nil
+}
+
+func (p *pinner) syncPMD(cpus *unix.CPUSet) error {
+	cpuList := ""
+	if cpus != nil {
+		cpuList = printCPUSet(*cpus)
+	}
+	if cpuList == p.pinnedPMDCPUs {
+		return nil
+	}
+
+	if cpus == nil {
+		klog.Infof("OVS PMD threads CPU pinning disabled, removing the PMD threads CPU mask")
+		if err := setOvsPMDCPUMaskFn(""); err != nil {
+			return err
+		}
+	} else {
+		klog.Infof("Setting OVS PMD threads CPUs to %s", cpuList)
+		if err := setOvsPMDCPUMaskFn(cpuMask(*cpus)); err != nil {
+			return err
+		}
+	}
+	if err := setOvsExternalIDFn(pinnedPMDCPUSetKey, cpuList); err != nil {
+		return err
+	}
+	p.pinnedPMDCPUs = cpuList
+	setAffinityMetric(pmdThreads, cpuList)
+	return nil
+}
+
+func setOvsDaemonsCPUAffinity(cpus unix.CPUSet) error {
+	var errs []error
+	if err := setOvsVSwitchdCPUAffinity(cpus); err != nil {
+		errs = append(errs, err)
+	}
+	if err := setOvsDBServerCPUAffinity(cpus); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func setAffinityMetric(threads, cpuList string) {
+	metrics.MetricOvsCPUAffinity.DeletePartialMatch(prometheus.Labels{"threads": threads})
+	if cpuList != "" {
+		metrics.MetricOvsCPUAffinity.WithLabelValues(threads, cpuList).Set(1)
+	}
 }
 
 func isFileNotEmpty(filename string) (bool, error) {
@@ -135,7 +291,7 @@ func isFileNotEmpty(filename string) (bool, error) {
 	return f.Size() > 0, nil
 }
 
-func setOvsVSwitchdCPUAffinity() error {
+func setOvsVSwitchdCPUAffinity(cpus unix.CPUSet) error {
 
 	ovsVSwitchdPID, err := getOvsVSwitchdPIDFn()
 	if err != nil {
@@ -143,10 +299,10 @@ func setOvsVSwitchdCPUAffinity() error {
 	}
 
 	klog.V(5).Infof("Managing ovs-vswitchd[%s] daemon CPU affinity", ovsVSwitchdPID)
-	return setProcessCPUAffinity(ovsVSwitchdPID)
+	return setProcessCPUAffinity(ovsVSwitchdPID, cpus)
 }
 
-func setOvsDBServerCPUAffinity() error {
+func setOvsDBServerCPUAffinity(cpus unix.CPUSet) error {
 
 	ovsDBserverPID, err := getOvsDBServerPIDFn()
 	if err != nil {
@@ -154,31 +310,26 @@ func setOvsDBServerCPUAffinity() error {
 	}
 
 	klog.V(5).Infof("Managing ovsdb-server[%s] daemon CPU affinity", ovsDBserverPID)
-	return setProcessCPUAffinity(ovsDBserverPID)
+	return setProcessCPUAffinity(ovsDBserverPID, cpus)
 }
 
-// setProcessCPUAffinity sets the CPU affinity of the given process to the same affinity as the current process
-func setProcessCPUAffinity(targetPIDStr string) error {
+// setProcessCPUAffinity sets the CPU affinity of the threads of the given process, except the PMD threads
+// which are pinned by ovs-vswitchd, to the given CPUs
+func setProcessCPUAffinity(targetPIDStr string, cpus unix.CPUSet) error {
 
 	targetPID, err := strconv.Atoi(targetPIDStr)
 	if err != nil {
 		return fmt.Errorf("can't convert PID[%s] to integer: %w", targetPIDStr, err)
 	}
 
-	var currentProcessCPUs unix.CPUSet
-	err = unix.SchedGetaffinity(os.Getpid(), &currentProcessCPUs)
-	if err != nil {
-		return fmt.Errorf("can't get own CPU affinity")
-	}
-
 	var targetProcessCPUs unix.CPUSet
 	err = unix.SchedGetaffinity(targetPID, &targetProcessCPUs)
 	if err != nil {
 		return fmt.Errorf("can't get process (PID:%d) CPU affinity: %w", targetPID, err)
 	}
 
-	if currentProcessCPUs == targetProcessCPUs {
-		klog.V(5).Infof("Process[%d] CPU affinity already match current process's affinity %s", targetPID, printCPUSet(currentProcessCPUs))
+	if cpus == targetProcessCPUs {
+		klog.V(5).Infof("Process[%d] CPU affinity already matches %s", targetPID, printCPUSet(cpus))
 		return nil
 	}
 
@@ -187,15 +338,94 @@ func setProcessCPUAffinity(targetPIDStr string) error {
 		return fmt.Errorf("can't get tasks of PID(%d):%w", targetPID, err)
 	}
 
-	klog.Infof("Setting CPU affinity of PID(%d) (ntasks=%d) to %s, was %s", targetPID, len(taskIDs), printCPUSet(currentProcessCPUs), printCPUSet(targetProcessCPUs))
+	klog.Infof("Setting CPU affinity of PID(%d) (ntasks=%d) to %s, was %s", targetPID, len(taskIDs), printCPUSet(cpus), printCPUSet(targetProcessCPUs))
 	for _, taskID := range taskIDs {
-		err = unix.SchedSetaffinity(taskID, &currentProcessCPUs)
+		if isPMDThread(targetPID, taskID) {
+			continue
+		}
+		err = unix.SchedSetaffinity(taskID, &cpus)
 		if err != nil {
 			// The task may have been stopped, don't break the loop and continue setting CPU affinity on other tasks.
-			klog.Warningf("Error while setting CPU affinity of task(%d) PID(%d) to %s: %v", taskID, targetPID, printCPUSet(currentProcessCPUs), err)
+			klog.Warningf("Error while setting CPU affinity of task(%d) PID(%d) to %s: %v", taskID, targetPID, printCPUSet(cpus), err)
+		}
+	}
+
+	return nil
+}
+
+// isPMDThread returns whether the given task is a PMD thread of ovs-vswitchd,
+// named pmd-c<core>/id:<id>
+func isPMDThread(pid, taskID int) bool {
+	comm, err := os.ReadFile(fmt.Sprintf("/proc/%d/task/%d/comm", pid, taskID))
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(string(comm), "pmd")
+}
+
+// parseCPUSet parses a non-empty list of CPUs in linux CPU list format
+func parseCPUSet(cpuList string) (unix.CPUSet, error) {
+	var cpus unix.CPUSet
+	set, err := cpuset.Parse(cpuList)
+	if err != nil {
+		return cpus, fmt.Errorf("invalid CPU list %q: %w", cpuList, err)
+	}
+	for _, cpu := range set.List() {
+		if cpu >= len(cpus)*64 {
+			return cpus, fmt.Errorf("invalid CPU list %q: CPU %d out of range", cpuList, cpu)
+		}
+		cpus.Set(cpu)
+	}
+	if cpus.Count() == 0 {
+		return cpus, fmt.Errorf("invalid CPU list %q: no CPU", cpuList)
+	}
+	return cpus, nil
+}
+
+// cpuMask returns the hexadecimal mask of the given CPUs, as expected by the
+// ovs-vswitchd pmd-cpu-mask configuration
+func cpuMask(cpus unix.CPUSet) string {
+	mask := new(big.Int)
+	for i, remaining := 0, cpus.Count(); remaining > 0; i++ {
+		if cpus.IsSet(i) {
+			mask.SetBit(mask, i, 1)
+			remaining--
 		}
 	}
+	return fmt.Sprintf("0x%x", mask)
+}
+
+func getOvsExternalID(key string) (string, error) {
+	stdout, stderr, err := util.RunOVSVsctl("--if-exists", "get", "Open_vSwitch", ".", "external_ids:"+key)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Open_vSwitch external_ids:%s, stderr: %q: %w", key, stderr, err)
+	}
+	return stdout, nil
+}
 
+// setOvsExternalID sets the given Open_vSwitch external_ids, or removes it if
+// value is empty
+func setOvsExternalID(key, value string) error {
+	args := []string{"remove", "Open_vSwitch", ".", "external_ids", key}
+	if value != "" {
+		args = []string{"set", "Open_vSwitch", ".", fmt.Sprintf("external_ids:%s=%q", key, value)}
+	}
+	if _, stderr, err := util.RunOVSVsctl(args...); err != nil {
+		return fmt.Errorf("failed to set Open_vSwitch external_ids:%s, stderr: %q: %w", key, stderr, err)
+	}
+	return nil
+}
+
+// setOvsPMDCPUMask sets the CPU mask of the PMD threads, or removes it if mask
+// is empty
+func setOvsPMDCPUMask(mask string) error {
+	args := []string{"remove", "Open_vSwitch", ".", "other_config", "pmd-cpu-mask"}
+	if mask != "" {
+		args = []string{"set", "Open_vSwitch", ".", "other_config:pmd-cpu-mask=" + mask}
+	}
+	if _, stderr, err := util.RunOVSVsctl(args...); err != nil {
+		return fmt.Errorf("failed to set Open_vSwitch other_config:pmd-cpu-mask, stderr: %q: %w", stderr, err)
+	}
 	return nil
 }
 
@@ -15,7 +15,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sys/unix"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 )
 
 func TestAlignCPUAffinity(t *testing.T) {
@@ -27,6 +33,7 @@ func TestAlignCPUAffinity(t *testing.T) {
 
 	defer setTickDuration(20 * time.Millisecond)()
 	defer mockFeatureEnableFile(t, "1")()
+	defer mockOvsConfig()()
 
 	var wg sync.WaitGroup
 	stopCh := make(chan struct{})
@@ -39,7 +46,7 @@ func TestAlignCPUAffinity(t *testing.T) {
 	go func() {
 		// Be sure the system under test goroutine is finished before cleaning
 		defer wg.Done()
-		Run(stopCh)
+		Run(stopCh, "node1", nil)
 	}()
 
 	var initialCPUset unix.CPUSet
@@ -79,6 +86,123 @@ func TestAlignCPUAffinity(t *testing.T) {
 	assertNeverPIDHasSchedAffinity(t, ovsDBPid, tmpCPUset)
 }
 
+func TestPinToConfiguredCPUs(t *testing.T) {
+	ovsDBPid, ovsDBStop := mockOvsdbProcess(t)
+	defer ovsDBStop()
+
+	ovsVSwitchdPid, ovsVSwitchdStop := mockOvsVSwitchdProcess(t)
+	defer ovsVSwitchdStop()
+
+	defer setTickDuration(20 * time.Millisecond)()
+	defer mockFeatureEnableFile(t, "")()
+	ovsConfig := mockOvsConfig()
+	defer ovsConfig()
+
+	assert.NoError(t, config.PrepareTestConfig())
+	defer func() {
+		assert.NoError(t, config.PrepareTestConfig())
+	}()
+	assert.Greater(t, runtime.NumCPU(), 1)
+
+	var defaultCPUset unix.CPUSet
+	err := unix.SchedGetaffinity(os.Getpid(), &defaultCPUset)
+	assert.NoError(t, err)
+	defaultAffinityPID = os.Getpid()
+	defer func() { defaultAffinityPID = 1 }()
+
+	lastCPU := runtime.NumCPU() - 1
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	setNodeAnnotations(t, indexer, map[string]string{OVSCPUSetAnnotation: fmt.Sprintf("%d", lastCPU)})
+	nodeLister := corelisters.NewNodeLister(indexer)
+	config.OvnKubeNode.OVSCPUSet = "0"
+
+	var wg sync.WaitGroup
+	stopCh := make(chan struct{})
+	defer func() {
+		close(stopCh)
+		wg.Wait()
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		Run(stopCh, "node1", nodeLister)
+	}()
+
+	// the node annotation takes precedence over the configuration
+	var annotatedCPUset unix.CPUSet
+	annotatedCPUset.Set(lastCPU)
+	assertPIDHasSchedAffinity(t, ovsVSwitchdPid, annotatedCPUset)
+	assertPIDHasSchedAffinity(t, ovsDBPid, annotatedCPUset)
+
+	setNodeAnnotations(t, indexer, nil)
+
+	var configuredCPUset unix.CPUSet
+	configuredCPUset.Set(0)
+	assertPIDHasSchedAffinity(t, ovsVSwitchdPid, configuredCPUset)
+	assertPIDHasSchedAffinity(t, ovsDBPid, configuredCPUset)
+
+	// disabling the pinning restores the default affinity
+	config.OvnKubeNode.OVSCPUSet = ""
+	assertPIDHasSchedAffinity(t, ovsVSwitchdPid, defaultCPUset)
+	assertPIDHasSchedAffinity(t, ovsDBPid, defaultCPUset)
+}
+
+func TestPinner_syncPMD(t *testing.T) {
+	assert.NoError(t, config.PrepareTestConfig())
+	defer func() {
+		assert.NoError(t, config.PrepareTestConfig())
+	}()
+	defer mockOvsConfig()()
+	defer mockFeatureEnableFile(t, "")()
+
+	var pmdMask string
+	previousSetter := setOvsPMDCPUMaskFn
+	setOvsPMDCPUMaskFn = func(mask string) error {
+		pmdMask = mask
+		return nil
+	}
+	defer func() { setOvsPMDCPUMaskFn = previousSetter }()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	setNodeAnnotations(t, indexer, map[string]string{OVSPMDCPUSetAnnotation: "2-3"})
+	nodeLister := corelisters.NewNodeLister(indexer)
+	config.OvnKubeNode.OVSPMDCPUSet = "4"
+
+	p := &pinner{nodeName: "node1", nodeLister: nodeLister}
+	p.sync()
+	assert.Equal(t, "0xc", pmdMask)
+	pinned, err := getOvsExternalIDFn(pinnedPMDCPUSetKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "2-3", pinned)
+
+	setNodeAnnotations(t, indexer, nil)
+	p.sync()
+	assert.Equal(t, "0x10", pmdMask)
+
+	// a restarted ovnkube-node with pinning disabled reverts the mask
+	config.OvnKubeNode.OVSPMDCPUSet = ""
+	p = &pinner{nodeName: "node1", nodeLister: nodeLister}
+	p.sync()
+	assert.Equal(t, "", pmdMask)
+	pinned, err = getOvsExternalIDFn(pinnedPMDCPUSetKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "", pinned)
+}
+
+func TestParseCPUSet(t *testing.T) {
+	cpus, err := parseCPUSet("0-2,5")
+	assert.NoError(t, err)
+	assert.Equal(t, "0-2,5", printCPUSet(cpus))
+	assert.Equal(t, "0x27", cpuMask(cpus))
+
+	_, err = parseCPUSet("2-")
+	assert.Error(t, err)
+
+	_, err = parseCPUSet("4096")
+	assert.Error(t, err)
+}
+
 func TestIsFileNotEmpty(t *testing.T) {
 	defer mockFeatureEnableFile(t, "")()
 
@@ -174,6 +298,47 @@ func mockOvsVSwitchdProcess(t *testing.T) (int, func()) {
 	}
 }
 
+// mockOvsConfig replaces the Open_vSwitch external_ids and PMD CPU mask
+// getters and setters with in-memory ones
+func mockOvsConfig() func() {
+	externalIDs := map[string]string{}
+	var mutex sync.Mutex
+
+	previousGetter := getOvsExternalIDFn
+	previousSetter := setOvsExternalIDFn
+	previousPMDSetter := setOvsPMDCPUMaskFn
+	getOvsExternalIDFn = func(key string) (string, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return externalIDs[key], nil
+	}
+	setOvsExternalIDFn = func(key, value string) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		externalIDs[key] = value
+		return nil
+	}
+	setOvsPMDCPUMaskFn = func(string) error {
+		return nil
+	}
+
+	return func() {
+		getOvsExternalIDFn = previousGetter
+		setOvsExternalIDFn = previousSetter
+		setOvsPMDCPUMaskFn = previousPMDSetter
+	}
+}
+
+func setNodeAnnotations(t *testing.T, indexer cache.Indexer, annotations map[string]string) {
+	err := indexer.Update(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "node1",
+			Annotations: annotations,
+		},
+	})
+	assert.NoError(t, err)
+}
+
 func setTickDuration(d time.Duration) func() {
 	previousValue := tickDuration
 	tickDuration = d
@@ -4,9 +4,10 @@
 package ovspinning
 
 import (
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 )
 
-func Run(_ <-chan struct{}) {
+func Run(_ <-chan struct{}, _ string, _ corelisters.NodeLister) {
 	klog.Infof("OVS CPU pinning is supported on linux platform only")
 }
@@ -36,6 +36,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/networkqos"
 	lsm "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/logical_switch_manager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/routeimport"
 	zoneic "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/zone_interconnect"
@@ -65,8 +66,7 @@ type CommonNetworkControllerInfo struct {
 	// has SCTP support
 	SCTPSupport bool
 
-	// has multicast support; set to false for secondary networks.
-	// TBD: Changes need to be made to support multicast for secondary networks
+	// has multicast support; secondary networks have to opt in
 	multicastSupport bool
 
 	// Supports OVN Template Load Balancers?
@@ -74,6 +74,11 @@ type CommonNetworkControllerInfo struct {
 
 	// Northbound database zone name to which this Controller is connected to - aka local zone
 	zone string
+
+	// networkQoSHandler handles the pod and node events for the NetworkQoS
+	// controllers of all the networks, only set if the NetworkQoS feature is
+	// enabled
+	networkQoSHandler *networkqos.PodNodeHandler
 }
 
 // BaseNetworkController structure holds per-network fields and network specific configuration
@@ -181,6 +186,10 @@ type BaseNetworkController struct {
 	observManager *observability.Manager
 
 	routeImportManager routeimport.Manager
+
+	// networkQoSController applies the NetworkQoSes selecting the network,
+	// only set if the NetworkQoS feature is enabled
+	networkQoSController *networkqos.Controller
 }
 
 // BaseSecondaryNetworkController structure holds per-network fields and network specific
@@ -206,6 +215,10 @@ func (oc *BaseSecondaryNetworkController) Reconcile(netInfo util.NetInfo) error
 		if err := ovnretry.RequeuePendingPods(oc.kube, oc.GetNetInfo(), oc.retryPods); err != nil {
 			klog.Errorf("Failed to requeue pending pods for network %s: %v", oc.GetNetworkName(), err)
 		}
+		// NetworkQoSes select the network by NAD
+		if oc.networkQoSController != nil {
+			oc.networkQoSController.ReconcileAll()
+		}
 	}
 	return nil
 }
@@ -217,7 +230,8 @@ func getNetworkControllerName(netName string) string {
 // NewCommonNetworkControllerInfo creates CommonNetworkControllerInfo shared by controllers
 func NewCommonNetworkControllerInfo(client clientset.Interface, kube *kube.KubeOVN, wf *factory.WatchFactory,
 	recorder record.EventRecorder, nbClient libovsdbclient.Client, sbClient libovsdbclient.Client,
-	podRecorder *metrics.PodRecorder, SCTPSupport, multicastSupport, svcTemplateSupport bool) (*CommonNetworkControllerInfo, error) {
+	podRecorder *metrics.PodRecorder, SCTPSupport, multicastSupport, svcTemplateSupport bool,
+	networkQoSHandler *networkqos.PodNodeHandler) (*CommonNetworkControllerInfo, error) {
 	zone, err := libovsdbutil.GetNBZone(nbClient)
 	if err != nil {
 		return nil, fmt.Errorf("error getting NB zone name : err - %w", err)
@@ -234,6 +248,7 @@ func NewCommonNetworkControllerInfo(client clientset.Interface, kube *kube.KubeO
 		multicastSupport:   multicastSupport,
 		svcTemplateSupport: svcTemplateSupport,
 		zone:               zone,
+		networkQoSHandler:  networkQoSHandler,
 	}, nil
 }
 
@@ -722,7 +737,7 @@ func (bnc *BaseNetworkController) syncNodeManagementPort(node *kapi.Node, switch
 	}
 
 	if v4Subnet != nil {
-		if err := libovsdbutil.UpdateNodeSwitchExcludeIPs(bnc.nbClient, bnc.GetNetworkScopedK8sMgmtIntfName(node.Name), bnc.GetNetworkScopedSwitchName(node.Name), node.Name, v4Subnet); err != nil {
+		if err := libovsdbutil.UpdateNodeSwitchExcludeIPs(bnc.nbClient, bnc.GetNetworkScopedK8sMgmtIntfName(node.Name), util.GetHybridOverlayPortName(bnc.GetNetworkScopedName(node.Name)), bnc.GetNetworkScopedSwitchName(node.Name), node.Name, v4Subnet); err != nil {
 			return nil, err
 		}
 	}
@@ -1118,3 +1133,32 @@ func (bnc *BaseNetworkController) GetSamplingConfig() *libovsdbops.SamplingConfi
 	}
 	return nil
 }
+
+// startNetworkQoSController starts the controller applying the NetworkQoSes
+// selecting the network. Its pod and node events are handled by the handler
+// shared by all the networks.
+func (bnc *BaseNetworkController) startNetworkQoSController() error {
+	if bnc.networkQoSHandler == nil {
+		return fmt.Errorf("unable to start NetworkQoS controller for network %s: no pod and node handler", bnc.GetNetworkName())
+	}
+	bnc.networkQoSController = networkqos.NewController(
+		bnc.controllerName,
+		bnc.GetNetInfo(),
+		bnc.nbClient,
+		bnc.addressSetFactory,
+		bnc.kube.NetworkQoSClient,
+		bnc.networkManager,
+		bnc.watchFactory.NetworkQoSInformer(),
+		bnc.networkQoSHandler,
+	)
+	if err := bnc.networkQoSController.Start(); err != nil {
+		return fmt.Errorf("unable to start NetworkQoS controller for network %s: %w", bnc.GetNetworkName(), err)
+	}
+	return nil
+}
+
+func (bnc *BaseNetworkController) stopNetworkQoSController() {
+	if bnc.networkQoSController != nil {
+		bnc.networkQoSController.Stop()
+	}
+}
@@ -72,10 +72,10 @@ func (bnc *BaseNetworkController) shouldWatchNamespaces() bool {
 	// Watch namespaces only if one of the following conditions is met:
 	// - The network is the default network.
 	// - The network is primary, and network segmentation is enabled.
-	// - The network is secondary, and multi NetworkPolicies are enabled.
+	// - The network is secondary, and multi NetworkPolicies or multicast are enabled.
 	return bnc.IsDefault() ||
 		bnc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() ||
-		bnc.IsSecondary() && util.IsMultiNetworkPoliciesSupportEnabled()
+		bnc.IsSecondary() && (util.IsMultiNetworkPoliciesSupportEnabled() || bnc.multicastSupport)
 }
 
 // WatchNamespaces starts the watching of namespace resource and calls
@@ -18,8 +18,11 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kubevirt"
 	logicalswitchmanager "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/logical_switch_manager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/tracing"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	kapi "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -443,7 +446,7 @@ func (bnc *BaseNetworkController) ensurePodAnnotation(pod *kapi.Pod, nadName str
 	return podAnnotation, true, nil
 }
 
-func (bnc *BaseNetworkController) addLogicalPortToNetwork(pod *kapi.Pod, nadName string,
+func (bnc *BaseNetworkController) addLogicalPortToNetwork(ctx context.Context, pod *kapi.Pod, nadName string,
 	network *nadapi.NetworkSelectionElement, enable *bool) (ops []ovsdb.Operation,
 	lsp *nbdb.LogicalSwitchPort, podAnnotation *util.PodAnnotation, newlyCreatedPort bool, err error) {
 	var ls *nbdb.LogicalSwitch
@@ -468,7 +471,9 @@ func (bnc *BaseNetworkController) addLogicalPortToNetwork(pod *kapi.Pod, nadName
 			pod.Namespace, pod.Name, pod.Spec.NodeName, podState)
 	}
 
+	_, span := tracing.StartSpan(ctx, "wait for logical switch", trace.WithAttributes(attribute.String("ovn.switch", switchName)))
 	ls, err = bnc.waitForNodeLogicalSwitch(switchName)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, nil, nil, false, err
 	}
@@ -551,11 +556,14 @@ func (bnc *BaseNetworkController) addLogicalPortToNetwork(pod *kapi.Pod, nadName
 	// it for the default network as well. If at all possible, keep them
 	// functionally equivalent going forward.
 	var annotationUpdated bool
+	_, span = tracing.StartSpan(ctx, "allocate pod annotation")
 	if bnc.IsSecondary() {
 		podAnnotation, annotationUpdated, err = bnc.allocatePodAnnotationForSecondaryNetwork(pod, existingLSP, nadName, network, networkRole)
 	} else {
 		podAnnotation, annotationUpdated, err = bnc.allocatePodAnnotation(pod, existingLSP, podDesc, nadName, network, networkRole)
 	}
+	span.SetAttributes(attribute.Bool("ovn.annotation_updated", annotationUpdated))
+	tracing.EndSpan(span, err)
 
 	if err != nil {
 		return nil, nil, nil, false, err
@@ -1,6 +1,7 @@
 package ovn
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -23,10 +24,12 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/persistentips"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/tracing"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
 
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/klog/v2"
@@ -297,16 +300,19 @@ func (bsnc *BaseSecondaryNetworkController) ensurePodForSecondaryNetwork(pod *co
 }
 
 func (bsnc *BaseSecondaryNetworkController) addLogicalPortToNetworkForNAD(pod *corev1.Pod, nadName, switchName string,
-	network *nadapi.NetworkSelectionElement, kubevirtLiveMigrationStatus *kubevirt.LiveMigrationStatus) error {
+	network *nadapi.NetworkSelectionElement, kubevirtLiveMigrationStatus *kubevirt.LiveMigrationStatus) (err error) {
 	var libovsdbExecuteTime time.Duration
 
+	ctx, span := tracing.StartPodSpan(context.TODO(), pod.Namespace, pod.Name, string(pod.UID), "add logical port",
+		trace.WithAttributes(tracing.NetworkKey.String(bsnc.GetNetworkName()), tracing.NADKey.String(nadName)))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	start := time.Now()
 	defer func() {
 		klog.Infof("[%s/%s] addLogicalPort for NAD %s took %v, libovsdb time %v",
 			pod.Namespace, pod.Name, nadName, time.Since(start), libovsdbExecuteTime)
 	}()
 
-	var err error
 	var podAnnotation *util.PodAnnotation
 	var ops []ovsdb.Operation
 	var lsp *nbdb.LogicalSwitchPort
@@ -328,7 +334,7 @@ func (bsnc *BaseSecondaryNetworkController) addLogicalPortToNetworkForNAD(pod *c
 	requiresLogicalPort := isLocalPod || bsnc.isLayer2Interconnect()
 
 	if requiresLogicalPort {
-		ops, lsp, podAnnotation, newlyCreated, err = bsnc.addLogicalPortToNetwork(pod, nadName, network, lspEnabled)
+		ops, lsp, podAnnotation, newlyCreated, err = bsnc.addLogicalPortToNetwork(ctx, pod, nadName, network, lspEnabled)
 		if err != nil {
 			return err
 		}
@@ -367,8 +373,7 @@ func (bsnc *BaseSecondaryNetworkController) addLogicalPortToNetworkForNAD(pod *c
 		}
 	}
 
-	if bsnc.doesNetworkRequireIPAM() &&
-		(util.IsMultiNetworkPoliciesSupportEnabled() || (util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork())) {
+	if bsnc.requiresNamespaceAddressSets() {
 		// Ensure the namespace/nsInfo exists
 		portUUID := ""
 		if lsp != nil {
@@ -381,6 +386,17 @@ func (bsnc *BaseSecondaryNetworkController) addLogicalPortToNetworkForNAD(pod *c
 		ops = append(ops, addOps...)
 	}
 
+	if lsp != nil && bsnc.multicastSupport && !bsnc.IsPrimaryNetwork() {
+		// The default multicast ACLs of the cluster port group apply to the
+		// switches having a port in the group. Secondary networks have no
+		// management port to add to it, so add the pods instead.
+		clusterPortGroupName := bsnc.getClusterPortGroupName(types.ClusterPortGroupNameBase)
+		ops, err = libovsdbops.AddPortsToPortGroupOps(bsnc.nbClient, ops, clusterPortGroupName, lsp.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to add port %s to cluster port group %s: %w", lsp.Name, clusterPortGroupName, err)
+		}
+	}
+
 	recordOps, txOkCallBack, _, err := bsnc.AddConfigDurationRecord("pod", pod.Namespace, pod.Name)
 	if err != nil {
 		klog.Errorf("Config duration recorder: %v", err)
@@ -388,7 +404,7 @@ func (bsnc *BaseSecondaryNetworkController) addLogicalPortToNetworkForNAD(pod *c
 	ops = append(ops, recordOps...)
 
 	transactStart := time.Now()
-	_, err = libovsdbops.TransactAndCheckAndSetUUIDs(bsnc.nbClient, lsp, ops)
+	_, err = libovsdbops.TransactAndCheckAndSetUUIDsContext(ctx, bsnc.nbClient, lsp, ops)
 	libovsdbExecuteTime = time.Since(transactStart)
 	if err != nil {
 		return fmt.Errorf("error transacting operations %+v: %v", ops, err)
@@ -451,10 +467,7 @@ func (bsnc *BaseSecondaryNetworkController) removePodForSecondaryNetwork(pod *co
 
 		// handle remote pod clean up but only do this one time
 		if !hasLogicalPort && !alreadyProcessed {
-			if bsnc.doesNetworkRequireIPAM() &&
-				// address set is for network policy only. So either multi network policy is enabled or network
-				// segmentation, and it is a primary UDN (regular netpol)
-				(util.IsMultiNetworkPoliciesSupportEnabled() || (util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork())) {
+			if bsnc.requiresNamespaceAddressSets() {
 				return bsnc.removeRemoteZonePodFromNamespaceAddressSet(pod)
 			}
 
@@ -637,6 +650,27 @@ func (bsnc *BaseSecondaryNetworkController) syncPodsForSecondaryNetwork(pods []i
 	return bsnc.deleteStaleLogicalSwitchPorts(expectedLogicalPorts)
 }
 
+// requiresNamespaceAddressSets returns whether the pod IPs are tracked in the
+// address sets of their namespace. These are used by network policies on
+// primary networks, by multi-network policies and by multicast.
+func (bsnc *BaseSecondaryNetworkController) requiresNamespaceAddressSets() bool {
+	return bsnc.doesNetworkRequireIPAM() &&
+		(util.IsMultiNetworkPoliciesSupportEnabled() ||
+			(util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork()) ||
+			bsnc.multicastSupport)
+}
+
+// isMulticastSupported returns whether multicast can be enabled on the
+// network. User defined primary networks support it like the default network
+// does, secondary networks have to opt in. Multicast is allowed within a
+// namespace from the IPs of its pods, so the network must assign the pod IPs.
+func (bsnc *BaseSecondaryNetworkController) isMulticastSupported() bool {
+	if !config.EnableMulticast || !bsnc.doesNetworkRequireIPAM() {
+		return false
+	}
+	return util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork() || bsnc.AllowsMulticast()
+}
+
 // addPodToNamespaceForSecondaryNetwork returns the ops needed to add pod's IP to the namespace's address set.
 func (bsnc *BaseSecondaryNetworkController) addPodToNamespaceForSecondaryNetwork(ns string, ips []*net.IPNet, portUUID string) ([]ovsdb.Operation, error) {
 	var err error
@@ -31,6 +31,7 @@ func (oc *BaseSecondaryLayer2NetworkController) stop() {
 	oc.cancelableCtx.Cancel()
 	oc.wg.Wait()
 
+	oc.stopNetworkQoSController()
 	if oc.ipamClaimsHandler != nil {
 		oc.watchFactory.RemoveIPAMClaimsHandler(oc.ipamClaimsHandler)
 	}
@@ -118,6 +119,13 @@ func (oc *BaseSecondaryLayer2NetworkController) run() error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableNetworkQoS {
+		// NetworkQoS depends on WatchPods and WatchNodes
+		if err := oc.startNetworkQoSController(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -150,6 +158,24 @@ func (oc *BaseSecondaryLayer2NetworkController) initializeLogicalSwitch(switchNa
 		logicalSwitch.LoadBalancerGroup = []string{clusterLoadBalancerGroupUUID, switchLoadBalancerGroupUUID}
 	}
 
+	// If supported, enable IGMP/MLD snooping and querier on the switch.
+	// There is no router port on the switch to source the queries from, so,
+	// like snooping switches do, the IGMP queries are sent from the
+	// unspecified address. The MLD queries are sent from the link local
+	// address of the MAC address derived from the unspecified address, which
+	// does not belong to any pod.
+	if oc.multicastSupport {
+		if logicalSwitch.OtherConfig == nil {
+			logicalSwitch.OtherConfig = map[string]string{}
+		}
+		querierMAC := util.IPAddrToHWAddr(net.IPv4zero)
+		logicalSwitch.OtherConfig["mcast_snoop"] = "true"
+		logicalSwitch.OtherConfig["mcast_querier"] = "true"
+		logicalSwitch.OtherConfig["mcast_eth_src"] = querierMAC.String()
+		logicalSwitch.OtherConfig["mcast_ip4_src"] = net.IPv4zero.String()
+		logicalSwitch.OtherConfig["mcast_ip6_src"] = util.HWAddrToIPv6LLA(querierMAC).String()
+	}
+
 	err := libovsdbops.CreateOrUpdateLogicalSwitch(oc.nbClient, &logicalSwitch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create logical switch %+v: %v", logicalSwitch, err)
@@ -0,0 +1,142 @@
+package ovn
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	clusterlinkapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+)
+
+// newClusterLinkController returns a controller that connects the cluster
+// router to the nodes of the clusters linked by ClusterLinks. The nodes and
+// their interconnect information are provided by cluster manager in the
+// ClusterLink status.
+func (oc *DefaultNetworkController) newClusterLinkController() controller.Controller {
+	informer := oc.watchFactory.ClusterLinkInformer()
+	controllerConfig := &controller.ControllerConfig[clusterlinkapi.ClusterLink]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       informer.Informer(),
+		Lister:         informer.Lister().List,
+		ObjNeedsUpdate: clusterLinkNeedsUpdate,
+		Reconcile:      oc.reconcileClusterLink,
+		Threadiness:    1,
+	}
+	return controller.NewController[clusterlinkapi.ClusterLink]("cluster_link_controller", controllerConfig)
+}
+
+func clusterLinkNeedsUpdate(oldObj, newObj *clusterlinkapi.ClusterLink) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldObj.Status.RemoteNodes, newObj.Status.RemoteNodes)
+}
+
+// syncClusterLinks deletes the interconnect resources of the ClusterLinks
+// that were deleted while ovnkube-controller was not running
+func (oc *DefaultNetworkController) syncClusterLinks() error {
+	clusterLinks, err := oc.watchFactory.ClusterLinkInformer().Lister().List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list ClusterLinks: %w", err)
+	}
+	links := sets.New[string]()
+	for _, cl := range clusterLinks {
+		links.Insert(cl.Name)
+	}
+	if err := oc.zoneICHandler.SyncRemoteClusterNodes(links); err != nil {
+		return err
+	}
+	if err := oc.zoneChassisHandler.SyncRemoteClusterNodes(links); err != nil {
+		return err
+	}
+	return oc.syncClusterLinkNoReroutePolicies()
+}
+
+// reconcileClusterLink creates the interconnect resources for the remote nodes
+// of the ClusterLink and deletes the ones of the nodes that are gone
+func (oc *DefaultNetworkController) reconcileClusterLink(name string) error {
+	startTime := time.Now()
+	klog.V(5).Infof("Syncing ClusterLink %s", name)
+	defer func() {
+		klog.V(4).Infof("Finished syncing ClusterLink %s, took %v", name, time.Since(startTime))
+	}()
+
+	cl, err := oc.watchFactory.ClusterLinkInformer().Lister().Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get ClusterLink %s: %w", name, err)
+	}
+
+	nodes := sets.New[string]()
+	var errs []error
+	if cl != nil {
+		for i := range cl.Status.RemoteNodes {
+			node := &cl.Status.RemoteNodes[i]
+			nodes.Insert(node.Name)
+			if err := oc.zoneChassisHandler.AddRemoteClusterNode(name, node); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if err := oc.zoneICHandler.AddRemoteClusterNode(name, node); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if err := oc.zoneICHandler.DeleteRemoteClusterNodes(name, nodes); err != nil {
+		errs = append(errs, err)
+	} else if err := oc.zoneChassisHandler.DeleteRemoteClusterNodes(name, nodes); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := oc.syncClusterLinkNoReroutePolicies(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := utilerrors.Join(errs...); err != nil {
+		return fmt.Errorf("failed to reconcile ClusterLink %s: %w", name, err)
+	}
+	return nil
+}
+
+// syncClusterLinkNoReroutePolicies keeps the pod subnets of all the linked
+// clusters in the no reroute policies of the cluster router, so that the
+// traffic of egress IP and egress service pods towards the pods of linked
+// clusters is not sent to an egress node.
+func (oc *DefaultNetworkController) syncClusterLinkNoReroutePolicies() error {
+	if !config.OVNKubernetesFeature.EnableEgressIP && !config.OVNKubernetesFeature.EnableEgressService {
+		return nil
+	}
+	clusterLinks, err := oc.watchFactory.ClusterLinkInformer().Lister().List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list ClusterLinks: %w", err)
+	}
+	var remoteSubnets []*net.IPNet
+	var errs []error
+	for _, cl := range clusterLinks {
+		for _, node := range cl.Status.RemoteNodes {
+			subnets, err := util.ParseIPNets(node.Subnets)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("invalid subnets of node %s of ClusterLink %s: %w", node.Name, cl.Name, err))
+				continue
+			}
+			remoteSubnets = append(remoteSubnets, subnets...)
+		}
+	}
+	clusterSubnets := util.GetAllClusterSubnetsFromEntries(oc.Subnets())
+	if err := ensureDefaultNoRerouteLinkedPodPolicies(oc.nbClient, oc.GetNetworkName(), oc.controllerName,
+		oc.GetNetworkScopedClusterRouterName(), clusterSubnets, remoteSubnets); err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.Join(errs...)
+}
@@ -0,0 +1,103 @@
+package ovn
+
+import (
+	"context"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	clusterlinkapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+var _ = ginkgo.Describe("OVN master ClusterLink", func() {
+	const clusterLinkName = "remote"
+
+	var fakeOvn *FakeOVN
+
+	getClusterLink := func(subnets ...string) *clusterlinkapi.ClusterLink {
+		cl := &clusterlinkapi.ClusterLink{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterLinkName},
+			Spec:       clusterlinkapi.ClusterLinkSpec{KubeconfigSecret: "remote-kubeconfig"},
+		}
+		for i, subnet := range subnets {
+			cl.Status.RemoteNodes = append(cl.Status.RemoteNodes, clusterlinkapi.RemoteNode{
+				Name:             "remote-node-" + string(rune('a'+i)),
+				NodeID:           int32(i + 2),
+				Subnets:          []string{subnet},
+				EncapIPs:         []string{"192.168.1.10"},
+				TransitSwitchIPs: []string{"100.88.0.10/16"},
+			})
+		}
+		return cl
+	}
+
+	getLinkedPodPolicies := func() []*nbdb.LogicalRouterPolicy {
+		dbIDs := getEgressIPLRPNoReRouteDbIDs(types.DefaultNoRereoutePriority, NoReRoutePodToLinkedPod, IPFamilyValueV4,
+			types.DefaultNetworkName, DefaultNetworkControllerName)
+		lrps, err := libovsdbops.FindLogicalRouterPoliciesWithPredicate(fakeOvn.nbClient,
+			libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](dbIDs, nil))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return lrps
+	}
+
+	ginkgo.BeforeEach(func() {
+		config.PrepareTestConfig()
+		config.OVNKubernetesFeature.EnableEgressIP = true
+		config.OVNKubernetesFeature.EnableClusterLink = true
+		fakeOvn = NewFakeOVN(false)
+	})
+
+	ginkgo.AfterEach(func() {
+		fakeOvn.shutdown()
+	})
+
+	ginkgo.It("keeps the pod subnets of the linked clusters in the no reroute policies", func() {
+		cl := getClusterLink("10.200.0.0/24", "10.200.1.0/24")
+		fakeOvn.startWithDBSetup(libovsdbtest.TestSetup{NBData: []libovsdbtest.TestData{
+			&nbdb.LogicalRouter{Name: types.OVNClusterRouter, UUID: types.OVNClusterRouter + "-UUID"},
+		}}, &clusterlinkapi.ClusterLinkList{Items: []clusterlinkapi.ClusterLink{*cl}})
+
+		err := fakeOvn.controller.syncClusterLinkNoReroutePolicies()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		lrps := getLinkedPodPolicies()
+		gomega.Expect(lrps).To(gomega.HaveLen(1))
+		gomega.Expect(lrps[0].Match).To(gomega.Equal("ip4.src == {10.128.0.0/14} && ip4.dst == {10.200.0.0/24, 10.200.1.0/24}"))
+		gomega.Expect(lrps[0].Action).To(gomega.Equal(nbdb.LogicalRouterPolicyActionAllow))
+
+		ginkgo.By("updating the policy when a remote node is gone")
+		cl = getClusterLink("10.200.1.0/24")
+		_, err = fakeOvn.fakeClient.ClusterLinkClient.K8sV1().ClusterLinks().UpdateStatus(context.TODO(), cl, metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(func() []clusterlinkapi.RemoteNode {
+			cl, err := fakeOvn.watcher.ClusterLinkInformer().Lister().Get(clusterLinkName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return cl.Status.RemoteNodes
+		}).Should(gomega.HaveLen(1))
+		err = fakeOvn.controller.syncClusterLinkNoReroutePolicies()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		lrps = getLinkedPodPolicies()
+		gomega.Expect(lrps).To(gomega.HaveLen(1))
+		gomega.Expect(lrps[0].Match).To(gomega.Equal("ip4.src == {10.128.0.0/14} && ip4.dst == {10.200.1.0/24}"))
+
+		ginkgo.By("removing the policy when the ClusterLink is deleted")
+		err = fakeOvn.fakeClient.ClusterLinkClient.K8sV1().ClusterLinks().Delete(context.TODO(), clusterLinkName, metav1.DeleteOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(func() error {
+			_, err := fakeOvn.watcher.ClusterLinkInformer().Lister().Get(clusterLinkName)
+			return err
+		}).Should(gomega.HaveOccurred())
+		err = fakeOvn.controller.syncClusterLinkNoReroutePolicies()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(getLinkedPodPolicies()).To(gomega.BeEmpty())
+		router, err := libovsdbops.GetLogicalRouter(fakeOvn.nbClient, &nbdb.LogicalRouter{Name: types.OVNClusterRouter})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(router.Policies).To(gomega.BeEmpty())
+	})
+})
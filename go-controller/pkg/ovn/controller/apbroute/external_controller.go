@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -228,6 +229,7 @@ type externalPolicyManager struct {
 
 type policyReferencedObjects struct {
 	targetNamespaces    sets.Set[string]
+	targetPods          sets.Set[ktypes.NamespacedName]
 	dynamicGWNamespaces sets.Set[string]
 	dynamicGWPods       sets.Set[ktypes.NamespacedName]
 }
@@ -447,6 +449,32 @@ func (m *externalPolicyManager) onPolicyDelete(obj interface{}) {
 	m.routeQueue.Add(key)
 }
 
+// onBFDStatusUpdate queues the policies with hops of different priorities, since the gateways they use depend on the
+// status of the BFD sessions of their hops.
+func (m *externalPolicyManager) onBFDStatusUpdate() {
+	routePolicies, err := m.getAllRoutePolicies()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list Admin Policy Based External Routes on BFD status update: %v", err))
+		return
+	}
+	for _, routePolicy := range routePolicies {
+		if hasPrioritizedHops(routePolicy) {
+			m.routeQueue.Add(routePolicy.Name)
+		}
+	}
+}
+
+func hasPrioritizedHops(routePolicy *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute) bool {
+	priorities := sets.New[int32]()
+	for _, hop := range routePolicy.Spec.NextHops.StaticHops {
+		priorities.Insert(hop.Priority)
+	}
+	for _, hop := range routePolicy.Spec.NextHops.DynamicHops {
+		priorities.Insert(hop.Priority)
+	}
+	return priorities.Len() > 1
+}
+
 func (m *externalPolicyManager) onNamespaceAdd(obj interface{}) {
 	ns, ok := obj.(*v1.Namespace)
 	if !ok {
@@ -683,8 +711,8 @@ func (m *externalPolicyManager) getPoliciesForNamespaceChange(namespace *v1.Name
 // Step 2 is done via policyReferencedObjects, which is a cache of the objects every policy selected last time.
 func (m *externalPolicyManager) getPoliciesForPodChange(pod *v1.Pod) (sets.Set[string], error) {
 	policyNames := sets.Set[string]{}
-	// first check which policies currently match given namespace.
-	// This should work when namespace is added, or starts matching a label selector
+	// first check which policies currently match given pod.
+	// This should work when pod is added, or starts matching a label selector
 	informerPolicies, err := m.getAllRoutePolicies()
 	if err != nil {
 		return nil, err
@@ -699,7 +727,11 @@ func (m *externalPolicyManager) getPoliciesForPodChange(pod *v1.Pod) (sets.Set[s
 		if err != nil {
 			return nil, err
 		}
-		if targetNsSel.Matches(labels.Set(podNs.Labels)) {
+		targetPodSel, err := metav1.LabelSelectorAsSelector(&informerPolicy.Spec.From.PodSelector)
+		if err != nil {
+			return nil, err
+		}
+		if targetNsSel.Matches(labels.Set(podNs.Labels)) && targetPodSel.Matches(labels.Set(pod.Labels)) {
 			policyNames.Insert(informerPolicy.Name)
 			continue
 		}
@@ -718,12 +750,11 @@ func (m *externalPolicyManager) getPoliciesForPodChange(pod *v1.Pod) (sets.Set[s
 			}
 		}
 	}
-	// check which namespaces were referenced by policies before
+	// check which pods were referenced by policies before
 	m.policyReferencedObjectsLock.RLock()
 	defer m.policyReferencedObjectsLock.RUnlock()
 	for policyName, policyRefs := range m.policyReferencedObjects {
-		// we don't store target pods, because all pods in the target namespace are affected, check namespace
-		if policyRefs.targetNamespaces.Has(podNs.Name) {
+		if policyRefs.targetPods.Has(getPodNamespacedName(pod)) {
 			policyNames.Insert(policyName)
 			continue
 		}
@@ -748,13 +779,35 @@ func (m *externalPolicyManager) getAllRoutePolicies() ([]*adminpolicybasedroutea
 	return routePolicies, nil
 }
 
-// getDynamicGatewayIPsForTargetNamespace is called by the annotation logic to identify if a namespace is managed by an CR.
+// isPolicyTarget returns whether the given policy targets the given pod, or
+// any pod of the given namespace if no pod is given.
+func (m *externalPolicyManager) isPolicyTarget(routePolicy *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute,
+	namespaceName string, pod *v1.Pod) (bool, error) {
+	targetNamespaces, err := m.listNamespacesBySelector(&routePolicy.Spec.From.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("failed to list namespaces %v", err)
+	}
+	if !slices.ContainsFunc(targetNamespaces, func(targetNS *v1.Namespace) bool { return targetNS.Name == namespaceName }) {
+		return false, nil
+	}
+	if pod == nil {
+		return true, nil
+	}
+	targetPodSel, err := metav1.LabelSelectorAsSelector(&routePolicy.Spec.From.PodSelector)
+	if err != nil {
+		return false, fmt.Errorf("failed to convert target pod selector: %w", err)
+	}
+	return targetPodSel.Matches(labels.Set(pod.Labels)), nil
+}
+
+// getDynamicGatewayIPsForTarget is called by the annotation logic to identify if a pod is managed by an CR.
 // Since the call can occur outside the lifecycle of the controller, it cannot rely on the namespace info cache object to have been populated.
-// Therefore it has to go through all policies until it identifies one that targets the namespace and retrieve the gateway IPs.
+// Therefore it has to go through all policies until it identifies one that targets the pod and retrieve the gateway IPs.
 // these IPs are used by the annotation logic to determine which ones to remove from the north bound DB (the ones not included in the list),
 // and the ones to keep (the ones that match both the annotation and the CR).
 // This logic ensures that both CR and annotations can coexist without duplicating gateway IPs.
-func (m *externalPolicyManager) getDynamicGatewayIPsForTargetNamespace(namespaceName string) (sets.Set[string], error) {
+// When no pod is given, the gateway IPs of the policies targeting any pod of the namespace are returned.
+func (m *externalPolicyManager) getDynamicGatewayIPsForTarget(namespaceName string, pod *v1.Pod) (sets.Set[string], error) {
 	policyGWIPs := sets.New[string]()
 
 	routePolicies, err := m.getAllRoutePolicies()
@@ -763,35 +816,34 @@ func (m *externalPolicyManager) getDynamicGatewayIPsForTargetNamespace(namespace
 	}
 
 	for _, routePolicy := range routePolicies {
-		targetNamespaces, err := m.listNamespacesBySelector(&routePolicy.Spec.From.NamespaceSelector)
+		isTarget, err := m.isPolicyTarget(routePolicy, namespaceName, pod)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get APB Policy %s dynamic gateway IPs: %w", routePolicy.Name, err)
+		}
+		if !isTarget {
+			continue
+		}
+		// only collect the dynamic gateways
+		dynamicGWInfo, _, _, err := m.processDynamicHopsGatewayInformation(routePolicy.Spec.NextHops.DynamicHops)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get APB Policy %s dynamic gateway IPs: failed to list namespaces %v",
+			return nil, fmt.Errorf("failed to get APB Policy %s dynamic gateway IPs: failed to process dynamic GW %v",
 				routePolicy.Name, err)
 		}
-		for _, targetNS := range targetNamespaces {
-			if targetNS.Name == namespaceName {
-				// only collect the dynamic gateways
-				dynamicGWInfo, _, _, err := m.processDynamicHopsGatewayInformation(routePolicy.Spec.NextHops.DynamicHops)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get APB Policy %s dynamic gateway IPs: failed to process dynamic GW %v",
-						routePolicy.Name, err)
-				}
-				for _, gwInfo := range dynamicGWInfo.Elems() {
-					insertSet(policyGWIPs, gwInfo.Gateways)
-				}
-			}
+		for _, gwInfo := range dynamicGWInfo.Elems() {
+			insertSet(policyGWIPs, gwInfo.Gateways)
 		}
 	}
 	return policyGWIPs, nil
 }
 
-// getStaticGatewayIPsForTargetNamespace is called by the annotation logic to identify if a namespace is managed by an CR.
+// getStaticGatewayIPsForTarget is called by the annotation logic to identify if a pod is managed by an CR.
 // Since the call can occur outside the lifecycle of the controller, it cannot rely on the namespace info cache object to have been populated.
-// Therefore it has to go through all policies until it identifies one that targets the namespace and retrieve the gateway IPs.
+// Therefore it has to go through all policies until it identifies one that targets the pod and retrieve the gateway IPs.
 // these IPs are used by the annotation logic to determine which ones to remove from the north bound DB (the ones not included in the list),
 // and the ones to keep (the ones that match both the annotation and the CR).
 // This logic ensures that both CR and annotations can coexist without duplicating gateway IPs.
-func (m *externalPolicyManager) getStaticGatewayIPsForTargetNamespace(namespaceName string) (sets.Set[string], error) {
+// When no pod is given, the gateway IPs of the policies targeting any pod of the namespace are returned.
+func (m *externalPolicyManager) getStaticGatewayIPsForTarget(namespaceName string, pod *v1.Pod) (sets.Set[string], error) {
 	policyGWIPs := sets.New[string]()
 
 	routePolicies, err := m.routeLister.List(labels.Everything())
@@ -800,24 +852,37 @@ func (m *externalPolicyManager) getStaticGatewayIPsForTargetNamespace(namespaceN
 		return nil, err
 	}
 	for _, routePolicy := range routePolicies {
-		targetNamespaces, err := m.listNamespacesBySelector(&routePolicy.Spec.From.NamespaceSelector)
+		isTarget, err := m.isPolicyTarget(routePolicy, namespaceName, pod)
 		if err != nil {
 			klog.Errorf("Failed to process Admin Policy Based External Route %s: %v", routePolicy.Name, err)
 			return nil, err
 		}
-		for _, targetNS := range targetNamespaces {
-			if targetNS.Name == namespaceName {
-				// only collect the static gateways
-				staticGWInfo, err := m.processStaticHopsGatewayInformation(routePolicy.Spec.NextHops.StaticHops)
-				if err != nil {
-					klog.Errorf("Failed to process Admin Policy Based External Route %s: %v", routePolicy.Name, err)
-					return nil, err
-				}
-				for _, gwInfo := range staticGWInfo.Elems() {
-					insertSet(policyGWIPs, gwInfo.Gateways)
-				}
-			}
+		if !isTarget {
+			continue
+		}
+		// only collect the static gateways
+		staticGWInfo, err := m.processStaticHopsGatewayInformation(routePolicy.Spec.NextHops.StaticHops)
+		if err != nil {
+			klog.Errorf("Failed to process Admin Policy Based External Route %s: %v", routePolicy.Name, err)
+			return nil, err
+		}
+		for _, gwInfo := range staticGWInfo.Elems() {
+			insertSet(policyGWIPs, gwInfo.Gateways)
 		}
 	}
 	return policyGWIPs, nil
 }
+
+// getGatewayIPsForTargetPod returns the static and dynamic gateway IPs of the
+// policies targeting the given pod.
+func (m *externalPolicyManager) getGatewayIPsForTargetPod(pod *v1.Pod) (sets.Set[string], error) {
+	gwIPs, err := m.getDynamicGatewayIPsForTarget(pod.Namespace, pod)
+	if err != nil {
+		return nil, err
+	}
+	staticGWIPs, err := m.getStaticGatewayIPsForTarget(pod.Namespace, pod)
+	if err != nil {
+		return nil, err
+	}
+	return gwIPs.Union(staticGWIPs), nil
+}
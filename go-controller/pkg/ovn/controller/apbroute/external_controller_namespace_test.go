@@ -126,6 +126,7 @@ func expectedPolicyStateAndRefs(targetNamespaces []*namespaceWithPods, staticGWI
 	}
 
 	targetNamespaceNames := sets.Set[string]{}
+	targetPodNames := sets.Set[ktypes.NamespacedName]{}
 	for _, targetNS := range targetNamespaces {
 		nsState := map[ktypes.NamespacedName]*podInfo{}
 		for _, pod := range targetNS.pods {
@@ -134,6 +135,7 @@ func expectedPolicyStateAndRefs(targetNamespaces []*namespaceWithPods, staticGWI
 				dynamicGWs,
 			}
 			nsState[getPodNamespacedName(pod)] = podInfo
+			targetPodNames.Insert(getPodNamespacedName(pod))
 		}
 		routeState.targetNamespaces[targetNS.nsName] = nsState
 		targetNamespaceNames.Insert(targetNS.nsName)
@@ -141,6 +143,7 @@ func expectedPolicyStateAndRefs(targetNamespaces []*namespaceWithPods, staticGWI
 
 	return routeState, &policyReferencedObjects{
 		targetNamespaces:    targetNamespaceNames,
+		targetPods:          targetPodNames,
 		dynamicGWNamespaces: dynamicGWNamespaces,
 		dynamicGWPods:       dynamicGWPods,
 	}
@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
@@ -447,6 +448,77 @@ var _ = Describe("OVN External Gateway pod", func() {
 			eventuallyExpectConfig(dynamicPolicyDiffTargetNSAndPodSel.Name, expectedPolicy2, expectedRefs2)
 		})
 
+		It("updates a target pod to match and then to no longer match the policy pod selector", func() {
+			targetPodMatch := map[string]string{"cnf": "true"}
+			targetPodSelPolicy := newPolicy(
+				"dynamic",
+				&v1.LabelSelector{MatchLabels: targetNamespace2Match},
+				nil,
+				&v1.LabelSelector{MatchLabels: gatewayNamespaceMatch},
+				&v1.LabelSelector{MatchLabels: map[string]string{"key": "pod"}},
+				false,
+			)
+			targetPodSelPolicy.Spec.From.PodSelector = v1.LabelSelector{MatchLabels: targetPodMatch}
+
+			initController([]runtime.Object{namespaceGW, namespaceTarget2, targetPod2, pod1},
+				[]runtime.Object{targetPodSelPolicy})
+
+			expectedPolicy, expectedRefs := expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{{nsName: targetNamespaceName2}},
+				nil,
+				[]*namespaceWithPods{namespaceGWWithPod}, false)
+
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectConfig(targetPodSelPolicy.Name, expectedPolicy, expectedRefs)
+
+			By("updating the target pod labels to match the policy pod selector")
+			updatePodLabels(targetPod2, targetPodMatch, fakeClient)
+			expectedPolicy, expectedRefs = expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{namespaceTarget2WithPod},
+				nil,
+				[]*namespaceWithPods{namespaceGWWithPod}, false)
+
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectConfig(targetPodSelPolicy.Name, expectedPolicy, expectedRefs)
+
+			By("updating the target pod labels to no longer match the policy pod selector")
+			updatePodLabels(targetPod2, map[string]string{}, fakeClient)
+			expectedPolicy, expectedRefs = expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{{nsName: targetNamespaceName2}},
+				nil,
+				[]*namespaceWithPods{namespaceGWWithPod}, false)
+
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectConfig(targetPodSelPolicy.Name, expectedPolicy, expectedRefs)
+		})
+
+		It("returns the gateway IPs of the policy only for the pods matching its pod selector", func() {
+			targetPodMatch := map[string]string{"cnf": "true"}
+			targetPodSelPolicy := newPolicy(
+				"dynamic",
+				&v1.LabelSelector{MatchLabels: targetNamespace2Match},
+				sets.New("10.10.10.1"),
+				&v1.LabelSelector{MatchLabels: gatewayNamespaceMatch},
+				&v1.LabelSelector{MatchLabels: map[string]string{"key": "pod"}},
+				false,
+			)
+			targetPodSelPolicy.Spec.From.PodSelector = v1.LabelSelector{MatchLabels: targetPodMatch}
+			selectedPod := newPod("pod_selected", namespaceTarget2.Name, "192.169.10.3", targetPodMatch)
+
+			initController([]runtime.Object{namespaceGW, namespaceTarget2, targetPod2, selectedPod, pod1},
+				[]runtime.Object{targetPodSelPolicy})
+			eventuallyExpectNumberOfPolicies(1)
+
+			Eventually(func() (sets.Set[string], error) {
+				return externalController.GetGatewayIPsForTargetPod(selectedPod)
+			}).Should(Equal(sets.New("10.10.10.1", "192.168.10.1")))
+			gwIPs, err := externalController.GetGatewayIPsForTargetPod(targetPod2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gwIPs.UnsortedList()).To(BeEmpty())
+			gwIPs, err = externalController.GetAdminPolicyBasedExternalRouteIPsForTargetNamespace(namespaceTarget2.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gwIPs).To(Equal(sets.New("10.10.10.1", "192.168.10.1")))
+		})
 	})
 })
 
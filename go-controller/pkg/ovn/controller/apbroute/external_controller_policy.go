@@ -53,6 +53,12 @@ func (m *externalPolicyManager) syncRoutePolicy(policyName string) (sets.Set[str
 			if err != nil {
 				return fmt.Errorf("failed to build updated policy: %w", err)
 			}
+			// only keep the gateways that should be used according to their priority
+			updatedPolicy.staticGateways, updatedPolicy.dynamicGateways, err = m.netClient.selectActiveGateways(
+				updatedPolicy.staticGateways, updatedPolicy.dynamicGateways)
+			if err != nil {
+				return fmt.Errorf("failed to select active gateways: %w", err)
+			}
 		}
 
 		// get existing policy and update routePolicySyncCache
@@ -330,7 +336,7 @@ func (m *externalPolicyManager) processStaticHopsGatewayInformation(hops []*admi
 		if ip == nil {
 			return nil, fmt.Errorf("could not parse routing static gw annotation value '%s'", h.IP)
 		}
-		gwList.InsertOverwrite(gateway_info.NewGatewayInfo(sets.New(ip.String()), h.BFDEnabled))
+		gwList.InsertOverwrite(gateway_info.NewWeightedGatewayInfo(sets.New(ip.String()), h.BFDEnabled, int(h.Weight), int(h.Priority)))
 	}
 	return gwList, nil
 }
@@ -370,7 +376,7 @@ func (m *externalPolicyManager) processDynamicHopsGatewayInformation(hops []*adm
 					continue
 				}
 				key := ktypes.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
-				podsInfo.InsertOverwrite(gateway_info.NewGatewayInfo(foundGws, h.BFDEnabled))
+				podsInfo.InsertOverwrite(gateway_info.NewWeightedGatewayInfo(foundGws, h.BFDEnabled, int(h.Weight), int(h.Priority)))
 				selectedPods.Insert(key)
 			}
 			selectedNamespaces.Insert(gwNamespace.Name)
@@ -409,13 +415,19 @@ func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *admin
 		return nil, fmt.Errorf("failed to list target namespaces: %w", err)
 	}
 
+	targetPodSel, err := metav1.LabelSelectorAsSelector(&policy.Spec.From.PodSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert target pod selector: %w", err)
+	}
+
 	targetNsNames := sets.Set[string]{}
+	targetPodNames := sets.Set[ktypes.NamespacedName]{}
 	targetNamespaces := map[string]map[ktypes.NamespacedName]*v1.Pod{}
 	for _, ns := range targetNs {
 		targetNsNames.Insert(ns.Name)
-		targetPods, err := m.podLister.Pods(ns.Name).List(labels.Everything())
+		targetPods, err := m.podLister.Pods(ns.Name).List(targetPodSel)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get all ns %s pods: %v", ns.Name, err)
+			return nil, fmt.Errorf("failed to get ns %s pods: %v", ns.Name, err)
 		}
 		podsMap := map[ktypes.NamespacedName]*v1.Pod{}
 		for _, pod := range targetPods {
@@ -424,6 +436,7 @@ func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *admin
 				continue
 			}
 			podsMap[getPodNamespacedName(pod)] = pod
+			targetPodNames.Insert(getPodNamespacedName(pod))
 		}
 		targetNamespaces[ns.Name] = podsMap
 	}
@@ -441,6 +454,7 @@ func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *admin
 	if updateRefs {
 		refObjs := &policyReferencedObjects{
 			targetNamespaces:    targetNsNames,
+			targetPods:          targetPodNames,
 			dynamicGWNamespaces: gwNamespaces,
 			dynamicGWPods:       gwPods,
 		}
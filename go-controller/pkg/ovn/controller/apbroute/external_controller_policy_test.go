@@ -16,6 +16,7 @@ import (
 	adminpolicybasedrouteclient "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned/fake"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
@@ -803,8 +804,148 @@ var _ = Describe("OVN External Gateway policy", func() {
 			eventuallyExpectConfig(policyName, expectedPolicy, expectedRefs)
 		})
 	})
+
+	var _ = Context("when using weighted and prioritized hops", func() {
+
+		It("creates a static route for every unit of weight of a hop", func() {
+			weightedPolicy := newPolicy(
+				"weighted",
+				&v1.LabelSelector{MatchLabels: targetNamespace1Match},
+				sets.New("10.10.10.1", "10.10.10.2"),
+				nil,
+				nil,
+				false,
+			)
+			setHopWeightAndPriority(weightedPolicy, "10.10.10.1", 3, 0)
+
+			initController([]runtime.Object{namespaceGW, namespaceTarget, targetPod1}, []runtime.Object{weightedPolicy})
+
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectNumberOfRoutes("10.10.10.1", 3)
+			eventuallyExpectNumberOfRoutes("10.10.10.2", 1)
+
+			By("decreasing the weight of the hop")
+			p, err := fakeRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Get(context.Background(), weightedPolicy.Name, v1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			setHopWeightAndPriority(p, "10.10.10.1", 2, 0)
+			p.Generation++
+			_, err = fakeRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Update(context.Background(), p, v1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			eventuallyExpectNumberOfRoutes("10.10.10.1", 2)
+			eventuallyExpectNumberOfRoutes("10.10.10.2", 1)
+		})
+
+		It("only uses hops with a lower priority when BFD reports the hops with a higher priority as down", func() {
+			prioritizedPolicy := newPolicy(
+				"prioritized",
+				&v1.LabelSelector{MatchLabels: targetNamespace1Match},
+				sets.New("10.10.10.1", "10.10.10.2"),
+				nil,
+				nil,
+				true,
+			)
+			setHopWeightAndPriority(prioritizedPolicy, "10.10.10.1", 1, 10)
+
+			initController([]runtime.Object{namespaceGW, namespaceTarget, targetPod1}, []runtime.Object{prioritizedPolicy})
+
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectNumberOfRoutes("10.10.10.1", 1)
+			eventuallyExpectNumberOfRoutes("10.10.10.2", 0)
+
+			By("reporting the BFD session of the hop with the higher priority as down")
+			setBFDStatus("10.10.10.1", nbdb.BFDStatusDown)
+			eventuallyExpectNumberOfRoutes("10.10.10.1", 1)
+			eventuallyExpectNumberOfRoutes("10.10.10.2", 1)
+
+			By("reporting the BFD session of the hop with the higher priority as up")
+			setBFDStatus("10.10.10.1", nbdb.BFDStatusUp)
+			eventuallyExpectNumberOfRoutes("10.10.10.1", 1)
+			eventuallyExpectNumberOfRoutes("10.10.10.2", 0)
+		})
+
+		It("ignores the BFD sessions of other features with the same IP as a hop", func() {
+			prioritizedPolicy := newPolicy(
+				"prioritized",
+				&v1.LabelSelector{MatchLabels: targetNamespace1Match},
+				sets.New("10.10.10.1", "10.10.10.2"),
+				nil,
+				nil,
+				true,
+			)
+			setHopWeightAndPriority(prioritizedPolicy, "10.10.10.1", 1, 10)
+
+			initController([]runtime.Object{namespaceGW, namespaceTarget, targetPod1}, []runtime.Object{prioritizedPolicy})
+
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectNumberOfRoutes("10.10.10.1", 1)
+			eventuallyExpectNumberOfRoutes("10.10.10.2", 0)
+
+			By("adding an up BFD session of another feature with the IP of the hop with the higher priority")
+			up := nbdb.BFDStatusUp
+			otherBFD := &nbdb.BFD{
+				LogicalPort: types.RouterToTransitSwitchPrefix + "node1",
+				DstIP:       "10.10.10.1",
+				Status:      &up,
+			}
+			ops, err := libovsdbops.CreateOrUpdateBFDOps(nbClient, nil, otherBFD)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = libovsdbops.TransactAndCheck(nbClient, ops)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("reporting the BFD session of the hop with the higher priority as down")
+			setBFDStatus("10.10.10.1", nbdb.BFDStatusDown)
+			eventuallyExpectNumberOfRoutes("10.10.10.1", 1)
+			eventuallyExpectNumberOfRoutes("10.10.10.2", 1)
+
+			By("reporting the BFD session of the other feature as down")
+			down := nbdb.BFDStatusDown
+			otherBFD.Status = &down
+			ops, err = nbClient.Where(otherBFD).Update(otherBFD, &otherBFD.Status)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = libovsdbops.TransactAndCheck(nbClient, ops)
+			Expect(err).NotTo(HaveOccurred())
+			setBFDStatus("10.10.10.1", nbdb.BFDStatusUp)
+			eventuallyExpectNumberOfRoutes("10.10.10.1", 1)
+			eventuallyExpectNumberOfRoutes("10.10.10.2", 0)
+		})
+	})
 })
 
+func setHopWeightAndPriority(policy *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute, ip string, weight, priority int32) {
+	for _, hop := range policy.Spec.NextHops.StaticHops {
+		if hop.IP == ip {
+			hop.Weight = weight
+			hop.Priority = priority
+		}
+	}
+}
+
+func eventuallyExpectNumberOfRoutes(nextHop string, n int) {
+	Eventually(func() []*nbdb.LogicalRouterStaticRoute {
+		routes, err := libovsdbops.FindLogicalRouterStaticRoutesWithPredicate(nbClient, func(item *nbdb.LogicalRouterStaticRoute) bool {
+			return item.Nexthop == nextHop
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return routes
+	}, 5).Should(HaveLen(n))
+}
+
+func setBFDStatus(dstIP string, status nbdb.BFDStatus) {
+	bfds, err := libovsdbops.FindBFDsWithPredicate(nbClient, func(item *nbdb.BFD) bool {
+		return item.DstIP == dstIP && isExternalGatewayPort(item.LogicalPort)
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(bfds).NotTo(BeEmpty())
+	for _, bfd := range bfds {
+		bfd.Status = &status
+		ops, err := nbClient.Where(bfd).Update(bfd, &bfd.Status)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = libovsdbops.TransactAndCheck(nbClient, ops)
+		Expect(err).NotTo(HaveOccurred())
+	}
+}
+
 func eventuallyCheckAPBRouteStatus(policyName string, expectFailure bool) {
 	Eventually(func() bool {
 		pol, err := fakeRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Get(context.TODO(), policyName, v1.GetOptions{})
@@ -147,23 +147,97 @@ func (g *GatewayInfoList) Equal(g2 *GatewayInfoList) bool {
 	return true
 }
 
+// SelectByPriority filters the given lists and returns the gateways that should be used as next hops, in the same
+// order as the lists were given. The gateways with the highest priority are always used, and the gateways with a
+// lower priority are only used when isDown reports all the gateways with a higher priority as down.
+func SelectByPriority(isDown func(gw *GatewayInfo) (bool, error), lists ...*GatewayInfoList) ([]*GatewayInfoList, error) {
+	priorities := sets.New[int]()
+	for _, list := range lists {
+		for _, gw := range list.elems {
+			priorities.Insert(gw.Priority)
+		}
+	}
+	if priorities.Len() <= 1 {
+		return lists, nil
+	}
+
+	sortedPriorities := sets.List(priorities)
+	// start with the highest priority and include the next one for as long as all the gateways are down
+	minPriority := sortedPriorities[0]
+	for i := len(sortedPriorities) - 1; i >= 0; i-- {
+		allDown := true
+		for _, list := range lists {
+			for _, gw := range list.elems {
+				if gw.Priority != sortedPriorities[i] {
+					continue
+				}
+				down, err := isDown(gw)
+				if err != nil {
+					return nil, err
+				}
+				if !down {
+					allDown = false
+					break
+				}
+			}
+			if !allDown {
+				break
+			}
+		}
+		if !allDown {
+			minPriority = sortedPriorities[i]
+			break
+		}
+	}
+
+	selected := make([]*GatewayInfoList, 0, len(lists))
+	for _, list := range lists {
+		selectedList := NewGatewayInfoList()
+		for _, gw := range list.elems {
+			if gw.Priority >= minPriority {
+				selectedList.elems = append(selectedList.elems, gw)
+			}
+		}
+		selected = append(selected, selectedList)
+	}
+	return selected, nil
+}
+
 type GatewayInfo struct {
-	Gateways      sets.Set[string]
-	BFDEnabled    bool
+	Gateways   sets.Set[string]
+	BFDEnabled bool
+	// Weight is the share of the traffic sent through the gateways relative to other gateways with the same Priority
+	Weight int
+	// Priority of the gateways, gateways with a lower priority are only used when all the gateways with a higher
+	// priority are down
+	Priority      int
 	failedToApply bool
 }
 
 func (g *GatewayInfo) String() string {
-	return fmt.Sprintf("BFDEnabled: %t, Gateways: %+v, failedToApply: %t", g.BFDEnabled, g.Gateways, g.failedToApply)
+	return fmt.Sprintf("BFDEnabled: %t, Weight: %d, Priority: %d, Gateways: %+v, failedToApply: %t", g.BFDEnabled,
+		g.Weight, g.Priority, g.Gateways, g.failedToApply)
 }
 
 func NewGatewayInfo(items sets.Set[string], bfdEnabled bool) *GatewayInfo {
-	return &GatewayInfo{Gateways: items, BFDEnabled: bfdEnabled}
+	return &GatewayInfo{Gateways: items, BFDEnabled: bfdEnabled, Weight: 1}
+}
+
+// NewWeightedGatewayInfo returns a GatewayInfo with the given weight and priority. Weights lower than 1 are
+// considered as 1.
+func NewWeightedGatewayInfo(items sets.Set[string], bfdEnabled bool, weight, priority int) *GatewayInfo {
+	gw := NewGatewayInfo(items, bfdEnabled)
+	if weight > 1 {
+		gw.Weight = weight
+	}
+	gw.Priority = priority
+	return gw
 }
 
 // SameSpec compares GatewayInfo fields, excluding applied
 func (g *GatewayInfo) SameSpec(g2 *GatewayInfo) bool {
-	return g.BFDEnabled == g2.BFDEnabled && g.Gateways.Equal(g2.Gateways)
+	return g.BFDEnabled == g2.BFDEnabled && g.Weight == g2.Weight && g.Priority == g2.Priority &&
+		g.Gateways.Equal(g2.Gateways)
 }
 
 func (g *GatewayInfo) RemoveIPs(g2 *GatewayInfo) {
@@ -172,7 +246,7 @@ func (g *GatewayInfo) RemoveIPs(g2 *GatewayInfo) {
 
 // Equal compares all GatewayInfo fields, including BFDEnabled and applied
 func (g *GatewayInfo) Equal(g2 *GatewayInfo) bool {
-	return g.BFDEnabled == g2.BFDEnabled && g.Gateways.Equal(g2.Gateways) && g.failedToApply == g2.failedToApply
+	return g.SameSpec(g2) && g.failedToApply == g2.failedToApply
 }
 
 func (g *GatewayInfo) Has(ip string) bool {
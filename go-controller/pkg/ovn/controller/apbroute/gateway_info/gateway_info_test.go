@@ -102,4 +102,58 @@ var _ = Describe("GatewayInfoList", func() {
 			Expect(s1.Equal(NewGatewayInfoList())).To(BeTrue())
 		})
 	})
+
+	var _ = Context("Selecting by priority", func() {
+		downGWs := sets.New("1.1.1.1", "1.1.1.2")
+		isDown := func(gw *GatewayInfo) (bool, error) {
+			return downGWs.IsSuperset(gw.Gateways), nil
+		}
+
+		It("returns all the gateways when they have the same priority", func() {
+			static := NewGatewayInfoList(NewWeightedGatewayInfo(sets.New("1.1.1.1"), true, 2, 0))
+			dynamic := NewGatewayInfoList(NewWeightedGatewayInfo(sets.New("2.2.2.2"), true, 1, 0))
+			selected, err := SelectByPriority(isDown, static, dynamic)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selected).To(HaveLen(2))
+			Expect(selected[0].Equal(static)).To(BeTrue())
+			Expect(selected[1].Equal(dynamic)).To(BeTrue())
+		})
+
+		It("returns only the gateways with the highest priority when any of them is up", func() {
+			static := NewGatewayInfoList(
+				NewWeightedGatewayInfo(sets.New("1.1.1.1"), true, 1, 10),
+				NewWeightedGatewayInfo(sets.New("1.1.1.3"), true, 1, 0))
+			dynamic := NewGatewayInfoList(NewWeightedGatewayInfo(sets.New("2.2.2.2"), true, 1, 10))
+			selected, err := SelectByPriority(isDown, static, dynamic)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selected).To(HaveLen(2))
+			Expect(selected[0].Equal(NewGatewayInfoList(NewWeightedGatewayInfo(sets.New("1.1.1.1"), true, 1, 10)))).To(BeTrue())
+			Expect(selected[1].Equal(dynamic)).To(BeTrue())
+		})
+
+		It("includes the gateways with lower priorities when all the gateways with higher priorities are down", func() {
+			static := NewGatewayInfoList(
+				NewWeightedGatewayInfo(sets.New("1.1.1.1"), true, 1, 20),
+				NewWeightedGatewayInfo(sets.New("1.1.1.2"), true, 1, 10),
+				NewWeightedGatewayInfo(sets.New("1.1.1.3"), true, 1, 5),
+				NewWeightedGatewayInfo(sets.New("1.1.1.4"), true, 1, 0))
+			selected, err := SelectByPriority(isDown, static)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selected).To(HaveLen(1))
+			Expect(selected[0].Equal(NewGatewayInfoList(
+				NewWeightedGatewayInfo(sets.New("1.1.1.1"), true, 1, 20),
+				NewWeightedGatewayInfo(sets.New("1.1.1.2"), true, 1, 10),
+				NewWeightedGatewayInfo(sets.New("1.1.1.3"), true, 1, 5)))).To(BeTrue())
+		})
+
+		It("returns all the gateways when all of them are down", func() {
+			static := NewGatewayInfoList(
+				NewWeightedGatewayInfo(sets.New("1.1.1.1"), true, 1, 20),
+				NewWeightedGatewayInfo(sets.New("1.1.1.2"), true, 1, 10))
+			selected, err := SelectByPriority(isDown, static)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selected).To(HaveLen(1))
+			Expect(selected[0].Equal(static)).To(BeTrue())
+		})
+	})
 })
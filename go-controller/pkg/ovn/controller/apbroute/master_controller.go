@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"reflect"
 	"strings"
 	"sync"
 
+	"github.com/ovn-org/libovsdb/cache"
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -20,6 +24,7 @@ import (
 	adminpolicybasedrouteclient "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned"
 	adminpolicybasedrouteinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/informers/externalversions/adminpolicybasedroute/v1"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -86,15 +91,30 @@ func NewExternalMasterController(
 func (c *ExternalGatewayMasterController) Run(wg *sync.WaitGroup, threadiness int) error {
 	klog.V(4).Info("Starting Admin Policy Based Route Controller")
 
+	// the gateways used by policies with prioritized hops depend on the status of their BFD sessions
+	c.nbClient.nbClient.Cache().AddEventHandler(&cache.EventHandlerFuncs{
+		UpdateFunc: func(table string, old, new model.Model) {
+			if table != nbdb.BFDTable {
+				return
+			}
+			oldBFD := old.(*nbdb.BFD)
+			newBFD := new.(*nbdb.BFD)
+			if reflect.DeepEqual(oldBFD.Status, newBFD.Status) {
+				return
+			}
+			c.mgr.onBFDStatusUpdate()
+		},
+	})
+
 	return c.mgr.Run(wg, threadiness)
 }
 
 func (c *ExternalGatewayMasterController) GetAdminPolicyBasedExternalRouteIPsForTargetNamespace(namespaceName string) (sets.Set[string], error) {
-	gwIPs, err := c.mgr.getDynamicGatewayIPsForTargetNamespace(namespaceName)
+	gwIPs, err := c.mgr.getDynamicGatewayIPsForTarget(namespaceName, nil)
 	if err != nil {
 		return nil, err
 	}
-	tmpIPs, err := c.mgr.getStaticGatewayIPsForTargetNamespace(namespaceName)
+	tmpIPs, err := c.mgr.getStaticGatewayIPsForTarget(namespaceName, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -153,12 +173,10 @@ func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyNam
 	return nil
 }
 
-func (c *ExternalGatewayMasterController) GetDynamicGatewayIPsForTargetNamespace(namespaceName string) (sets.Set[string], error) {
-	return c.mgr.getDynamicGatewayIPsForTargetNamespace(namespaceName)
-}
-
-func (c *ExternalGatewayMasterController) GetStaticGatewayIPsForTargetNamespace(namespaceName string) (sets.Set[string], error) {
-	return c.mgr.getStaticGatewayIPsForTargetNamespace(namespaceName)
+// GetGatewayIPsForTargetPod returns the gateway IPs of the policies
+// targeting the given pod
+func (c *ExternalGatewayMasterController) GetGatewayIPsForTargetPod(pod *v1.Pod) (sets.Set[string], error) {
+	return c.mgr.getGatewayIPsForTargetPod(pod)
 }
 
 // AddHybridRoutePolicyForPod exposes the function addHybridRoutePolicyForPod
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strconv"
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
@@ -27,9 +28,15 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
+// weightIndexExternalID identifies the static routes created for the same pod IP and gateway to implement weights.
+const weightIndexExternalID = types.OvnK8sPrefix + "/weight-index"
+
 type networkClient interface {
 	deleteGatewayIPs(podNsName ktypes.NamespacedName, toBeDeletedGWIPs, toBeKept sets.Set[string]) error
 	addGatewayIPs(pod *v1.Pod, egress *gateway_info.GatewayInfoList) (bool, error)
+	// selectActiveGateways returns the static and dynamic gateways that should be used as next hops according to
+	// their priority.
+	selectActiveGateways(staticGWs, dynamicGWs *gateway_info.GatewayInfoList) (*gateway_info.GatewayInfoList, *gateway_info.GatewayInfoList, error)
 }
 
 type northBoundClient struct {
@@ -269,7 +276,7 @@ func (nb *northBoundClient) addGWRoutesForPod(gateways []*gateway_info.GatewayIn
 						continue
 					}
 					mask := util.GetIPFullMaskString(podIP)
-					if err := nb.createOrUpdateBFDStaticRoute(gateway.BFDEnabled, gw, podIP, gr, port, mask); err != nil {
+					if err := nb.createOrUpdateBFDStaticRoute(gateway.BFDEnabled, gateway.Weight, gw, podIP, gr, port, mask); err != nil {
 						return err
 					}
 					if routeInfo.PodExternalRoutes[podIP] == nil {
@@ -368,19 +375,13 @@ func (nb *northBoundClient) addHybridRoutePolicyForPod(podIP net.IP, node string
 	return nil
 }
 
-func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, gw string, podIP, gr, port, mask string) error {
-	lrsr := nbdb.LogicalRouterStaticRoute{
-		Policy: &nbdb.LogicalRouterStaticRoutePolicySrcIP,
-		Options: map[string]string{
-			"ecmp_symmetric_reply": "true",
-		},
-		Nexthop:    gw,
-		IPPrefix:   podIP + mask,
-		OutputPort: &port,
-	}
-
+// createOrUpdateBFDStaticRoute creates or updates the static routes from the pod IP to the gateway. OVN doesn't support
+// weighted ECMP, so weights are implemented by creating one static route for every unit of weight, which OVN adds to
+// the ECMP group as separate members. Routes other than the first one are told apart by their weightIndexExternalID.
+func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, weight int, gw string, podIP, gr, port, mask string) error {
 	ops := []ovsdb.Operation{}
 	var err error
+	var bfdUUID *string
 	if bfdEnabled {
 		bfd := nbdb.BFD{
 			DstIP:       gw,
@@ -390,20 +391,51 @@ func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, gw str
 		if err != nil {
 			return fmt.Errorf("error creating or updating BFD %+v: %v", bfd, err)
 		}
-		lrsr.BFD = &bfd.UUID
+		bfdUUID = &bfd.UUID
+	}
+
+	weight = max(weight, 1)
+	for i := 0; i < weight; i++ {
+		lrsr := nbdb.LogicalRouterStaticRoute{
+			Policy: &nbdb.LogicalRouterStaticRoutePolicySrcIP,
+			Options: map[string]string{
+				"ecmp_symmetric_reply": "true",
+			},
+			Nexthop:    gw,
+			IPPrefix:   podIP + mask,
+			OutputPort: &port,
+			BFD:        bfdUUID,
+		}
+		if i > 0 {
+			lrsr.ExternalIDs = map[string]string{weightIndexExternalID: strconv.Itoa(i)}
+		}
+		weightIndex := i
+		p := func(item *nbdb.LogicalRouterStaticRoute) bool {
+			return item.IPPrefix == lrsr.IPPrefix &&
+				item.Nexthop == lrsr.Nexthop &&
+				item.OutputPort != nil &&
+				*item.OutputPort == *lrsr.OutputPort &&
+				item.Policy == lrsr.Policy &&
+				getWeightIndex(item) == weightIndex
+		}
+		ops, err = libovsdbops.CreateOrUpdateLogicalRouterStaticRoutesWithPredicateOps(nb.nbClient, ops, gr, &lrsr, p,
+			&lrsr.Options)
+		if err != nil {
+			return fmt.Errorf("error creating or updating static route %+v on router %s: %v", lrsr, gr, err)
+		}
 	}
 
+	// remove the routes left over from a higher weight
 	p := func(item *nbdb.LogicalRouterStaticRoute) bool {
-		return item.IPPrefix == lrsr.IPPrefix &&
-			item.Nexthop == lrsr.Nexthop &&
+		return item.IPPrefix == podIP+mask &&
+			item.Nexthop == gw &&
 			item.OutputPort != nil &&
-			*item.OutputPort == *lrsr.OutputPort &&
-			item.Policy == lrsr.Policy
+			*item.OutputPort == port &&
+			getWeightIndex(item) >= weight
 	}
-	ops, err = libovsdbops.CreateOrUpdateLogicalRouterStaticRoutesWithPredicateOps(nb.nbClient, ops, gr, &lrsr, p,
-		&lrsr.Options)
+	ops, err = libovsdbops.DeleteLogicalRouterStaticRoutesWithPredicateOps(nb.nbClient, ops, gr, p)
 	if err != nil {
-		return fmt.Errorf("error creating or updating static route %+v on router %s: %v", lrsr, gr, err)
+		return fmt.Errorf("error deleting static routes to %s for %s on router %s: %v", gw, podIP, gr, err)
 	}
 
 	_, err = libovsdbops.TransactAndCheck(nb.nbClient, ops)
@@ -414,7 +446,8 @@ func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, gw str
 	return nil
 }
 
-func (nb *northBoundClient) updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP, gwIP, nodeName, gr string, bfdEnabled bool, namespacedName ktypes.NamespacedName) error {
+func (nb *northBoundClient) updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP, gwIP, nodeName, gr string, bfdEnabled bool, weight int,
+	namespacedName ktypes.NamespacedName) error {
 	return nb.externalGatewayRouteInfo.CreateOrLoad(namespacedName, func(routeInfo *RouteInfo) error {
 		// if route was already programmed, skip it
 		if foundGR, ok := routeInfo.PodExternalRoutes[podIP][gwIP]; ok && foundGR == gr {
@@ -430,7 +463,7 @@ func (nb *northBoundClient) updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP
 		if bfdEnabled {
 			port := portPrefix + types.GWRouterToExtSwitchPrefix + gr
 			// update the BFD static route just in case it has changed
-			if err := nb.createOrUpdateBFDStaticRoute(bfdEnabled, gwIP, podIP, gr, port, mask); err != nil {
+			if err := nb.createOrUpdateBFDStaticRoute(bfdEnabled, weight, gwIP, podIP, gr, port, mask); err != nil {
 				return err
 			}
 		} else {
@@ -619,6 +652,50 @@ func (nb *northBoundClient) extSwitchPrefix(nodeName string) (string, error) {
 	return "", nil
 }
 
+// selectActiveGateways returns the gateways with the highest priority, and the gateways with a lower priority when BFD
+// reports all the gateways with a higher priority as down.
+func (nb *northBoundClient) selectActiveGateways(staticGWs, dynamicGWs *gateway_info.GatewayInfoList) (*gateway_info.GatewayInfoList,
+	*gateway_info.GatewayInfoList, error) {
+	selected, err := gateway_info.SelectByPriority(nb.isGatewayDown, staticGWs, dynamicGWs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return selected[0], selected[1], nil
+}
+
+// isGatewayDown returns true when the gateway has BFD enabled and all its BFD sessions are down. Gateways without BFD
+// sessions, e.g. because no routes were created for them yet, are not considered down. Only the BFD sessions on the
+// gateway router ports the external gateway routes go out of are considered, the sessions other features have with the
+// same IPs are ignored.
+func (nb *northBoundClient) isGatewayDown(gw *gateway_info.GatewayInfo) (bool, error) {
+	if !gw.BFDEnabled {
+		return false, nil
+	}
+	p := func(item *nbdb.BFD) bool {
+		return gw.Has(item.DstIP) && isExternalGatewayPort(item.LogicalPort)
+	}
+	bfds, err := libovsdbops.FindBFDsWithPredicate(nb.nbClient, p)
+	if err != nil {
+		return false, fmt.Errorf("failed to find BFD sessions for gateways %v: %w", sets.List(gw.Gateways), err)
+	}
+	if len(bfds) == 0 {
+		return false, nil
+	}
+	for _, bfd := range bfds {
+		if bfd.Status == nil || *bfd.Status != nbdb.BFDStatusDown {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// isExternalGatewayPort tells if the port is a gateway router port to the external switch, or to the external
+// switch of the second bridge, that the external gateway routes go out of.
+func isExternalGatewayPort(port string) bool {
+	port = strings.TrimPrefix(port, types.EgressGWSwitchPrefix)
+	return strings.HasPrefix(port, types.GWRouterToExtSwitchPrefix+types.GWRouterPrefix)
+}
+
 func (nb *northBoundClient) lookupBFDEntry(gatewayIP, gatewayRouter, prefix string) (*nbdb.BFD, error) {
 	portName := prefix + types.GWRouterToExtSwitchPrefix + gatewayRouter
 	bfd := nbdb.BFD{
@@ -657,6 +734,15 @@ func buildPodSNAT(extIPs, podIPNets []*net.IPNet) ([]*nbdb.NAT, error) {
 	return nats, nil
 }
 
+// getWeightIndex returns the index of a static route among the routes created for the same pod IP and gateway
+func getWeightIndex(lrsr *nbdb.LogicalRouterStaticRoute) int {
+	index, err := strconv.Atoi(lrsr.ExternalIDs[weightIndexExternalID])
+	if err != nil {
+		return 0
+	}
+	return index
+}
+
 func GetHybridRouteAddrSetDbIDs(nodeName, controller string) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetHybridNodeRoute, controller,
 		map[libovsdbops.ExternalIDKey]string{
@@ -677,3 +763,10 @@ func (c *conntrackClient) deleteGatewayIPs(podNsName ktypes.NamespacedName, _, t
 func (c *conntrackClient) addGatewayIPs(pod *v1.Pod, egress *gateway_info.GatewayInfoList) (bool, error) {
 	return true, nil
 }
+
+// selectActiveGateways returns all the gateways in the conntrack client, so that the conntrack entries of the gateways
+// with a lower priority are kept while they are in use.
+func (c *conntrackClient) selectActiveGateways(staticGWs, dynamicGWs *gateway_info.GatewayInfoList) (*gateway_info.GatewayInfoList,
+	*gateway_info.GatewayInfoList, error) {
+	return staticGWs, dynamicGWs, nil
+}
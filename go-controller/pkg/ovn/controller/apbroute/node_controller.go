@@ -46,11 +46,11 @@ func (c *ExternalGatewayNodeController) Run(wg *sync.WaitGroup, threadiness int)
 }
 
 func (c *ExternalGatewayNodeController) GetAdminPolicyBasedExternalRouteIPsForTargetNamespace(namespaceName string) (sets.Set[string], error) {
-	gwIPs, err := c.mgr.getDynamicGatewayIPsForTargetNamespace(namespaceName)
+	gwIPs, err := c.mgr.getDynamicGatewayIPsForTarget(namespaceName, nil)
 	if err != nil {
 		return nil, err
 	}
-	tmpIPs, err := c.mgr.getStaticGatewayIPsForTargetNamespace(namespaceName)
+	tmpIPs, err := c.mgr.getStaticGatewayIPsForTarget(namespaceName, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -264,13 +264,14 @@ func (c *ExternalGatewayMasterController) processOVNRoute(ovnRoute *ovnRoute, gw
 	// podIP exists, check if route matches
 	for _, gwInfo := range gwList.Elems() {
 		for clusterNextHop := range gwInfo.Gateways {
-			if ovnRoute.nextHop == clusterNextHop {
+			// routes beyond the gateway weight are stale
+			if ovnRoute.nextHop == clusterNextHop && ovnRoute.weightIndex < max(gwInfo.Weight, 1) {
 				// populate the externalGWInfo cache with this pair podIP->next Hop IP.
 				if noDbChanges {
 					return true
 				}
 				err := c.nbClient.updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP, ovnRoute.nextHop, managedIPGWInfo.nodeName,
-					util.GetGatewayRouterFromNode(managedIPGWInfo.nodeName), gwInfo.BFDEnabled, managedIPGWInfo.namespacedName)
+					util.GetGatewayRouterFromNode(managedIPGWInfo.nodeName), gwInfo.BFDEnabled, gwInfo.Weight, managedIPGWInfo.namespacedName)
 				if err == nil {
 					return true
 				}
@@ -306,7 +307,9 @@ func (c *ExternalGatewayMasterController) buildExternalIPGatewaysFromAnnotations
 				return nil, err
 			}
 			// set static gateway ips for all pods in the namespace
-			populateManagedGWIPsCacheForPods(gwInfo, clusterRouteCache, nsPodList)
+			if err := c.populateManagedGWIPsCacheForPods(gwInfo, clusterRouteCache, nsPodList); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -343,17 +346,30 @@ func (c *ExternalGatewayMasterController) buildExternalIPGatewaysFromAnnotations
 				return nil, err
 			}
 			// set dynamic gateway ips for all pods in the targetNamespaces
-			populateManagedGWIPsCacheForPods(gwInfo, clusterRouteCache, nsPodList)
+			if err := c.populateManagedGWIPsCacheForPods(gwInfo, clusterRouteCache, nsPodList); err != nil {
+				return nil, err
+			}
 		}
 	}
 	return clusterRouteCache, nil
 }
 
-func populateManagedGWIPsCacheForPods(gwInfo *gateway_info.GatewayInfo, cache map[string]*managedGWIPs, podList []*v1.Pod) {
-	for gwIP := range gwInfo.Gateways {
-		for _, pod := range podList {
-			// ignore completed pods, host networked pods, pods not scheduled
-			if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) || !util.PodScheduled(pod) {
+// populateManagedGWIPsCacheForPods sets the gateway IPs of an annotation for
+// the given pods, but the ones managed by the policies targeting each pod, as
+// the annotation logic leaves these to the policies.
+func (c *ExternalGatewayMasterController) populateManagedGWIPsCacheForPods(gwInfo *gateway_info.GatewayInfo,
+	cache map[string]*managedGWIPs, podList []*v1.Pod) error {
+	for _, pod := range podList {
+		// ignore completed pods, host networked pods, pods not scheduled
+		if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) || !util.PodScheduled(pod) {
+			continue
+		}
+		policyGWIPs, err := c.mgr.getGatewayIPsForTargetPod(pod)
+		if err != nil {
+			return fmt.Errorf("failed to get the policy gateway IPs of pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+		for gwIP := range gwInfo.Gateways {
+			if policyGWIPs.Has(gwIP) {
 				continue
 			}
 			for _, podIP := range pod.Status.PodIPs {
@@ -372,6 +388,7 @@ func populateManagedGWIPsCacheForPods(gwInfo *gateway_info.GatewayInfo, cache ma
 			}
 		}
 	}
+	return nil
 }
 
 // Build cache of routes in OVN
@@ -381,6 +398,7 @@ type ovnRoute struct {
 	uuid        string
 	router      string
 	outport     string
+	weightIndex int
 	shouldExist bool
 }
 
@@ -404,10 +422,11 @@ func (c *ExternalGatewayMasterController) buildOVNECMPCache() (map[string][]*ovn
 		}
 
 		route := &ovnRoute{
-			nextHop: logicalRouterStaticRoute.Nexthop,
-			uuid:    logicalRouterStaticRoute.UUID,
-			router:  logicalRouters[0].Name,
-			outport: *logicalRouterStaticRoute.OutputPort,
+			nextHop:     logicalRouterStaticRoute.Nexthop,
+			uuid:        logicalRouterStaticRoute.UUID,
+			router:      logicalRouters[0].Name,
+			outport:     *logicalRouterStaticRoute.OutputPort,
+			weightIndex: getWeightIndex(logicalRouterStaticRoute),
 		}
 		podIP, _, _ := net.ParseCIDR(logicalRouterStaticRoute.IPPrefix)
 		if _, ok := ovnRouteCache[podIP.String()]; !ok {
@@ -3,6 +3,7 @@ package egressservice
 import (
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -89,7 +90,7 @@ type Controller struct {
 }
 
 type svcState struct {
-	node string
+	nodes []string // the nodes hosting the service, used as nexthops of its logical router policies
 	// service endpoints that are hosted in the local zone (if IC is disabled, this holds all service endpoints)
 	v4LocalEndpoints sets.Set[string]
 	v6LocalEndpoints sets.Set[string]
@@ -333,19 +334,8 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		svcHost := es.Status.Host
-		if svcHost == "" || svcHost == ovntypes.EgressServiceNoSNATHost {
-			continue
-		}
-
-		node, found := allNodes[svcHost]
-		if !found {
-			klog.Errorf("Node %s not found: %v", svcHost, err)
-			continue
-		}
-
-		if !nodeIsReady(node) {
-			klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+		svcHosts := util.GetEgressServiceHosts(es)
+		if len(svcHosts) == 0 {
 			continue
 		}
 
@@ -361,14 +351,35 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		nodeState, ok := c.nodes[svcHost]
-		if !ok {
-			nodeState, err = c.nodeStateFor(svcHost)
-			if err != nil {
-				klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+		svcNodes := []string{}
+		for _, svcHost := range svcHosts {
+			node, found := allNodes[svcHost]
+			if !found {
+				klog.Errorf("Node %s not found for egress service %s", svcHost, key)
 				continue
 			}
+
+			if !nodeIsReady(node) {
+				klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+				continue
+			}
+
+			nodeState, ok := c.nodes[svcHost]
+			if !ok {
+				nodeState, err = c.nodeStateFor(svcHost)
+				if err != nil {
+					klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+					continue
+				}
+			}
+			c.nodes[svcHost] = nodeState
+			svcNodes = append(svcNodes, svcHost)
+		}
+
+		if len(svcNodes) == 0 {
+			continue
 		}
+
 		svcKeyToLocalV4Endpoints[key] = v4Local
 		svcKeyToLocalV6Endpoints[key] = v6Local
 		svcKeyToRemoteV4Endpoints[key] = v4Remote
@@ -376,13 +387,12 @@ func (c *Controller) repair() error {
 		svcKeyToLocalConfiguredV4Endpoints[key] = []string{}
 		svcKeyToLocalConfiguredV6Endpoints[key] = []string{}
 		svcState := &svcState{
-			node:              svcHost,
+			nodes:             svcNodes,
 			v4LocalEndpoints:  sets.New[string](),
 			v6LocalEndpoints:  sets.New[string](),
 			v4RemoteEndpoints: sets.New[string](),
 			v6RemoteEndpoints: sets.New[string](),
 		}
-		c.nodes[svcHost] = nodeState
 		c.services[key] = svcState
 	}
 
@@ -423,28 +433,18 @@ func (c *Controller) repair() error {
 			return true
 		}
 
-		if len(item.Nexthops) != 1 {
-			klog.Infof("Egress service repair will delete lrp for service %s because it has more than one nexthop: %v", svcKey, item)
+		nextHopsV4, nextHopsV6, _, _, err := c.nextHopsFor(svc.nodes)
+		if err != nil {
+			klog.Errorf("Failed to get the nexthops of service %s, deleting lrp: %v", svcKey, err)
 			return true
 		}
-
-		node := c.nodes[svc.node]
-		nextHopV4 := node.v4MgmtIP.String()
-		nextHopV6 := node.v6MgmtIP.String()
-		if config.OVNKubernetesFeature.EnableInterconnect {
-			svcNodeInLocalZone, zoneKnown := c.nodesZoneState[node.name]
-			if !zoneKnown {
-				klog.Errorf("Failed to verify whether the svc node: %s is in the local zone, deleting lrp", node.name)
-				return true
-			}
-			if !svcNodeInLocalZone {
-				nextHopV4 = node.transitIPV4.String()
-				nextHopV6 = node.transitIPV6.String()
-			}
+		nextHops := nextHopsV4
+		if !utilnet.IsIPv4String(logicalIP) {
+			nextHops = nextHopsV6
 		}
 
-		if item.Nexthops[0] != nextHopV4 && item.Nexthops[0] != nextHopV6 {
-			klog.Infof("Egress service repair will delete %s because it is uses a stale nexthop for service %s: %v", logicalIP, svcKey, item)
+		if !sets.New(item.Nexthops...).Equal(sets.New(nextHops...)) {
+			klog.Infof("Egress service repair will delete %s because it is uses stale nexthops for service %s: %v", logicalIP, svcKey, item)
 			return true
 		}
 
@@ -490,13 +490,12 @@ func (c *Controller) repair() error {
 				klog.Infof("Egress service repair continues with repairing service %s because it is valid: %v", svcKey, item)
 			}
 
-			node := c.nodes[svc.node]
-			svcNodeInLocalZone, zoneKnown := c.nodesZoneState[node.name]
-			if !zoneKnown {
-				klog.Errorf("Egress service repair failed to verify whether the svc node %s is in the local zone, deleting lrp", node.name)
+			_, _, remoteNextHopsV4, remoteNextHopsV6, err := c.nextHopsFor(svc.nodes)
+			if err != nil {
+				klog.Errorf("Egress service repair failed to get the nexthops of service %s, deleting lrp: %v", svcKey, err)
 				return true
 			}
-			if !svcNodeInLocalZone {
+			if len(remoteNextHopsV4)+len(remoteNextHopsV6) == 0 {
 				klog.Infof("Egress service repair will delete lrp for service %s because the service is no longer hosted in the local zone: %v", svcKey, item)
 				return true
 			}
@@ -513,13 +512,13 @@ func (c *Controller) repair() error {
 				return true
 			}
 
-			if len(item.Nexthops) != 1 {
-				klog.Infof("Egress service repair will delete lrp for service %s because it has more than one nexthop: %v", svcKey, item)
-				return true
+			remoteNextHops := remoteNextHopsV4
+			if !utilnet.IsIPv4String(logicalIP) {
+				remoteNextHops = remoteNextHopsV6
 			}
 
-			if item.Nexthops[0] != node.v4MgmtIP.String() && item.Nexthops[0] != node.v6MgmtIP.String() {
-				klog.Infof("Egress service repair will delete %s lrp because it is uses a stale nexthop for service %s: %v", logicalIP, svcKey, item)
+			if !sets.New(item.Nexthops...).Equal(sets.New(remoteNextHops...)) {
+				klog.Infof("Egress service repair will delete %s lrp because it is uses stale nexthops for service %s: %v", logicalIP, svcKey, item)
 				return true
 			}
 
@@ -731,36 +730,49 @@ func (c *Controller) syncEgressService(key string) error {
 		return c.clearServiceResourcesAndRequeue(key, state)
 	}
 
-	if state == nil {
-		// The service has a valid EgressService and wasn't configured before.
-		nodeName := es.Status.Host
-		newState := &svcState{
-			node:              nodeName,
-			v4LocalEndpoints:  sets.New[string](),
-			v6LocalEndpoints:  sets.New[string](),
-			v4RemoteEndpoints: sets.New[string](),
-			v6RemoteEndpoints: sets.New[string](),
-		}
-		c.services[key] = newState
-		if _, exists := c.nodes[nodeName]; !exists {
-			nodeState, err := c.nodeStateFor(nodeName)
+	// Only the hosts of the service which exist, are ready and are not draining
+	// can be used as nexthops for its endpoints.
+	nodes := []string{}
+	for _, nodeName := range util.GetEgressServiceHosts(es) {
+		node, exists := c.nodes[nodeName]
+		if !exists {
+			n, err := c.nodeLister.Get(nodeName)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			if n == nil || !nodeIsReady(n) {
+				klog.Warningf("EgressService %s/%s host %s does not exist or is not ready, skipping it", namespace, name, nodeName)
+				continue
+			}
+			node, err = c.nodeStateFor(nodeName)
 			if err != nil {
 				return err
 			}
-			c.nodes[nodeName] = nodeState
+			c.nodes[nodeName] = node
+		}
+		if node.draining {
+			continue
 		}
-		state = newState
+		nodes = append(nodes, nodeName)
 	}
 
-	if state.node != es.Status.Host {
-		klog.Errorf("EgressService %s/%s is configured for %s instead of %s, removing any existing configuration", namespace, name, state.node, es.Status.Host)
+	if len(nodes) == 0 {
+		if state == nil {
+			return nil
+		}
+		klog.Warningf("EgressService %s/%s is configured on non-existing or not ready nodes, removing", namespace, name)
 		return c.clearServiceResourcesAndRequeue(key, state)
 	}
 
-	node, ok := c.nodes[state.node]
-	if !ok || node.draining {
-		klog.Warningf("EgressService %s/%s is configured on non-existing or not ready node %s, removing", namespace, name, state.node)
-		return c.clearServiceResourcesAndRequeue(key, state)
+	if state == nil {
+		// The service has a valid EgressService and wasn't configured before.
+		state = &svcState{
+			v4LocalEndpoints:  sets.New[string](),
+			v6LocalEndpoints:  sets.New[string](),
+			v4RemoteEndpoints: sets.New[string](),
+			v6RemoteEndpoints: sets.New[string](),
+		}
+		c.services[key] = state
 	}
 
 	// At this point the states are valid and we should create the proper logical router policies and static routes.
@@ -768,7 +780,10 @@ func (c *Controller) syncEgressService(key string) error {
 	// to the known state:
 	// We need to create policies for endpoints that were fetched but not found in the cache,
 	// and delete the policies for those which are found in the cache but were not fetched.
+	// When the hosts of the service changed we update the policies of all of the endpoints
+	// with the new nexthops instead.
 	// We do it in one transaction, if it succeeds we update the cache to reflect the new state.
+	hostsChanged := !slices.Equal(state.nodes, nodes)
 
 	v4LocalToAdd := v4LocalEndpoints.Difference(state.v4LocalEndpoints).UnsortedList()
 	v6LocalToAdd := v6LocalEndpoints.Difference(state.v6LocalEndpoints).UnsortedList()
@@ -780,43 +795,43 @@ func (c *Controller) syncEgressService(key string) error {
 	v4RemoteToRemove := state.v4RemoteEndpoints.Difference(v4RemoteEndpoints).UnsortedList()
 	v6RemoteToRemove := state.v6RemoteEndpoints.Difference(v6RemoteEndpoints).UnsortedList()
 
+	if hostsChanged {
+		v4LocalToAdd = v4LocalEndpoints.UnsortedList()
+		v6LocalToAdd = v6LocalEndpoints.UnsortedList()
+		v4RemoteToAdd = v4RemoteEndpoints.UnsortedList()
+		v6RemoteToAdd = v6RemoteEndpoints.UnsortedList()
+	}
+
 	// v[4|6]LocalEndpoints represents endpoints local to the current zone.
 	// v[4|6]RemoteEndpoints represents endpoints remote to the current zone.
-	// If a service is hosted in the local zone:
-	//  - create LRPs for local endpoints with mgmt IP as a nextHop
-	//  - create LRSRs for remote endpoints with mgmt IP as a nextHop
-	// If a service is hosted in a remote zone:
-	//  - create LRPs for local endpoints with node router transit IP as a next hop as a nextHop
-	//  - do nothing for remote endpoints
+	// For each host of the service:
+	// If it is in the local zone:
+	//  - use its mgmt IP as a nextHop of the LRPs of the local endpoints
+	//  - use its mgmt IP as a nextHop of the LRPs of the remote endpoints
+	// If it is in a remote zone:
+	//  - use its node router transit IP as a nextHop of the LRPs of the local endpoints
+	// LRPs are created for the remote endpoints only if some of the hosts are in the local zone.
+	// When a service has multiple hosts the LRPs have multiple nextHops, spreading its
+	// traffic across them with ECMP.
 	// When IC is disabled v[4|6]RemoteEndpoints are empty,
-	// service is considered to be local and LRSRs are not modified.
-
-	nextHopV4 := node.v4MgmtIP.String()
-	nextHopV6 := node.v6MgmtIP.String()
-	svcNodeInLocalZone := true
-	if config.OVNKubernetesFeature.EnableInterconnect {
-		var zoneKnown bool
-		svcNodeInLocalZone, zoneKnown = c.nodesZoneState[node.name]
-		if !zoneKnown {
-			return fmt.Errorf("failed to verify whether the svc node %s is in the local zone", node.name)
-		}
-		if !svcNodeInLocalZone {
-			nextHopV4 = node.transitIPV4.String()
-			nextHopV6 = node.transitIPV6.String()
-		}
+	// the hosts are considered to be local and LRSRs are not modified.
+	nextHopsV4, nextHopsV6, remoteNextHopsV4, remoteNextHopsV6, err := c.nextHopsFor(nodes)
+	if err != nil {
+		return err
 	}
+	svcHostedInLocalZone := len(remoteNextHopsV4)+len(remoteNextHopsV6) > 0
 
 	allOps := []libovsdb.Operation{}
-	createOps, err := c.createOrUpdateLogicalRouterPoliciesOps(key, nextHopV4, nextHopV6, v4LocalToAdd, v6LocalToAdd)
+	createOps, err := c.createOrUpdateLogicalRouterPoliciesOps(key, nextHopsV4, nextHopsV6, v4LocalToAdd, v6LocalToAdd)
 	if err != nil {
 		return err
 	}
 	allOps = append(allOps, createOps...)
 
-	if config.OVNKubernetesFeature.EnableInterconnect && svcNodeInLocalZone && (len(v4RemoteToAdd)+len(v6RemoteToAdd)) > 0 {
+	if config.OVNKubernetesFeature.EnableInterconnect && svcHostedInLocalZone && (len(v4RemoteToAdd)+len(v6RemoteToAdd)) > 0 {
 		// when IC is disabled v[4|6]RemoteToRemove are empty and no ops are created
 		// with IC enabled, when service is hosted in the local zone, create logical router policies for remote endpoints
-		createOps, err = c.createOrUpdateLogicalRouterPoliciesOps(key+interconnectSuffix, node.v4MgmtIP.String(), node.v6MgmtIP.String(), v4RemoteToAdd, v6RemoteToAdd)
+		createOps, err = c.createOrUpdateLogicalRouterPoliciesOps(key+interconnectSuffix, remoteNextHopsV4, remoteNextHopsV6, v4RemoteToAdd, v6RemoteToAdd)
 		if err != nil {
 			return err
 		}
@@ -842,6 +857,12 @@ func (c *Controller) syncEgressService(key string) error {
 		// when IC is disabled v[4|6]RemoteToRemove are empty and no ops are created
 		// with IC enabled, it is safer to avoid checking whether the service is local
 		// as we want to remove the logical router policies configured for the specific remote pods.
+		if hostsChanged && !svcHostedInLocalZone {
+			// none of the hosts of the service is in the local zone anymore,
+			// the logical router policies of all of the remote pods are removed.
+			v4RemoteToRemove = append(v4RemoteToRemove, v4RemoteToAdd...)
+			v6RemoteToRemove = append(v6RemoteToRemove, v6RemoteToAdd...)
+		}
 		deleteOps, err = c.deleteLogicalRouterPoliciesOps(key+interconnectSuffix, v4RemoteToRemove, v6RemoteToRemove)
 		if err != nil {
 			return err
@@ -859,6 +880,7 @@ func (c *Controller) syncEgressService(key string) error {
 		return fmt.Errorf("failed to update router policies for %s, err: %v", key, err)
 	}
 
+	state.nodes = nodes
 	state.v4LocalEndpoints.Insert(v4LocalToAdd...)
 	state.v4LocalEndpoints.Delete(v4LocalToRemove...)
 	state.v6LocalEndpoints.Insert(v6LocalToAdd...)
@@ -903,3 +925,48 @@ func (c *Controller) clearServiceResourcesAndRequeue(key string, svcState *svcSt
 	c.egressServiceQueue.Add(key)
 	return nil
 }
+
+// Returns the nexthops of the logical router policies of a service hosted on the given nodes:
+// the nexthops of the policies of the endpoints local to the zone, and the nexthops of the
+// policies of the endpoints remote to the zone which are only the nodes in the local zone.
+// This should only be called with the controller locked.
+func (c *Controller) nextHopsFor(nodes []string) ([]string, []string, []string, []string, error) {
+	var nextHopsV4, nextHopsV6, remoteNextHopsV4, remoteNextHopsV6 []string
+	for _, nodeName := range nodes {
+		node, found := c.nodes[nodeName]
+		if !found {
+			return nil, nil, nil, nil, fmt.Errorf("node %s state not found", nodeName)
+		}
+
+		nodeInLocalZone := true
+		if config.OVNKubernetesFeature.EnableInterconnect {
+			var zoneKnown bool
+			nodeInLocalZone, zoneKnown = c.nodesZoneState[nodeName]
+			if !zoneKnown {
+				return nil, nil, nil, nil, fmt.Errorf("failed to verify whether the svc node %s is in the local zone", nodeName)
+			}
+		}
+
+		nextHopV4, nextHopV6 := node.v4MgmtIP, node.v6MgmtIP
+		if !nodeInLocalZone {
+			nextHopV4, nextHopV6 = node.transitIPV4, node.transitIPV6
+		}
+		if nextHopV4 != nil {
+			nextHopsV4 = append(nextHopsV4, nextHopV4.String())
+		}
+		if nextHopV6 != nil {
+			nextHopsV6 = append(nextHopsV6, nextHopV6.String())
+		}
+
+		if nodeInLocalZone && config.OVNKubernetesFeature.EnableInterconnect {
+			if node.v4MgmtIP != nil {
+				remoteNextHopsV4 = append(remoteNextHopsV4, node.v4MgmtIP.String())
+			}
+			if node.v6MgmtIP != nil {
+				remoteNextHopsV6 = append(remoteNextHopsV6, node.v6MgmtIP.String())
+			}
+		}
+	}
+
+	return nextHopsV4, nextHopsV6, remoteNextHopsV4, remoteNextHopsV6, nil
+}
@@ -3,6 +3,7 @@ package egressservice
 import (
 	"fmt"
 	"net"
+	"slices"
 	"sync"
 	"time"
 
@@ -139,12 +140,8 @@ func (c *Controller) syncNode(key string) error {
 			// We mark it as draining and remove all the service configurations made for it,
 			// Services can't be configured for a node while it is in draining status.
 			state.draining = true
-			for svcKey, svcState := range c.services {
-				if svcState.node == state.name {
-					if err := c.clearServiceResourcesAndRequeue(svcKey, svcState); err != nil {
-						return err
-					}
-				}
+			if err := c.clearNodeServicesAndRequeue(state.name); err != nil {
+				return err
 			}
 			delete(c.nodes, nodeName)
 		}
@@ -160,10 +157,20 @@ func (c *Controller) syncNode(key string) error {
 
 	// If the node is used by any service but is not in cache enqueue it
 	if state == nil {
-		for svcKey, svcState := range c.services {
-			if svcState.node == n.Name {
-				c.egressServiceQueue.Add(svcKey)
+		egressServices, err := c.egressServiceLister.List(labels.Everything())
+		if err != nil {
+			return err
+		}
+		for _, es := range egressServices {
+			if !slices.Contains(util.GetEgressServiceHosts(es), n.Name) {
+				continue
+			}
+			svcKey, err := cache.MetaNamespaceKeyFunc(es)
+			if err != nil {
+				klog.Errorf("Failed to read EgressService key: %v", err)
+				continue
 			}
+			c.egressServiceQueue.Add(svcKey)
 		}
 		return nil
 	}
@@ -172,12 +179,8 @@ func (c *Controller) syncNode(key string) error {
 		// The node hosting an egress service is draining and is not usable.
 		// We remove all the service configurations made for it,
 		// Services can't be configured for a node while it is in draining status.
-		for svcKey, svcState := range c.services {
-			if svcState.node == state.name {
-				if err := c.clearServiceResourcesAndRequeue(svcKey, svcState); err != nil {
-					return err
-				}
-			}
+		if err := c.clearNodeServicesAndRequeue(state.name); err != nil {
+			return err
 		}
 		delete(c.nodes, nodeName)
 	}
@@ -185,6 +188,26 @@ func (c *Controller) syncNode(key string) error {
 	return nil
 }
 
+// Removes the configuration made for the given node from the services it hosts.
+// The services hosted only by the node have all of their logical router policies removed,
+// the services which have other hosts are requeued to update their nexthops.
+// This should only be called with the controller locked.
+func (c *Controller) clearNodeServicesAndRequeue(nodeName string) error {
+	for svcKey, svcState := range c.services {
+		if !slices.Contains(svcState.nodes, nodeName) {
+			continue
+		}
+		if len(svcState.nodes) > 1 {
+			c.egressServiceQueue.Add(svcKey)
+			continue
+		}
+		if err := c.clearServiceResourcesAndRequeue(svcKey, svcState); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Returns if the given node is in "Ready" state.
 func nodeIsReady(n *corev1.Node) bool {
 	for _, condition := range n.Status.Conditions {
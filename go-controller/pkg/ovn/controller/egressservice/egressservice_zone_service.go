@@ -231,8 +231,8 @@ func (c *Controller) setPodIPsInAddressSet(addrSetIPs []string) error {
 }
 
 // Returns the libovsdb operations to create or updates the logical router policies for the service,
-// given its key, the nexthops (mgmt ips) and endpoints to add.
-func (c *Controller) createOrUpdateLogicalRouterPoliciesOps(key, v4MgmtIP, v6MgmtIP string, v4Endpoints, v6Endpoints []string) ([]libovsdb.Operation, error) {
+// given its key, the nexthops (mgmt or transit ips of its hosts) and endpoints to add.
+func (c *Controller) createOrUpdateLogicalRouterPoliciesOps(key string, v4NextHops, v6NextHops, v4Endpoints, v6Endpoints []string) ([]libovsdb.Operation, error) {
 	allOps := []libovsdb.Operation{}
 	var err error
 
@@ -240,7 +240,7 @@ func (c *Controller) createOrUpdateLogicalRouterPoliciesOps(key, v4MgmtIP, v6Mgm
 		lrp := &nbdb.LogicalRouterPolicy{
 			Match:    fmt.Sprintf("ip4.src == %s", addr),
 			Priority: ovntypes.EgressSVCReroutePriority,
-			Nexthops: []string{v4MgmtIP},
+			Nexthops: v4NextHops,
 			Action:   nbdb.LogicalRouterPolicyActionReroute,
 			ExternalIDs: map[string]string{
 				svcExternalIDKey: key,
@@ -260,7 +260,7 @@ func (c *Controller) createOrUpdateLogicalRouterPoliciesOps(key, v4MgmtIP, v6Mgm
 		lrp := &nbdb.LogicalRouterPolicy{
 			Match:    fmt.Sprintf("ip6.src == %s", addr),
 			Priority: ovntypes.EgressSVCReroutePriority,
-			Nexthops: []string{v6MgmtIP},
+			Nexthops: v6NextHops,
 			Action:   nbdb.LogicalRouterPolicyActionReroute,
 			ExternalIDs: map[string]string{
 				svcExternalIDKey: key,
@@ -0,0 +1,282 @@
+package networkqos
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/ovsdb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	networkqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// qosBasePriority is the OVN QoS priority of the last rule of a
+	// NetworkQoS of priority 0
+	qosBasePriority = 10000
+	// maxRules is the maximum number of egress rules of a NetworkQoS
+	maxRules = 20
+)
+
+// getQoSPriority returns the OVN QoS priority of the rule at ruleIndex in a
+// NetworkQoS of the given priority. Rules of higher priority NetworkQoSes come
+// first, then rules are ordered as listed in the NetworkQoS.
+func getQoSPriority(nqosPriority, ruleIndex int) int {
+	return qosBasePriority + nqosPriority*maxRules + maxRules - 1 - ruleIndex
+}
+
+func getNetworkQoSAddrSetDbIDs(key, controller string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetNetworkQoS, controller, map[libovsdbops.ExternalIDKey]string{
+		libovsdbops.ObjectNameKey: key,
+	})
+}
+
+func getNetworkQoSRuleDbIDs(key, controller string, ruleIndex int) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.QoSNetworkQoS, controller, map[libovsdbops.ExternalIDKey]string{
+		libovsdbops.ObjectNameKey: key,
+		libovsdbops.RuleIndex:     strconv.Itoa(ruleIndex),
+	})
+}
+
+// ensureNetworkQoS sets the address set of the NetworkQoS to the IPs of the
+// selected pods of the local zone, and creates a QoS rule for each of its
+// egress rules on the logical switches of the network.
+func (c *Controller) ensureNetworkQoS(nqos *networkqosapi.NetworkQoS) error {
+	key := nqos.Namespace + "/" + nqos.Name
+	podIPs, err := c.getSelectedPodIPs(nqos)
+	if err != nil {
+		return err
+	}
+	addrSet, err := c.addressSetFactory.EnsureAddressSet(getNetworkQoSAddrSetDbIDs(key, c.controllerName))
+	if err != nil {
+		return fmt.Errorf("cannot ensure address set for NetworkQoS %s: %w", key, err)
+	}
+	if err := addrSet.SetAddresses(podIPs); err != nil {
+		return fmt.Errorf("cannot set addresses of NetworkQoS %s: %w", key, err)
+	}
+	hashedIPv4, hashedIPv6 := addrSet.GetASHashNames()
+
+	qoses := make([]*nbdb.QoS, 0, len(nqos.Spec.Egress))
+	for i, rule := range nqos.Spec.Egress {
+		qos := &nbdb.QoS{
+			Direction:   nbdb.QoSDirectionFromLport,
+			Match:       generateNetworkQoSMatch(&rule, hashedIPv4, hashedIPv6),
+			Priority:    getQoSPriority(nqos.Spec.Priority, i),
+			Action:      map[string]int{},
+			Bandwidth:   map[string]int{},
+			ExternalIDs: getNetworkQoSRuleDbIDs(key, c.controllerName, i).GetExternalIDs(),
+		}
+		if rule.DSCP != nil {
+			qos.Action[nbdb.QoSActionDSCP] = *rule.DSCP
+		}
+		if rule.Bandwidth != nil {
+			qos.Bandwidth[nbdb.QoSBandwidthRate] = int(rule.Bandwidth.Rate)
+			if rule.Bandwidth.Burst > 0 {
+				qos.Bandwidth[nbdb.QoSBandwidthBurst] = int(rule.Bandwidth.Burst)
+			}
+		}
+		qoses = append(qoses, qos)
+	}
+
+	ops, err := libovsdbops.CreateOrUpdateQoSesOps(c.nbClient, nil, qoses...)
+	if err != nil {
+		return fmt.Errorf("cannot create QoS rules of NetworkQoS %s: %w", key, err)
+	}
+	switches, err := c.getLogicalSwitches()
+	if err != nil {
+		return err
+	}
+	for _, sw := range switches {
+		ops, err = libovsdbops.AddQoSesToLogicalSwitchOps(c.nbClient, ops, sw, qoses...)
+		if err != nil {
+			return fmt.Errorf("cannot add QoS rules of NetworkQoS %s to switch %s: %w", key, sw, err)
+		}
+	}
+
+	// remove the QoS rules of the egress rules that no longer exist
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSNetworkQoS, c.controllerName,
+		map[libovsdbops.ExternalIDKey]string{libovsdbops.ObjectNameKey: key})
+	ruleCount := len(nqos.Spec.Egress)
+	staleQoSes, err := libovsdbops.FindQoSesWithPredicate(c.nbClient, libovsdbops.GetPredicate[*nbdb.QoS](predicateIDs,
+		func(q *nbdb.QoS) bool {
+			ruleIndex, err := strconv.Atoi(q.ExternalIDs[libovsdbops.RuleIndex.String()])
+			return err != nil || ruleIndex >= ruleCount
+		}))
+	if err != nil {
+		return fmt.Errorf("cannot find stale QoS rules of NetworkQoS %s: %w", key, err)
+	}
+	if len(staleQoSes) > 0 {
+		ops, err = c.deleteQoSesOps(ops, staleQoSes)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to create QoS rules of NetworkQoS %s: %w", key, err)
+	}
+	return nil
+}
+
+// deleteNetworkQoS removes the QoS rules and the address set of the
+// NetworkQoS from the network.
+func (c *Controller) deleteNetworkQoS(key string) error {
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSNetworkQoS, c.controllerName,
+		map[libovsdbops.ExternalIDKey]string{libovsdbops.ObjectNameKey: key})
+	qoses, err := libovsdbops.FindQoSesWithPredicate(c.nbClient, libovsdbops.GetPredicate[*nbdb.QoS](predicateIDs, nil))
+	if err != nil {
+		return fmt.Errorf("cannot find QoS rules of NetworkQoS %s: %w", key, err)
+	}
+	if len(qoses) > 0 {
+		ops, err := c.deleteQoSesOps(nil, qoses)
+		if err != nil {
+			return err
+		}
+		if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+			return fmt.Errorf("failed to delete QoS rules of NetworkQoS %s: %w", key, err)
+		}
+	}
+	if err := c.addressSetFactory.DestroyAddressSet(getNetworkQoSAddrSetDbIDs(key, c.controllerName)); err != nil {
+		return fmt.Errorf("cannot delete address set of NetworkQoS %s: %w", key, err)
+	}
+	return nil
+}
+
+// deleteQoSesOps returns the ops removing the QoS rules from the logical
+// switches of the network and deleting them.
+func (c *Controller) deleteQoSesOps(ops []ovsdb.Operation, qoses []*nbdb.QoS) ([]ovsdb.Operation, error) {
+	switches, err := c.getLogicalSwitches()
+	if err != nil {
+		return nil, err
+	}
+	for _, sw := range switches {
+		ops, err = libovsdbops.RemoveQoSesFromLogicalSwitchOps(c.nbClient, ops, sw, qoses...)
+		if err != nil {
+			return nil, fmt.Errorf("cannot remove NetworkQoS rules from switch %s: %w", sw, err)
+		}
+	}
+	ops, err = libovsdbops.DeleteQoSesOps(c.nbClient, ops, qoses...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot delete NetworkQoS rules: %w", err)
+	}
+	return ops, nil
+}
+
+// getLogicalSwitches returns the names of the logical switches of the
+// network the pods are connected to.
+func (c *Controller) getLogicalSwitches() ([]string, error) {
+	networkName := ""
+	if !c.netInfo.IsDefault() {
+		networkName = c.netInfo.GetNetworkName()
+	}
+	p := func(item *nbdb.LogicalSwitch) bool {
+		if item.ExternalIDs[types.NetworkExternalID] != networkName {
+			return false
+		}
+		// Ignore external, join and transit switches
+		name := c.netInfo.RemoveNetworkScopeFromName(item.Name)
+		return !(strings.HasPrefix(name, types.JoinSwitchPrefix) || name == types.OVNJoinSwitch ||
+			name == types.TransitSwitch || strings.HasPrefix(name, types.ExternalSwitchPrefix))
+	}
+	logicalSwitches, err := libovsdbops.FindLogicalSwitchesWithPredicate(c.nbClient, p)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch logical switches for NetworkQoS: %w", err)
+	}
+	names := make([]string, 0, len(logicalSwitches))
+	for _, sw := range logicalSwitches {
+		names = append(names, sw.Name)
+	}
+	return names, nil
+}
+
+// getSelectedPodIPs returns the IPs on the network of the pods selected by
+// the NetworkQoS that run in the local zone.
+func (c *Controller) getSelectedPodIPs(nqos *networkqosapi.NetworkQoS) ([]string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&nqos.Spec.PodSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pod selector of NetworkQoS %s/%s: %w", nqos.Namespace, nqos.Name, err)
+	}
+	pods, err := c.podLister.Pods(nqos.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	podIPs := []string{}
+	for _, pod := range pods {
+		if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) || !util.PodScheduled(pod) {
+			continue
+		}
+		local, err := c.handler.isPodLocal(pod)
+		if err != nil {
+			return nil, err
+		}
+		if !local {
+			continue
+		}
+		ips, err := util.GetPodIPsOfNetwork(pod, c.netInfo)
+		if err != nil {
+			if errors.Is(err, util.ErrNoPodIPFound) {
+				// the pod will be reconciled again once it has IPs
+				klog.V(5).Infof("Pod %s/%s has no IPs on network %s yet", pod.Namespace, pod.Name, c.netInfo.GetNetworkName())
+				continue
+			}
+			return nil, fmt.Errorf("cannot get IPs of pod %s/%s on network %s: %w", pod.Namespace, pod.Name,
+				c.netInfo.GetNetworkName(), err)
+		}
+		for _, ip := range ips {
+			podIPs = append(podIPs, ip.String())
+		}
+	}
+	return podIPs, nil
+}
+
+func generateNetworkQoSMatch(rule *networkqosapi.NetworkQoSRule, hashedAddressSetNameIPv4, hashedAddressSetNameIPv6 string) string {
+	var src string
+	switch {
+	case config.IPv4Mode && config.IPv6Mode:
+		src = fmt.Sprintf("(ip4.src == $%s || ip6.src == $%s)", hashedAddressSetNameIPv4, hashedAddressSetNameIPv6)
+	case config.IPv4Mode:
+		src = fmt.Sprintf("ip4.src == $%s", hashedAddressSetNameIPv4)
+	case config.IPv6Mode:
+		src = fmt.Sprintf("ip6.src == $%s", hashedAddressSetNameIPv6)
+	}
+	match := src
+
+	if len(rule.Classifier.To) > 0 {
+		destinations := make([]string, 0, len(rule.Classifier.To))
+		for _, to := range rule.Classifier.To {
+			ipFamily := "ip4"
+			if utilnet.IsIPv6CIDRString(to.IPBlock.CIDR) {
+				ipFamily = "ip6"
+			}
+			dst := fmt.Sprintf("%s.dst == %s", ipFamily, to.IPBlock.CIDR)
+			if len(to.IPBlock.Except) > 0 {
+				dst = fmt.Sprintf("(%s && %s.dst != {%s})", dst, ipFamily, strings.Join(to.IPBlock.Except, ", "))
+			}
+			destinations = append(destinations, dst)
+		}
+		match = fmt.Sprintf("%s && (%s)", match, strings.Join(destinations, " || "))
+	}
+
+	if len(rule.Classifier.Ports) > 0 {
+		ports := make([]string, 0, len(rule.Classifier.Ports))
+		for _, port := range rule.Classifier.Ports {
+			protocol := strings.ToLower(port.Protocol)
+			if port.Port != nil {
+				ports = append(ports, fmt.Sprintf("(%s && %s.dst == %d)", protocol, protocol, *port.Port))
+			} else {
+				ports = append(ports, protocol)
+			}
+		}
+		match = fmt.Sprintf("%s && (%s)", match, strings.Join(ports, " || "))
+	}
+	return match
+}
@@ -0,0 +1,276 @@
+package networkqos
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	networkqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1"
+	networkqosclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/clientset/versioned"
+	networkqosinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/informers/externalversions/networkqos/v1"
+	networkqoslisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/listers/networkqos/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// Controller programs the NetworkQoSes applying on a network as OVN QoS rules
+// on the logical switches of the network in the local zone. There is one
+// Controller per network controller, the pod and node events are handled by
+// a PodNodeHandler shared by the Controllers of all the networks.
+type Controller struct {
+	netInfo        util.NetInfo
+	controllerName string
+	zone           string
+
+	// libovsdb northbound client interface
+	nbClient libovsdbclient.Client
+	// An address set factory that creates address sets
+	addressSetFactory addressset.AddressSetFactory
+	client            networkqosclientset.Interface
+	networkManager    networkmanager.Interface
+
+	nqosLister networkqoslisters.NetworkQoSLister
+	podLister  corelisters.PodLister
+	nodeLister corelisters.NodeLister
+
+	nqosController controller.Controller
+	handler        *PodNodeHandler
+}
+
+// NewController creates a controller that reconciles the NetworkQoSes
+// selecting the network of netInfo. The pods and the nodes they apply to are
+// handled by the shared handler. It should be started and stopped with Start
+// and Stop.
+func NewController(
+	controllerName string,
+	netInfo util.NetInfo,
+	nbClient libovsdbclient.Client,
+	addressSetFactory addressset.AddressSetFactory,
+	client networkqosclientset.Interface,
+	networkManager networkmanager.Interface,
+	nqosInformer networkqosinformer.NetworkQoSInformer,
+	handler *PodNodeHandler) *Controller {
+	c := &Controller{
+		netInfo:           netInfo,
+		controllerName:    controllerName,
+		zone:              handler.zone,
+		nbClient:          nbClient,
+		addressSetFactory: addressSetFactory,
+		client:            client,
+		networkManager:    networkManager,
+		nqosLister:        nqosInformer.Lister(),
+		podLister:         handler.podLister,
+		nodeLister:        handler.nodeLister,
+		handler:           handler,
+	}
+
+	nqosConfig := &controller.ControllerConfig[networkqosapi.NetworkQoS]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       nqosInformer.Informer(),
+		Lister:         nqosInformer.Lister().List,
+		ObjNeedsUpdate: nqosNeedsUpdate,
+		Reconcile:      c.syncNetworkQoS,
+		HandleError:    c.handleNetworkQoSError,
+		Threadiness:    1,
+	}
+	c.nqosController = controller.NewController[networkqosapi.NetworkQoS](
+		netInfo.GetNetworkName()+"-network-qos-controller", nqosConfig)
+
+	return c
+}
+
+// Start removes the OVN configuration of the NetworkQoSes that were deleted
+// while the controller was not running and starts processing events.
+func (c *Controller) Start() error {
+	klog.Infof("Starting NetworkQoS controller for network %s", c.netInfo.GetNetworkName())
+	// register first so that no pod or node event is missed once the
+	// NetworkQoSes are synced
+	c.handler.register(c)
+	if err := controller.StartWithInitialSync(c.repair, c.nqosController); err != nil {
+		c.handler.unregister(c)
+		return err
+	}
+	return nil
+}
+
+// Stop the controller
+func (c *Controller) Stop() {
+	c.handler.unregister(c)
+	controller.Stop(c.nqosController)
+	klog.Infof("Stopped NetworkQoS controller for network %s", c.netInfo.GetNetworkName())
+}
+
+// ReconcileAll reconciles all the NetworkQoSes, i.e. when the NADs of the
+// network changed.
+func (c *Controller) ReconcileAll() {
+	c.nqosController.ReconcileAll()
+}
+
+// repair deletes the QoS rules and address sets of the NetworkQoSes that no
+// longer exist.
+func (c *Controller) repair() error {
+	startTime := time.Now()
+	klog.V(4).Infof("Starting repairing loop for NetworkQoS on network %s", c.netInfo.GetNetworkName())
+	defer func() {
+		klog.V(4).Infof("Finished repairing loop for NetworkQoS on network %s: %v", c.netInfo.GetNetworkName(), time.Since(startTime))
+	}()
+
+	existing, err := c.nqosLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	existingKeys := map[string]bool{}
+	for _, nqos := range existing {
+		existingKeys[nqos.Namespace+"/"+nqos.Name] = true
+	}
+
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSNetworkQoS, c.controllerName, nil)
+	qosPredicate := libovsdbops.GetPredicate[*nbdb.QoS](predicateIDs, func(q *nbdb.QoS) bool {
+		return !existingKeys[q.ExternalIDs[libovsdbops.ObjectNameKey.String()]]
+	})
+	staleQoSes, err := libovsdbops.FindQoSesWithPredicate(c.nbClient, qosPredicate)
+	if err != nil {
+		return err
+	}
+	if len(staleQoSes) > 0 {
+		ops, err := c.deleteQoSesOps(nil, staleQoSes)
+		if err != nil {
+			return err
+		}
+		if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+			return fmt.Errorf("unable to remove stale NetworkQoS rules: %w", err)
+		}
+	}
+
+	predicateIDs = libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetNetworkQoS, c.controllerName, nil)
+	asPredicate := libovsdbops.GetPredicate[*nbdb.AddressSet](predicateIDs, func(as *nbdb.AddressSet) bool {
+		return !existingKeys[as.ExternalIDs[libovsdbops.ObjectNameKey.String()]]
+	})
+	if err := libovsdbops.DeleteAddressSetsWithPredicate(c.nbClient, asPredicate); err != nil {
+		return fmt.Errorf("failed to remove stale NetworkQoS address sets: %w", err)
+	}
+	return nil
+}
+
+func (c *Controller) syncNetworkQoS(key string) error {
+	startTime := time.Now()
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	klog.V(5).Infof("Processing sync for NetworkQoS %s on network %s", key, c.netInfo.GetNetworkName())
+	defer func() {
+		klog.V(4).Infof("Finished syncing NetworkQoS %s on network %s: %v", key, c.netInfo.GetNetworkName(), time.Since(startTime))
+	}()
+
+	nqos, err := c.nqosLister.NetworkQoSes(namespace).Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if nqos != nil {
+		selected, err := c.selectsNetwork(nqos)
+		if err != nil {
+			return err
+		}
+		if !selected {
+			nqos = nil
+		}
+	}
+	if nqos == nil {
+		return c.deleteNetworkQoS(key)
+	}
+
+	if err := c.ensureNetworkQoS(nqos); err != nil {
+		return err
+	}
+	if err := c.updateStatusToReady(nqos); err != nil {
+		return fmt.Errorf("failed to update NetworkQoS %s status: %w", key, err)
+	}
+	return nil
+}
+
+// handleNetworkQoSError reports in the status of the NetworkQoS the error
+// it could not be applied with in the local zone.
+func (c *Controller) handleNetworkQoSError(key string, syncErr error) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	nqos, err := c.nqosLister.NetworkQoSes(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return syncErr
+	}
+	if err != nil {
+		return err
+	}
+	if err := c.updateStatusToNotReady(nqos, syncErr); err != nil {
+		return fmt.Errorf("failed to update NetworkQoS %s status: %w", key, err)
+	}
+	return syncErr
+}
+
+// selectsNetwork tells if the NetworkQoS applies on the network of the
+// controller.
+func (c *Controller) selectsNetwork(nqos *networkqosapi.NetworkQoS) (bool, error) {
+	if nqos.Spec.NetworkAttachmentName != "" {
+		return c.netInfo.HasNAD(util.GetNADName(nqos.Namespace, nqos.Spec.NetworkAttachmentName)), nil
+	}
+	if !c.netInfo.IsDefault() && !c.netInfo.IsPrimaryNetwork() {
+		return false, nil
+	}
+	activeNetwork, err := c.networkManager.GetActiveNetworkForNamespace(nqos.Namespace)
+	if err != nil {
+		return false, fmt.Errorf("could not get active network for namespace %s: %w", nqos.Namespace, err)
+	}
+	return activeNetwork.GetNetworkName() == c.netInfo.GetNetworkName(), nil
+}
+
+// syncNamespace reconciles the NetworkQoSes of the namespace, i.e. when one
+// of its pods attached to the network changed.
+func (c *Controller) syncNamespace(namespace string) error {
+	nqoses, err := c.nqosLister.NetworkQoSes(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, nqos := range nqoses {
+		c.nqosController.Reconcile(nqos.Namespace + "/" + nqos.Name)
+	}
+	return nil
+}
+
+// syncNode reconciles all the NetworkQoSes once the logical switch of a node
+// of the local zone exists, so that they are applied on it.
+func (c *Controller) syncNode(key string) error {
+	node, err := c.nodeLister.Get(key)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if node != nil && util.GetNodeZone(node) == c.zone && c.netInfo.TopologyType() == types.Layer3Topology {
+		switchName := c.netInfo.GetNetworkScopedSwitchName(node.Name)
+		if _, err := libovsdbops.GetLogicalSwitch(c.nbClient, &nbdb.LogicalSwitch{Name: switchName}); err != nil {
+			return fmt.Errorf("unable to get logical switch %s for NetworkQoS: %w", switchName, err)
+		}
+	}
+	c.nqosController.ReconcileAll()
+	return nil
+}
+
+func nqosNeedsUpdate(oldObj, newObj *networkqosapi.NetworkQoS) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldObj.Spec, newObj.Spec)
+}
@@ -0,0 +1,343 @@
+package networkqos
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/ovn-org/libovsdb/client"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	networkqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	controllerName = "default-network-controller"
+	namespace      = "ns1"
+	localNode      = "node1"
+	remoteNode     = "node2"
+	localPodIP     = "10.128.1.3"
+	remotePodIP    = "10.128.2.3"
+)
+
+func TestNetworkQoS(t *testing.T) {
+	tests := []struct {
+		name                  string
+		networkAttachmentName string
+		expectedQoSes         []*nbdb.QoS
+	}{
+		{
+			name: "applies the rules on the node switches to the local selected pods",
+			expectedQoSes: []*nbdb.QoS{
+				{
+					Direction: nbdb.QoSDirectionFromLport,
+					Match:     "ip4.src == $%s && ((ip4.dst == 10.0.0.0/8 && ip4.dst != {10.1.0.0/16})) && ((tcp && tcp.dst == 80))",
+					Priority:  10219,
+					Action:    map[string]int{nbdb.QoSActionDSCP: 46},
+					Bandwidth: map[string]int{},
+				},
+				{
+					Direction: nbdb.QoSDirectionFromLport,
+					Match:     "ip4.src == $%s",
+					Priority:  10218,
+					Action:    map[string]int{},
+					Bandwidth: map[string]int{nbdb.QoSBandwidthRate: 10000, nbdb.QoSBandwidthBurst: 1000},
+				},
+			},
+		},
+		{
+			name:                  "does not apply the rules of a NetworkQoS selecting another network",
+			networkAttachmentName: "other",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d: %s", i, tt.name), func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			if err := config.PrepareTestConfig(); err != nil {
+				t.Fatalf("failed to prepare test config: %v", err)
+			}
+			config.IPv4Mode = true
+			config.OVNKubernetesFeature.EnableNetworkQoS = true
+
+			// a QoS rule of a NetworkQoS that no longer exists
+			staleQoS := &nbdb.QoS{
+				UUID:        "stale-qos-UUID",
+				Direction:   nbdb.QoSDirectionFromLport,
+				Match:       "ip4.src == 10.128.1.10",
+				Priority:    10000,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 10},
+				ExternalIDs: getNetworkQoSRuleDbIDs(namespace+"/stale", controllerName, 0).GetExternalIDs(),
+			}
+			initialDB := []libovsdbtest.TestData{
+				staleQoS,
+				&nbdb.LogicalSwitch{UUID: localNode + "-UUID", Name: localNode, QOSRules: []string{staleQoS.UUID}},
+				&nbdb.LogicalSwitch{UUID: "join-UUID", Name: types.OVNJoinSwitch},
+				&nbdb.LogicalSwitch{UUID: "ext-UUID", Name: types.ExternalSwitchPrefix + localNode},
+				&nbdb.LogicalSwitch{
+					UUID:        "secondary-UUID",
+					Name:        "other_" + localNode,
+					ExternalIDs: map[string]string{types.NetworkExternalID: "other"},
+				},
+			}
+			nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{NBData: initialDB}, nil)
+			if err != nil {
+				t.Fatalf("failed to create new NB test harness: %v", err)
+			}
+			defer cleanup.Cleanup()
+
+			nqos := &networkqosapi.NetworkQoS{
+				ObjectMeta: util.NewObjectMeta("nqos", namespace),
+				Spec: networkqosapi.NetworkQoSSpec{
+					NetworkAttachmentName: tt.networkAttachmentName,
+					PodSelector:           metav1.LabelSelector{MatchLabels: map[string]string{"app": "qos"}},
+					Priority:              10,
+					Egress: []networkqosapi.NetworkQoSRule{
+						{
+							DSCP: ptr.To(46),
+							Classifier: networkqosapi.Classifier{
+								To: []networkqosapi.Destination{{
+									IPBlock: networkingv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}},
+								}},
+								Ports: []networkqosapi.Port{{Protocol: "TCP", Port: ptr.To[int32](80)}},
+							},
+						},
+						{
+							Bandwidth: &networkqosapi.Bandwidth{Rate: 10000, Burst: 1000},
+						},
+					},
+				},
+			}
+			objects := []runtime.Object{
+				util.NewNamespace(namespace),
+				newNode(localNode, types.OvnDefaultZone),
+				newNode(remoteNode, "remote"),
+				newPod("local", localNode, localPodIP, map[string]string{"app": "qos"}),
+				newPod("local-not-selected", localNode, "10.128.1.4", nil),
+				newPod("remote", remoteNode, remotePodIP, map[string]string{"app": "qos"}),
+				nqos,
+			}
+			ovnClient := util.GetOVNClientset(objects...).GetOVNKubeControllerClientset()
+			watchFactory, err := factory.NewOVNKubeControllerWatchFactory(ovnClient)
+			if err != nil {
+				t.Fatalf("failed to create new OVN kube controller watch factory: %v", err)
+			}
+			if err = watchFactory.Start(); err != nil {
+				t.Fatalf("failed to start watch factory: %v", err)
+			}
+			defer watchFactory.Shutdown()
+
+			asf := addressset.NewOvnAddressSetFactory(nbClient, true, false)
+			handler := NewPodNodeHandler(types.OvnDefaultZone, watchFactory.PodCoreInformer(), watchFactory.NodeCoreInformer())
+			if err = handler.Start(); err != nil {
+				t.Fatalf("failed to start the pod and node handler: %v", err)
+			}
+			defer handler.Stop()
+			c := NewController(controllerName, &util.DefaultNetInfo{}, nbClient, asf, ovnClient.NetworkQoSClient,
+				networkmanager.Default().Interface(), watchFactory.NetworkQoSInformer(), handler)
+			if err = c.Start(); err != nil {
+				t.Fatalf("failed to start the controller: %v", err)
+			}
+			defer c.Stop()
+
+			key := namespace + "/" + nqos.Name
+			if len(tt.expectedQoSes) == 0 {
+				g.Consistently(func() ([]*nbdb.QoS, error) {
+					return findNetworkQoSRules(nbClient, key)
+				}).Should(gomega.BeEmpty())
+			} else {
+				var hashedIPv4 string
+				g.Eventually(func() ([]string, error) {
+					addrSet, err := asf.GetAddressSet(getNetworkQoSAddrSetDbIDs(key, controllerName))
+					if err != nil {
+						return nil, err
+					}
+					hashedIPv4, _ = addrSet.GetASHashNames()
+					v4IPs, _ := addrSet.GetAddresses()
+					return v4IPs, nil
+				}).Should(gomega.ConsistOf(localPodIP))
+
+				g.Eventually(func() error {
+					return checkNetworkQoSRules(nbClient, key, tt.expectedQoSes, hashedIPv4)
+				}).WithTimeout(5 * time.Second).Should(gomega.Succeed())
+
+				g.Eventually(func() bool {
+					nq, err := ovnClient.NetworkQoSClient.K8sV1().NetworkQoSes(namespace).Get(context.TODO(), nqos.Name, metav1.GetOptions{})
+					if err != nil {
+						return false
+					}
+					return meta.IsStatusConditionTrue(nq.Status.Conditions, networkqosapi.NetworkQoSReadyInZoneConditionPrefix+types.OvnDefaultZone)
+				}).Should(gomega.BeTrue())
+			}
+
+			// the QoS rule of the deleted NetworkQoS is removed
+			g.Eventually(func() ([]string, error) {
+				sw, err := libovsdbops.GetLogicalSwitch(nbClient, &nbdb.LogicalSwitch{Name: localNode})
+				if err != nil {
+					return nil, err
+				}
+				return sw.QOSRules, nil
+			}).ShouldNot(gomega.ContainElement(staleQoS.UUID))
+
+			err = ovnClient.NetworkQoSClient.K8sV1().NetworkQoSes(namespace).Delete(context.TODO(), nqos.Name, metav1.DeleteOptions{})
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Eventually(func() ([]*nbdb.QoS, error) {
+				return findNetworkQoSRules(nbClient, key)
+			}).Should(gomega.BeEmpty())
+			g.Eventually(func() ([]*nbdb.AddressSet, error) {
+				predicate := libovsdbops.GetPredicate[*nbdb.AddressSet](getNetworkQoSAddrSetDbIDs(key, controllerName), nil)
+				return libovsdbops.FindAddressSetsWithPredicate(nbClient, predicate)
+			}).Should(gomega.BeEmpty())
+		})
+	}
+}
+
+func TestGenerateNetworkQoSMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		v4Mode   bool
+		v6Mode   bool
+		rule     networkqosapi.NetworkQoSRule
+		expected string
+	}{
+		{
+			name:     "matches all the egress traffic without classifier",
+			v4Mode:   true,
+			expected: "ip4.src == $as_v4",
+		},
+		{
+			name:   "matches any of the destinations and ports",
+			v4Mode: true,
+			v6Mode: true,
+			rule: networkqosapi.NetworkQoSRule{
+				Classifier: networkqosapi.Classifier{
+					To: []networkqosapi.Destination{
+						{IPBlock: networkingv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16", "10.2.0.0/16"}}},
+						{IPBlock: networkingv1.IPBlock{CIDR: "fd00::/64"}},
+					},
+					Ports: []networkqosapi.Port{
+						{Protocol: "UDP", Port: ptr.To[int32](53)},
+						{Protocol: "SCTP"},
+					},
+				},
+			},
+			expected: "(ip4.src == $as_v4 || ip6.src == $as_v6) && " +
+				"((ip4.dst == 10.0.0.0/8 && ip4.dst != {10.1.0.0/16, 10.2.0.0/16}) || ip6.dst == fd00::/64) && " +
+				"((udp && udp.dst == 53) || sctp)",
+		},
+		{
+			name:   "matches ports on single stack IPv6",
+			v6Mode: true,
+			rule: networkqosapi.NetworkQoSRule{
+				Classifier: networkqosapi.Classifier{
+					Ports: []networkqosapi.Port{{Protocol: "TCP", Port: ptr.To[int32](443)}},
+				},
+			},
+			expected: "ip6.src == $as_v6 && ((tcp && tcp.dst == 443))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.IPv4Mode = tt.v4Mode
+			config.IPv6Mode = tt.v6Mode
+			if actual := generateNetworkQoSMatch(&tt.rule, "as_v4", "as_v6"); actual != tt.expected {
+				t.Errorf("expected match %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func findNetworkQoSRules(nbClient client.Client, key string) ([]*nbdb.QoS, error) {
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSNetworkQoS, controllerName,
+		map[libovsdbops.ExternalIDKey]string{libovsdbops.ObjectNameKey: key})
+	return libovsdbops.FindQoSesWithPredicate(nbClient, libovsdbops.GetPredicate[*nbdb.QoS](predicateIDs, nil))
+}
+
+// checkNetworkQoSRules checks the QoS rules of the NetworkQoS are the
+// expected ones, and are only set on the node switch of the network
+func checkNetworkQoSRules(nbClient client.Client, key string, expected []*nbdb.QoS, hashedIPv4 string) error {
+	qoses, err := findNetworkQoSRules(nbClient, key)
+	if err != nil {
+		return err
+	}
+	if len(qoses) != len(expected) {
+		return fmt.Errorf("expected %d QoS rules, got %d", len(expected), len(qoses))
+	}
+	qosUUIDs := make([]string, 0, len(qoses))
+	for i, e := range expected {
+		var qos *nbdb.QoS
+		for _, q := range qoses {
+			if q.ExternalIDs[libovsdbops.RuleIndex.String()] == fmt.Sprintf("%d", i) {
+				qos = q
+			}
+		}
+		if qos == nil {
+			return fmt.Errorf("missing QoS rule %d", i)
+		}
+		expectedMatch := e.Match
+		if expectedMatch != "" {
+			expectedMatch = fmt.Sprintf(expectedMatch, hashedIPv4)
+		}
+		if qos.Direction != e.Direction || qos.Match != expectedMatch || qos.Priority != e.Priority ||
+			!maps.Equal(qos.Action, e.Action) || !maps.Equal(qos.Bandwidth, e.Bandwidth) {
+			return fmt.Errorf("unexpected QoS rule %d: %+v", i, qos)
+		}
+		qosUUIDs = append(qosUUIDs, qos.UUID)
+	}
+	switches, err := libovsdbops.FindLogicalSwitchesWithPredicate(nbClient, func(*nbdb.LogicalSwitch) bool { return true })
+	if err != nil {
+		return err
+	}
+	for _, sw := range switches {
+		for _, uuid := range qosUUIDs {
+			found := false
+			for _, rule := range sw.QOSRules {
+				if rule == uuid {
+					found = true
+				}
+			}
+			if found != (sw.Name == localNode) {
+				return fmt.Errorf("unexpected QoS rules %v on switch %s", sw.QOSRules, sw.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func newNode(name, zone string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{util.OvnNodeZoneName: zone},
+		},
+	}
+}
+
+func newPod(name, node, ip string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: util.NewObjectMetaWithLabels(name, namespace, labels),
+		Spec:       corev1.PodSpec{NodeName: node},
+		Status: corev1.PodStatus{
+			Phase:  corev1.PodRunning,
+			PodIP:  ip,
+			PodIPs: []corev1.PodIP{{IP: ip}},
+		},
+	}
+}
@@ -0,0 +1,217 @@
+package networkqos
+
+import (
+	"reflect"
+	"sync"
+
+	kapi "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+)
+
+// PodNodeHandler handles the pod and node events for the NetworkQoS
+// controllers of all the networks, so that pods and nodes are watched once
+// rather than once per network. Only the pods of the local zone are queued,
+// and they are dispatched to the controllers of the networks they are
+// attached to. Nodes are dispatched to all the controllers.
+type PodNodeHandler struct {
+	zone       string
+	podLister  corelisters.PodLister
+	nodeLister corelisters.NodeLister
+
+	podController  controller.Controller
+	nodeController controller.Controller
+
+	sync.RWMutex
+	// controllers holds the NetworkQoS controllers by network name
+	controllers map[string]*Controller
+
+	// podNetworks holds the networks a pod was attached to when last synced,
+	// by pod key, so that deleted pods are dispatched to them. Only accessed
+	// by the single pod worker.
+	podNetworks map[string]sets.Set[string]
+}
+
+// NewPodNodeHandler creates the handler shared by the NetworkQoS controllers
+// of the local zone. It should be started and stopped with Start and Stop.
+func NewPodNodeHandler(zone string, podInformer coreinformers.PodInformer, nodeInformer coreinformers.NodeInformer) *PodNodeHandler {
+	h := &PodNodeHandler{
+		zone:        zone,
+		podLister:   podInformer.Lister(),
+		nodeLister:  nodeInformer.Lister(),
+		controllers: map[string]*Controller{},
+		podNetworks: map[string]sets.Set[string]{},
+	}
+
+	podConfig := &controller.ControllerConfig[kapi.Pod]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       podInformer.Informer(),
+		Lister:         podInformer.Lister().List,
+		ObjNeedsUpdate: h.podNeedsUpdate,
+		Reconcile:      h.syncPod,
+		Threadiness:    1,
+	}
+	h.podController = controller.NewController[kapi.Pod]("network-qos-pod-controller", podConfig)
+
+	nodeConfig := &controller.ControllerConfig[kapi.Node]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       nodeInformer.Informer(),
+		Lister:         nodeInformer.Lister().List,
+		ObjNeedsUpdate: nodeNeedsUpdate,
+		Reconcile:      h.syncNode,
+		Threadiness:    1,
+	}
+	h.nodeController = controller.NewController[kapi.Node]("network-qos-node-controller", nodeConfig)
+
+	return h
+}
+
+// Start processing the pod and node events
+func (h *PodNodeHandler) Start() error {
+	klog.Info("Starting NetworkQoS pod and node handler")
+	return controller.Start(h.podController, h.nodeController)
+}
+
+// Stop the handler
+func (h *PodNodeHandler) Stop() {
+	controller.Stop(h.podController, h.nodeController)
+	klog.Info("Stopped NetworkQoS pod and node handler")
+}
+
+func (h *PodNodeHandler) register(c *Controller) {
+	h.Lock()
+	defer h.Unlock()
+	h.controllers[c.netInfo.GetNetworkName()] = c
+}
+
+func (h *PodNodeHandler) unregister(c *Controller) {
+	h.Lock()
+	defer h.Unlock()
+	delete(h.controllers, c.netInfo.GetNetworkName())
+}
+
+// isPodLocal tells if the pod runs on a node of the local zone.
+func (h *PodNodeHandler) isPodLocal(pod *kapi.Pod) (bool, error) {
+	node, err := h.nodeLister.Get(pod.Spec.NodeName)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return util.GetNodeZone(node) == h.zone, nil
+}
+
+// getPodNetworks returns the names of the networks of the registered
+// controllers the pod is attached to.
+func (h *PodNodeHandler) getPodNetworks(pod *kapi.Pod) (sets.Set[string], error) {
+	podNetworks, err := util.UnmarshalPodAnnotationAllNetworks(pod.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	h.RLock()
+	defer h.RUnlock()
+	// all the pods are attached to the default network
+	networks := sets.New(types.DefaultNetworkName)
+	for nadName := range podNetworks {
+		for name, c := range h.controllers {
+			if c.netInfo.HasNAD(nadName) {
+				networks.Insert(name)
+			}
+		}
+	}
+	return networks, nil
+}
+
+// syncPod reconciles the NetworkQoSes of the namespace of the pod on the
+// networks it is, or was, attached to.
+func (h *PodNodeHandler) syncPod(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	pod, err := h.podLister.Pods(namespace).Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	networks := h.podNetworks[key]
+	if pod == nil {
+		delete(h.podNetworks, key)
+	} else {
+		current, err := h.getPodNetworks(pod)
+		if err != nil {
+			return err
+		}
+		h.podNetworks[key] = current
+		networks = current.Union(networks)
+	}
+
+	h.RLock()
+	defer h.RUnlock()
+	for network := range networks {
+		if c, ok := h.controllers[network]; ok {
+			if err := c.syncNamespace(namespace); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// syncNode dispatches the node to the controllers of all the networks.
+func (h *PodNodeHandler) syncNode(key string) error {
+	h.RLock()
+	defer h.RUnlock()
+	var errs []error
+	for _, c := range h.controllers {
+		if err := c.syncNode(key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.Join(errs...)
+}
+
+// podNeedsUpdate only queues the pods of the local zone whose changes affect
+// the address sets of the NetworkQoSes. Deleted pods are always queued, and
+// only dispatched if they were synced before.
+func (h *PodNodeHandler) podNeedsUpdate(oldObj, newObj *kapi.Pod) bool {
+	if newObj == nil {
+		return true
+	}
+	if util.PodWantsHostNetwork(newObj) || !util.PodScheduled(newObj) {
+		return false
+	}
+	local, err := h.isPodLocal(newObj)
+	if err != nil {
+		klog.Errorf("Failed to check if pod %s/%s is local for NetworkQoS: %v", newObj.Namespace, newObj.Name, err)
+		return true
+	}
+	if !local {
+		return false
+	}
+	if oldObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldObj.Labels, newObj.Labels) ||
+		oldObj.Spec.NodeName != newObj.Spec.NodeName ||
+		util.PodCompleted(oldObj) != util.PodCompleted(newObj) ||
+		!reflect.DeepEqual(oldObj.Status.PodIPs, newObj.Status.PodIPs) ||
+		oldObj.Annotations[util.OvnPodAnnotationName] != newObj.Annotations[util.OvnPodAnnotationName]
+}
+
+func nodeNeedsUpdate(oldObj, newObj *kapi.Node) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return util.NodeZoneAnnotationChanged(oldObj, newObj)
+}
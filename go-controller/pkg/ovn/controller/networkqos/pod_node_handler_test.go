@@ -0,0 +1,129 @@
+package networkqos
+
+import (
+	"testing"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func TestPodNodeHandler(t *testing.T) {
+	nodeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, node := range []*corev1.Node{newNode(localNode, types.OvnDefaultZone), newNode(remoteNode, "remote")} {
+		if err := nodeIndexer.Add(node); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h := &PodNodeHandler{
+		zone:        types.OvnDefaultZone,
+		nodeLister:  corelisters.NewNodeLister(nodeIndexer),
+		controllers: map[string]*Controller{},
+	}
+	netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: "blue"},
+		Topology: types.Layer3Topology,
+		Subnets:  "10.129.0.0/16/24",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blue := util.NewMutableNetInfo(netInfo)
+	blue.SetNADs(namespace + "/blue")
+	h.register(&Controller{netInfo: &util.DefaultNetInfo{}})
+	h.register(&Controller{netInfo: blue})
+
+	localPod := newPod("local", localNode, localPodIP, map[string]string{"app": "qos"})
+	relabeledPod := localPod.DeepCopy()
+	relabeledPod.Labels["app"] = "other"
+	readyPod := localPod.DeepCopy()
+	readyPod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	hostNetworkPod := localPod.DeepCopy()
+	hostNetworkPod.Spec.HostNetwork = true
+	unscheduledPod := localPod.DeepCopy()
+	unscheduledPod.Spec.NodeName = ""
+
+	needsUpdateTests := []struct {
+		name     string
+		oldObj   *corev1.Pod
+		newObj   *corev1.Pod
+		expected bool
+	}{
+		{
+			name:     "queues the new pods of the local zone",
+			newObj:   localPod,
+			expected: true,
+		},
+		{
+			name:   "does not queue the pods of remote zones",
+			newObj: newPod("remote", remoteNode, remotePodIP, map[string]string{"app": "qos"}),
+		},
+		{
+			name:   "does not queue the pods of unknown nodes",
+			newObj: newPod("unknown", "node3", "10.128.3.3", nil),
+		},
+		{
+			name:   "does not queue the unscheduled pods",
+			newObj: unscheduledPod,
+		},
+		{
+			name:   "does not queue the host network pods",
+			newObj: hostNetworkPod,
+		},
+		{
+			name:     "queues the relabeled pods of the local zone",
+			oldObj:   localPod,
+			newObj:   relabeledPod,
+			expected: true,
+		},
+		{
+			name:   "does not queue the pods of the local zone for unrelated changes",
+			oldObj: localPod,
+			newObj: readyPod,
+		},
+	}
+	for _, tt := range needsUpdateTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := h.podNeedsUpdate(tt.oldObj, tt.newObj); actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+
+	networksTests := []struct {
+		name       string
+		annotation string
+		expected   sets.Set[string]
+	}{
+		{
+			name:     "dispatches the pods without annotation to the default network",
+			expected: sets.New(types.DefaultNetworkName),
+		},
+		{
+			name:       "dispatches the pods to the registered networks they are attached to",
+			annotation: `{"default":{},"ns1/blue":{},"ns1/red":{}}`,
+			expected:   sets.New(types.DefaultNetworkName, "blue"),
+		},
+	}
+	for _, tt := range networksTests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := localPod.DeepCopy()
+			if tt.annotation != "" {
+				pod.Annotations = map[string]string{util.OvnPodAnnotationName: tt.annotation}
+			}
+			networks, err := h.getPodNetworks(pod)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !networks.Equal(tt.expected) {
+				t.Errorf("expected networks %v, got %v", sets.List(tt.expected), sets.List(networks))
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package networkqos
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/utils/ptr"
+
+	networkqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1"
+	networkqosapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/applyconfiguration/networkqos/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+const (
+	networkQoSAppliedCorrectly = "NetworkQoS Rules applied"
+	networkQoSReadyReason      = "SetupSucceeded"
+	networkQoSNotReadyReason   = "SetupFailed"
+)
+
+// updateStatusToReady updates the status of the NetworkQoS to reflect that it
+// is applied in the local zone. Each zone's ovnkube-controller will call this,
+// hence the status is updated using server side apply.
+func (c *Controller) updateStatusToReady(nqos *networkqosapi.NetworkQoS) error {
+	readyCondition := metav1.Condition{
+		Type:    networkqosapi.NetworkQoSReadyInZoneConditionPrefix + c.zone,
+		Status:  metav1.ConditionTrue,
+		Reason:  networkQoSReadyReason,
+		Message: networkQoSAppliedCorrectly,
+	}
+	return c.updateStatusCondition(nqos, readyCondition)
+}
+
+// updateStatusToNotReady updates the status of the NetworkQoS to reflect that
+// it could not be applied in the local zone.
+func (c *Controller) updateStatusToNotReady(nqos *networkqosapi.NetworkQoS, handlerErr error) error {
+	notReadyCondition := metav1.Condition{
+		Type:    networkqosapi.NetworkQoSReadyInZoneConditionPrefix + c.zone,
+		Status:  metav1.ConditionFalse,
+		Reason:  networkQoSNotReadyReason,
+		Message: types.NetworkQoSErrorMsg + ": " + handlerErr.Error(),
+	}
+	return c.updateStatusCondition(nqos, notReadyCondition)
+}
+
+func (c *Controller) updateStatusCondition(nqos *networkqosapi.NetworkQoS, newCondition metav1.Condition) error {
+	existingCondition := meta.FindStatusCondition(nqos.Status.Conditions, newCondition.Type)
+	if existingCondition != nil && existingCondition.Status == newCondition.Status &&
+		existingCondition.Reason == newCondition.Reason && existingCondition.Message == newCondition.Message {
+		// nothing to update
+		return nil
+	}
+
+	newConditionApply := &metaapplyv1.ConditionApplyConfiguration{
+		Type:    &newCondition.Type,
+		Status:  &newCondition.Status,
+		Reason:  &newCondition.Reason,
+		Message: &newCondition.Message,
+	}
+	if existingCondition == nil || existingCondition.Status != newCondition.Status {
+		newConditionApply.LastTransitionTime = ptr.To(metav1.NewTime(time.Now()))
+	} else {
+		newConditionApply.LastTransitionTime = &existingCondition.LastTransitionTime
+	}
+
+	applyObj := networkqosapply.NetworkQoS(nqos.Name, nqos.Namespace).
+		WithStatus(networkqosapply.NetworkQoSStatus().WithConditions(newConditionApply))
+	_, err := c.client.K8sV1().NetworkQoSes(nqos.Namespace).ApplyStatus(context.TODO(), applyObj,
+		metav1.ApplyOptions{FieldManager: c.zone, Force: true})
+	return err
+}
@@ -0,0 +1,307 @@
+package unidling
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kapi "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// LastActiveAtAnnotation is set by cluster manager on a service with the
+	// last time traffic to the service was reported by the nodes.
+	LastActiveAtAnnotation = "k8s.ovn.org/last-active-at"
+	// IdleSinceAnnotation is set by cluster manager on a service that had no
+	// traffic for the configured idle timeout, with the time the service
+	// became idle. It is removed as soon as traffic is seen again.
+	IdleSinceAnnotation = "k8s.ovn.org/idle-since"
+	// ServiceActivityLabel is set on the leases ovnkube-node reports the
+	// activity of the services in.
+	ServiceActivityLabel = "k8s.ovn.org/service-activity"
+	// ServiceActivityAnnotation is set by ovnkube-node on its service
+	// activity lease with the last time traffic was seen to the services
+	// from the node, as a JSON map of the service keys to RFC3339 times.
+	ServiceActivityAnnotation = "k8s.ovn.org/service-activity"
+
+	serviceActivityLeasePrefix = "ovn-service-activity-"
+
+	serviceIdleEventReason   = "ServiceIdle"
+	serviceActiveEventReason = "ServiceActive"
+)
+
+// GetServiceActivityLeaseName returns the name of the lease the node reports
+// the activity of the services in.
+func GetServiceActivityLeaseName(nodeName string) string {
+	return serviceActivityLeasePrefix + nodeName
+}
+
+// ParseServiceActivity returns the last time traffic was seen to the services
+// reported in the lease, indexed by service key.
+func ParseServiceActivity(lease *coordinationv1.Lease) (map[string]time.Time, error) {
+	activity := map[string]time.Time{}
+	value, ok := lease.Annotations[ServiceActivityAnnotation]
+	if !ok {
+		return activity, nil
+	}
+	times := map[string]string{}
+	if err := json.Unmarshal([]byte(value), &times); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s annotation of lease %s: %w", ServiceActivityAnnotation, lease.Name, err)
+	}
+	for key, t := range times {
+		parsed, err := time.Parse(time.RFC3339, t)
+		if err != nil {
+			return nil, fmt.Errorf("bad time %q of service %s in lease %s: %w", t, key, lease.Name, err)
+		}
+		activity[key] = parsed
+	}
+	return activity, nil
+}
+
+// IdleController signals the services that have been idle for the
+// configured timeout, so that serverless platforms can scale them to zero.
+// Each node reports the activity of the services in its own lease in the
+// ovn-kubernetes namespace, and the controller records the latest activity
+// in the 'k8s.ovn.org/last-active-at' annotation of the services. Services
+// without activity are considered idle since their creation.
+type IdleController struct {
+	kube          kube.Interface
+	eventRecorder record.EventRecorder
+	serviceLister corelisters.ServiceLister
+	idleTimeout   time.Duration
+	controller    controller.Controller
+
+	leaseInformerFactory informers.SharedInformerFactory
+	stopCh               chan struct{}
+
+	// activityLock protects activity
+	activityLock sync.Mutex
+	// activity holds the service activity reported in each lease
+	activity map[string]map[string]time.Time
+}
+
+// NewIdleController creates a controller that annotates services idle for
+// longer than idleTimeout with 'k8s.ovn.org/idle-since' and emits a
+// ServiceIdle event for them.
+func NewIdleController(client kubernetes.Interface, recorder record.EventRecorder, serviceInformer coreinformers.ServiceInformer,
+	idleTimeout time.Duration) *IdleController {
+	ic := &IdleController{
+		kube:          &kube.Kube{KClient: client},
+		eventRecorder: recorder,
+		serviceLister: serviceInformer.Lister(),
+		idleTimeout:   idleTimeout,
+		leaseInformerFactory: informers.NewSharedInformerFactoryWithOptions(client, 0,
+			informers.WithNamespace(config.Kubernetes.OVNConfigNamespace),
+			informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+				options.LabelSelector = ServiceActivityLabel
+			})),
+		stopCh:   make(chan struct{}),
+		activity: map[string]map[string]time.Time{},
+	}
+	controllerConfig := &controller.ControllerConfig[kapi.Service]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       serviceInformer.Informer(),
+		Lister:         serviceInformer.Lister().List,
+		ObjNeedsUpdate: serviceIdleNeedsUpdate,
+		Reconcile:      ic.reconcile,
+		Threadiness:    1,
+	}
+	ic.controller = controller.NewController[kapi.Service]("service_idle_controller", controllerConfig)
+	return ic
+}
+
+// Start the controller
+func (ic *IdleController) Start() error {
+	leaseInformer := ic.leaseInformerFactory.Coordination().V1().Leases().Informer()
+	_, err := leaseInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			ic.onLeaseUpdate(obj)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			ic.onLeaseUpdate(newObj)
+		},
+		DeleteFunc: ic.onLeaseDelete,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add service activity lease event handler: %w", err)
+	}
+	ic.leaseInformerFactory.Start(ic.stopCh)
+	if !cache.WaitForCacheSync(ic.stopCh, leaseInformer.HasSynced) {
+		return fmt.Errorf("failed to sync service activity leases")
+	}
+	return controller.Start(ic.controller)
+}
+
+// Stop the controller
+func (ic *IdleController) Stop() {
+	controller.Stop(ic.controller)
+	close(ic.stopCh)
+	ic.leaseInformerFactory.Shutdown()
+}
+
+// onLeaseUpdate records the service activity reported in the lease and
+// reconciles the services with new activity
+func (ic *IdleController) onLeaseUpdate(obj interface{}) {
+	lease, ok := obj.(*coordinationv1.Lease)
+	if !ok {
+		return
+	}
+	activity, err := ParseServiceActivity(lease)
+	if err != nil {
+		klog.Errorf("Failed to get the service activity reported by lease %s: %v", lease.Name, err)
+		return
+	}
+	var changed []string
+	ic.activityLock.Lock()
+	previous := ic.activity[lease.Name]
+	for key, t := range activity {
+		if !t.Equal(previous[key]) {
+			changed = append(changed, key)
+		}
+	}
+	ic.activity[lease.Name] = activity
+	ic.activityLock.Unlock()
+	for _, key := range changed {
+		ic.controller.Reconcile(key)
+	}
+}
+
+// onLeaseDelete forgets the service activity reported in the lease, which
+// was already recorded on the services
+func (ic *IdleController) onLeaseDelete(obj interface{}) {
+	lease, ok := obj.(*coordinationv1.Lease)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if lease, ok = tombstone.Obj.(*coordinationv1.Lease); !ok {
+			return
+		}
+	}
+	ic.activityLock.Lock()
+	defer ic.activityLock.Unlock()
+	delete(ic.activity, lease.Name)
+}
+
+// getReportedLastActive returns the last time traffic to the service was
+// reported by any node
+func (ic *IdleController) getReportedLastActive(key string) time.Time {
+	ic.activityLock.Lock()
+	defer ic.activityLock.Unlock()
+	var lastActive time.Time
+	for _, activity := range ic.activity {
+		if t := activity[key]; t.After(lastActive) {
+			lastActive = t
+		}
+	}
+	return lastActive
+}
+
+func (ic *IdleController) reconcile(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	svc, err := ic.serviceLister.Services(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get service %s: %w", key, err)
+	}
+	if !util.ServiceTypeHasClusterIP(svc) || !util.IsClusterIPSet(svc) {
+		return nil
+	}
+
+	lastActive := svc.CreationTimestamp.Time
+	var recordedLastActive time.Time
+	if lastActiveAt, ok := svc.Annotations[LastActiveAtAnnotation]; ok {
+		t, err := time.Parse(time.RFC3339, lastActiveAt)
+		if err != nil {
+			klog.Warningf("Bad value [%s] for [%s] annotation on service [%s/%s]", lastActiveAt, LastActiveAtAnnotation, svc.Namespace, svc.Name)
+		} else {
+			recordedLastActive = t
+			if t.After(lastActive) {
+				lastActive = t
+			}
+		}
+	}
+
+	annotations := map[string]interface{}{}
+	if reportedLastActive := ic.getReportedLastActive(key); reportedLastActive.After(lastActive) {
+		lastActive = reportedLastActive
+		// the nodes report the activity every quarter of the idle timeout,
+		// only record it when it is older than half of that to bound the
+		// updates of the service while keeping the recorded time accurate
+		if reportedLastActive.Sub(recordedLastActive) >= ic.idleTimeout/8 {
+			annotations[LastActiveAtAnnotation] = reportedLastActive.Format(time.RFC3339)
+		}
+	}
+
+	_, isIdle := svc.Annotations[IdleSinceAnnotation]
+	idleFor := time.Since(lastActive)
+	if idleFor < ic.idleTimeout {
+		// check again once the service reaches the idle timeout without
+		// further activity
+		ic.controller.ReconcileAfter(key, ic.idleTimeout-idleFor)
+		if isIdle {
+			annotations[IdleSinceAnnotation] = nil
+		}
+		if err := ic.setAnnotations(svc, annotations); err != nil {
+			return err
+		}
+		if isIdle {
+			ic.eventRecorder.Eventf(svc, kapi.EventTypeNormal, serviceActiveEventReason,
+				"The service received traffic at %s", lastActive.Format(time.RFC3339))
+		}
+		return nil
+	}
+
+	if isIdle {
+		return ic.setAnnotations(svc, annotations)
+	}
+	idleSince := lastActive.Format(time.RFC3339)
+	annotations[IdleSinceAnnotation] = idleSince
+	if err := ic.setAnnotations(svc, annotations); err != nil {
+		return err
+	}
+	ic.eventRecorder.Eventf(svc, kapi.EventTypeNormal, serviceIdleEventReason,
+		"The service received no traffic since %s", idleSince)
+	return nil
+}
+
+func (ic *IdleController) setAnnotations(svc *kapi.Service, annotations map[string]interface{}) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+	if err := ic.kube.SetAnnotationsOnService(svc.Namespace, svc.Name, annotations); err != nil {
+		return fmt.Errorf("can't set service [%s/%s] annotations %v: %w", svc.Namespace, svc.Name, annotations, err)
+	}
+	return nil
+}
+
+func serviceIdleNeedsUpdate(oldObj, newObj *kapi.Service) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return oldObj.Annotations[LastActiveAtAnnotation] != newObj.Annotations[LastActiveAtAnnotation] ||
+		oldObj.Annotations[IdleSinceAnnotation] != newObj.Annotations[IdleSinceAnnotation]
+}
@@ -0,0 +1,170 @@
+package unidling
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kapi "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Idle Controller", func() {
+	var (
+		client          *fake.Clientset
+		recorder        *record.FakeRecorder
+		informerFactory informers.SharedInformerFactory
+		ctx             context.Context
+		cancel          context.CancelFunc
+	)
+
+	newService := func(name string, created time.Time, annotations map[string]string) *kapi.Service {
+		return &kapi.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Annotations:       annotations,
+			},
+			Spec: kapi.ServiceSpec{
+				ClusterIP: "10.10.10.10",
+				Ports:     []kapi.ServicePort{{Port: 80, Protocol: kapi.ProtocolTCP}},
+				Type:      kapi.ServiceTypeClusterIP,
+			},
+		}
+	}
+
+	reportActivity := func(nodeName string, activity map[string]time.Time) {
+		times := map[string]string{}
+		for key, t := range activity {
+			times[key] = t.Format(time.RFC3339)
+		}
+		value, err := json.Marshal(times)
+		Expect(err).ToNot(HaveOccurred())
+		lease := &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   config.Kubernetes.OVNConfigNamespace,
+				Name:        GetServiceActivityLeaseName(nodeName),
+				Labels:      map[string]string{ServiceActivityLabel: ""},
+				Annotations: map[string]string{ServiceActivityAnnotation: string(value)},
+			},
+		}
+		leases := client.CoordinationV1().Leases(config.Kubernetes.OVNConfigNamespace)
+		_, err = leases.Update(context.Background(), lease, metav1.UpdateOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = leases.Create(context.Background(), lease, metav1.CreateOptions{})
+		}
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getAnnotation := func(name, annotation string) func() string {
+		return func() string {
+			svc, err := client.CoreV1().Services("default").Get(context.Background(), name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return svc.Annotations[annotation]
+		}
+	}
+
+	BeforeEach(func() {
+		client = fake.NewSimpleClientset()
+		recorder = record.NewFakeRecorder(10)
+		informerFactory = informers.NewSharedInformerFactory(client, 0)
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	startController := func() *IdleController {
+		serviceInformer := informerFactory.Core().V1().Services()
+		c := NewIdleController(client, recorder, serviceInformer, time.Hour)
+		informerFactory.Start(ctx.Done())
+		cache.WaitForCacheSync(ctx.Done(), serviceInformer.Informer().HasSynced)
+		Expect(c.Start()).To(Succeed())
+		DeferCleanup(c.Stop)
+		return c
+	}
+
+	It("should signal a service without traffic for the idle timeout", func() {
+		created := time.Now().Add(-3 * time.Hour).Truncate(time.Second)
+		lastActive := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+		_, err := client.CoreV1().Services("default").Create(context.Background(),
+			newService("idle", created, map[string]string{LastActiveAtAnnotation: lastActive.Format(time.RFC3339)}),
+			metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.CoreV1().Services("default").Create(context.Background(),
+			newService("never-active", created, nil),
+			metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		startController()
+
+		Eventually(getAnnotation("idle", IdleSinceAnnotation)).Should(Equal(lastActive.Format(time.RFC3339)))
+		Eventually(getAnnotation("never-active", IdleSinceAnnotation)).Should(Equal(created.Format(time.RFC3339)))
+		Eventually(recorder.Events).Should(Receive(HavePrefix("Normal ServiceIdle")))
+		Eventually(recorder.Events).Should(Receive(HavePrefix("Normal ServiceIdle")))
+	})
+
+	It("should not signal a service with recent traffic", func() {
+		created := time.Now().Add(-3 * time.Hour)
+		_, err := client.CoreV1().Services("default").Create(context.Background(),
+			newService("active", created, map[string]string{LastActiveAtAnnotation: time.Now().Format(time.RFC3339)}),
+			metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		startController()
+
+		Consistently(getAnnotation("active", IdleSinceAnnotation)).Should(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should remove the idle signal once the service has traffic again", func() {
+		created := time.Now().Add(-3 * time.Hour)
+		_, err := client.CoreV1().Services("default").Create(context.Background(),
+			newService("idle", created, nil),
+			metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		startController()
+
+		Eventually(getAnnotation("idle", IdleSinceAnnotation)).ShouldNot(BeEmpty())
+		Eventually(recorder.Events).Should(Receive(HavePrefix("Normal ServiceIdle")))
+
+		lastActive := time.Now().Truncate(time.Second)
+		reportActivity("node1", map[string]time.Time{"default/idle": lastActive})
+
+		Eventually(getAnnotation("idle", LastActiveAtAnnotation)).Should(Equal(lastActive.Format(time.RFC3339)))
+		Eventually(getAnnotation("idle", IdleSinceAnnotation)).Should(BeEmpty())
+		Eventually(recorder.Events).Should(Receive(HavePrefix("Normal ServiceActive")))
+	})
+
+	It("should record the latest activity reported by the nodes", func() {
+		created := time.Now().Add(-3 * time.Hour)
+		_, err := client.CoreV1().Services("default").Create(context.Background(),
+			newService("active", created, nil),
+			metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		older := time.Now().Add(-30 * time.Minute).Truncate(time.Second)
+		latest := time.Now().Add(-10 * time.Minute).Truncate(time.Second)
+		reportActivity("node1", map[string]time.Time{"default/active": latest})
+		reportActivity("node2", map[string]time.Time{"default/active": older})
+
+		startController()
+
+		Eventually(getAnnotation("active", LastActiveAtAnnotation)).Should(Equal(latest.Format(time.RFC3339)))
+		Consistently(getAnnotation("active", IdleSinceAnnotation)).Should(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
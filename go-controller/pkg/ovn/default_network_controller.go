@@ -111,6 +111,10 @@ type DefaultNetworkController struct {
 	dnsNameResolver  dnsnameresolver.DNSNameResolver
 	efNodeController controller.Controller
 
+	// clusterLinkController connects the cluster router to the nodes of the
+	// clusters linked by ClusterLinks
+	clusterLinkController controller.Controller
+
 	// retry framework for egress firewall
 	retryEgressFirewalls *retry.RetryFramework
 
@@ -283,6 +287,7 @@ func (oc *DefaultNetworkController) newRetryFramework(
 		HasUpdateFunc:          hasResourceAnUpdateFunc(objectType),
 		NeedsUpdateDuringRetry: needsUpdateDuringRetry(objectType),
 		ObjType:                objectType,
+		NetworkName:            oc.GetNetworkName(),
 		EventHandler:           eventHandler,
 	}
 	r := retry.NewRetryFramework(
@@ -367,6 +372,10 @@ func (oc *DefaultNetworkController) Stop() {
 	if oc.efNodeController != nil {
 		controller.Stop(oc.efNodeController)
 	}
+	if oc.clusterLinkController != nil {
+		controller.Stop(oc.clusterLinkController)
+	}
+	oc.stopNetworkQoSController()
 	if oc.routeImportManager != nil {
 		oc.routeImportManager.ForgetNetwork(oc.GetNetworkName())
 	}
@@ -513,6 +522,9 @@ func (oc *DefaultNetworkController) Run(ctx context.Context) error {
 		if err := WithSyncDurationMetric("egress ip", oc.WatchEgressIP); err != nil {
 			return err
 		}
+		if config.OVNKubernetesFeature.EgressIPReachabilityBFD {
+			oc.eIPC.startEgressNodeBFDStatusReporter(oc.stopChan, oc.wg)
+		}
 	}
 
 	if config.OVNKubernetesFeature.EnableEgressFirewall {
@@ -557,6 +569,12 @@ func (oc *DefaultNetworkController) Run(ctx context.Context) error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableNetworkQoS {
+		if err = oc.startNetworkQoSController(); err != nil {
+			return err
+		}
+	}
+
 	if config.OVNKubernetesFeature.EnableEgressService {
 		c, err := oc.InitEgressServiceZoneController()
 		if err != nil {
@@ -584,6 +602,14 @@ func (oc *DefaultNetworkController) Run(ctx context.Context) error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableClusterLink {
+		oc.clusterLinkController = oc.newClusterLinkController()
+		err = controller.StartWithInitialSync(oc.syncClusterLinks, oc.clusterLinkController)
+		if err != nil {
+			return fmt.Errorf("unable to start ClusterLink controller: %w", err)
+		}
+	}
+
 	end := time.Since(start)
 	klog.Infof("Completing all the Watchers took %v", end)
 	metrics.MetricOVNKubeControllerSyncDuration.WithLabelValues("all watchers").Set(end.Seconds())
@@ -877,7 +903,11 @@ func (h *defaultNetworkControllerEventHandler) AddResource(obj interface{}, from
 
 	case factory.EgressIPType:
 		eIP := obj.(*egressipv1.EgressIP)
-		return h.oc.eIPC.reconcileEgressIP(nil, eIP)
+		err := h.oc.eIPC.reconcileEgressIP(nil, eIP)
+		if statusErr := h.oc.eIPC.setEgressIPZoneStatus(eIP.Name, err); statusErr != nil {
+			klog.Errorf("Failed to update EgressIP %s status, error: %v", eIP.Name, statusErr)
+		}
+		return err
 
 	case factory.EgressIPNamespaceType:
 		namespace := obj.(*kapi.Namespace)
@@ -906,6 +936,11 @@ func (h *defaultNetworkControllerEventHandler) AddResource(obj interface{}, from
 		if err != nil {
 			return err
 		}
+		if config.OVNKubernetesFeature.EgressIPReachabilityBFD {
+			if err := h.oc.eIPC.reconcileEgressNodeBFD(node); err != nil {
+				return err
+			}
+		}
 		// Add routing specific to Egress IP NOTE: GARP configuration that
 		// Egress IP depends on is added from the gateway reconciliation logic
 		return h.oc.eIPC.addEgressNode(node)
@@ -995,7 +1030,8 @@ func (h *defaultNetworkControllerEventHandler) UpdateResource(oldObj, newObj int
 					nodeGatewayMTUSupportChanged(oldNode, newNode))
 				_, hoSync := h.oc.hybridOverlayFailed.Load(newNode.Name)
 				_, syncZoneIC := h.oc.syncZoneICFailed.Load(newNode.Name)
-				syncZoneIC = syncZoneIC || zoneClusterChanged || primaryAddrChanged(oldNode, newNode)
+				syncZoneIC = syncZoneIC || zoneClusterChanged || primaryAddrChanged(oldNode, newNode) ||
+					util.NodeEncapIPsAnnotationChanged(oldNode, newNode)
 				nodeSyncsParam = &nodeSyncs{
 					nodeSync,
 					clusterRtrSync,
@@ -1019,7 +1055,8 @@ func (h *defaultNetworkControllerEventHandler) UpdateResource(oldObj, newObj int
 			// Check if the node moved from local zone to remote zone and if so syncZoneIC should be set to true.
 			// Also check if node subnet changed, so static routes are properly set
 			// Also check if the node is used to be a hybrid overlay node
-			syncZoneIC = syncZoneIC || h.oc.isLocalZoneNode(oldNode) || nodeSubnetChanged || zoneClusterChanged || primaryAddrChanged(oldNode, newNode) || switchToOvnNode
+			syncZoneIC = syncZoneIC || h.oc.isLocalZoneNode(oldNode) || nodeSubnetChanged || zoneClusterChanged || primaryAddrChanged(oldNode, newNode) || switchToOvnNode ||
+				util.NodeEncapIPsAnnotationChanged(oldNode, newNode)
 			if syncZoneIC {
 				klog.Infof("Node %s in remote zone %s needs interconnect zone sync up. Zone cluster changed: %v",
 					newNode.Name, util.GetNodeZone(newNode), zoneClusterChanged)
@@ -1042,7 +1079,11 @@ func (h *defaultNetworkControllerEventHandler) UpdateResource(oldObj, newObj int
 	case factory.EgressIPType:
 		oldEIP := oldObj.(*egressipv1.EgressIP)
 		newEIP := newObj.(*egressipv1.EgressIP)
-		return h.oc.eIPC.reconcileEgressIP(oldEIP, newEIP)
+		err := h.oc.eIPC.reconcileEgressIP(oldEIP, newEIP)
+		if statusErr := h.oc.eIPC.setEgressIPZoneStatus(newEIP.Name, err); statusErr != nil {
+			klog.Errorf("Failed to update EgressIP %s status, error: %v", newEIP.Name, statusErr)
+		}
+		return err
 
 	case factory.EgressIPNamespaceType:
 		oldNamespace := oldObj.(*kapi.Namespace)
@@ -1080,6 +1121,11 @@ func (h *defaultNetworkControllerEventHandler) UpdateResource(oldObj, newObj int
 				return err
 			}
 		}
+		if config.OVNKubernetesFeature.EgressIPReachabilityBFD && egressNodeBFDChanged(oldNode, newNode) {
+			if err := h.oc.eIPC.reconcileEgressNodeBFD(newNode); err != nil {
+				return err
+			}
+		}
 		return h.oc.eIPC.addEgressNode(newNode)
 
 	case factory.NamespaceType:
@@ -1115,7 +1161,7 @@ func (h *defaultNetworkControllerEventHandler) DeleteResource(obj, cachedObj int
 		if err := h.oc.deleteEgressFirewall(egressFirewall); err != nil {
 			return err
 		}
-		metrics.UpdateEgressFirewallRuleCount(float64(-len(egressFirewall.Spec.Egress)))
+		metrics.UpdateEgressFirewallRuleCount(float64(-len(egressFirewall.Spec.Egress)), h.oc.GetNetworkName())
 		metrics.DecrementEgressFirewallCount()
 		return nil
 
@@ -1138,6 +1184,11 @@ func (h *defaultNetworkControllerEventHandler) DeleteResource(obj, cachedObj int
 		if err != nil {
 			return err
 		}
+		if config.OVNKubernetesFeature.EgressIPReachabilityBFD {
+			if err := h.oc.eIPC.deleteEgressNodeBFD(node.Name); err != nil {
+				return err
+			}
+		}
 		// Update node in zone cache; remove the node key since node has been deleted.
 		h.oc.eIPC.nodeZoneState.LockKey(node.Name)
 		h.oc.eIPC.nodeZoneState.Delete(node.Name)
@@ -833,7 +833,7 @@ func (oc *DefaultNetworkController) setEgressFirewallStatus(egressFirewall *egre
 		newMsg = types.EgressFirewallErrorMsg + ": " + handlerErr.Error()
 	} else {
 		newMsg = egressFirewallAppliedCorrectly
-		metrics.UpdateEgressFirewallRuleCount(float64(len(egressFirewall.Spec.Egress)))
+		metrics.UpdateEgressFirewallRuleCount(float64(len(egressFirewall.Spec.Egress)), oc.GetNetworkName())
 		metrics.IncrementEgressFirewallCount()
 	}
 
@@ -421,16 +421,19 @@ func (oc *DefaultNetworkController) deletePodGWRoutesForNamespace(pod *kapi.Pod,
 func (oc *DefaultNetworkController) deleteGWRoutesForNamespace(namespace string, matchGWs sets.Set[string]) error {
 	deleteAll := (matchGWs == nil || matchGWs.Len() == 0)
 
-	policyGWIPs, err := oc.apbExternalRouteController.GetDynamicGatewayIPsForTargetNamespace(namespace)
-	if err != nil {
-		return err
-	}
-	policyStaticGWIPs, err := oc.apbExternalRouteController.GetStaticGatewayIPsForTargetNamespace(namespace)
-	if err != nil {
-		return err
-	}
-	policyGWIPs = policyGWIPs.Union(policyStaticGWIPs)
 	return oc.externalGatewayRouteInfo.CleanupNamespace(namespace, func(routeInfo *apbroutecontroller.RouteInfo) error {
+		policyGWIPs := sets.New[string]()
+		pod, err := oc.watchFactory.GetPod(routeInfo.PodName.Namespace, routeInfo.PodName.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		// policies don't target deleted pods
+		if pod != nil {
+			policyGWIPs, err = oc.apbExternalRouteController.GetGatewayIPsForTargetPod(pod)
+			if err != nil {
+				return err
+			}
+		}
 		for podIP, routes := range routeInfo.PodExternalRoutes {
 			for gw, gr := range routes {
 				if (deleteAll || matchGWs.Has(gw)) && !policyGWIPs.Has(gw) {
@@ -447,17 +450,13 @@ func (oc *DefaultNetworkController) deleteGWRoutesForNamespace(namespace string,
 }
 
 // deleteGwRoutesForPod handles deleting all routes to gateways for a pod IP on a specific GR
-func (oc *DefaultNetworkController) deleteGWRoutesForPod(name ktypes.NamespacedName, podIPNets []*net.IPNet) (err error) {
+func (oc *DefaultNetworkController) deleteGWRoutesForPod(pod *kapi.Pod, podIPNets []*net.IPNet) (err error) {
+	name := ktypes.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
 	return oc.externalGatewayRouteInfo.Cleanup(name, func(routeInfo *apbroutecontroller.RouteInfo) error {
-		policyGWIPs, err := oc.apbExternalRouteController.GetDynamicGatewayIPsForTargetNamespace(name.Namespace)
-		if err != nil {
-			return err
-		}
-		policyStaticGWIPs, err := oc.apbExternalRouteController.GetStaticGatewayIPsForTargetNamespace(name.Namespace)
+		policyGWIPs, err := oc.apbExternalRouteController.GetGatewayIPsForTargetPod(pod)
 		if err != nil {
 			return err
 		}
-		policyGWIPs = policyGWIPs.Union(policyStaticGWIPs)
 
 		for _, podIPNet := range podIPNets {
 			podIP := podIPNet.IP.String()
@@ -511,15 +510,10 @@ func (oc *DefaultNetworkController) addGWRoutesForPod(gateways []*gatewayInfo, p
 	port := portPrefix + types.GWRouterToExtSwitchPrefix + gr
 
 	return oc.externalGatewayRouteInfo.CreateOrLoad(podNsName, func(routeInfo *apbroutecontroller.RouteInfo) error {
-		policyGWIPs, err := oc.apbExternalRouteController.GetDynamicGatewayIPsForTargetNamespace(podNsName.Namespace)
-		if err != nil {
-			return err
-		}
-		policyStaticGWIPs, err := oc.apbExternalRouteController.GetStaticGatewayIPsForTargetNamespace(podNsName.Namespace)
+		policyGWIPs, err := oc.apbExternalRouteController.GetGatewayIPsForTargetPod(pod)
 		if err != nil {
 			return err
 		}
-		policyGWIPs = policyGWIPs.Union(policyStaticGWIPs)
 
 		for _, podIPNet := range podIfAddrs {
 			for _, gateway := range gateways {
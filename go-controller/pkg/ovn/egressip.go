@@ -19,6 +19,7 @@ import (
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/applyconfiguration/egressip/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
@@ -38,10 +39,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	kapi "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -66,10 +69,17 @@ const (
 	NoReRoutePodToJoin      egressIPNoReroutePolicyName = "EIP-No-Reroute-Pod-To-Join"
 	NoReRoutePodToNode      egressIPNoReroutePolicyName = "EIP-No-Reroute-Pod-To-Node"
 	NoReRouteUDNPodToCDNSvc egressIPNoReroutePolicyName = "EIP-No-Reroute-Pod-To-CDN-Svc"
+	NoReRoutePodToLinkedPod egressIPNoReroutePolicyName = "EIP-No-Reroute-Pod-To-Linked-Pod"
 	ReplyTrafficMark        egressIPQoSRuleName         = "EgressIP-Mark-Reply-Traffic"
 	dbIDEIPNamePodDivider                               = "_"
 )
 
+const (
+	egressIPProgrammedReason    = "EgressIPProgrammed"
+	egressIPNotProgrammedReason = "EgressIPProgrammingFailed"
+	egressIPProgrammedCorrectly = "EgressIP programmed correctly"
+)
+
 func getEgressIPAddrSetDbIDs(name egressIPAddrSetName, network, controller string) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressIP, controller, map[libovsdbops.ExternalIDKey]string{
 		// egress ip creates cluster-wide address sets with egressIpAddrSetName
@@ -249,11 +259,13 @@ func NewEIPController(
 //	  CASE 3.2: Only Namespace selectors on Spec changed
 //	  CASE 3.3: Only Pod Selectors on Spec changed
 //	  CASE 3.4: Both Namespace && Pod Selectors on Spec changed
+//	  CASE 3.5: Destination CIDRs on Spec changed, we do a full teardown and
+//	            setup for all statuses
 //	}
 //
 // NOTE: `Spec.EgressIPs“ updates for EIP object are not processed here, that is the job of cluster manager
 //
-//	We only care about `Spec.NamespaceSelector`, `Spec.PodSelector` and `Status` field
+//	We only care about `Spec.NamespaceSelector`, `Spec.PodSelector`, `Spec.DestinationCIDRs` and `Status` field
 func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (err error) {
 	// CASE 1: EIP object deletion, we need to teardown database configuration for all the statuses
 	if old != nil && new == nil {
@@ -282,6 +294,22 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 	if old != nil && new != nil {
 		oldEIP := old
 		newEIP := new
+		// CASE 3.5: Destination CIDRs on Spec changed, the match of the
+		// existing configuration is not updated in place so tear it all down
+		// and set it up again
+		if !reflect.DeepEqual(oldEIP.Spec.DestinationCIDRs, newEIP.Spec.DestinationCIDRs) {
+			if len(oldEIP.Status.Items) > 0 {
+				if err := e.deleteEgressIPAssignments(old.Name, oldEIP.Status.Items); err != nil {
+					return err
+				}
+			}
+			if len(newEIP.Status.Items) > 0 {
+				if err := e.addEgressIPAssignments(new.Name, newEIP.Status.Items, mark, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
 		// CASE 3.1: we need to see which statuses
 		//        1) need teardown
 		//        2) need setup
@@ -663,6 +691,57 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 	return nil
 }
 
+// setEgressIPZoneStatus reports in the EgressIP status condition of this zone
+// whether the EgressIP was correctly programmed. Each zone's ovnkube-controller
+// calls this, hence the status is updated using server side apply.
+func (e *EgressIPController) setEgressIPZoneStatus(name string, handlerErr error) error {
+	eIP, err := e.watchFactory.GetEgressIP(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	// wait for the cluster manager to initialize the status, which it does
+	// along with the Assigned condition, before reporting anything
+	if meta.FindStatusCondition(eIP.Status.Conditions, egressipv1.EgressIPAssignedCondition) == nil {
+		return nil
+	}
+	newCondition := metav1.Condition{
+		Type:    egressipv1.EgressIPProgrammedInZoneConditionPrefix + e.zone,
+		Status:  metav1.ConditionTrue,
+		Reason:  egressIPProgrammedReason,
+		Message: egressIPProgrammedCorrectly,
+	}
+	if handlerErr != nil {
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = egressIPNotProgrammedReason
+		newCondition.Message = types.EgressIPErrorMsg + ": " + handlerErr.Error()
+	}
+	lastTransitionTime := metav1.NewTime(time.Now())
+	if existingCondition := meta.FindStatusCondition(eIP.Status.Conditions, newCondition.Type); existingCondition != nil {
+		if existingCondition.Status == newCondition.Status && existingCondition.Reason == newCondition.Reason &&
+			existingCondition.Message == newCondition.Message {
+			return nil
+		}
+		if existingCondition.Status == newCondition.Status {
+			lastTransitionTime = existingCondition.LastTransitionTime
+		}
+	}
+	newConditionApply := &metaapplyv1.ConditionApplyConfiguration{
+		Type:               &newCondition.Type,
+		Status:             &newCondition.Status,
+		LastTransitionTime: &lastTransitionTime,
+		Reason:             &newCondition.Reason,
+		Message:            &newCondition.Message,
+	}
+	applyObj := egressipapply.EgressIP(name).
+		WithStatus(egressipapply.EgressIPStatus().WithConditions(newConditionApply))
+	_, err = e.kube.EIPClient.K8sV1().EgressIPs().Apply(context.TODO(), applyObj,
+		metav1.ApplyOptions{FieldManager: e.zone, Force: true})
+	return err
+}
+
 // main reconcile functions end here and local zone controller functions begin
 
 func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, namespaceSelector, podSelector metav1.LabelSelector) error {
@@ -1351,13 +1430,20 @@ func (e *EgressIPController) syncStaleGWMarkRules(egressIPCache egressIPCache) e
 	processPodFn := func(ops []ovsdb.Operation, eIPName, podKey, mark, routerName, networkName string, podIPs sets.Set[string], isEIPIPv6 bool) ([]ovsdb.Operation, error) {
 		podNamespace, podName := getPodNamespaceAndNameFromKey(podKey)
 		dbIDs := getEgressIPLRPSNATMarkDbIDs(eIPName, podNamespace, podName, getEIPIPFamily(isEIPIPv6), networkName, e.controllerName)
+		dstMatch, applies, err := e.getEgressIPDestinationMatch(eIPName, isEIPIPv6)
+		if err != nil {
+			return ops, err
+		}
+		if !applies {
+			return ops, nil
+		}
 		for _, podIPStr := range podIPs.UnsortedList() {
 			podIP := net.ParseIP(podIPStr)
 			if podIP == nil || utilnet.IsIPv6(podIP) != isEIPIPv6 && !isSupportedIP(podIP) {
 				continue
 			}
 			lrp := nbdb.LogicalRouterPolicy{
-				Match:       fmt.Sprintf("%s.src == %s", getEIPIPFamily(isEIPIPv6), podIPStr),
+				Match:       withEgressIPDestinationMatch(fmt.Sprintf("%s.src == %s", getEIPIPFamily(isEIPIPv6), podIPStr), dstMatch),
 				Priority:    types.EgressIPSNATMarkPriority,
 				Action:      nbdb.LogicalRouterPolicyActionAllow,
 				ExternalIDs: dbIDs.GetExternalIDs(),
@@ -2283,6 +2369,15 @@ func (e *EgressIPController) addPodEgressIPAssignment(ni util.NetInfo, egressIPN
 		return fmt.Errorf("could not calculate the next hop for pod %s/%s when configuring egress IP %s"+
 			" IP %s", pod.Namespace, pod.Name, egressIPName, status.EgressIP)
 	}
+	dstMatch, applies, err := e.getEgressIPDestinationMatch(egressIPName, utilnet.IsIPv6(eIPIP))
+	if err != nil {
+		return err
+	}
+	if !applies {
+		klog.V(5).Infof("EgressIP %s has no destination CIDR of the IP family of %s, nothing to configure for pod %s/%s",
+			egressIPName, status.EgressIP, pod.Namespace, pod.Name)
+		return nil
+	}
 	var ops []ovsdb.Operation
 	if loadedEgressNode && isLocalZoneEgressNode {
 		// create NATs for CDNs only
@@ -2290,14 +2385,14 @@ func (e *EgressIPController) addPodEgressIPAssignment(ni util.NetInfo, egressIPN
 		// L2 UDNs require LRPs with reroute action with a pkt_mark option attached to GW router.
 		if isOVNNetwork {
 			if ni.IsDefault() {
-				ops, err = e.createNATRuleOps(ni, nil, podIPs, status, egressIPName, pod.Namespace, pod.Name)
+				ops, err = e.createNATRuleOps(ni, nil, podIPs, status, egressIPName, pod.Namespace, pod.Name, dstMatch)
 				if err != nil {
 					return fmt.Errorf("unable to create NAT rule ops for status: %v, err: %v", status, err)
 				}
 
 			} else if ni.IsSecondary() && ni.TopologyType() == types.Layer3Topology {
 				// not required for L2 because we always have LRPs using reroute action to pkt mark
-				ops, err = e.createGWMarkPolicyOps(ni, ops, podIPs, status, mark, pod.Namespace, pod.Name, egressIPName)
+				ops, err = e.createGWMarkPolicyOps(ni, ops, podIPs, status, mark, pod.Namespace, pod.Name, egressIPName, dstMatch)
 				if err != nil {
 					return fmt.Errorf("unable to create GW router LRP ops to packet mark pod %s/%s: %v", pod.Namespace, pod.Name, err)
 				}
@@ -2310,7 +2405,7 @@ func (e *EgressIPController) addPodEgressIPAssignment(ni util.NetInfo, egressIPN
 			if err != nil {
 				return err
 			}
-			ops, err = e.createReroutePolicyOps(ni, ops, podIPs, status, mark, egressIPName, nextHopIP, routerName, pod.Namespace, pod.Name)
+			ops, err = e.createReroutePolicyOps(ni, ops, podIPs, status, mark, egressIPName, nextHopIP, routerName, pod.Namespace, pod.Name, dstMatch)
 			if err != nil {
 				return fmt.Errorf("unable to create logical router policy ops %v, err: %v", status, err)
 			}
@@ -2331,12 +2426,14 @@ func (e *EgressIPController) addPodEgressIPAssignment(ni util.NetInfo, egressIPN
 	// don't add a reroute policy if the egress node towards which we are adding this doesn't exist
 	if loadedEgressNode && loadedPodNode {
 		if isLocalZonePod || (isLocalZoneEgressNode && ni.IsSecondary() && ni.TopologyType() == types.Layer2Topology) {
-			ops, err = e.createReroutePolicyOps(ni, ops, podIPs, status, mark, egressIPName, nextHopIP, routerName, pod.Namespace, pod.Name)
+			ops, err = e.createReroutePolicyOps(ni, ops, podIPs, status, mark, egressIPName, nextHopIP, routerName, pod.Namespace, pod.Name, dstMatch)
 			if err != nil {
 				return fmt.Errorf("unable to create logical router policy ops, err: %v", err)
 			}
 		}
-		if isLocalZonePod {
+		// the SNAT to the node IP is still needed for the traffic to other
+		// destinations when the egress IP is restricted to some of them
+		if isLocalZonePod && dstMatch == "" {
 			ops, err = e.deleteExternalGWPodSNATOps(ni, ops, pod, podIPs, status, isOVNNetwork)
 			if err != nil {
 				return err
@@ -2633,6 +2730,38 @@ func ipFamilyName(isIPv6 bool) string {
 	return string(IPFamilyValueV4)
 }
 
+// getEgressIPDestinationMatch returns the match restricting the egress IP
+// logical router policies and NATs to the destination CIDRs of the EgressIP
+// of the provided IP family, if any. It returns false if the EgressIP has
+// destination CIDRs but none of that IP family, in which case no traffic of
+// that IP family uses the egress IP.
+func (e *EgressIPController) getEgressIPDestinationMatch(egressIPName string, isIPv6 bool) (string, bool, error) {
+	eIP, err := e.watchFactory.GetEgressIP(egressIPName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", true, nil
+		}
+		return "", false, fmt.Errorf("failed to get EgressIP %s: %w", egressIPName, err)
+	}
+	if len(eIP.Spec.DestinationCIDRs) == 0 {
+		return "", true, nil
+	}
+	cidrs := util.MatchAllIPNetsStringFamily(isIPv6, eIP.Spec.DestinationCIDRs)
+	if len(cidrs) == 0 {
+		return "", false, nil
+	}
+	return fmt.Sprintf("%s.dst == {%s}", ipFamilyName(isIPv6), strings.Join(cidrs, ", ")), true, nil
+}
+
+// withEgressIPDestinationMatch appends the egress IP destination match to the
+// provided match
+func withEgressIPDestinationMatch(match, dstMatch string) string {
+	if dstMatch == "" {
+		return match
+	}
+	return match + " && " + dstMatch
+}
+
 func (e *EgressIPController) getTransitIP(nodeName string, wantsIPv6 bool) (string, error) {
 	// fetch node annotation of the egress node
 	node, err := e.watchFactory.GetNode(nodeName)
@@ -2707,7 +2836,7 @@ func (e *EgressIPController) getNextHop(ni util.NetInfo, egressNodeName, egressI
 // enabled, the appropriate transit switch port.
 // This function should be called with lock on nodeZoneState cache key status.Node
 func (e *EgressIPController) createReroutePolicyOps(ni util.NetInfo, ops []ovsdb.Operation, podIPNets []*net.IPNet, status egressipv1.EgressIPStatusItem,
-	mark util.EgressIPMark, egressIPName, nextHopIP, routerName, podNamespace, podName string) ([]ovsdb.Operation, error) {
+	mark util.EgressIPMark, egressIPName, nextHopIP, routerName, podNamespace, podName, dstMatch string) ([]ovsdb.Operation, error) {
 	isEgressIPv6 := utilnet.IsIPv6String(status.EgressIP)
 	ipFamily := getEIPIPFamily(isEgressIPv6)
 	options := make(map[string]string)
@@ -2724,7 +2853,7 @@ func (e *EgressIPController) createReroutePolicyOps(ni util.NetInfo, ops []ovsdb
 	for _, podIPNet := range util.MatchAllIPNetFamily(isEgressIPv6, podIPNets) {
 
 		lrp := nbdb.LogicalRouterPolicy{
-			Match:       fmt.Sprintf("%s.src == %s", ipFamilyName(isEgressIPv6), podIPNet.IP.String()),
+			Match:       withEgressIPDestinationMatch(fmt.Sprintf("%s.src == %s", ipFamilyName(isEgressIPv6), podIPNet.IP.String()), dstMatch),
 			Priority:    types.EgressIPReroutePriority,
 			Nexthops:    []string{nextHopIP},
 			Action:      nbdb.LogicalRouterPolicyActionReroute,
@@ -2777,7 +2906,7 @@ func (e *EgressIPController) deleteReroutePolicyOps(ni util.NetInfo, ops []ovsdb
 }
 
 func (e *EgressIPController) createGWMarkPolicyOps(ni util.NetInfo, ops []ovsdb.Operation, podIPNets []*net.IPNet, status egressipv1.EgressIPStatusItem,
-	mark util.EgressIPMark, podNamespace, podName, egressIPName string) ([]ovsdb.Operation, error) {
+	mark util.EgressIPMark, podNamespace, podName, egressIPName, dstMatch string) ([]ovsdb.Operation, error) {
 	isEgressIPv6 := utilnet.IsIPv6String(status.EgressIP)
 	routerName := ni.GetNetworkScopedGWRouterName(status.Node)
 	options := make(map[string]string)
@@ -2792,7 +2921,7 @@ func (e *EgressIPController) createGWMarkPolicyOps(ni util.NetInfo, ops []ovsdb.
 	// Handle all pod IPs that match the egress IP address family
 	for _, podIPNet := range util.MatchAllIPNetFamily(isEgressIPv6, podIPNets) {
 		lrp := nbdb.LogicalRouterPolicy{
-			Match:       fmt.Sprintf("%s.src == %s && pkt.mark == 0", ovnIPFamilyName, podIPNet.IP.String()), // only add pkt mark if one already doesn't exist
+			Match:       withEgressIPDestinationMatch(fmt.Sprintf("%s.src == %s && pkt.mark == 0", ovnIPFamilyName, podIPNet.IP.String()), dstMatch), // only add pkt mark if one already doesn't exist
 			Priority:    types.EgressIPSNATMarkPriority,
 			Action:      nbdb.LogicalRouterPolicyActionAllow,
 			ExternalIDs: dbIDs.GetExternalIDs(),
@@ -3147,6 +3276,32 @@ func createDefaultNoReroutePodPolicies(nbClient libovsdbclient.Client, network,
 	return nil
 }
 
+// ensureDefaultNoRerouteLinkedPodPolicies ensures egress pods east<->west traffic with the pods of the clusters linked
+// by ClusterLinks, which is routed to them over the transit switch just like the traffic to the pods of the local
+// cluster. The policy of an IP family is removed when there are no remote pod subnets of that family.
+func ensureDefaultNoRerouteLinkedPodPolicies(nbClient libovsdbclient.Client, network, controller, routerName string,
+	clusterSubnets, remoteSubnets []*net.IPNet) error {
+	for _, ipFamily := range []egressIPFamilyValue{IPFamilyValueV4, IPFamilyValueV6} {
+		isIPv6 := ipFamily == IPFamilyValueV6
+		dbIDs := getEgressIPLRPNoReRouteDbIDs(types.DefaultNoRereoutePriority, NoReRoutePodToLinkedPod, ipFamily, network, controller)
+		localSubnets := util.MatchAllIPNetFamily(isIPv6, clusterSubnets)
+		linkedSubnets := util.MatchAllIPNetFamily(isIPv6, remoteSubnets)
+		if len(localSubnets) == 0 || len(linkedSubnets) == 0 {
+			p := libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](dbIDs, nil)
+			if err := libovsdbops.DeleteLogicalRouterPoliciesWithPredicate(nbClient, routerName, p); err != nil {
+				return fmt.Errorf("unable to delete %s no-reroute linked pod policy, err: %v", ipFamily, err)
+			}
+			continue
+		}
+		match := fmt.Sprintf("%[1]s.src == {%[2]s} && %[1]s.dst == {%[3]s}", ipFamily,
+			util.JoinIPNets(localSubnets, ", "), util.JoinIPNets(linkedSubnets, ", "))
+		if err := createLogicalRouterPolicy(nbClient, routerName, match, types.DefaultNoRereoutePriority, nil, dbIDs); err != nil {
+			return fmt.Errorf("unable to create %s no-reroute linked pod policy, err: %v", ipFamily, err)
+		}
+	}
+	return nil
+}
+
 // createDefaultReRouteQoSRule builds QoS rule ops to be created on every node's switch that let's us
 // mark packets that are tracked in conntrack and replies emerging from the pod.
 // This mark is then matched on the reroute policies to determine if its a reply packet
@@ -3490,7 +3645,7 @@ func createLogicalRouterPolicy(nbClient libovsdbclient.Client, clusterRouter, ma
 	return nil
 }
 
-func (e *EgressIPController) buildSNATFromEgressIPStatus(ni util.NetInfo, podIP net.IP, status egressipv1.EgressIPStatusItem, egressIPName, podNamespace, podName string) (*nbdb.NAT, error) {
+func (e *EgressIPController) buildSNATFromEgressIPStatus(ni util.NetInfo, podIP net.IP, status egressipv1.EgressIPStatusItem, egressIPName, podNamespace, podName, dstMatch string) (*nbdb.NAT, error) {
 	logicalIP := &net.IPNet{
 		IP:   podIP,
 		Mask: util.GetIPFullMask(podIP),
@@ -3502,18 +3657,18 @@ func (e *EgressIPController) buildSNATFromEgressIPStatus(ni util.NetInfo, podIP
 	externalIP := net.ParseIP(status.EgressIP)
 	logicalPort := ni.GetNetworkScopedK8sMgmtIntfName(status.Node)
 	externalIds := getEgressIPNATDbIDs(egressIPName, podNamespace, podName, ipFamily, e.controllerName).GetExternalIDs()
-	nat := libovsdbops.BuildSNAT(&externalIP, logicalIP, logicalPort, externalIds)
+	nat := libovsdbops.BuildSNATWithMatch(&externalIP, logicalIP, logicalPort, externalIds, dstMatch)
 	return nat, nil
 }
 
 func (e *EgressIPController) createNATRuleOps(ni util.NetInfo, ops []ovsdb.Operation, podIPs []*net.IPNet, status egressipv1.EgressIPStatusItem,
-	egressIPName, podNamespace, podName string) ([]ovsdb.Operation, error) {
+	egressIPName, podNamespace, podName, dstMatch string) ([]ovsdb.Operation, error) {
 	nats := make([]*nbdb.NAT, 0, len(podIPs))
 	var nat *nbdb.NAT
 	var err error
 	for _, podIP := range podIPs {
 		if (utilnet.IsIPv6String(status.EgressIP) && utilnet.IsIPv6(podIP.IP)) || (!utilnet.IsIPv6String(status.EgressIP) && !utilnet.IsIPv6(podIP.IP)) {
-			nat, err = e.buildSNATFromEgressIPStatus(ni, podIP.IP, status, egressIPName, podNamespace, podName)
+			nat, err = e.buildSNATFromEgressIPStatus(ni, podIP.IP, status, egressIPName, podNamespace, podName, dstMatch)
 			if err != nil {
 				return nil, err
 			}
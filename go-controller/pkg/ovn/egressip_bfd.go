@@ -0,0 +1,286 @@
+package ovn
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	libovsdbcache "github.com/ovn-org/libovsdb/cache"
+	"github.com/ovn-org/libovsdb/model"
+	libovsdb "github.com/ovn-org/libovsdb/ovsdb"
+
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+)
+
+// egressIPBFDStatusRetryInterval is how long the BFD status reporter waits
+// before retrying a failed node annotation update
+const egressIPBFDStatusRetryInterval = time.Second
+
+// When egress IP BFD reachability is enabled, every zone keeps a BFD session
+// from the join switch port of its node gateway router towards the gateway
+// router join IP of each remote egress assignable node, and a zone hosting an
+// egress assignable node keeps a session towards every remote node, so that
+// sessions are configured on both ends. ovn-controller only runs BFD on ports
+// bound to its chassis, which the gateway router ports are, unlike the
+// distributed cluster router ports. The join IPs of remote nodes are reachable
+// through the transit switch routes interconnect adds to the cluster router.
+// The state of the sessions is published on the local node through the
+// OVNNodeEgressIPBFDStatus annotation, which cluster manager uses as egress
+// node reachability instead of probing the nodes itself.
+
+func getEgressIPBFDDbIDs(nodeName string, ipFamily egressIPFamilyValue, controller string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.BFDEgressIP, controller, map[libovsdbops.ExternalIDKey]string{
+		libovsdbops.ObjectNameKey: nodeName,
+		libovsdbops.IPFamilyKey:   string(ipFamily),
+	})
+}
+
+func isEgressAssignableNode(node *corev1.Node) bool {
+	_, ok := node.Labels[util.GetNodeEgressLabel()]
+	return ok
+}
+
+// egressNodeBFDChanged returns true if the node changed in a way that affects
+// the BFD sessions towards or from it
+func egressNodeBFDChanged(oldNode, newNode *corev1.Node) bool {
+	return isEgressAssignableNode(oldNode) != isEgressAssignableNode(newNode) ||
+		util.NodeGatewayRouterLRPAddrsAnnotationChanged(oldNode, newNode) ||
+		util.NodeZoneAnnotationChanged(oldNode, newNode)
+}
+
+// reconcileEgressNodeBFD ensures the BFD sessions related to the provided node.
+// A change of the local node affects the sessions towards all remote nodes.
+func (e *EgressIPController) reconcileEgressNodeBFD(node *corev1.Node) error {
+	if !e.isLocalZoneNode(node) {
+		return e.ensureEgressNodeBFD(node)
+	}
+	nodes, err := e.watchFactory.GetNodes()
+	if err != nil {
+		return fmt.Errorf("unable to list nodes for egress IP BFD sessions: %w", err)
+	}
+	var errs []error
+	for _, remoteNode := range nodes {
+		if e.isLocalZoneNode(remoteNode) {
+			continue
+		}
+		if err := e.ensureEgressNodeBFD(remoteNode); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to reconcile egress IP BFD sessions for local node %s: %v", node.Name, errs)
+	}
+	return nil
+}
+
+// ensureEgressNodeBFD creates, updates or removes the BFD sessions from the
+// local gateway router towards the provided remote node
+func (e *EgressIPController) ensureEgressNodeBFD(node *corev1.Node) error {
+	localNodeName, err := e.getALocalZoneNodeName()
+	if err != nil {
+		// nothing to do until the local node has been processed
+		klog.V(5).Infof("Skipping egress IP BFD sessions for node %s: %v", node.Name, err)
+		return nil
+	}
+	localNode, err := e.watchFactory.GetNode(localNodeName)
+	if err != nil {
+		return fmt.Errorf("unable to get local node %s: %w", localNodeName, err)
+	}
+
+	if util.NoHostSubnet(node) || (!isEgressAssignableNode(node) && !isEgressAssignableNode(localNode)) {
+		return e.deleteEgressNodeBFD(node.Name)
+	}
+	joinIPs, err := util.ParseNodeGatewayRouterJoinAddrs(node, types.DefaultNetworkName)
+	if err != nil {
+		if util.IsAnnotationNotSetError(err) {
+			// the node is not ready yet, we will get another update
+			return nil
+		}
+		return fmt.Errorf("unable to parse gateway router join addresses of node %s: %w", node.Name, err)
+	}
+	logicalPort := types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + localNodeName
+	desired := map[string]*nbdb.BFD{}
+	for _, joinIP := range joinIPs {
+		ip := joinIP.IP
+		isIPv6 := utilnet.IsIPv6(ip)
+		if (isIPv6 && !e.v6) || (!isIPv6 && !e.v4) {
+			continue
+		}
+		dbIDs := getEgressIPBFDDbIDs(node.Name, getEIPIPFamily(isIPv6), e.controllerName)
+		desired[ip.String()] = &nbdb.BFD{
+			LogicalPort: logicalPort,
+			DstIP:       ip.String(),
+			ExternalIDs: dbIDs.GetExternalIDs(),
+		}
+	}
+
+	existing, err := e.findEgressNodeBFD(node.Name)
+	if err != nil {
+		return err
+	}
+	var stale []*nbdb.BFD
+	for _, bfd := range existing {
+		if want, ok := desired[bfd.DstIP]; ok && want.LogicalPort == bfd.LogicalPort &&
+			reflect.DeepEqual(want.ExternalIDs, bfd.ExternalIDs) {
+			delete(desired, bfd.DstIP)
+			continue
+		}
+		stale = append(stale, bfd)
+	}
+	if len(stale) > 0 {
+		if err := libovsdbops.DeleteBFDs(e.nbClient, stale...); err != nil {
+			return fmt.Errorf("failed to delete stale egress IP BFD sessions towards node %s: %w", node.Name, err)
+		}
+	}
+	if len(desired) == 0 {
+		return nil
+	}
+	var ops []libovsdb.Operation
+	for _, bfd := range desired {
+		ops, err = libovsdbops.CreateOrUpdateBFDOps(e.nbClient, ops, bfd)
+		if err != nil {
+			return fmt.Errorf("failed to create egress IP BFD session ops towards node %s: %w", node.Name, err)
+		}
+	}
+	if _, err := libovsdbops.TransactAndCheck(e.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to create egress IP BFD sessions towards node %s: %w", node.Name, err)
+	}
+	klog.V(5).Infof("Created egress IP BFD sessions from %s towards node %s", logicalPort, node.Name)
+	return nil
+}
+
+// deleteEgressNodeBFD removes the BFD sessions towards the provided node
+func (e *EgressIPController) deleteEgressNodeBFD(nodeName string) error {
+	existing, err := e.findEgressNodeBFD(nodeName)
+	if err != nil {
+		return err
+	}
+	if len(existing) == 0 {
+		return nil
+	}
+	if err := libovsdbops.DeleteBFDs(e.nbClient, existing...); err != nil {
+		return fmt.Errorf("failed to delete egress IP BFD sessions towards node %s: %w", nodeName, err)
+	}
+	return nil
+}
+
+func (e *EgressIPController) findEgressNodeBFD(nodeName string) ([]*nbdb.BFD, error) {
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.BFDEgressIP, e.controllerName,
+		map[libovsdbops.ExternalIDKey]string{libovsdbops.ObjectNameKey: nodeName})
+	p := libovsdbops.GetPredicate[*nbdb.BFD](predicateIDs, nil)
+	bfds, err := libovsdbops.FindBFDsWithPredicate(e.nbClient, p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find egress IP BFD sessions towards node %s: %w", nodeName, err)
+	}
+	return bfds, nil
+}
+
+// getEgressNodeBFDStatus aggregates the status of the BFD sessions owned by
+// this controller per node. A node is reported up if any of its sessions is up
+// and down if all of its established sessions are down. Sessions that are still
+// initializing or administratively down are not reported.
+func (e *EgressIPController) getEgressNodeBFDStatus() (map[string]string, error) {
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.BFDEgressIP, e.controllerName, nil)
+	p := libovsdbops.GetPredicate[*nbdb.BFD](predicateIDs, nil)
+	bfds, err := libovsdbops.FindBFDsWithPredicate(e.nbClient, p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find egress IP BFD sessions: %w", err)
+	}
+	up := sets.New[string]()
+	status := map[string]string{}
+	for _, bfd := range bfds {
+		if bfd.Status == nil {
+			continue
+		}
+		nodeName := bfd.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+		switch *bfd.Status {
+		case nbdb.BFDStatusUp:
+			up.Insert(nodeName)
+			status[nodeName] = nbdb.BFDStatusUp
+		case nbdb.BFDStatusDown:
+			if !up.Has(nodeName) {
+				status[nodeName] = nbdb.BFDStatusDown
+			}
+		}
+	}
+	return status, nil
+}
+
+// startEgressNodeBFDStatusReporter watches the egress IP BFD sessions in the
+// northbound database and publishes their status on the local node
+func (e *EgressIPController) startEgressNodeBFDStatusReporter(stopChan <-chan struct{}, wg *sync.WaitGroup) {
+	statusChanged := make(chan struct{}, 1)
+	notify := func(table string, m model.Model) {
+		if _, ok := m.(*nbdb.BFD); !ok {
+			return
+		}
+		select {
+		case statusChanged <- struct{}{}:
+		default:
+		}
+	}
+	e.nbClient.Cache().AddEventHandler(&libovsdbcache.EventHandlerFuncs{
+		AddFunc: notify,
+		UpdateFunc: func(table string, old model.Model, new model.Model) {
+			notify(table, new)
+		},
+		DeleteFunc: notify,
+	})
+	// make sure the current status is published on startup
+	statusChanged <- struct{}{}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var reported map[string]string
+		retry := time.NewTimer(egressIPBFDStatusRetryInterval)
+		retry.Stop()
+		defer retry.Stop()
+		for {
+			select {
+			case <-statusChanged:
+			case <-retry.C:
+			case <-stopChan:
+				klog.V(5).Infof("Stop channel got triggered: will stop egress IP BFD status reporter")
+				return
+			}
+			status, err := e.reportEgressNodeBFDStatus(reported)
+			if err != nil {
+				klog.Errorf("Failed to report egress IP BFD status: %v", err)
+				retry.Reset(egressIPBFDStatusRetryInterval)
+				continue
+			}
+			reported = status
+		}
+	}()
+}
+
+// reportEgressNodeBFDStatus updates the local node annotation if the BFD
+// status differs from the previously reported one
+func (e *EgressIPController) reportEgressNodeBFDStatus(reported map[string]string) (map[string]string, error) {
+	localNodeName, err := e.getALocalZoneNodeName()
+	if err != nil {
+		return nil, err
+	}
+	status, err := e.getEgressNodeBFDStatus()
+	if err != nil {
+		return nil, err
+	}
+	if reported != nil && reflect.DeepEqual(reported, status) {
+		return status, nil
+	}
+	if err := util.SetNodeEgressIPBFDStatus(e.kube, localNodeName, status); err != nil {
+		return nil, fmt.Errorf("failed to set egress IP BFD status on node %s: %w", localNodeName, err)
+	}
+	klog.V(5).Infof("Reported egress IP BFD status %v on node %s", status, localNodeName)
+	return status, nil
+}
@@ -0,0 +1,167 @@
+package ovn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+var _ = ginkgo.Describe("OVN master EgressIP BFD reachability", func() {
+	const (
+		localNodeName  = "node1"
+		remoteNodeName = "node2"
+		localJoinIP    = "100.64.0.2"
+		remoteJoinIP   = "100.64.0.3"
+	)
+
+	var fakeOvn *FakeOVN
+
+	getBFDNode := func(name, zone, joinIP string, egressAssignable bool) *corev1.Node {
+		annotations := map[string]string{
+			"k8s.ovn.org/node-subnets":                    fmt.Sprintf("{\"default\":\"%s\"}", v4Node1Subnet),
+			"k8s.ovn.org/node-gateway-router-lrp-ifaddrs": fmt.Sprintf("{\"default\":{\"ipv4\":\"%s/16\"}}", joinIP),
+			"k8s.ovn.org/zone-name":                       zone,
+		}
+		if zone != types.OvnDefaultZone {
+			annotations["k8s.ovn.org/remote-zone-migrated"] = zone
+		}
+		labels := map[string]string{}
+		if egressAssignable {
+			labels[util.GetNodeEgressLabel()] = ""
+		}
+		node := getNodeObj(name, annotations, labels)
+		return &node
+	}
+
+	getEgressIPBFDs := func() []*nbdb.BFD {
+		predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.BFDEgressIP, DefaultNetworkControllerName, nil)
+		bfds, err := libovsdbops.FindBFDsWithPredicate(fakeOvn.nbClient, libovsdbops.GetPredicate[*nbdb.BFD](predicateIDs, nil))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return bfds
+	}
+
+	start := func(nodes ...*corev1.Node) {
+		nodeList := &corev1.NodeList{}
+		for _, node := range nodes {
+			nodeList.Items = append(nodeList.Items, *node)
+		}
+		fakeOvn.startWithDBSetup(libovsdbtest.TestSetup{}, nodeList)
+	}
+
+	updateNode := func(node *corev1.Node) {
+		_, err := fakeOvn.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node, metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(func() bool {
+			cached, err := fakeOvn.watcher.GetNode(node.Name)
+			return err == nil && !egressNodeBFDChanged(cached, node)
+		}).Should(gomega.BeTrue())
+	}
+
+	ginkgo.BeforeEach(func() {
+		config.PrepareTestConfig()
+		config.OVNKubernetesFeature.EnableEgressIP = true
+		config.OVNKubernetesFeature.EnableInterconnect = true
+		config.OVNKubernetesFeature.EgressIPReachabilityBFD = true
+		fakeOvn = NewFakeOVN(false)
+	})
+
+	ginkgo.AfterEach(func() {
+		fakeOvn.shutdown()
+	})
+
+	ginkgo.It("monitors a remote egress node from the local gateway router join port", func() {
+		localNode := getBFDNode(localNodeName, types.OvnDefaultZone, localJoinIP, false)
+		remoteNode := getBFDNode(remoteNodeName, remoteNodeName, remoteJoinIP, true)
+		start(localNode, remoteNode)
+
+		err := fakeOvn.eIPController.reconcileEgressNodeBFD(remoteNode)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		bfds := getEgressIPBFDs()
+		gomega.Expect(bfds).To(gomega.HaveLen(1))
+		gomega.Expect(bfds[0].LogicalPort).To(gomega.Equal(types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + localNodeName))
+		gomega.Expect(bfds[0].DstIP).To(gomega.Equal(remoteJoinIP))
+
+		ginkgo.By("removing the sessions once neither node is egress assignable")
+		remoteNode = getBFDNode(remoteNodeName, remoteNodeName, remoteJoinIP, false)
+		updateNode(remoteNode)
+		err = fakeOvn.eIPController.reconcileEgressNodeBFD(remoteNode)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(getEgressIPBFDs()).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("keeps sessions from a local egress node towards every remote node", func() {
+		localNode := getBFDNode(localNodeName, types.OvnDefaultZone, localJoinIP, true)
+		remoteNode := getBFDNode(remoteNodeName, remoteNodeName, remoteJoinIP, false)
+		start(localNode, remoteNode)
+
+		err := fakeOvn.eIPController.reconcileEgressNodeBFD(localNode)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		bfds := getEgressIPBFDs()
+		gomega.Expect(bfds).To(gomega.HaveLen(1))
+		gomega.Expect(bfds[0].DstIP).To(gomega.Equal(remoteJoinIP))
+		gomega.Expect(bfds[0].ExternalIDs[libovsdbops.ObjectNameKey.String()]).To(gomega.Equal(remoteNodeName))
+	})
+
+	ginkgo.It("replaces the session when the join IP of the remote node changes", func() {
+		const newRemoteJoinIP = "100.64.0.4"
+		localNode := getBFDNode(localNodeName, types.OvnDefaultZone, localJoinIP, false)
+		remoteNode := getBFDNode(remoteNodeName, remoteNodeName, remoteJoinIP, true)
+		start(localNode, remoteNode)
+
+		err := fakeOvn.eIPController.reconcileEgressNodeBFD(remoteNode)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(getEgressIPBFDs()).To(gomega.HaveLen(1))
+
+		newRemoteNode := getBFDNode(remoteNodeName, remoteNodeName, newRemoteJoinIP, true)
+		gomega.Expect(egressNodeBFDChanged(remoteNode, newRemoteNode)).To(gomega.BeTrue())
+		updateNode(newRemoteNode)
+		err = fakeOvn.eIPController.reconcileEgressNodeBFD(newRemoteNode)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		bfds := getEgressIPBFDs()
+		gomega.Expect(bfds).To(gomega.HaveLen(1))
+		gomega.Expect(bfds[0].DstIP).To(gomega.Equal(newRemoteJoinIP))
+	})
+
+	ginkgo.It("reports a node up if any of its sessions is up", func() {
+		up := nbdb.BFDStatusUp
+		down := nbdb.BFDStatusDown
+		initializing := nbdb.BFDStatusInit
+		newBFD := func(uuid, nodeName string, ipFamily egressIPFamilyValue, status *nbdb.BFDStatus) *nbdb.BFD {
+			return &nbdb.BFD{
+				UUID:        uuid,
+				LogicalPort: types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + localNodeName,
+				DstIP:       uuid,
+				Status:      status,
+				ExternalIDs: getEgressIPBFDDbIDs(nodeName, ipFamily, DefaultNetworkControllerName).GetExternalIDs(),
+			}
+		}
+		fakeOvn.startWithDBSetup(libovsdbtest.TestSetup{NBData: []libovsdbtest.TestData{
+			newBFD("bfd-1", "node2", IPFamilyValueV4, &down),
+			newBFD("bfd-2", "node2", IPFamilyValueV6, &up),
+			newBFD("bfd-3", "node3", IPFamilyValueV4, &down),
+			newBFD("bfd-4", "node4", IPFamilyValueV4, &initializing),
+		}})
+
+		status, err := fakeOvn.eIPController.getEgressNodeBFDStatus()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(status).To(gomega.Equal(map[string]string{
+			"node2": nbdb.BFDStatusUp,
+			"node3": nbdb.BFDStatusDown,
+		}))
+	})
+})
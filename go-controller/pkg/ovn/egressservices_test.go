@@ -784,6 +784,233 @@ var _ = ginkgo.Describe("OVN Egress Service Operations", func() {
 			ginkgo.Entry("IC Enabled, node1 is in the local zone, node2 in remote", true),
 		)
 
+		ginkgo.DescribeTable("should create/update/delete OVN configuration of a service with multiple hosts", func(interconnectEnabled bool) {
+			app.Action = func(ctx *cli.Context) error {
+				namespaceT := *newNamespace("testns")
+				config.IPv6Mode = true
+				config.OVNKubernetesFeature.EnableInterconnect = interconnectEnabled
+				node1 := nodeFor(node1Name, node1IPv4, node1IPv6, node1IPv4Subnet, node1IPv6Subnet, node1transitIPv4, node1transitIPv6)
+				node1.Labels = map[string]string{"house": "Gryffindor"}
+				node2 := nodeFor(node2Name, node2IPv4, node2IPv6, node2IPv4Subnet, node2IPv6Subnet, node2transitIPv4, node2transitIPv6)
+				node2.Labels = map[string]string{"house": "Gryffindor"}
+
+				clusterRouter := &nbdb.LogicalRouter{
+					Name: ovntypes.OVNClusterRouter,
+					UUID: ovntypes.OVNClusterRouter + "-UUID",
+				}
+
+				dbSetup := libovsdbtest.TestSetup{
+					NBData: []libovsdbtest.TestData{
+						clusterRouter,
+					},
+				}
+
+				ginkgo.By("creating a service allocated to both nodes with v4 and v6 endpoints")
+				esvc1 := egressserviceapi.EgressService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1",
+						Namespace: "testns",
+					},
+					Spec: egressserviceapi.EgressServiceSpec{
+						SourceIPBy: egressserviceapi.SourceIPLoadBalancer,
+						NodeSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"house": "Gryffindor",
+							},
+						},
+						HostCount: 2,
+					},
+					Status: egressserviceapi.EgressServiceStatus{
+						Host:  node1Name,
+						Hosts: []string{node1Name, node2Name},
+					},
+				}
+				svc1 := lbSvcFor("testns", "svc1")
+
+				v4EpSlice := discovery.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1-ipv4-epslice",
+						Namespace: "testns",
+						Labels: map[string]string{
+							discovery.LabelServiceName: "svc1",
+						},
+					},
+					AddressType: discovery.AddressTypeIPv4,
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"10.128.1.5"},
+							NodeName:  &node1.Name,
+						},
+						{
+							Addresses: []string{"10.128.2.5"},
+							NodeName:  &node2.Name,
+						},
+					},
+				}
+
+				v6EpSlice := discovery.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1-ipv6-epslice",
+						Namespace: "testns",
+						Labels: map[string]string{
+							discovery.LabelServiceName: "svc1",
+						},
+					},
+					AddressType: discovery.AddressTypeIPv6,
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"fe00:10:128:1::5"},
+							NodeName:  &node1.Name,
+						},
+						{
+							Addresses: []string{"fe00:10:128:2::5"},
+							NodeName:  &node2.Name,
+						},
+					},
+				}
+
+				fakeOVN.startWithDBSetup(dbSetup,
+					&v1.NamespaceList{
+						Items: []v1.Namespace{
+							namespaceT,
+						},
+					},
+					&v1.NodeList{
+						Items: []v1.Node{
+							*node1,
+							*node2,
+						},
+					},
+					&v1.ServiceList{
+						Items: []v1.Service{
+							svc1,
+						},
+					},
+					&discovery.EndpointSliceList{
+						Items: []discovery.EndpointSlice{
+							v4EpSlice,
+							v6EpSlice,
+						},
+					},
+					&egressserviceapi.EgressServiceList{
+						Items: []egressserviceapi.EgressService{
+							esvc1,
+						},
+					},
+				)
+
+				if interconnectEnabled {
+					fakeOVN.controller.zone = node1Name
+				}
+				fakeOVN.InitAndRunEgressSVCController()
+
+				v4lrp1 := egressServiceRouterPolicy("v4lrp1-UUID", "testns/svc1", "10.128.1.5", "10.128.1.2")
+				v4lrp2 := egressServiceRouterPolicy("v4lrp2-UUID", "testns/svc1", "10.128.2.5", "10.128.1.2")
+				v6lrp1 := egressServiceRouterPolicy("v6lrp1-UUID", "testns/svc1", "fe00:10:128:1::5", "fe00:10:128:1::2")
+				v6lrp2 := egressServiceRouterPolicy("v6lrp2-UUID", "testns/svc1", "fe00:10:128:2::5", "fe00:10:128:1::2")
+				v4lrsr := egressServiceRouterPolicy("v4lrsr-UUID", "testns/svc1:ic", "10.128.2.5", "10.128.1.2")
+				v6lrsr := egressServiceRouterPolicy("v6lrsr-UUID", "testns/svc1:ic", "fe00:10:128:2::5", "fe00:10:128:1::2")
+
+				expectedDatabaseState := []libovsdbtest.TestData{}
+				expectedEgressSvcAddrSet := []string{}
+				if !interconnectEnabled {
+					v4lrp1.Nexthops = []string{"10.128.1.2", "10.128.2.2"}
+					v4lrp2.Nexthops = []string{"10.128.1.2", "10.128.2.2"}
+					v6lrp1.Nexthops = []string{"fe00:10:128:1::2", "fe00:10:128:2::2"}
+					v6lrp2.Nexthops = []string{"fe00:10:128:1::2", "fe00:10:128:2::2"}
+					clusterRouter.Policies = []string{"v4lrp1-UUID", "v4lrp2-UUID", "v6lrp1-UUID", "v6lrp2-UUID"}
+					expectedDatabaseState = []libovsdbtest.TestData{
+						clusterRouter,
+						v4lrp1,
+						v4lrp2,
+						v6lrp1,
+						v6lrp2,
+					}
+					expectedEgressSvcAddrSet = []string{"10.128.1.5", "10.128.2.5", "fe00:10:128:1::5", "fe00:10:128:2::5"}
+				} else {
+					// the remote host is reached through its transit IP, only the local one is used for remote endpoints
+					v4lrp1.Nexthops = []string{"10.128.1.2", node2transitIPv4}
+					v6lrp1.Nexthops = []string{"fe00:10:128:1::2", node2transitIPv6}
+					clusterRouter.Policies = []string{"v4lrp1-UUID", "v6lrp1-UUID", "v4lrsr-UUID", "v6lrsr-UUID"}
+					expectedDatabaseState = []libovsdbtest.TestData{
+						clusterRouter,
+						v4lrp1,
+						v6lrp1,
+						v4lrsr,
+						v6lrsr,
+					}
+					expectedEgressSvcAddrSet = []string{"10.128.1.5", "fe00:10:128:1::5"}
+				}
+
+				for _, lrp := range getDefaultNoReroutePolicies(controllerName) {
+					expectedDatabaseState = append(expectedDatabaseState, lrp)
+					clusterRouter.Policies = append(clusterRouter.Policies, lrp.UUID)
+				}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+				fakeOVN.asf.ExpectAddressSetWithAddresses(egresssvc.GetEgressServiceAddrSetDbIDs(controllerName), expectedEgressSvcAddrSet)
+
+				ginkgo.By("removing the first node from the EgressService's hosts its nexthops will be updated")
+				esvc1.Status.Host = node2Name
+				esvc1.Status.Hosts = []string{node2Name}
+				esvc1.ResourceVersion = "2"
+				_, err := fakeOVN.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Update(context.TODO(), &esvc1, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				if !interconnectEnabled {
+					v4lrp1.Nexthops = []string{"10.128.2.2"}
+					v4lrp2.Nexthops = []string{"10.128.2.2"}
+					v6lrp1.Nexthops = []string{"fe00:10:128:2::2"}
+					v6lrp2.Nexthops = []string{"fe00:10:128:2::2"}
+
+					clusterRouter.Policies = []string{"v4lrp1-UUID", "v4lrp2-UUID", "v6lrp1-UUID", "v6lrp2-UUID"}
+					expectedDatabaseState = []libovsdbtest.TestData{
+						clusterRouter,
+						v4lrp1,
+						v4lrp2,
+						v6lrp1,
+						v6lrp2,
+					}
+				} else {
+					v4lrp1.Nexthops = []string{node2transitIPv4}
+					v6lrp1.Nexthops = []string{node2transitIPv6}
+					clusterRouter.Policies = []string{"v4lrp1-UUID", "v6lrp1-UUID"}
+
+					expectedDatabaseState = []libovsdbtest.TestData{
+						clusterRouter,
+						v4lrp1,
+						v6lrp1,
+					}
+				}
+
+				for _, lrp := range getDefaultNoReroutePolicies(controllerName) {
+					expectedDatabaseState = append(expectedDatabaseState, lrp)
+					clusterRouter.Policies = append(clusterRouter.Policies, lrp.UUID)
+				}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+				fakeOVN.asf.ExpectAddressSetWithAddresses(egresssvc.GetEgressServiceAddrSetDbIDs(controllerName), expectedEgressSvcAddrSet)
+
+				ginkgo.By("removing the EgressService its lrps will be removed")
+				err = fakeOVN.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Delete(context.TODO(), esvc1.Name, metav1.DeleteOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				clusterRouter.Policies = []string{}
+				expectedDatabaseState = []libovsdbtest.TestData{clusterRouter}
+				for _, lrp := range getDefaultNoReroutePolicies(controllerName) {
+					expectedDatabaseState = append(expectedDatabaseState, lrp)
+					clusterRouter.Policies = append(clusterRouter.Policies, lrp.UUID)
+				}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+				fakeOVN.asf.ExpectAddressSetWithAddresses(egresssvc.GetEgressServiceAddrSetDbIDs(controllerName), []string{})
+
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		},
+			ginkgo.Entry("IC Disabled, all nodes are in a single zone", false),
+			ginkgo.Entry("IC Enabled, node1 is in the local zone, node2 in remote", true),
+		)
+
 		ginkgo.DescribeTable("should delete resources when host changes to ALL", func(interconnectEnabled bool) {
 			app.Action = func(ctx *cli.Context) error {
 				namespaceT := *newNamespace("testns")
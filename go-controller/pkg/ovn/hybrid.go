@@ -6,6 +6,7 @@ import (
 	"net"
 	"regexp"
 	"strings"
+	"sync"
 	"sync/atomic"
 
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
@@ -20,6 +21,7 @@ import (
 
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
 
 	kapi "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
@@ -121,7 +123,7 @@ func (oc *DefaultNetworkController) handleHybridOverlayPort(node *kapi.Node, ann
 			return fmt.Errorf("failed to add hybrid overlay port %+v for node %s: %w", lsp, node.Name, err)
 		}
 		for _, subnet := range subnets {
-			if err := libovsdbutil.UpdateNodeSwitchExcludeIPs(oc.nbClient, oc.GetNetworkScopedK8sMgmtIntfName(node.Name), oc.GetNetworkScopedSwitchName(node.Name), node.Name, subnet); err != nil {
+			if err := libovsdbutil.UpdateNodeSwitchExcludeIPs(oc.nbClient, oc.GetNetworkScopedK8sMgmtIntfName(node.Name), portName, oc.GetNetworkScopedSwitchName(node.Name), node.Name, subnet); err != nil {
 				return err
 			}
 		}
@@ -436,3 +438,260 @@ func (oc *DefaultNetworkController) removeRoutesToHONodeSubnet(nodeName string,
 	}
 	return nil
 }
+
+// hybridOverlayEnabled returns whether the network is connected to the hybrid
+// overlay, through the hybrid overlay ports of its node switches
+func (oc *SecondaryLayer3NetworkController) hybridOverlayEnabled() bool {
+	return config.HybridOverlay.Enabled && len(oc.HybridOverlaySubnets()) > 0
+}
+
+// syncHybridOverlayPort reconciles the node's hybrid overlay port of the
+// network with OVN: it allocates the port distributed router IP, which is
+// annotated on the node for the hybrid overlay node to set up its side of the
+// port, and steers the traffic to the hybrid overlay subnets of the network
+// to it. Unlike on the default network, the port MAC is always derived from
+// its IP.
+func (oc *SecondaryLayer3NetworkController) syncHybridOverlayPort(node *kapi.Node) error {
+	hostSubnets, err := util.ParseNodeHostSubnetAnnotation(node, oc.GetNetworkName())
+	if err != nil {
+		return fmt.Errorf("failed to get the node %s subnets on network %s: %w", node.Name, oc.GetNetworkName(), err)
+	}
+	drIP, err := oc.allocateHybridOverlayNetworkDRIP(node, hostSubnets)
+	if err != nil {
+		return err
+	}
+	portMAC := util.IPAddrToHWAddr(drIP)
+	portName := util.GetHybridOverlayPortName(oc.GetNetworkScopedName(node.Name))
+	switchName := oc.GetNetworkScopedSwitchName(node.Name)
+
+	lsp := nbdb.LogicalSwitchPort{
+		Name:      portName,
+		Addresses: []string{portMAC.String()},
+	}
+	sw := nbdb.LogicalSwitch{Name: switchName}
+	if err := libovsdbops.CreateOrUpdateLogicalSwitchPortsOnSwitch(oc.nbClient, &sw, &lsp); err != nil {
+		return fmt.Errorf("failed to add hybrid overlay port %+v for node %s on network %s: %w",
+			lsp, node.Name, oc.GetNetworkName(), err)
+	}
+	for _, subnet := range hostSubnets {
+		if err := libovsdbutil.UpdateNodeSwitchExcludeIPs(oc.nbClient, oc.GetNetworkScopedK8sMgmtIntfName(node.Name),
+			portName, switchName, node.Name, subnet); err != nil {
+			return err
+		}
+	}
+
+	routerName := oc.GetNetworkScopedClusterRouterName()
+	routerPort := ovntypes.RouterToSwitchPrefix + switchName
+	for _, hybridSubnet := range oc.HybridOverlaySubnets() {
+		if utilnet.IsIPv6CIDR(hybridSubnet) {
+			// the hybrid overlay is IPv4 only
+			continue
+		}
+		// Logical route policy to steer the packets from the node pods to the
+		// hybrid overlay nodes
+		policy := nbdb.LogicalRouterPolicy{
+			Priority: ovntypes.HybridOverlaySubnetPriority,
+			ExternalIDs: map[string]string{
+				"name": oc.hybridOverlayPolicyName(node.Name, hybridSubnet),
+			},
+			Action:   nbdb.LogicalRouterPolicyActionReroute,
+			Nexthops: []string{drIP.String()},
+			Match:    fmt.Sprintf(`inport == "%s" && ip4.dst == %s`, routerPort, hybridSubnet),
+		}
+		if err := libovsdbops.CreateOrUpdateLogicalRouterPolicyWithPredicate(oc.nbClient, routerName, &policy,
+			func(item *nbdb.LogicalRouterPolicy) bool {
+				return item.Priority == policy.Priority && item.ExternalIDs["name"] == policy.ExternalIDs["name"]
+			}, &policy.Nexthops, &policy.Match, &policy.Action); err != nil {
+			return fmt.Errorf("failed to add policy route '%s' for node %s on %s: %w", policy.Match, node.Name, routerName, err)
+		}
+	}
+
+	smb := &nbdb.StaticMACBinding{
+		LogicalPort:        routerPort,
+		MAC:                portMAC.String(),
+		IP:                 drIP.String(),
+		OverrideDynamicMAC: true,
+	}
+	if err := libovsdbops.CreateOrUpdateStaticMacBinding(oc.nbClient, smb); err != nil {
+		return fmt.Errorf("failed to create MAC Binding for hybrid overlay on network %s: %w", oc.GetNetworkName(), err)
+	}
+	klog.Infof("Set up hybrid overlay port %s with IP %s and MAC %s for node %s on network %s",
+		portName, drIP, portMAC, node.Name, oc.GetNetworkName())
+	return nil
+}
+
+// allocateHybridOverlayNetworkDRIP allocates the node's hybrid overlay
+// distributed router IP of the network from its IPv4 host subnet, preferably
+// the one already annotated, and annotates it on the node
+func (oc *SecondaryLayer3NetworkController) allocateHybridOverlayNetworkDRIP(node *kapi.Node, hostSubnets []*net.IPNet) (net.IP, error) {
+	var v4HostSubnet *net.IPNet
+	for _, hostSubnet := range hostSubnets {
+		if !utilnet.IsIPv6CIDR(hostSubnet) {
+			v4HostSubnet = hostSubnet
+			break
+		}
+	}
+	if v4HostSubnet == nil {
+		return nil, fmt.Errorf("hybrid overlay requires an IPv4 subnet for node %s on network %s", node.Name, oc.GetNetworkName())
+	}
+
+	drIPs, err := houtil.ParseHybridOverlayNetworkDRIPs(node)
+	if err != nil {
+		return nil, err
+	}
+	annotatedDRIP := drIPs[oc.GetNetworkName()]
+	if annotatedDRIP == nil {
+		// the node might not have been updated in the informer cache with
+		// the annotation set by a previous sync yet
+		if cached, ok := oc.hybridOverlayDRIPs.Load(node.Name); ok {
+			annotatedDRIP = cached.(net.IP)
+		}
+	}
+	var annotatedDRIPs []string
+	if annotatedDRIP != nil && v4HostSubnet.Contains(annotatedDRIP) {
+		annotatedDRIPs = []string{annotatedDRIP.String()}
+	}
+
+	switchName := oc.GetNetworkScopedSwitchName(node.Name)
+	allocatedIPs, err := oc.lsManager.AllocateHybridOverlay(switchName, annotatedDRIPs)
+	if err != nil {
+		return nil, fmt.Errorf("cannot allocate hybrid overlay interface address on node %s for network %s: %w",
+			node.Name, oc.GetNetworkName(), err)
+	}
+	var drIP net.IP
+	var unusedIPs []*net.IPNet
+	for _, allocatedIP := range allocatedIPs {
+		if drIP == nil && v4HostSubnet.Contains(allocatedIP.IP) {
+			drIP = allocatedIP.IP
+			continue
+		}
+		unusedIPs = append(unusedIPs, allocatedIP)
+	}
+	if len(unusedIPs) > 0 {
+		if err := oc.lsManager.ReleaseIPs(switchName, unusedIPs); err != nil {
+			klog.Warningf("Failed to release unused hybrid overlay addresses %s of switch %s: %v",
+				util.StringSlice(unusedIPs), switchName, err)
+		}
+	}
+	if drIP == nil {
+		return nil, fmt.Errorf("cannot allocate hybrid overlay interface address from subnet %s on node %s for network %s",
+			v4HostSubnet, node.Name, oc.GetNetworkName())
+	}
+
+	if !drIP.Equal(drIPs[oc.GetNetworkName()]) {
+		klog.Infof("Setting node %s hybrid overlay distributed router IP of network %s to %s", node.Name, oc.GetNetworkName(), drIP)
+		if err := oc.updateHybridOverlayNetworkDRIP(node.Name, drIP); err != nil {
+			return nil, err
+		}
+	}
+	oc.hybridOverlayDRIPs.Store(node.Name, drIP)
+	return drIP, nil
+}
+
+// hybridOverlayNetworkDRIPsLock serializes the updates of the node annotation
+// holding the hybrid overlay distributed router IPs, which is shared by the
+// controllers of all the networks
+var hybridOverlayNetworkDRIPsLock sync.Mutex
+
+// updateHybridOverlayNetworkDRIP sets the node's hybrid overlay distributed
+// router IP of the network, or removes it if drIP is nil
+func (oc *SecondaryLayer3NetworkController) updateHybridOverlayNetworkDRIP(nodeName string, drIP net.IP) error {
+	hybridOverlayNetworkDRIPsLock.Lock()
+	defer hybridOverlayNetworkDRIPsLock.Unlock()
+	// the informer cache might not have caught up with the updates of the
+	// other networks yet, so get the latest node from the API server
+	node, err := oc.kube.GetNode(nodeName)
+	if err != nil {
+		return fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+	annotations, err := houtil.UpdateHybridOverlayNetworkDRIPs(node.Annotations, oc.GetNetworkName(), drIP)
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	if annotation, ok := annotations[hotypes.HybridOverlayNetworkDRIPs]; ok {
+		value = annotation
+	}
+	if err := oc.kube.SetAnnotationsOnNode(nodeName, map[string]interface{}{hotypes.HybridOverlayNetworkDRIPs: value}); err != nil {
+		return fmt.Errorf("failed to update node %s hybrid overlay distributed router IP of network %s: %w",
+			nodeName, oc.GetNetworkName(), err)
+	}
+	return nil
+}
+
+// deleteHybridOverlayPort removes the logical route policies and static MAC
+// binding of the node's hybrid overlay port of the network. The port itself
+// goes away with the node switch.
+func (oc *SecondaryLayer3NetworkController) deleteHybridOverlayPort(nodeName string) error {
+	routerName := oc.GetNetworkScopedClusterRouterName()
+	policyNamePrefix := oc.hybridOverlayPolicyName(nodeName, nil)
+	if err := libovsdbops.DeleteLogicalRouterPoliciesWithPredicate(oc.nbClient, routerName, func(item *nbdb.LogicalRouterPolicy) bool {
+		return item.Priority == ovntypes.HybridOverlaySubnetPriority && strings.HasPrefix(item.ExternalIDs["name"], policyNamePrefix)
+	}); err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+		return fmt.Errorf("failed to delete hybrid overlay policies of node %s from %s: %w", nodeName, routerName, err)
+	}
+
+	routerPort := ovntypes.RouterToSwitchPrefix + oc.GetNetworkScopedSwitchName(nodeName)
+	if err := libovsdbops.DeleteStaticMACBindingWithPredicate(oc.nbClient, func(item *nbdb.StaticMACBinding) bool {
+		return item.LogicalPort == routerPort
+	}); err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+		return fmt.Errorf("failed to delete hybrid overlay static MAC binding of port %s: %w", routerPort, err)
+	}
+	return nil
+}
+
+// cleanupHybridOverlay removes the static MAC bindings of the hybrid overlay
+// ports of the network and their distributed router IPs from all the nodes
+func (oc *SecondaryLayer3NetworkController) cleanupHybridOverlay() error {
+	routerPortPrefix := ovntypes.RouterToSwitchPrefix + util.GetSecondaryNetworkPrefix(oc.GetNetworkName())
+	if err := libovsdbops.DeleteStaticMACBindingWithPredicate(oc.nbClient, func(item *nbdb.StaticMACBinding) bool {
+		return strings.HasPrefix(item.LogicalPort, routerPortPrefix)
+	}); err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+		return fmt.Errorf("failed to delete hybrid overlay static MAC bindings of network %s: %w", oc.GetNetworkName(), err)
+	}
+
+	nodes, err := oc.watchFactory.GetNodes()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, node := range nodes {
+		drIPs, err := houtil.ParseHybridOverlayNetworkDRIPs(node)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, ok := drIPs[oc.GetNetworkName()]; !ok {
+			continue
+		}
+		if err := oc.updateHybridOverlayNetworkDRIP(node.Name, nil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := utilerrors.Join(errs...); err != nil {
+		return fmt.Errorf("failed to remove the hybrid overlay distributed router IPs of network %s: %w", oc.GetNetworkName(), err)
+	}
+	return nil
+}
+
+// hybridOverlayPolicyName returns the name of the logical route policy
+// steering the traffic from the node pods to the given hybrid overlay subnet,
+// or the prefix of the names of all the node policies if the subnet is nil
+func (oc *SecondaryLayer3NetworkController) hybridOverlayPolicyName(nodeName string, hybridSubnet *net.IPNet) string {
+	name := ovntypes.HybridSubnetPrefix + oc.GetNetworkScopedName(nodeName) + ":"
+	if hybridSubnet != nil {
+		name += hybridSubnet.String()
+	}
+	return name
+}
+
+// hybridOverlayNetworkDRIPChanged returns whether the node's hybrid overlay
+// distributed router IP of the network changed
+func hybridOverlayNetworkDRIPChanged(oldNode, newNode *kapi.Node, netName string) bool {
+	if oldNode.Annotations[hotypes.HybridOverlayNetworkDRIPs] == newNode.Annotations[hotypes.HybridOverlayNetworkDRIPs] {
+		return false
+	}
+	oldDRIPs, _ := houtil.ParseHybridOverlayNetworkDRIPs(oldNode)
+	newDRIPs, _ := houtil.ParseHybridOverlayNetworkDRIPs(newNode)
+	return !oldDRIPs[netName].Equal(newDRIPs[netName])
+}
@@ -3,6 +3,7 @@ package ovn
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/urfave/cli/v2"
 
@@ -358,6 +359,21 @@ var _ = Describe("OVN Multicast with IP Address Family", func() {
 			nad.Annotations = map[string]string{types.OvnNetworkIDAnnotation: networkID}
 			return nad
 		}
+
+		secondaryNADFromTopology = func(topology string) *nadapi.NetworkAttachmentDefinition {
+			nad := ovntest.GenerateNADWithConfig(nadName, namespaceName1, fmt.Sprintf(`{
+				"cniVersion": "0.4.0",
+				"name": %q,
+				"type": "ovn-k8s-cni-overlay",
+				"topology": %q,
+				"subnets": "100.128.0.0/16",
+				"netAttachDefName": %q,
+				"role": %q,
+				"enableMulticast": true
+			}`, networkName, topology, namespaceName1+"/"+nadName, types.NetworkRoleSecondary))
+			nad.Annotations = map[string]string{types.OvnNetworkIDAnnotation: networkID}
+			return nad
+		}
 	)
 
 	BeforeEach(func() {
@@ -419,6 +435,9 @@ var _ = Describe("OVN Multicast with IP Address Family", func() {
 			Entry("IPv6", false, true, nil),
 			Entry("[Network Segmentation] IPv4", true, false, nadFromIPMode(true, false)),
 			Entry("[Network Segmentation] IPv6", false, true, nadFromIPMode(false, true)),
+			Entry("[Secondary network] Layer3 IPv4", true, false, secondaryNADFromTopology(types.Layer3Topology)),
+			Entry("[Secondary network] Layer2 IPv4", true, false, secondaryNADFromTopology(types.Layer2Topology)),
+			Entry("[Secondary network] Localnet IPv4", true, false, secondaryNADFromTopology(types.LocalnetTopology)),
 		)
 
 		DescribeTable("updates stale default Multicast ACLs", func(useIPv4, useIPv6 bool, nad *nadapi.NetworkAttachmentDefinition) {
@@ -534,6 +553,9 @@ var _ = Describe("OVN Multicast with IP Address Family", func() {
 			Entry("IPv6", false, true, nil),
 			Entry("[Network Segmentation] IPv4", true, false, nadFromIPMode(true, false)),
 			Entry("[Network Segmentation] IPv6", false, true, nadFromIPMode(false, true)),
+			Entry("[Secondary network] Layer3 IPv4", true, false, secondaryNADFromTopology(types.Layer3Topology)),
+			Entry("[Secondary network] Layer2 IPv4", true, false, secondaryNADFromTopology(types.Layer2Topology)),
+			Entry("[Secondary network] Localnet IPv4", true, false, secondaryNADFromTopology(types.LocalnetTopology)),
 		)
 
 		DescribeTable("updates stale namespace Multicast ACLs", func(useIPv4, useIPv6 bool, nad *nadapi.NetworkAttachmentDefinition) {
@@ -662,6 +684,9 @@ var _ = Describe("OVN Multicast with IP Address Family", func() {
 			Entry("IPv6", false, true, nil),
 			Entry("[Network Segmentation] IPv4", true, false, nadFromIPMode(true, false)),
 			Entry("[Network Segmentation] IPv6", false, true, nadFromIPMode(false, true)),
+			Entry("[Secondary network] Layer3 IPv4", true, false, secondaryNADFromTopology(types.Layer3Topology)),
+			Entry("[Secondary network] Layer2 IPv4", true, false, secondaryNADFromTopology(types.Layer2Topology)),
+			Entry("[Secondary network] Localnet IPv4", true, false, secondaryNADFromTopology(types.LocalnetTopology)),
 		)
 
 		DescribeTable("tests enabling multicast in a namespace with a pod", func(useIPv4, useIPv6 bool, nad *nadapi.NetworkAttachmentDefinition) {
@@ -911,4 +936,118 @@ var _ = Describe("OVN Multicast with IP Address Family", func() {
 			Entry("[Network Segmentation] IPv6", false, true, nadFromIPMode(false, true)),
 		)
 	})
+
+	Context("on secondary networks", func() {
+		DescribeTable("configures multicast on the network switch", func(topology, switchName, querierMAC, querierIPv4, querierIPv6 string) {
+			app.Action = func(ctx *cli.Context) error {
+				config.IPv4Mode = true
+
+				netInfo := getNetInfoFromNAD(secondaryNADFromTopology(topology))
+				fakeOvn.startWithDBSetup(libovsdb.TestSetup{
+					NBData: []libovsdb.TestData{
+						&nbdb.NBGlobal{Name: types.OvnDefaultZone, UUID: types.OvnDefaultZone},
+					},
+				})
+
+				var bnc *BaseNetworkController
+				var initFunc func() error
+				switch topology {
+				case types.Layer3Topology:
+					controller, err := NewSecondaryLayer3NetworkController(&fakeOvn.controller.CommonNetworkControllerInfo,
+						netInfo, fakeOvn.networkManager.Interface(), nil, fakeOvn.portCache)
+					Expect(err).NotTo(HaveOccurred())
+					bnc = &controller.BaseNetworkController
+					initFunc = func() error {
+						if err := controller.Init(context.TODO()); err != nil {
+							return err
+						}
+						// Layer3 networks have a switch per node
+						return bnc.createNodeLogicalSwitch(switchName, []*net.IPNet{ovntest.MustParseIPNet("100.128.1.0/24")}, "", "")
+					}
+				case types.Layer2Topology:
+					controller, err := NewSecondaryLayer2NetworkController(&fakeOvn.controller.CommonNetworkControllerInfo,
+						netInfo, fakeOvn.networkManager.Interface(), nil, fakeOvn.portCache)
+					Expect(err).NotTo(HaveOccurred())
+					bnc, initFunc = &controller.BaseNetworkController, controller.Init
+				case types.LocalnetTopology:
+					controller := NewSecondaryLocalnetNetworkController(&fakeOvn.controller.CommonNetworkControllerInfo,
+						netInfo, fakeOvn.networkManager.Interface())
+					bnc, initFunc = &controller.BaseNetworkController, controller.Init
+				}
+				Expect(bnc.multicastSupport).To(BeTrue())
+				Expect(initFunc()).To(Succeed())
+
+				sw, err := libovsdbops.GetLogicalSwitch(fakeOvn.nbClient,
+					&nbdb.LogicalSwitch{Name: netInfo.GetNetworkScopedSwitchName(switchName)})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sw.OtherConfig).To(HaveKeyWithValue("mcast_snoop", "true"))
+				Expect(sw.OtherConfig).To(HaveKeyWithValue("mcast_querier", "true"))
+				Expect(sw.OtherConfig).To(HaveKeyWithValue("mcast_eth_src", querierMAC))
+				Expect(sw.OtherConfig).To(HaveKeyWithValue("mcast_ip4_src", querierIPv4))
+				if querierIPv6 != "" {
+					Expect(sw.OtherConfig).To(HaveKeyWithValue("mcast_ip6_src", querierIPv6))
+				} else {
+					Expect(sw.OtherConfig).NotTo(HaveKey("mcast_ip6_src"))
+				}
+
+				// the default multicast ACLs are created on the cluster port groups
+				clusterPortGroup, err := libovsdbops.GetPortGroup(fakeOvn.nbClient, newNetworkClusterPortGroup(netInfo))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clusterPortGroup.ACLs).To(HaveLen(2))
+				clusterRtrPortGroup, err := libovsdbops.GetPortGroup(fakeOvn.nbClient, newNetworkRouterPortGroup(netInfo))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clusterRtrPortGroup.ACLs).To(HaveLen(2))
+
+				if topology == types.Layer3Topology {
+					// multicast is routed between the node switches
+					router, err := libovsdbops.GetLogicalRouter(fakeOvn.nbClient,
+						&nbdb.LogicalRouter{Name: netInfo.GetNetworkScopedClusterRouterName()})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(router.Options).To(HaveKeyWithValue("mcast_relay", "true"))
+				}
+
+				if topology == types.LocalnetTopology {
+					// multicast is allowed from and to the physical network
+					localnetPort, err := libovsdbops.GetLogicalSwitchPort(fakeOvn.nbClient,
+						&nbdb.LogicalSwitchPort{Name: netInfo.GetNetworkScopedName(types.OVNLocalnetPort)})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(localnetPort.Options).To(HaveKeyWithValue("mcast_flood_reports", "true"))
+					Expect(clusterRtrPortGroup.Ports).To(ConsistOf(localnetPort.UUID))
+				}
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			Expect(err).NotTo(HaveOccurred())
+		},
+			Entry("Layer3", types.Layer3Topology, "node1", "0a:58:64:80:01:01", "100.128.1.1", ""),
+			Entry("Layer2", types.Layer2Topology, types.OVNLayer2Switch, "0a:58:00:00:00:00", "0.0.0.0", "fe80::858:ff:fe00:0"),
+			Entry("Localnet", types.LocalnetTopology, types.OVNLocalnetSwitch, "0a:58:00:00:00:00", "0.0.0.0", "fe80::858:ff:fe00:0"),
+		)
+
+		It("does not configure multicast on the networks that don't opt in", func() {
+			app.Action = func(ctx *cli.Context) error {
+				config.IPv4Mode = true
+
+				nad := ovntest.GenerateNAD(networkName, nadName, namespaceName1,
+					types.Layer2Topology, "100.128.0.0/16", types.NetworkRoleSecondary)
+				nad.Annotations = map[string]string{types.OvnNetworkIDAnnotation: networkID}
+				netInfo := getNetInfoFromNAD(nad)
+				fakeOvn.startWithDBSetup(libovsdb.TestSetup{
+					NBData: []libovsdb.TestData{
+						&nbdb.NBGlobal{Name: types.OvnDefaultZone, UUID: types.OvnDefaultZone},
+					},
+				})
+
+				controller, err := NewSecondaryLayer2NetworkController(&fakeOvn.controller.CommonNetworkControllerInfo,
+					netInfo, fakeOvn.networkManager.Interface(), nil, fakeOvn.portCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(controller.multicastSupport).To(BeFalse())
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -39,6 +39,7 @@ func (bnc *BaseNetworkController) newNetpolRetryFramework(
 		HasUpdateFunc:          hasPolicyResourceAnUpdateFunc(objectType),
 		NeedsUpdateDuringRetry: needsPolicyResourceUpdateDuringRetry(objectType),
 		ObjType:                objectType,
+		NetworkName:            bnc.GetNetworkName(),
 		EventHandler:           eventHandler,
 	}
 	return retry.NewRetryFramework(
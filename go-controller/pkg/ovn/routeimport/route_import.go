@@ -3,6 +3,7 @@ package routeimport
 import (
 	"fmt"
 	"net"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
@@ -157,12 +158,13 @@ func (c *controller) NeedsReconciliation(network util.NetInfo) bool {
 	c.RLock()
 	defer c.RUnlock()
 
-	if c.networks[network.GetNetworkName()] == nil {
+	info := c.networks[network.GetNetworkName()]
+	if info == nil {
 		return false
 	}
 
 	// TODO check if overlay mode changed
-	return false
+	return !reflect.DeepEqual(info.GetRouteImportPolicy(), network.GetRouteImportPolicy())
 }
 
 func (c *controller) ReconcileNetwork(name string) error {
@@ -355,7 +357,12 @@ func (c *controller) syncNetwork(network string) error {
 		return nil
 	}
 
-	expected, err := c.getBGPRoutes(table, ignoreSubnets)
+	policy, err := newImportPolicy(info.GetRouteImportPolicy())
+	if err != nil {
+		return fmt.Errorf("invalid route import policy for network %s: %w", network, err)
+	}
+
+	expected, err := c.getBGPRoutes(table, ignoreSubnets, policy)
 	if err != nil {
 		return err
 	}
@@ -367,6 +374,17 @@ func (c *controller) syncNetwork(network string) error {
 
 	deletes := actual.Difference(expected)
 	adds := expected.Difference(actual)
+	if policy != nil && policy.maxRoutes > 0 && expected.Len() > policy.maxRoutes {
+		// don't import any new route while over the limit but do remove the
+		// ones no longer learned, subsequent route events will trigger a new
+		// reconciliation
+		c.log.Error(nil, "Learned more routes than allowed, not importing new routes",
+			"network", network,
+			"routes", expected.Len(),
+			"maxRoutes", policy.maxRoutes,
+		)
+		adds = nil
+	}
 	if len(deletes)+len(adds) == 0 {
 		c.log.V(5).Info("Found no updates for router", "router", router)
 		return nil
@@ -418,7 +436,7 @@ func (c *controller) syncNetwork(network string) error {
 	return err
 }
 
-func (c *controller) getBGPRoutes(table int, ignoreSubnets []*net.IPNet) (sets.Set[route], error) {
+func (c *controller) getBGPRoutes(table int, ignoreSubnets []*net.IPNet, policy *importPolicy) (sets.Set[route], error) {
 	start := time.Now()
 	filter := &netlink.Route{
 		Protocol: unix.RTPROT_BGP,
@@ -434,6 +452,9 @@ func (c *controller) getBGPRoutes(table int, ignoreSubnets []*net.IPNet) (sets.S
 		if util.IsContainedInAnyCIDR(nlroute.Dst, ignoreSubnets...) {
 			continue
 		}
+		if !policy.allows(nlroute.Dst) {
+			continue
+		}
 		routes.Insert(routesFromNetlinkRoute(&nlroute)...)
 	}
 
@@ -441,6 +462,57 @@ func (c *controller) getBGPRoutes(table int, ignoreSubnets []*net.IPNet) (sets.S
 	return routes, nil
 }
 
+// importPolicy is the parsed form of util.RouteImportPolicy
+type importPolicy struct {
+	allowed          []*net.IPNet
+	denied           []*net.IPNet
+	maxRoutes        int
+	defaultRouteOnly bool
+}
+
+func newImportPolicy(policy *util.RouteImportPolicy) (*importPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	allowed, err := util.ParseIPNets(policy.AllowedPrefixes)
+	if err != nil {
+		return nil, err
+	}
+	denied, err := util.ParseIPNets(policy.DeniedPrefixes)
+	if err != nil {
+		return nil, err
+	}
+	return &importPolicy{
+		allowed:          allowed,
+		denied:           denied,
+		maxRoutes:        policy.MaxRoutes,
+		defaultRouteOnly: policy.DefaultRouteOnly,
+	}, nil
+}
+
+// allows returns whether a route to the provided destination can be imported
+// as per policy. A nil policy allows any destination.
+func (p *importPolicy) allows(dst *net.IPNet) bool {
+	if p == nil {
+		return true
+	}
+	if dst == nil {
+		return false
+	}
+	if p.defaultRouteOnly {
+		if ones, _ := dst.Mask.Size(); ones != 0 {
+			return false
+		}
+	}
+	if util.IsContainedInAnyCIDR(dst, p.denied...) {
+		return false
+	}
+	if len(p.allowed) > 0 && !util.IsContainedInAnyCIDR(dst, p.allowed...) {
+		return false
+	}
+	return true
+}
+
 func (c *controller) getOVNRoutes(router string) (sets.Set[route], map[route]string, error) {
 	start := time.Now()
 	lr := &nbdb.LogicalRouter{
@@ -25,6 +25,19 @@ import (
 func Test_controller_syncNetwork(t *testing.T) {
 	node := "testnode"
 	defaultNetwork := &util.DefaultNetInfo{}
+	filteredNetwork := &util.DefaultNetInfo{}
+	filteredNetwork.SetRouteImportPolicy(&util.RouteImportPolicy{
+		AllowedPrefixes: []string{"1.1.0.0/16", "2.2.0.0/16"},
+		DeniedPrefixes:  []string{"2.2.2.0/24"},
+	})
+	defaultRouteOnlyNetwork := &util.DefaultNetInfo{}
+	defaultRouteOnlyNetwork.SetRouteImportPolicy(&util.RouteImportPolicy{
+		DefaultRouteOnly: true,
+	})
+	limitedNetwork := &util.DefaultNetInfo{}
+	limitedNetwork.SetRouteImportPolicy(&util.RouteImportPolicy{
+		MaxRoutes: 2,
+	})
 	type fields struct {
 		networkIDs map[int]string
 		networks   map[string]*netInfo
@@ -101,6 +114,72 @@ func Test_controller_syncNetwork(t *testing.T) {
 				&nbdb.LogicalRouterStaticRoute{UUID: "add-3", IPPrefix: "3.3.3.0/24", Nexthop: "3.3.3.2", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
 			},
 		},
+		{
+			name: "only adds routes allowed and not denied by the import policy",
+			args: args{"default"},
+			fields: fields{
+				networkIDs: map[int]string{0: "default"},
+				networks:   map[string]*netInfo{"default": {NetInfo: filteredNetwork, id: 0, table: unix.RT_TABLE_MAIN}},
+			},
+			initial: []libovsdb.TestData{
+				&nbdb.LogicalRouter{Name: filteredNetwork.GetNetworkScopedGWRouterName(node), StaticRoutes: []string{"remove"}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "remove", IPPrefix: "2.2.2.0/24", Nexthop: "2.2.2.1", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+			},
+			routes: []netlink.Route{
+				{Dst: ovntesting.MustParseIPNet("1.1.1.0/24"), Gw: ovntesting.MustParseIP("1.1.1.1")},
+				{Dst: ovntesting.MustParseIPNet("2.2.2.0/24"), Gw: ovntesting.MustParseIP("2.2.2.1")},
+				{Dst: ovntesting.MustParseIPNet("2.2.3.0/24"), Gw: ovntesting.MustParseIP("2.2.3.1")},
+				{Dst: ovntesting.MustParseIPNet("3.3.3.0/24"), Gw: ovntesting.MustParseIP("3.3.3.1")},
+			},
+			expected: []libovsdb.TestData{
+				&nbdb.LogicalRouter{UUID: "router", Name: filteredNetwork.GetNetworkScopedGWRouterName(node), StaticRoutes: []string{"add-1", "add-2"}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "add-1", IPPrefix: "1.1.1.0/24", Nexthop: "1.1.1.1", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "add-2", IPPrefix: "2.2.3.0/24", Nexthop: "2.2.3.1", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+			},
+		},
+		{
+			name: "only adds default routes if the import policy requires so",
+			args: args{"default"},
+			fields: fields{
+				networkIDs: map[int]string{0: "default"},
+				networks:   map[string]*netInfo{"default": {NetInfo: defaultRouteOnlyNetwork, id: 0, table: unix.RT_TABLE_MAIN}},
+			},
+			initial: []libovsdb.TestData{
+				&nbdb.LogicalRouter{Name: defaultRouteOnlyNetwork.GetNetworkScopedGWRouterName(node)},
+			},
+			routes: []netlink.Route{
+				{Dst: ovntesting.MustParseIPNet("0.0.0.0/0"), Gw: ovntesting.MustParseIP("1.1.1.1")},
+				{Dst: ovntesting.MustParseIPNet("::/0"), Gw: ovntesting.MustParseIP("fd00::1")},
+				{Dst: ovntesting.MustParseIPNet("2.2.2.0/24"), Gw: ovntesting.MustParseIP("2.2.2.1")},
+			},
+			expected: []libovsdb.TestData{
+				&nbdb.LogicalRouter{UUID: "router", Name: defaultRouteOnlyNetwork.GetNetworkScopedGWRouterName(node), StaticRoutes: []string{"add-1", "add-2"}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "add-1", IPPrefix: "0.0.0.0/0", Nexthop: "1.1.1.1", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "add-2", IPPrefix: "::/0", Nexthop: "fd00::1", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+			},
+		},
+		{
+			name: "only removes routes if over the maximum allowed by the import policy",
+			args: args{"default"},
+			fields: fields{
+				networkIDs: map[int]string{0: "default"},
+				networks:   map[string]*netInfo{"default": {NetInfo: limitedNetwork, id: 0, table: unix.RT_TABLE_MAIN}},
+			},
+			initial: []libovsdb.TestData{
+				&nbdb.LogicalRouter{Name: limitedNetwork.GetNetworkScopedGWRouterName(node), StaticRoutes: []string{"keep", "remove"}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "keep", IPPrefix: "1.1.1.0/24", Nexthop: "1.1.1.1", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "remove", IPPrefix: "6.6.6.0/24", Nexthop: "6.6.6.1", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+			},
+			routes: []netlink.Route{
+				{Dst: ovntesting.MustParseIPNet("1.1.1.0/24"), Gw: ovntesting.MustParseIP("1.1.1.1")},
+				{Dst: ovntesting.MustParseIPNet("2.2.2.0/24"), Gw: ovntesting.MustParseIP("2.2.2.1")},
+				{Dst: ovntesting.MustParseIPNet("3.3.3.0/24"), Gw: ovntesting.MustParseIP("3.3.3.1")},
+			},
+			expected: []libovsdb.TestData{
+				&nbdb.LogicalRouter{UUID: "router", Name: limitedNetwork.GetNetworkScopedGWRouterName(node), StaticRoutes: []string{"keep"}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "keep", IPPrefix: "1.1.1.0/24", Nexthop: "1.1.1.1", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -348,9 +348,7 @@ func NewSecondaryLayer2NetworkController(
 			claimsReconciler)
 	}
 
-	// enable multicast support for UDN only for primaries + multicast enabled
-	// TBD: changes needs to be made to support multicast beyond primary UDN
-	oc.multicastSupport = oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() && config.EnableMulticast
+	oc.multicastSupport = oc.isMulticastSupported()
 
 	oc.initRetryFramework()
 	return oc, nil
@@ -454,15 +452,16 @@ func (oc *SecondaryLayer2NetworkController) Init() error {
 		return err
 	}
 
-	// Configure cluster port groups and multicast default policies for user defined primary networks.
-	if oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() {
+	// Cluster port groups are needed by network policies on user defined
+	// primary networks and by multicast.
+	if oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() || oc.multicastSupport {
 		if err := oc.setupClusterPortGroups(); err != nil {
 			return fmt.Errorf("failed to create cluster port groups for network %q: %w", oc.GetNetworkName(), err)
 		}
+	}
 
-		if err := oc.syncDefaultMulticastPolicies(); err != nil {
-			return fmt.Errorf("failed to sync default multicast policies for network %q: %w", oc.GetNetworkName(), err)
-		}
+	if err := oc.syncDefaultMulticastPolicies(); err != nil {
+		return fmt.Errorf("failed to sync default multicast policies for network %q: %w", oc.GetNetworkName(), err)
 	}
 
 	return err
@@ -487,6 +486,11 @@ func (oc *SecondaryLayer2NetworkController) initRetryFramework() {
 		oc.retryNetworkPolicies = oc.newRetryFramework(factory.PolicyType)
 	}
 
+	// Multicast is enabled per namespace, watch for namespace events.
+	if oc.multicastSupport {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
+
 	// For secondary networks, we don't have to watch namespace events if
 	// multi-network policy support is not enabled. We don't support
 	// multi-network policy for IPAM-less secondary networks either.
@@ -510,6 +514,7 @@ func (oc *SecondaryLayer2NetworkController) newRetryFramework(
 		HasUpdateFunc:          hasResourceAnUpdateFunc(objectType),
 		NeedsUpdateDuringRetry: needsUpdateDuringRetry(objectType),
 		ObjType:                objectType,
+		NetworkName:            oc.GetNetworkName(),
 		EventHandler:           eventHandler,
 	}
 	return retry.NewRetryFramework(
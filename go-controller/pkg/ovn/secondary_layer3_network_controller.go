@@ -111,12 +111,14 @@ func (h *secondaryLayer3NetworkControllerEventHandler) AddResource(obj interface
 				_, syncMgmtPort := h.oc.mgmtPortFailed.Load(node.Name)
 				_, syncGw := h.oc.gatewaysFailed.Load(node.Name)
 				_, syncZoneIC := h.oc.syncZoneICFailed.Load(node.Name)
+				_, hoSync := h.oc.hybridOverlayFailed.Load(node.Name)
 				nodeParams = &nodeSyncs{
 					syncNode:              nodeSync,
 					syncClusterRouterPort: clusterRtrSync,
 					syncMgmtPort:          syncMgmtPort,
 					syncZoneIC:            syncZoneIC,
 					syncGw:                syncGw,
+					syncHo:                hoSync,
 				}
 			} else {
 				nodeParams = &nodeSyncs{
@@ -125,6 +127,7 @@ func (h *secondaryLayer3NetworkControllerEventHandler) AddResource(obj interface
 					syncMgmtPort:          true,
 					syncZoneIC:            config.OVNKubernetesFeature.EnableInterconnect,
 					syncGw:                true,
+					syncHo:                h.oc.hybridOverlayEnabled(),
 				}
 			}
 			if err := h.oc.addUpdateLocalNodeEvent(node, nodeParams); err != nil {
@@ -178,12 +181,16 @@ func (h *secondaryLayer3NetworkControllerEventHandler) UpdateResource(oldObj, ne
 					nodeSubnetChanged ||
 					hostCIDRsChanged(oldNode, newNode) ||
 					nodeGatewayMTUSupportChanged(oldNode, newNode)
+				_, hoSync := h.oc.hybridOverlayFailed.Load(newNode.Name)
+				hoSync = h.oc.hybridOverlayEnabled() && (hoSync || nodeSubnetChanged ||
+					hybridOverlayNetworkDRIPChanged(oldNode, newNode, h.oc.GetNetworkName()))
 				nodeSyncsParam = &nodeSyncs{
 					syncNode:              nodeSync,
 					syncClusterRouterPort: clusterRtrSync,
 					syncMgmtPort:          syncMgmtPort,
 					syncZoneIC:            syncZoneIC,
 					syncGw:                syncGw,
+					syncHo:                hoSync,
 				}
 			} else {
 				klog.Infof("Node %s moved from the remote zone %s to local zone %s.",
@@ -195,6 +202,7 @@ func (h *secondaryLayer3NetworkControllerEventHandler) UpdateResource(oldObj, ne
 					syncMgmtPort:          true,
 					syncZoneIC:            config.OVNKubernetesFeature.EnableInterconnect,
 					syncGw:                true,
+					syncHo:                h.oc.hybridOverlayEnabled(),
 				}
 			}
 
@@ -283,6 +291,10 @@ type SecondaryLayer3NetworkController struct {
 	nodeClusterRouterPortFailed sync.Map
 	syncZoneICFailed            sync.Map
 	gatewaysFailed              sync.Map
+	hybridOverlayFailed         sync.Map
+
+	// node name -> hybrid overlay distributed router IP of the network
+	hybridOverlayDRIPs sync.Map
 
 	gatewayManagers        sync.Map
 	gatewayTopologyFactory *topology.GatewayTopologyFactory
@@ -383,9 +395,7 @@ func NewSecondaryLayer3NetworkController(
 		oc.podAnnotationAllocator = podAnnotationAllocator
 	}
 
-	// enable multicast support for UDN only for primaries + multicast enabled
-	// TBD: changes needs to be made to support multicast beyond primary UDN
-	oc.multicastSupport = oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() && config.EnableMulticast
+	oc.multicastSupport = oc.isMulticastSupported()
 
 	oc.initRetryFramework()
 	return oc, nil
@@ -402,6 +412,11 @@ func (oc *SecondaryLayer3NetworkController) initRetryFramework() {
 		oc.retryNetworkPolicies = oc.newRetryFramework(factory.PolicyType)
 	}
 
+	// Multicast is enabled per namespace, watch for namespace events.
+	if oc.multicastSupport {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
+
 	// For secondary networks, we don't have to watch namespace events if
 	// multi-network policy support is not enabled. We don't support
 	// multi-network policy for IPAM-less secondary networks either.
@@ -425,6 +440,7 @@ func (oc *SecondaryLayer3NetworkController) newRetryFramework(
 		HasUpdateFunc:          hasResourceAnUpdateFunc(objectType),
 		NeedsUpdateDuringRetry: needsUpdateDuringRetry(objectType),
 		ObjType:                objectType,
+		NetworkName:            oc.GetNetworkName(),
 		EventHandler:           eventHandler,
 	}
 	return retry.NewRetryFramework(
@@ -456,6 +472,7 @@ func (oc *SecondaryLayer3NetworkController) Stop() {
 	oc.cancelableCtx.Cancel()
 	oc.wg.Wait()
 
+	oc.stopNetworkQoSController()
 	if oc.netPolicyHandler != nil {
 		oc.watchFactory.RemovePolicyHandler(oc.netPolicyHandler)
 	}
@@ -542,6 +559,12 @@ func (oc *SecondaryLayer3NetworkController) Cleanup() error {
 		klog.Errorf("Failed to delete load balancer groups on network: %q, error: %v", oc.GetNetworkName(), err)
 	}
 
+	if oc.hybridOverlayEnabled() {
+		if err := oc.cleanupHybridOverlay(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -589,6 +612,13 @@ func (oc *SecondaryLayer3NetworkController) Run() error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableNetworkQoS {
+		// NetworkQoS depends on WatchPods and WatchNodes
+		if err := oc.startNetworkQoSController(); err != nil {
+			return err
+		}
+	}
+
 	klog.Infof("Completing all the Watchers for network %s took %v", oc.GetNetworkName(), time.Since(start))
 
 	return nil
@@ -624,19 +654,24 @@ func (oc *SecondaryLayer3NetworkController) Init(ctx context.Context) error {
 		return fmt.Errorf("failed to create OVN cluster router for network %q: %v", oc.GetNetworkName(), err)
 	}
 
-	// Only configure join switch, GR, cluster port groups and multicast default policies for user defined primary networks.
-	if util.IsNetworkSegmentationSupportEnabled() && oc.IsPrimaryNetwork() {
+	// Only configure join switch and GR for user defined primary networks.
+	isPrimaryUDN := util.IsNetworkSegmentationSupportEnabled() && oc.IsPrimaryNetwork()
+	if isPrimaryUDN {
 		if err := oc.gatewayTopologyFactory.NewJoinSwitch(clusterRouter, oc.GetNetInfo(), oc.ovnClusterLRPToJoinIfAddrs); err != nil {
 			return fmt.Errorf("failed to create join switch for network %q: %v", oc.GetNetworkName(), err)
 		}
+	}
 
+	// Cluster port groups are needed by network policies on user defined
+	// primary networks and by multicast.
+	if isPrimaryUDN || oc.multicastSupport {
 		if err := oc.setupClusterPortGroups(); err != nil {
 			return fmt.Errorf("failed to create cluster port groups for network %q: %w", oc.GetNetworkName(), err)
 		}
+	}
 
-		if err := oc.syncDefaultMulticastPolicies(); err != nil {
-			return fmt.Errorf("failed to sync default multicast policies for network %q: %w", oc.GetNetworkName(), err)
-		}
+	if err := oc.syncDefaultMulticastPolicies(); err != nil {
+		return fmt.Errorf("failed to sync default multicast policies for network %q: %w", oc.GetNetworkName(), err)
 	}
 
 	// FIXME: When https://github.com/ovn-org/libovsdb/issues/235 is fixed,
@@ -678,6 +713,7 @@ func (oc *SecondaryLayer3NetworkController) addUpdateLocalNodeEvent(node *kapi.N
 			oc.mgmtPortFailed.Store(node.Name, true)
 			oc.syncZoneICFailed.Store(node.Name, true)
 			oc.gatewaysFailed.Store(node.Name, true)
+			oc.hybridOverlayFailed.Store(node.Name, oc.hybridOverlayEnabled())
 			err = fmt.Errorf("nodeAdd: error adding node %q for network %s: %w", node.Name, oc.GetNetworkName(), err)
 			oc.recordNodeErrorEvent(node, err)
 			return err
@@ -685,6 +721,15 @@ func (oc *SecondaryLayer3NetworkController) addUpdateLocalNodeEvent(node *kapi.N
 		oc.addNodeFailed.Delete(node.Name)
 	}
 
+	if nSyncs.syncHo {
+		if err = oc.syncHybridOverlayPort(node); err != nil {
+			errs = append(errs, err)
+			oc.hybridOverlayFailed.Store(node.Name, true)
+		} else {
+			oc.hybridOverlayFailed.Delete(node.Name)
+		}
+	}
+
 	if nSyncs.syncClusterRouterPort {
 		if err = oc.syncNodeClusterRouterPort(node, hostSubnets); err != nil {
 			errs = append(errs, err)
@@ -876,6 +921,8 @@ func (oc *SecondaryLayer3NetworkController) deleteNodeEvent(node *kapi.Node) err
 	oc.addNodeFailed.Delete(node.Name)
 	oc.mgmtPortFailed.Delete(node.Name)
 	oc.nodeClusterRouterPortFailed.Delete(node.Name)
+	oc.hybridOverlayFailed.Delete(node.Name)
+	oc.hybridOverlayDRIPs.Delete(node.Name)
 	if config.OVNKubernetesFeature.EnableInterconnect {
 		if err := oc.zoneICHandler.DeleteNode(node); err != nil {
 			return err
@@ -889,6 +936,11 @@ func (oc *SecondaryLayer3NetworkController) deleteNode(nodeName string) error {
 	if err := oc.deleteNodeLogicalNetwork(nodeName); err != nil {
 		return fmt.Errorf("error deleting node %s logical network: %v", nodeName, err)
 	}
+	if oc.hybridOverlayEnabled() {
+		if err := oc.deleteHybridOverlayPort(nodeName); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
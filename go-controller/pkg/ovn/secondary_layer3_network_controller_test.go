@@ -21,6 +21,7 @@ import (
 
 	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
+	hotypes "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/types"
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
@@ -442,6 +443,95 @@ var _ = Describe("OVN Multi-Homed pod operations for layer 3 network", func() {
 			}),
 		),
 	)
+
+	It("sets up and tears down the hybrid overlay port of a node on a user defined primary network", func() {
+		config.HybridOverlay.Enabled = true
+		config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+
+		netInfo := dummyPrimaryLayer3UserDefinedNetwork("192.168.0.0/16", "192.168.1.0/24")
+		netConf := netInfo.netconf()
+		netConf.HybridOverlaySubnets = "11.1.0.0/16"
+		networkConfig, err := util.NewNetInfo(netConf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(netInfo.setupOVNDependencies(&initialDB)).To(Succeed())
+		clusterRouter := &nbdb.LogicalRouter{
+			Name: networkConfig.GetNetworkScopedClusterRouterName(),
+			UUID: networkConfig.GetNetworkScopedClusterRouterName() + "-UUID",
+		}
+		nbZone := &nbdb.NBGlobal{Name: types.OvnDefaultZone, UUID: types.OvnDefaultZone}
+		initialDB.NBData = append(initialDB.NBData, clusterRouter, nbZone)
+
+		testNode, err := newNodeWithSecondaryNets(nodeName, "192.168.126.202/24", netInfo)
+		Expect(err).NotTo(HaveOccurred())
+		fakeOvn.startWithDBSetup(initialDB, &v1.NodeList{Items: []v1.Node{*testNode}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		controller := newSecondaryLayer3NetworkController(
+			&fakeOvn.controller.CommonNetworkControllerInfo,
+			networkConfig,
+			nodeName,
+			fakeOvn.networkManager.Interface(),
+			nil,
+			NewPortCache(ctx.Done()),
+		)
+		switchName := networkConfig.GetNetworkScopedSwitchName(nodeName)
+		Expect(controller.lsManager.AddOrUpdateSwitch(switchName, testing.MustParseIPNets(netInfo.hostsubnets))).To(Succeed())
+
+		Expect(controller.syncHybridOverlayPort(testNode)).To(Succeed())
+
+		Eventually(func() string {
+			node, err := fakeOvn.fakeClient.KubeClient.CoreV1().Nodes().Get(context.Background(), nodeName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return node.Annotations[hotypes.HybridOverlayNetworkDRIPs]
+		}).Should(MatchJSON(fmt.Sprintf(`{%q: "192.168.1.3"}`, netInfo.netName)))
+
+		hybridOverlayPort := &nbdb.LogicalSwitchPort{
+			UUID:      "hybrid-overlay-port-UUID",
+			Name:      util.GetHybridOverlayPortName(networkConfig.GetNetworkScopedName(nodeName)),
+			Addresses: []string{"0a:58:c0:a8:01:03"},
+		}
+		nodeSwitch := &nbdb.LogicalSwitch{
+			UUID: switchName + "_UUID",
+			Name: switchName,
+			ExternalIDs: map[string]string{
+				types.NetworkExternalID:     netInfo.netName,
+				types.NetworkRoleExternalID: netInfo.getNetworkRole(),
+			},
+			Ports:       []string{hybridOverlayPort.UUID},
+			OtherConfig: map[string]string{"exclude_ips": "192.168.1.2"},
+		}
+		routerPort := types.RouterToSwitchPrefix + switchName
+		policy := &nbdb.LogicalRouterPolicy{
+			UUID:     "hybrid-overlay-policy-UUID",
+			Priority: types.HybridOverlaySubnetPriority,
+			ExternalIDs: map[string]string{
+				"name": types.HybridSubnetPrefix + networkConfig.GetNetworkScopedName(nodeName) + ":11.1.0.0/16",
+			},
+			Action:   nbdb.LogicalRouterPolicyActionReroute,
+			Nexthops: []string{"192.168.1.3"},
+			Match:    fmt.Sprintf(`inport == "%s" && ip4.dst == 11.1.0.0/16`, routerPort),
+		}
+		staticMACBinding := &nbdb.StaticMACBinding{
+			UUID:               "hybrid-overlay-smb-UUID",
+			LogicalPort:        routerPort,
+			MAC:                "0a:58:c0:a8:01:03",
+			IP:                 "192.168.1.3",
+			OverrideDynamicMAC: true,
+		}
+		routerWithPolicy := clusterRouter.DeepCopy()
+		routerWithPolicy.Policies = []string{policy.UUID}
+		Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(
+			append(emptyDefaultClusterNetworkNodeSwitch(nodeName),
+				nbZone, nodeSwitch, hybridOverlayPort, routerWithPolicy, policy, staticMACBinding)...,
+		))
+
+		Expect(controller.deleteHybridOverlayPort(nodeName)).To(Succeed())
+		Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(
+			append(emptyDefaultClusterNetworkNodeSwitch(nodeName),
+				nbZone, nodeSwitch, hybridOverlayPort, clusterRouter)...,
+		))
+	})
 })
 
 func newPodWithPrimaryUDN(
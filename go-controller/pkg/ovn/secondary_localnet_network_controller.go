@@ -238,9 +238,7 @@ func NewSecondaryLocalnetNetworkController(
 			claimsReconciler)
 	}
 
-	// disable multicast support for secondary networks
-	// TBD: changes needs to be made to support multicast in secondary networks
-	oc.multicastSupport = false
+	oc.multicastSupport = oc.isMulticastSupported()
 
 	oc.initRetryFramework()
 	return oc
@@ -294,12 +292,34 @@ func (oc *SecondaryLocalnetNetworkController) Init() error {
 		logicalSwitchPort.TagRequest = &intVlanID
 	}
 
+	if oc.multicastSupport {
+		// Send the IGMP/MLD reports of the pods to the multicast routers of
+		// the physical network
+		logicalSwitchPort.Options["mcast_flood_reports"] = "true"
+	}
+
 	err = libovsdbops.CreateOrUpdateLogicalSwitchPortsOnSwitch(oc.nbClient, logicalSwitch, &logicalSwitchPort)
 	if err != nil {
 		klog.Errorf("Failed to add logical port %+v to switch %s: %v", logicalSwitchPort, switchName, err)
 		return err
 	}
 
+	if oc.multicastSupport {
+		if err := oc.setupClusterPortGroups(); err != nil {
+			return fmt.Errorf("failed to create cluster port groups for network %q: %w", oc.GetNetworkName(), err)
+		}
+		// The localnet port plays the role of the cluster router ports of
+		// the Layer3 networks: multicast is allowed from and to it.
+		err = libovsdbops.AddPortsToPortGroup(oc.nbClient, oc.getClusterPortGroupName(types.ClusterRtrPortGroupNameBase), logicalSwitchPort.UUID)
+		if err != nil {
+			return fmt.Errorf("failed adding localnet port to port group for multicast: %w", err)
+		}
+	}
+
+	if err := oc.syncDefaultMulticastPolicies(); err != nil {
+		return fmt.Errorf("failed to sync default multicast policies for network %q: %w", oc.GetNetworkName(), err)
+	}
+
 	return nil
 }
 
@@ -315,6 +335,11 @@ func (oc *SecondaryLocalnetNetworkController) initRetryFramework() {
 		oc.retryIPAMClaims = oc.newRetryFramework(factory.IPAMClaimsType)
 	}
 
+	// Multicast is enabled per namespace, watch for namespace events.
+	if oc.multicastSupport {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
+
 	// For secondary networks, we don't have to watch namespace events if
 	// multi-network policy support is not enabled. We don't support
 	// multi-network policy for IPAM-less secondary networks either.
@@ -338,6 +363,7 @@ func (oc *SecondaryLocalnetNetworkController) newRetryFramework(
 		HasUpdateFunc:          hasResourceAnUpdateFunc(objectType),
 		NeedsUpdateDuringRetry: needsUpdateDuringRetry(objectType),
 		ObjType:                objectType,
+		NetworkName:            oc.GetNetworkName(),
 		EventHandler:           eventHandler,
 	}
 	return retry.NewRetryFramework(
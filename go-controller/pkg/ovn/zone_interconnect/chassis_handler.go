@@ -107,6 +107,10 @@ func (zic *ZoneChassisHandler) SyncNodes(kNodes []interface{}) error {
 	}
 
 	for _, ch := range chassis {
+		if isClusterLinkChassis(ch) {
+			// chassis of the nodes of linked clusters are synced by the ClusterLink controller
+			continue
+		}
 		if ch.OtherConfig != nil && strings.ToLower(ch.OtherConfig["is-remote"]) == "true" {
 			if !foundNodes.Has(ch.Hostname) {
 				// Its a stale remote chassis, delete it.
@@ -133,9 +137,9 @@ func (zch *ZoneChassisHandler) createOrUpdateNodeChassis(node *corev1.Node, isRe
 			node.Name, parsedErr)
 	}
 
-	nodePrimaryIp, err := util.GetNodePrimaryIP(node)
+	encapIPs, err := util.GetNodeEncapIPs(node)
 	if err != nil {
-		return fmt.Errorf("failed to parse node %s primary IP %w", node.Name, err)
+		return err
 	}
 
 	chassis := sbdb.Chassis{
@@ -146,17 +150,21 @@ func (zch *ZoneChassisHandler) createOrUpdateNodeChassis(node *corev1.Node, isRe
 		},
 	}
 
-	encap := sbdb.Encap{
-		ChassisName: chassisID,
-		IP:          nodePrimaryIp,
-		Type:        "geneve",
-		Options:     map[string]string{"csum": "true"},
-	}
+	encaps := make([]*sbdb.Encap, 0, len(encapIPs))
+	for _, encapIP := range encapIPs {
+		encap := &sbdb.Encap{
+			ChassisName: chassisID,
+			IP:          encapIP,
+			Type:        "geneve",
+			Options:     map[string]string{"csum": "true"},
+		}
 
-	// set the geneve port if using something else than default
-	if config.Default.EncapPort != config.DefaultEncapPort {
-		encap.Options["dst_port"] = strconv.FormatUint(uint64(config.Default.EncapPort), 10)
+		// set the geneve port if using something else than default
+		if config.Default.EncapPort != config.DefaultEncapPort {
+			encap.Options["dst_port"] = strconv.FormatUint(uint64(config.Default.EncapPort), 10)
+		}
+		encaps = append(encaps, encap)
 	}
 
-	return libovsdbops.CreateOrUpdateChassis(zch.sbClient, &chassis, &encap)
+	return libovsdbops.CreateOrUpdateChassis(zch.sbClient, &chassis, encaps...)
 }
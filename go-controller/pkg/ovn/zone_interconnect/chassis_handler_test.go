@@ -175,6 +175,61 @@ var _ = ginkgo.Describe("Zone Interconnect Chassis Operations", func() {
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	ginkgo.It("creates an encap for each of the node encap IPs", func() {
+		app.Action = func(ctx *cli.Context) error {
+			dbSetup := libovsdbtest.TestSetup{
+				SBData: initialSBDB,
+			}
+
+			_, err := config.InitConfig(ctx, nil, nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			config.Kubernetes.HostNetworkNamespace = ""
+
+			var libovsdbOvnSBClient libovsdbclient.Client
+			_, libovsdbOvnSBClient, libovsdbCleanup, err = libovsdbtest.NewNBSBTestHarness(dbSetup)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			zoneChassisHandler := NewZoneChassisHandler(libovsdbOvnSBClient)
+			testNode3.Annotations["k8s.ovn.org/node-encap-ips"] = `["10.0.0.12","10.1.0.12"]`
+			err = zoneChassisHandler.AddRemoteZoneNode(&testNode3)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			nodeCh, err := libovsdbops.GetChassis(libovsdbOvnSBClient, &node3Chassis)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(nodeCh.Encaps).To(gomega.HaveLen(2))
+			for _, ip := range []string{"10.0.0.12", "10.1.0.12"} {
+				encap := &sbdb.Encap{Type: "geneve", IP: ip}
+				err = libovsdbOvnSBClient.Get(context.Background(), encap)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(encap.ChassisName).To(gomega.Equal(node3Chassis.Name))
+				gomega.Expect(nodeCh.Encaps).To(gomega.ContainElement(encap.UUID))
+			}
+
+			// remove one of the encap IPs
+			testNode3.Annotations["k8s.ovn.org/node-encap-ips"] = `["10.1.0.12"]`
+			err = zoneChassisHandler.AddRemoteZoneNode(&testNode3)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			nodeCh, err = libovsdbops.GetChassis(libovsdbOvnSBClient, &node3Chassis)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(nodeCh.Encaps).To(gomega.HaveLen(1))
+			encap := &sbdb.Encap{Type: "geneve", IP: "10.1.0.12"}
+			err = libovsdbOvnSBClient.Get(context.Background(), encap)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(nodeCh.Encaps).To(gomega.ConsistOf(encap.UUID))
+			return nil
+		}
+
+		err := app.Run([]string{
+			app.Name,
+			"-cluster-subnets=" + clusterCIDR,
+			"-init-cluster-manager",
+			"-zone-join-switch-subnets=" + joinSubnetCIDR,
+			"-enable-interconnect",
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	ginkgo.It("Move chassis zone", func() {
 		app.Action = func(ctx *cli.Context) error {
 			dbSetup := libovsdbtest.TestSetup{
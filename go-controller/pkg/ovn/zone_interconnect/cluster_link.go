@@ -0,0 +1,254 @@
+package zoneinterconnect
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/libovsdb/ovsdb"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	clusterlinkapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+/*
+ * A ClusterLink connects the pod network of the cluster with the pod network
+ * of a remote cluster. The nodes of the remote cluster are handled like remote
+ * zone nodes, with the following differences:
+ *
+ *  - The names of the remote cluster nodes may collide with the names of the
+ *    local nodes, so the interconnect resources are named after the node name
+ *    scoped by the ClusterLink name: <link>_<node>. '_' is not valid in either
+ *    name so the scoped names can't collide with each other.
+ *  - The join subnets of both clusters overlap, so only the pod subnets of the
+ *    remote cluster nodes are routed through their transit switch port.
+ *  - The transit switch ports, static routes and chassis are marked with the
+ *    ClusterLink name so that they are not deleted when the local nodes are
+ *    synced.
+ *
+ * $ ovn-nbctl show transit_switch
+ *     port tstor-east_ovn-worker
+ *        type: remote
+ *        addresses: ["0a:58:64:58:13:8a 100.88.19.138/16"]
+ *
+ * $ ovn-nbctl lr-route-list ovn_cluster_router
+ *    IPv4 Routes
+ *    Route Table <main>:
+ *    ...
+ *    10.245.1.0/24 (east ovn-worker subnet)      100.88.19.138 dst-ip
+ *
+ * $ ovn-sbctl show
+ *     Chassis "0b1f6e62-4e0a-4b4c-a8f5-5bd7a8bbd4a2"
+ *     hostname: east_ovn-worker
+ *     Encap geneve
+ *         ip: "172.19.0.4"
+ *         options: {csum="true"}
+ *     Port_Binding tstor-east_ovn-worker
+ */
+
+// ClusterLinkExternalID is the external ID of the NB transit switch ports and
+// static routes, and the other_config key of the SB chassis, holding the name
+// of the ClusterLink they were created for
+const ClusterLinkExternalID = "cluster-link"
+
+// GetClusterLinkNodeName returns the name the interconnect resources of the
+// node 'node' of the cluster linked by the ClusterLink 'link' are named after
+func GetClusterLinkNodeName(link, node string) string {
+	return link + "_" + node
+}
+
+// AddRemoteClusterNode creates the interconnect resources in OVN NBDB for the
+// node of the cluster linked by the ClusterLink 'link':
+//   - a logical port of type "remote" in the transit switch bound to the node
+//     chassis
+//   - static routes for the node pod subnets via the remote port ip in the
+//     ovn_cluster_router
+func (zic *ZoneInterconnectHandler) AddRemoteClusterNode(link string, node *clusterlinkapi.RemoteNode) error {
+	nodeName := GetClusterLinkNodeName(link, node.Name)
+	klog.Infof("Creating interconnect resources for node %s of ClusterLink %s for the network %s", node.Name, link, zic.GetNetworkName())
+
+	nodeTransitSwitchPortIPs, err := util.ParseIPNets(node.TransitSwitchIPs)
+	if err != nil || len(nodeTransitSwitchPortIPs) == 0 {
+		return fmt.Errorf("failed to parse the transit switch port IP addresses %v of node %s: %v", node.TransitSwitchIPs, nodeName, err)
+	}
+	nodeSubnets, err := util.ParseIPNets(node.Subnets)
+	if err != nil {
+		return fmt.Errorf("failed to parse the subnets %v of node %s: %w", node.Subnets, nodeName, err)
+	}
+
+	remotePortAddr := util.IPAddrToHWAddr(nodeTransitSwitchPortIPs[0].IP).String()
+	for _, ip := range nodeTransitSwitchPortIPs {
+		remotePortAddr = remotePortAddr + " " + ip.String()
+	}
+
+	lspOptions := map[string]string{
+		"requested-tnl-key": strconv.Itoa(int(node.NodeID)),
+		"requested-chassis": nodeName,
+	}
+	externalIDs := map[string]string{
+		"node":                nodeName,
+		ClusterLinkExternalID: link,
+	}
+	remotePortName := zic.GetNetworkScopedName(types.TransitSwitchToRouterPrefix + nodeName)
+	if err := zic.addNodeLogicalSwitchPort(zic.networkTransitSwitchName, remotePortName, lportTypeRemote, []string{remotePortAddr}, lspOptions, externalIDs); err != nil {
+		return err
+	}
+
+	staticRoutes := sets.New[string]()
+	for _, staticRoute := range zic.getStaticRoutes(nodeSubnets, nodeTransitSwitchPortIPs, false) {
+		staticRoutes.Insert(staticRoute.prefix + " " + staticRoute.nexthop)
+		lrsr := nbdb.LogicalRouterStaticRoute{
+			ExternalIDs: map[string]string{
+				"ic-node":             nodeName,
+				ClusterLinkExternalID: link,
+			},
+			Nexthop:  staticRoute.nexthop,
+			IPPrefix: staticRoute.prefix,
+		}
+		p := func(item *nbdb.LogicalRouterStaticRoute) bool {
+			return item.IPPrefix == lrsr.IPPrefix &&
+				item.Nexthop == lrsr.Nexthop &&
+				item.ExternalIDs["ic-node"] == nodeName
+		}
+		if err := libovsdbops.CreateOrReplaceLogicalRouterStaticRouteWithPredicate(zic.nbClient, zic.networkClusterRouterName, &lrsr, p); err != nil {
+			return fmt.Errorf("error adding static route %s - %s to the router %s : %w", lrsr.IPPrefix, lrsr.Nexthop, zic.networkClusterRouterName, err)
+		}
+	}
+
+	// Delete the routes to subnets the node doesn't have anymore
+	p := func(item *nbdb.LogicalRouterStaticRoute) bool {
+		return item.ExternalIDs["ic-node"] == nodeName && !staticRoutes.Has(item.IPPrefix+" "+item.Nexthop)
+	}
+	if err := libovsdbops.DeleteLogicalRouterStaticRoutesWithPredicate(zic.nbClient, zic.networkClusterRouterName, p); err != nil {
+		return fmt.Errorf("failed to cleanup stale static routes for node %s: %w", nodeName, err)
+	}
+
+	return nil
+}
+
+// DeleteRemoteClusterNodes deletes the interconnect resources in OVN NBDB of
+// the nodes of the cluster linked by the ClusterLink 'link', other than the
+// nodes in 'keep'
+func (zic *ZoneInterconnectHandler) DeleteRemoteClusterNodes(link string, keep sets.Set[string]) error {
+	keepNodeNames := sets.New[string]()
+	for node := range keep {
+		keepNodeNames.Insert(GetClusterLinkNodeName(link, node))
+	}
+	return zic.deleteRemoteClusterNodesWithPredicate(func(nodeLink, nodeName string) bool {
+		return nodeLink == link && !keepNodeNames.Has(nodeName)
+	})
+}
+
+// SyncRemoteClusterNodes deletes the interconnect resources in OVN NBDB of the
+// nodes of the clusters linked by ClusterLinks other than 'links'
+func (zic *ZoneInterconnectHandler) SyncRemoteClusterNodes(links sets.Set[string]) error {
+	return zic.deleteRemoteClusterNodesWithPredicate(func(nodeLink, _ string) bool {
+		return !links.Has(nodeLink)
+	})
+}
+
+func (zic *ZoneInterconnectHandler) deleteRemoteClusterNodesWithPredicate(stale func(link, nodeName string) bool) error {
+	var ops []ovsdb.Operation
+	var err error
+	lspPredicate := func(lsp *nbdb.LogicalSwitchPort) bool {
+		link, ok := lsp.ExternalIDs[ClusterLinkExternalID]
+		return ok && stale(link, lsp.ExternalIDs["node"])
+	}
+	ops, err = libovsdbops.DeleteLogicalSwitchPortsWithPredicateOps(zic.nbClient, ops, &nbdb.LogicalSwitch{Name: zic.networkTransitSwitchName}, lspPredicate)
+	if err != nil {
+		return fmt.Errorf("failed to get the ops to delete stale ClusterLink ports from switch %s: %w", zic.networkTransitSwitchName, err)
+	}
+
+	lrsrPredicate := func(lrsr *nbdb.LogicalRouterStaticRoute) bool {
+		link, ok := lrsr.ExternalIDs[ClusterLinkExternalID]
+		return ok && stale(link, lrsr.ExternalIDs["ic-node"])
+	}
+	ops, err = libovsdbops.DeleteLogicalRouterStaticRoutesWithPredicateOps(zic.nbClient, ops, zic.networkClusterRouterName, lrsrPredicate)
+	if err != nil {
+		return fmt.Errorf("failed to get the ops to delete stale ClusterLink static routes from router %s: %w", zic.networkClusterRouterName, err)
+	}
+
+	if _, err = libovsdbops.TransactAndCheck(zic.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to delete stale ClusterLink interconnect resources for the network %s: %w", zic.GetNetworkName(), err)
+	}
+	return nil
+}
+
+// AddRemoteClusterNode creates the remote chassis in the SB DB for the node of
+// the cluster linked by the ClusterLink 'link'
+func (zch *ZoneChassisHandler) AddRemoteClusterNode(link string, node *clusterlinkapi.RemoteNode) error {
+	nodeName := GetClusterLinkNodeName(link, node.Name)
+	chassis := sbdb.Chassis{
+		Name:     node.ChassisID,
+		Hostname: nodeName,
+		OtherConfig: map[string]string{
+			"is-remote":           "true",
+			ClusterLinkExternalID: link,
+		},
+	}
+
+	encaps := make([]*sbdb.Encap, 0, len(node.EncapIPs))
+	for _, encapIP := range node.EncapIPs {
+		encap := &sbdb.Encap{
+			ChassisName: node.ChassisID,
+			IP:          encapIP,
+			Type:        "geneve",
+			Options:     map[string]string{"csum": "true"},
+		}
+		if config.Default.EncapPort != config.DefaultEncapPort {
+			encap.Options["dst_port"] = strconv.FormatUint(uint64(config.Default.EncapPort), 10)
+		}
+		encaps = append(encaps, encap)
+	}
+
+	if err := libovsdbops.CreateOrUpdateChassis(zch.sbClient, &chassis, encaps...); err != nil {
+		return fmt.Errorf("failed to create or update chassis for node %s: %w", nodeName, err)
+	}
+	return nil
+}
+
+// DeleteRemoteClusterNodes deletes the remote chassis of the nodes of the
+// cluster linked by the ClusterLink 'link', other than the nodes in 'keep'
+func (zch *ZoneChassisHandler) DeleteRemoteClusterNodes(link string, keep sets.Set[string]) error {
+	keepNodeNames := sets.New[string]()
+	for node := range keep {
+		keepNodeNames.Insert(GetClusterLinkNodeName(link, node))
+	}
+	p := func(chassis *sbdb.Chassis) bool {
+		return isClusterLinkChassis(chassis) && chassis.OtherConfig[ClusterLinkExternalID] == link && !keepNodeNames.Has(chassis.Hostname)
+	}
+	if err := libovsdbops.DeleteChassisWithPredicate(zch.sbClient, p); err != nil {
+		return fmt.Errorf("failed to delete stale chassis of ClusterLink %s: %w", link, err)
+	}
+	return nil
+}
+
+// SyncRemoteClusterNodes deletes the remote chassis of the nodes of the
+// clusters linked by ClusterLinks other than 'links'
+func (zch *ZoneChassisHandler) SyncRemoteClusterNodes(links sets.Set[string]) error {
+	p := func(chassis *sbdb.Chassis) bool {
+		return isClusterLinkChassis(chassis) && !links.Has(chassis.OtherConfig[ClusterLinkExternalID])
+	}
+	if err := libovsdbops.DeleteChassisWithPredicate(zch.sbClient, p); err != nil {
+		return fmt.Errorf("failed to delete stale ClusterLink chassis: %w", err)
+	}
+	return nil
+}
+
+// isClusterLinkChassis returns true if the chassis is the remote chassis of a
+// node of a cluster linked by a ClusterLink
+func isClusterLinkChassis(chassis *sbdb.Chassis) bool {
+	if chassis.OtherConfig == nil || strings.ToLower(chassis.OtherConfig["is-remote"]) != "true" {
+		return false
+	}
+	_, ok := chassis.OtherConfig[ClusterLinkExternalID]
+	return ok
+}
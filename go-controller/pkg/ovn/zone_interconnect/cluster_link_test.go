@@ -0,0 +1,266 @@
+package zoneinterconnect
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/urfave/cli/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	clusterlinkapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+var _ = ginkgo.Describe("Zone Interconnect ClusterLink Operations", func() {
+	var (
+		app             *cli.App
+		libovsdbCleanup *libovsdbtest.Context
+		localNode       corev1.Node
+		remoteNode      clusterlinkapi.RemoteNode
+	)
+
+	const (
+		clusterCIDR     string = "10.1.0.0/16"
+		joinSubnetCIDR  string = "100.64.0.0/16/19"
+		link            string = "east"
+		remoteChassisID string = "0b1f6e62-4e0a-4b4c-a8f5-5bd7a8bbd4a2"
+	)
+
+	getRemotePort := func(nbClient libovsdbclient.Client) (*nbdb.LogicalSwitchPort, error) {
+		return libovsdbops.GetLogicalSwitchPort(nbClient, &nbdb.LogicalSwitchPort{Name: types.TransitSwitchToRouterPrefix + "east_node1"})
+	}
+
+	getRemoteRoutes := func(nbClient libovsdbclient.Client) []string {
+		routes, err := libovsdbops.FindLogicalRouterStaticRoutesWithPredicate(nbClient, func(lrsr *nbdb.LogicalRouterStaticRoute) bool {
+			return lrsr.ExternalIDs["ic-node"] == "east_node1"
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		var prefixes []string
+		for _, route := range routes {
+			prefixes = append(prefixes, route.IPPrefix+" via "+route.Nexthop)
+		}
+		return prefixes
+	}
+
+	ginkgo.BeforeEach(func() {
+		// Restore global default values before each testcase
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+
+		app = cli.NewApp()
+		app.Name = "test"
+		app.Flags = config.Flags
+		libovsdbCleanup = nil
+
+		// the local node has the same name as the remote cluster node
+		localNode = corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node1",
+				Annotations: map[string]string{
+					ovnNodeChassisIDAnnotatin:          "cb9ec8fa-b409-4ef3-9f42-d9283c47aac6",
+					ovnNodeZoneNameAnnotation:          "global",
+					ovnNodeIDAnnotaton:                 "2",
+					ovnNodeSubnetsAnnotation:           "{\"default\":[\"10.244.2.0/24\"]}",
+					ovnTransitSwitchPortAddrAnnotation: "{\"ipv4\":\"100.88.0.2/16\"}",
+					util.OVNNodeGRLRPAddrs:             "{\"default\":{\"ipv4\":\"100.64.0.2/16\"}}",
+					ovnNodeNetworkIDsAnnotation:        "{\"default\":\"0\"}",
+				},
+			},
+			Status: corev1.NodeStatus{
+				Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.0.10"}},
+			},
+		}
+		remoteNode = clusterlinkapi.RemoteNode{
+			Name:             "node1",
+			NodeID:           5002,
+			ChassisID:        remoteChassisID,
+			EncapIPs:         []string{"172.19.0.4", "172.19.0.5"},
+			TransitSwitchIPs: []string{"100.88.19.138/16"},
+			Subnets:          []string{"10.245.1.0/24"},
+		}
+	})
+
+	ginkgo.AfterEach(func() {
+		if libovsdbCleanup != nil {
+			libovsdbCleanup.Cleanup()
+		}
+	})
+
+	ginkgo.It("creates and deletes the interconnect resources of the remote cluster nodes", func() {
+		app.Action = func(ctx *cli.Context) error {
+			dbSetup := libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					newClusterJoinSwitch(),
+					newOVNClusterRouter(types.DefaultNetworkName),
+				},
+				SBData: []libovsdbtest.TestData{
+					&sbdb.Chassis{Name: "cb9ec8fa-b409-4ef3-9f42-d9283c47aac6", Hostname: "node1", UUID: "cb9ec8fa-b409-4ef3-9f42-d9283c47aac6"},
+				},
+			}
+
+			_, err := config.InitConfig(ctx, nil, nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			config.Kubernetes.HostNetworkNamespace = ""
+
+			var libovsdbOvnNBClient, libovsdbOvnSBClient libovsdbclient.Client
+			libovsdbOvnNBClient, libovsdbOvnSBClient, libovsdbCleanup, err = libovsdbtest.NewNBSBTestHarness(dbSetup)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			err = createTransitSwitchPortBindings(libovsdbOvnSBClient, types.DefaultNetworkName, &localNode)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			zoneICHandler := NewZoneInterconnectHandler(&util.DefaultNetInfo{}, libovsdbOvnNBClient, libovsdbOvnSBClient, nil)
+			zoneChassisHandler := NewZoneChassisHandler(libovsdbOvnSBClient)
+			err = zoneICHandler.createOrUpdateTransitSwitch(0)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = zoneICHandler.AddLocalZoneNode(&localNode)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = zoneChassisHandler.AddLocalZoneNode(&localNode)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			err = zoneChassisHandler.AddRemoteClusterNode(link, &remoteNode)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = zoneICHandler.AddRemoteClusterNode(link, &remoteNode)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			// the remote chassis is named after the scoped node name
+			chassis, err := libovsdbops.GetChassis(libovsdbOvnSBClient, &sbdb.Chassis{Name: remoteChassisID})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(chassis.Hostname).To(gomega.Equal("east_node1"))
+			gomega.Expect(chassis.OtherConfig).To(gomega.HaveKeyWithValue("is-remote", "true"))
+			gomega.Expect(chassis.OtherConfig).To(gomega.HaveKeyWithValue(ClusterLinkExternalID, link))
+			gomega.Expect(chassis.Encaps).To(gomega.HaveLen(2))
+
+			// the remote port is bound to the remote chassis with the remote node ID
+			lsp, err := getRemotePort(libovsdbOvnNBClient)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(lsp.Type).To(gomega.Equal(lportTypeRemote))
+			gomega.Expect(lsp.Addresses).To(gomega.ConsistOf("0a:58:64:58:13:8a 100.88.19.138/16"))
+			gomega.Expect(lsp.Options).To(gomega.HaveKeyWithValue("requested-tnl-key", "5002"))
+			gomega.Expect(lsp.Options).To(gomega.HaveKeyWithValue("requested-chassis", "east_node1"))
+			gomega.Expect(lsp.ExternalIDs).To(gomega.HaveKeyWithValue(ClusterLinkExternalID, link))
+
+			// only the pod subnet of the remote node is routed
+			gomega.Expect(getRemoteRoutes(libovsdbOvnNBClient)).To(gomega.ConsistOf("10.245.1.0/24 via 100.88.19.138"))
+
+			// the local node with the same name is not affected
+			_, err = libovsdbops.GetLogicalRouterPort(libovsdbOvnNBClient, &nbdb.LogicalRouterPort{Name: types.RouterToTransitSwitchPrefix + "node1"})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			// syncing the local nodes keeps the remote cluster nodes
+			err = zoneICHandler.SyncNodes([]interface{}{&localNode})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = zoneChassisHandler.SyncNodes([]interface{}{&localNode})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = getRemotePort(libovsdbOvnNBClient)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = libovsdbops.GetChassis(libovsdbOvnSBClient, &sbdb.Chassis{Name: remoteChassisID})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			// routes to subnets the remote node doesn't have anymore are removed
+			remoteNode.Subnets = []string{"10.245.2.0/24"}
+			err = zoneICHandler.AddRemoteClusterNode(link, &remoteNode)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(getRemoteRoutes(libovsdbOvnNBClient)).To(gomega.ConsistOf("10.245.2.0/24 via 100.88.19.138"))
+
+			// nodes to keep are not deleted
+			err = zoneICHandler.DeleteRemoteClusterNodes(link, sets.New(remoteNode.Name))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = zoneChassisHandler.DeleteRemoteClusterNodes(link, sets.New(remoteNode.Name))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = getRemotePort(libovsdbOvnNBClient)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			// the remote node is gone
+			err = zoneICHandler.DeleteRemoteClusterNodes(link, sets.New[string]())
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = zoneChassisHandler.DeleteRemoteClusterNodes(link, sets.New[string]())
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = getRemotePort(libovsdbOvnNBClient)
+			gomega.Expect(err).To(gomega.MatchError(libovsdbclient.ErrNotFound))
+			gomega.Expect(getRemoteRoutes(libovsdbOvnNBClient)).To(gomega.BeEmpty())
+			_, err = libovsdbops.GetChassis(libovsdbOvnSBClient, &sbdb.Chassis{Name: remoteChassisID})
+			gomega.Expect(err).To(gomega.MatchError(libovsdbclient.ErrNotFound))
+
+			// the local node is still there
+			_, err = libovsdbops.GetLogicalSwitchPort(libovsdbOvnNBClient, &nbdb.LogicalSwitchPort{Name: types.TransitSwitchToRouterPrefix + "node1"})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return nil
+		}
+
+		err := app.Run([]string{
+			app.Name,
+			"-cluster-subnets=" + clusterCIDR,
+			"-init-cluster-manager",
+			"-zone-join-switch-subnets=" + joinSubnetCIDR,
+			"-enable-interconnect",
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("deletes the interconnect resources of deleted ClusterLinks on sync", func() {
+		app.Action = func(ctx *cli.Context) error {
+			dbSetup := libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					newClusterJoinSwitch(),
+					newOVNClusterRouter(types.DefaultNetworkName),
+				},
+			}
+
+			_, err := config.InitConfig(ctx, nil, nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			config.Kubernetes.HostNetworkNamespace = ""
+
+			var libovsdbOvnNBClient, libovsdbOvnSBClient libovsdbclient.Client
+			libovsdbOvnNBClient, libovsdbOvnSBClient, libovsdbCleanup, err = libovsdbtest.NewNBSBTestHarness(dbSetup)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			zoneICHandler := NewZoneInterconnectHandler(&util.DefaultNetInfo{}, libovsdbOvnNBClient, libovsdbOvnSBClient, nil)
+			zoneChassisHandler := NewZoneChassisHandler(libovsdbOvnSBClient)
+			err = zoneICHandler.createOrUpdateTransitSwitch(0)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			err = zoneChassisHandler.AddRemoteClusterNode(link, &remoteNode)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = zoneICHandler.AddRemoteClusterNode(link, &remoteNode)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			// the ClusterLink still exists
+			err = zoneICHandler.SyncRemoteClusterNodes(sets.New(link))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = zoneChassisHandler.SyncRemoteClusterNodes(sets.New(link))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = getRemotePort(libovsdbOvnNBClient)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			// the ClusterLink was deleted
+			err = zoneICHandler.SyncRemoteClusterNodes(sets.New[string]())
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = zoneChassisHandler.SyncRemoteClusterNodes(sets.New[string]())
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = getRemotePort(libovsdbOvnNBClient)
+			gomega.Expect(err).To(gomega.MatchError(libovsdbclient.ErrNotFound))
+			gomega.Expect(getRemoteRoutes(libovsdbOvnNBClient)).To(gomega.BeEmpty())
+			_, err = libovsdbops.GetChassis(libovsdbOvnSBClient, &sbdb.Chassis{Name: remoteChassisID})
+			gomega.Expect(err).To(gomega.MatchError(libovsdbclient.ErrNotFound))
+			return nil
+		}
+
+		err := app.Run([]string{
+			app.Name,
+			"-cluster-subnets=" + clusterCIDR,
+			"-init-cluster-manager",
+			"-zone-join-switch-subnets=" + joinSubnetCIDR,
+			"-enable-interconnect",
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+})
@@ -310,6 +310,11 @@ func (zic *ZoneInterconnectHandler) SyncNodes(objs []interface{}) error {
 			continue
 		}
 
+		// ports of the nodes of linked clusters are synced by the ClusterLink controller
+		if _, ok := lp.ExternalIDs[ClusterLinkExternalID]; ok {
+			continue
+		}
+
 		lportNode := lp.ExternalIDs["node"]
 		if !foundNodeNames.Has(lportNode) {
 			staleNodeNames = append(staleNodeNames, lportNode)
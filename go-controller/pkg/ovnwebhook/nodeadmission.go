@@ -34,6 +34,9 @@ var commonNodeAnnotationChecks = map[string]checkNodeAnnot{
 	util.OvnNodeMasqCIDR:                   nil,
 	util.OvnNodeGatewayMtuSupport:          nil,
 	util.OvnNodeManagementPort:             nil,
+	util.OvnNodeIPsecStatus:                nil,
+	util.OVNNodeEgressIPBFDStatus:          nil,
+	util.OVNNodeEncapIPs:                   nil,
 	util.OvnNodeChassisID: func(v annotationChange, nodeName string) error {
 		if v.action == removed {
 			return fmt.Errorf("%s cannot be removed", util.OvnNodeChassisID)
@@ -68,8 +71,9 @@ var interconnectNodeAnnotationChecks = map[string]checkNodeAnnot{
 
 // hybridOverlayNodeAnnotationChecks holds annotations allowed for ovnkube-node:<nodeName> users hybrid overlay environments
 var hybridOverlayNodeAnnotationChecks = map[string]checkNodeAnnot{
-	hotypes.HybridOverlayDRMAC: nil,
-	hotypes.HybridOverlayDRIP:  nil,
+	hotypes.HybridOverlayDRMAC:        nil,
+	hotypes.HybridOverlayDRIP:         nil,
+	hotypes.HybridOverlayNetworkDRIPs: nil,
 }
 
 type NodeAdmission struct {
@@ -288,6 +288,44 @@ func TestNodeAdmission_ValidateUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ovnkube-node can set util.OVNNodeEgressIPBFDStatus",
+			ctx: admission.NewContextWithRequest(context.TODO(), admission.Request{
+				AdmissionRequest: v1.AdmissionRequest{UserInfo: authenticationv1.UserInfo{
+					Username: userName,
+				}},
+			}),
+			oldObj: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			},
+			newObj: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        nodeName,
+					Annotations: map[string]string{util.OVNNodeEgressIPBFDStatus: `{"node-b":"up"}`},
+				},
+			},
+		},
+		{
+			name: "ovnkube-node can set util.OVNNodeEncapIPs",
+			ctx: admission.NewContextWithRequest(context.TODO(), admission.Request{
+				AdmissionRequest: v1.AdmissionRequest{UserInfo: authenticationv1.UserInfo{
+					Username: userName,
+				}},
+			}),
+			oldObj: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			},
+			newObj: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        nodeName,
+					Annotations: map[string]string{util.OVNNodeEncapIPs: `["10.0.0.1","10.0.0.2"]`},
+				},
+			},
+		},
 		{
 			name: "ovnkube-node can add util.OvnNodeZoneName with <nodeName> value",
 			ctx: admission.NewContextWithRequest(context.TODO(), admission.Request{
@@ -490,6 +528,25 @@ func TestNodeAdmission_ValidateUpdateHybridOverlay(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ovnkube-node can set HybridOverlayNetworkDRIPs in hybrid overlay environments",
+			ctx: admission.NewContextWithRequest(context.TODO(), admission.Request{
+				AdmissionRequest: v1.AdmissionRequest{UserInfo: authenticationv1.UserInfo{
+					Username: userName,
+				}},
+			}),
+			oldObj: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			},
+			newObj: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        nodeName,
+					Annotations: map[string]string{hotypes.HybridOverlayNetworkDRIPs: `{"tenant-blue":"10.100.1.3"}`},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
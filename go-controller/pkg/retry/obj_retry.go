@@ -100,6 +100,9 @@ type ResourceHandler struct {
 	HasUpdateFunc          bool
 	NeedsUpdateDuringRetry bool
 	ObjType                reflect.Type
+	// NetworkName is the name of the network the resources are handled for.
+	// When set, the latency of the add events is recorded for the network.
+	NetworkName string
 	EventHandler
 }
 
@@ -498,6 +501,12 @@ func (r *RetryFramework) WatchResourceFiltered(namespaceForFilteredHandler strin
 		labelSelectorForFilteredHandler, // filter out objects not matching these labels
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
+				if r.ResourceHandler.NetworkName != "" {
+					start := time.Now()
+					defer func() {
+						metrics.MetricResourceAddLatency.WithLabelValues(r.ResourceHandler.NetworkName).Observe(time.Since(start).Seconds())
+					}()
+				}
 				r.ResourceHandler.RecordAddEvent(obj)
 
 				key, err := GetResourceKey(obj)
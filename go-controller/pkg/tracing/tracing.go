@@ -0,0 +1,150 @@
+// Package tracing provides optional OpenTelemetry tracing of pod network
+// setup. When an OTLP endpoint is configured, spans are exported over gRPC.
+// Spans started for a pod use a trace ID derived from the pod UID so that the
+// spans emitted by the different ovn-kubernetes components for the same pod
+// (ovnkube-controller, ovnkube-node CNI server, ...) end up in the same trace
+// without having to propagate a context between them.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+)
+
+const (
+	tracerName = "github.com/ovn-org/ovn-kubernetes/go-controller"
+
+	// PodUIDKey is the span attribute holding the UID of the pod a span
+	// refers to.
+	PodUIDKey = attribute.Key("k8s.pod.uid")
+	// PodNameKey is the span attribute holding the name of the pod.
+	PodNameKey = attribute.Key("k8s.pod.name")
+	// NamespaceKey is the span attribute holding the namespace of the pod.
+	NamespaceKey = attribute.Key("k8s.namespace.name")
+	// NetworkKey is the span attribute holding the name of the network.
+	NetworkKey = attribute.Key("ovn.network")
+	// NADKey is the span attribute holding the name of the network
+	// attachment definition.
+	NADKey = attribute.Key("ovn.nad")
+)
+
+type podUIDContextKey struct{}
+
+// Init sets up the global tracer provider to export spans to the OTLP
+// endpoint configured in config.Metrics. It is a no-op if no endpoint is
+// configured. The returned function flushes and stops the exporter and must
+// be called on shutdown.
+func Init(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	if config.Metrics.OTLPTraceEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Metrics.OTLPTraceEndpoint)}
+	if config.Metrics.OTLPTraceInsecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter for %s: %w", config.Metrics.OTLPTraceEndpoint, err)
+	}
+
+	tp := newTracerProvider(sdktrace.WithBatcher(exporter), serviceName, config.Metrics.OTLPTraceSampleRatio)
+	otel.SetTracerProvider(tp)
+	klog.Infof("Exporting traces of %s to %s with sample ratio %v", serviceName,
+		config.Metrics.OTLPTraceEndpoint, config.Metrics.OTLPTraceSampleRatio)
+	return tp.Shutdown, nil
+}
+
+func newTracerProvider(processor sdktrace.TracerProviderOption, serviceName string, sampleRatio float64) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		processor,
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithIDGenerator(podIDGenerator{}),
+	)
+}
+
+// StartPodSpan starts a span for the given pod. If ctx does not already carry
+// a span, a new trace is started whose ID is derived from the pod UID. The
+// namespace and name of the pod are optional.
+func StartPodSpan(ctx context.Context, namespace, podName, podUID, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	ctx = context.WithValue(ctx, podUIDContextKey{}, podUID)
+	attrs := []attribute.KeyValue{PodUIDKey.String(podUID)}
+	if namespace != "" {
+		attrs = append(attrs, NamespaceKey.String(namespace))
+	}
+	if podName != "" {
+		attrs = append(attrs, PodNameKey.String(podName))
+	}
+	opts = append(opts, trace.WithAttributes(attrs...))
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// StartSpan starts a child span of the span carried by ctx. If that span is
+// not being recorded, no span is started and the one from ctx is returned, so
+// that callers shared with untraced code paths do not start traces of their
+// own.
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	parent := trace.SpanFromContext(ctx)
+	if !parent.IsRecording() {
+		return ctx, parent
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// EndSpan ends span, recording err on it if not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// podIDGenerator generates random span IDs and, for spans started through
+// StartPodSpan, trace IDs derived from the pod UID.
+type podIDGenerator struct{}
+
+func (g podIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	var traceID trace.TraceID
+	if podUID, ok := ctx.Value(podUIDContextKey{}).(string); ok && podUID != "" {
+		traceID = PodTraceID(podUID)
+	} else {
+		_, _ = rand.Read(traceID[:])
+	}
+	return traceID, g.NewSpanID(ctx, traceID)
+}
+
+func (podIDGenerator) NewSpanID(_ context.Context, _ trace.TraceID) trace.SpanID {
+	var spanID trace.SpanID
+	_, _ = rand.Read(spanID[:])
+	return spanID
+}
+
+// PodTraceID returns the ID of the trace holding the spans of the pod with the
+// given UID.
+func PodTraceID(podUID string) trace.TraceID {
+	var traceID trace.TraceID
+	if id, err := uuid.Parse(podUID); err == nil {
+		copy(traceID[:], id[:])
+	} else {
+		sum := sha256.Sum256([]byte(podUID))
+		copy(traceID[:], sum[:])
+	}
+	return traceID
+}
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestPodSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := newTracerProvider(sdktrace.WithSyncer(exporter), "ovnkube-test", 1)
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	const podUID = "0b8a8f6d-4e8e-4cd6-9d1c-2f6b0a1f3c11"
+
+	// a child span without a recording parent is not started
+	_, span := StartSpan(context.Background(), "orphan")
+	EndSpan(span, nil)
+
+	ctx, podSpan := StartPodSpan(context.Background(), "ns", "pod", podUID, "pod add")
+	_, childSpan := StartSpan(ctx, "transact")
+	EndSpan(childSpan, errors.New("transact failed"))
+	EndSpan(podSpan, nil)
+
+	// a span for the same pod from a different component joins the same trace
+	_, otherSpan := StartPodSpan(context.Background(), "ns", "pod", podUID, "cni add")
+	EndSpan(otherSpan, nil)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(spans))
+	}
+	byName := map[string]tracetest.SpanStub{}
+	for _, s := range spans {
+		byName[s.Name] = s
+	}
+
+	traceID := PodTraceID(podUID)
+	if traceID.String() != "0b8a8f6d4e8e4cd69d1c2f6b0a1f3c11" {
+		t.Errorf("unexpected trace ID %s for pod %s", traceID, podUID)
+	}
+	for _, name := range []string{"pod add", "transact", "cni add"} {
+		s, ok := byName[name]
+		if !ok {
+			t.Fatalf("span %q not exported", name)
+		}
+		if s.SpanContext.TraceID() != traceID {
+			t.Errorf("span %q has trace ID %s, expected %s", name, s.SpanContext.TraceID(), traceID)
+		}
+	}
+	if byName["transact"].Parent.SpanID() != byName["pod add"].SpanContext.SpanID() {
+		t.Errorf("span transact is not a child of span pod add")
+	}
+	if byName["transact"].Status.Code != codes.Error {
+		t.Errorf("expected span transact to have an error status, got %v", byName["transact"].Status)
+	}
+	if byName["cni add"].Parent.IsValid() {
+		t.Errorf("expected span cni add to be a root span")
+	}
+}
+
+func TestPodTraceIDForNonUUID(t *testing.T) {
+	if PodTraceID("not-a-uuid") != PodTraceID("not-a-uuid") {
+		t.Errorf("expected a stable trace ID")
+	}
+	if PodTraceID("not-a-uuid") == PodTraceID("another") {
+		t.Errorf("expected different trace IDs for different pods")
+	}
+}
@@ -10,6 +10,8 @@ const (
 	APBRouteErrorMsg       = "failed to apply policy"
 	EgressFirewallErrorMsg = "EgressFirewall Rules not correctly applied"
 	EgressQoSErrorMsg      = "EgressQoS Rules not correctly applied"
+	EgressIPErrorMsg       = "EgressIP not correctly programmed"
+	NetworkQoSErrorMsg     = "NetworkQoS Rules not correctly applied"
 )
 
 func GetZoneStatus(zoneID, message string) string {
@@ -0,0 +1,21 @@
+package util
+
+import (
+	egressserviceapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+// GetEgressServiceHosts returns the names of the nodes selected to handle the
+// traffic of the given EgressService, or nil if no node is selected or all
+// of the nodes handle it (sourceIPBy=Network).
+// The status of EgressServices set before hosts was introduced only holds host,
+// in which case it is returned as the only node.
+func GetEgressServiceHosts(es *egressserviceapi.EgressService) []string {
+	if len(es.Status.Hosts) > 0 {
+		return es.Status.Hosts
+	}
+	if es.Status.Host == types.EgressServiceNoHost || es.Status.Host == types.EgressServiceNoSNATHost {
+		return nil
+	}
+	return []string{es.Status.Host}
+}
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"testing"
+
+	egressserviceapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEgressServiceHosts(t *testing.T) {
+	testcases := []struct {
+		name     string
+		status   egressserviceapi.EgressServiceStatus
+		expected []string
+	}{
+		{
+			name:     "should return no hosts when no node is selected",
+			status:   egressserviceapi.EgressServiceStatus{},
+			expected: nil,
+		},
+		{
+			name:     "should return no hosts when all of the nodes handle the service",
+			status:   egressserviceapi.EgressServiceStatus{Host: "ALL"},
+			expected: nil,
+		},
+		{
+			name:     "should return the host when hosts is not set",
+			status:   egressserviceapi.EgressServiceStatus{Host: "node1"},
+			expected: []string{"node1"},
+		},
+		{
+			name:     "should return all of the hosts",
+			status:   egressserviceapi.EgressServiceStatus{Host: "node1", Hosts: []string{"node1", "node2"}},
+			expected: []string{"node1", "node2"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			es := &egressserviceapi.EgressService{Status: tc.status}
+			assert.Equal(t, tc.expected, GetEgressServiceHosts(es))
+		})
+	}
+}
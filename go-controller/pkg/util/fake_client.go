@@ -13,6 +13,8 @@ import (
 	ocpnetworkclientfake "github.com/openshift/client-go/network/clientset/versioned/fake"
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	adminpolicybasedroutefake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned/fake"
+	clusterlink "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1"
+	clusterlinkfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/clientset/versioned/fake"
 	egressfirewall "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
 	egressfirewallfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1/apis/clientset/versioned/fake"
 	egressip "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
@@ -21,6 +23,8 @@ import (
 	egressqosfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned/fake"
 	egressservice "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
 	egressservicefake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned/fake"
+	networkqos "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1"
+	networkqosfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/clientset/versioned/fake"
 	routeadvertisements "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
 	routeadvertisementsfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned/fake"
 	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
@@ -40,6 +44,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 	egressIPObjects := []runtime.Object{}
 	egressFirewallObjects := []runtime.Object{}
 	egressQoSObjects := []runtime.Object{}
+	networkQoSObjects := []runtime.Object{}
 	multiNetworkPolicyObjects := []runtime.Object{}
 	egressServiceObjects := []runtime.Object{}
 	apbExternalRouteObjects := []runtime.Object{}
@@ -51,6 +56,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 	udnObjects := []runtime.Object{}
 	raObjects := []runtime.Object{}
 	frrObjects := []runtime.Object{}
+	clusterLinkObjects := []runtime.Object{}
 	for _, object := range objects {
 		switch object.(type) {
 		case *egressip.EgressIP:
@@ -59,6 +65,8 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 			egressFirewallObjects = append(egressFirewallObjects, object)
 		case *egressqos.EgressQoS:
 			egressQoSObjects = append(egressQoSObjects, object)
+		case *networkqos.NetworkQoS:
+			networkQoSObjects = append(networkQoSObjects, object)
 		case *ocpcloudnetworkapi.CloudPrivateIPConfig:
 			cloudObjects = append(cloudObjects, object)
 		case *mnpapi.MultiNetworkPolicy:
@@ -73,12 +81,14 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 			anpObjects = append(anpObjects, object)
 		case *ocpnetworkapiv1alpha1.DNSNameResolver:
 			dnsNameResolverObjects = append(dnsNameResolverObjects, object)
-		case *udnv1.UserDefinedNetwork, *udnv1.ClusterUserDefinedNetwork:
+		case *udnv1.UserDefinedNetwork, *udnv1.ClusterUserDefinedNetwork, *udnv1.UserDefinedNetworkQuota, *udnv1.UserDefinedNetworkCIDRPool:
 			udnObjects = append(udnObjects, object)
 		case *routeadvertisements.RouteAdvertisements:
 			raObjects = append(raObjects, object)
 		case *frrapi.FRRConfiguration:
 			frrObjects = append(frrObjects, object)
+		case *clusterlink.ClusterLink:
+			clusterLinkObjects = append(clusterLinkObjects, object)
 		default:
 			v1Objects = append(v1Objects, object)
 		}
@@ -98,6 +108,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 		EgressFirewallClient:      egressfirewallfake.NewSimpleClientset(egressFirewallObjects...),
 		CloudNetworkClient:        cloudservicefake.NewSimpleClientset(cloudObjects...),
 		EgressQoSClient:           egressqosfake.NewSimpleClientset(egressQoSObjects...),
+		NetworkQoSClient:          networkqosfake.NewSimpleClientset(networkQoSObjects...),
 		NetworkAttchDefClient:     nadClient,
 		MultiNetworkPolicyClient:  mnpfake.NewSimpleClientset(multiNetworkPolicyObjects...),
 		EgressServiceClient:       egressservicefake.NewSimpleClientset(egressServiceObjects...),
@@ -106,6 +117,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 		UserDefinedNetworkClient:  udnfake.NewSimpleClientset(udnObjects...),
 		RouteAdvertisementsClient: routeadvertisementsfake.NewSimpleClientset(raObjects...),
 		FRRClient:                 frrfake.NewSimpleClientset(frrObjects...),
+		ClusterLinkClient:         clusterlinkfake.NewSimpleClientset(clusterLinkObjects...),
 	}
 }
 
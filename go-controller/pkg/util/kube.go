@@ -44,10 +44,12 @@ import (
 	ocpnetworkclientset "github.com/openshift/client-go/network/clientset/versioned"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	adminpolicybasedrouteclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned"
+	clusterlinkclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/clusterlink/v1/apis/clientset/versioned"
 	egressfirewallclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1/apis/clientset/versioned"
 	egressipclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned"
 	egressqosclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned"
 	egressserviceclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned"
+	networkqosclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1/apis/clientset/versioned"
 	routeadvertisementsclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned"
 	userdefinednetworkclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned"
 	anpclientset "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned"
@@ -62,6 +64,7 @@ type OVNClientset struct {
 	OCPNetworkClient          ocpnetworkclientset.Interface
 	CloudNetworkClient        ocpcloudnetworkclientset.Interface
 	EgressQoSClient           egressqosclientset.Interface
+	NetworkQoSClient          networkqosclientset.Interface
 	NetworkAttchDefClient     networkattchmentdefclientset.Interface
 	MultiNetworkPolicyClient  multinetworkpolicyclientset.Interface
 	EgressServiceClient       egressserviceclientset.Interface
@@ -69,6 +72,7 @@ type OVNClientset struct {
 	IPAMClaimsClient          ipamclaimssclientset.Interface
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
+	ClusterLinkClient         clusterlinkclientset.Interface
 	FRRClient                 frrclientset.Interface
 }
 
@@ -81,6 +85,7 @@ type OVNMasterClientset struct {
 	EgressFirewallClient      egressfirewallclientset.Interface
 	OCPNetworkClient          ocpnetworkclientset.Interface
 	EgressQoSClient           egressqosclientset.Interface
+	NetworkQoSClient          networkqosclientset.Interface
 	MultiNetworkPolicyClient  multinetworkpolicyclientset.Interface
 	EgressServiceClient       egressserviceclientset.Interface
 	AdminPolicyRouteClient    adminpolicybasedrouteclientset.Interface
@@ -88,6 +93,7 @@ type OVNMasterClientset struct {
 	NetworkAttchDefClient     networkattchmentdefclientset.Interface
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
+	ClusterLinkClient         clusterlinkclientset.Interface
 	FRRClient                 frrclientset.Interface
 }
 
@@ -99,6 +105,7 @@ type OVNKubeControllerClientset struct {
 	EgressFirewallClient      egressfirewallclientset.Interface
 	OCPNetworkClient          ocpnetworkclientset.Interface
 	EgressQoSClient           egressqosclientset.Interface
+	NetworkQoSClient          networkqosclientset.Interface
 	MultiNetworkPolicyClient  multinetworkpolicyclientset.Interface
 	EgressServiceClient       egressserviceclientset.Interface
 	AdminPolicyRouteClient    adminpolicybasedrouteclientset.Interface
@@ -106,6 +113,7 @@ type OVNKubeControllerClientset struct {
 	NetworkAttchDefClient     networkattchmentdefclientset.Interface
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
+	ClusterLinkClient         clusterlinkclientset.Interface
 }
 
 type OVNNodeClientset struct {
@@ -128,10 +136,12 @@ type OVNClusterManagerClientset struct {
 	AdminPolicyRouteClient    adminpolicybasedrouteclientset.Interface
 	EgressFirewallClient      egressfirewallclientset.Interface
 	EgressQoSClient           egressqosclientset.Interface
+	NetworkQoSClient          networkqosclientset.Interface
 	IPAMClaimsClient          ipamclaimssclientset.Interface
 	OCPNetworkClient          ocpnetworkclientset.Interface
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
+	ClusterLinkClient         clusterlinkclientset.Interface
 	FRRClient                 frrclientset.Interface
 }
 
@@ -154,6 +164,7 @@ func (cs *OVNClientset) GetMasterClientset() *OVNMasterClientset {
 		EgressFirewallClient:      cs.EgressFirewallClient,
 		OCPNetworkClient:          cs.OCPNetworkClient,
 		EgressQoSClient:           cs.EgressQoSClient,
+		NetworkQoSClient:          cs.NetworkQoSClient,
 		MultiNetworkPolicyClient:  cs.MultiNetworkPolicyClient,
 		EgressServiceClient:       cs.EgressServiceClient,
 		AdminPolicyRouteClient:    cs.AdminPolicyRouteClient,
@@ -161,6 +172,7 @@ func (cs *OVNClientset) GetMasterClientset() *OVNMasterClientset {
 		NetworkAttchDefClient:     cs.NetworkAttchDefClient,
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
+		ClusterLinkClient:         cs.ClusterLinkClient,
 		FRRClient:                 cs.FRRClient,
 	}
 }
@@ -173,6 +185,7 @@ func (cs *OVNMasterClientset) GetOVNKubeControllerClientset() *OVNKubeController
 		EgressFirewallClient:      cs.EgressFirewallClient,
 		OCPNetworkClient:          cs.OCPNetworkClient,
 		EgressQoSClient:           cs.EgressQoSClient,
+		NetworkQoSClient:          cs.NetworkQoSClient,
 		MultiNetworkPolicyClient:  cs.MultiNetworkPolicyClient,
 		EgressServiceClient:       cs.EgressServiceClient,
 		AdminPolicyRouteClient:    cs.AdminPolicyRouteClient,
@@ -180,6 +193,7 @@ func (cs *OVNMasterClientset) GetOVNKubeControllerClientset() *OVNKubeController
 		NetworkAttchDefClient:     cs.NetworkAttchDefClient,
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
+		ClusterLinkClient:         cs.ClusterLinkClient,
 	}
 }
 
@@ -191,6 +205,7 @@ func (cs *OVNClientset) GetOVNKubeControllerClientset() *OVNKubeControllerClient
 		EgressFirewallClient:      cs.EgressFirewallClient,
 		OCPNetworkClient:          cs.OCPNetworkClient,
 		EgressQoSClient:           cs.EgressQoSClient,
+		NetworkQoSClient:          cs.NetworkQoSClient,
 		MultiNetworkPolicyClient:  cs.MultiNetworkPolicyClient,
 		EgressServiceClient:       cs.EgressServiceClient,
 		AdminPolicyRouteClient:    cs.AdminPolicyRouteClient,
@@ -198,6 +213,7 @@ func (cs *OVNClientset) GetOVNKubeControllerClientset() *OVNKubeControllerClient
 		NetworkAttchDefClient:     cs.NetworkAttchDefClient,
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
+		ClusterLinkClient:         cs.ClusterLinkClient,
 	}
 }
 
@@ -212,10 +228,12 @@ func (cs *OVNClientset) GetClusterManagerClientset() *OVNClusterManagerClientset
 		AdminPolicyRouteClient:    cs.AdminPolicyRouteClient,
 		EgressFirewallClient:      cs.EgressFirewallClient,
 		EgressQoSClient:           cs.EgressQoSClient,
+		NetworkQoSClient:          cs.NetworkQoSClient,
 		IPAMClaimsClient:          cs.IPAMClaimsClient,
 		OCPNetworkClient:          cs.OCPNetworkClient,
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
+		ClusterLinkClient:         cs.ClusterLinkClient,
 		FRRClient:                 cs.FRRClient,
 	}
 }
@@ -482,6 +500,10 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	networkqosClientset, err := networkqosclientset.NewForConfig(kconfig)
+	if err != nil {
+		return nil, err
+	}
 	networkAttchmntDefClientset, err := networkattchmentdefclientset.NewForConfig(kconfig)
 	if err != nil {
 		return nil, err
@@ -521,6 +543,11 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 		return nil, err
 	}
 
+	clusterLinkClientset, err := clusterlinkclientset.NewForConfig(kconfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return &OVNClientset{
 		KubeClient:                kclientset,
 		ANPClient:                 anpClientset,
@@ -529,6 +556,7 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 		OCPNetworkClient:          networkClientset,
 		CloudNetworkClient:        cloudNetworkClientset,
 		EgressQoSClient:           egressqosClientset,
+		NetworkQoSClient:          networkqosClientset,
 		NetworkAttchDefClient:     networkAttchmntDefClientset,
 		MultiNetworkPolicyClient:  multiNetworkPolicyClientset,
 		EgressServiceClient:       egressserviceClientset,
@@ -537,6 +565,7 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 		UserDefinedNetworkClient:  userDefinedNetworkClientSet,
 		RouteAdvertisementsClient: routeAdvertisementsClientset,
 		FRRClient:                 frrClientset,
+		ClusterLinkClient:         clusterLinkClientset,
 	}, nil
 }
 
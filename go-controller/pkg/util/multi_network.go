@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 
@@ -46,6 +47,9 @@ type NetInfo interface {
 	Vlan() uint
 	AllowsPersistentIPs() bool
 	PhysicalNetworkName() string
+	IPAMProvider() string
+	HybridOverlaySubnets() []*net.IPNet
+	AllowsMulticast() bool
 
 	// dynamic information, can change over time
 	GetNADs() []string
@@ -66,6 +70,10 @@ type NetInfo interface {
 	// GetEgressIPAdvertisedNodes return the nodes where egress IP are
 	// advertised.
 	GetEgressIPAdvertisedNodes() []string
+	// GetRouteImportPolicy returns the policy that applies to the routes
+	// learned through BGP that are imported into the network, or nil if all
+	// routes are to be imported.
+	GetRouteImportPolicy() *RouteImportPolicy
 
 	// derived information.
 	GetNADNamespaces() []string
@@ -113,6 +121,24 @@ type MutableNetInfo interface {
 
 	// Nodes advertising Egress IP
 	SetEgressIPAdvertisedVRFs(eipAdvertisements map[string][]string)
+
+	// Policy for routes imported into the network
+	SetRouteImportPolicy(policy *RouteImportPolicy)
+}
+
+// RouteImportPolicy determines which of the routes learned through BGP are
+// imported into a network.
+type RouteImportPolicy struct {
+	// AllowedPrefixes, if not empty, restricts imported routes to those with a
+	// destination contained in any of these prefixes
+	AllowedPrefixes []string
+	// DeniedPrefixes excludes imported routes with a destination contained in
+	// any of these prefixes
+	DeniedPrefixes []string
+	// MaxRoutes, if not zero, is the maximum number of routes imported
+	MaxRoutes int
+	// DefaultRouteOnly restricts imported routes to default routes
+	DefaultRouteOnly bool
 }
 
 // NewMutableNetInfo builds a copy of netInfo as a MutableNetInfo
@@ -221,6 +247,7 @@ type mutableNetInfo struct {
 	nads                     sets.Set[string]
 	podNetworkAdvertisements map[string][]string
 	eipAdvertisements        map[string][]string
+	routeImportPolicy        *RouteImportPolicy
 
 	// information generated from previous fields, not used in comparisons
 
@@ -261,7 +288,8 @@ func (l *mutableNetInfo) equals(r *mutableNetInfo) bool {
 	return reflect.DeepEqual(l.id, r.id) &&
 		reflect.DeepEqual(l.nads, r.nads) &&
 		reflect.DeepEqual(l.podNetworkAdvertisements, r.podNetworkAdvertisements) &&
-		reflect.DeepEqual(l.eipAdvertisements, r.eipAdvertisements)
+		reflect.DeepEqual(l.eipAdvertisements, r.eipAdvertisements) &&
+		reflect.DeepEqual(l.routeImportPolicy, r.routeImportPolicy)
 }
 
 func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
@@ -274,6 +302,7 @@ func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
 	aux.nads = r.nads.Clone()
 	aux.setPodNetworkAdvertisedOnVRFs(r.podNetworkAdvertisements)
 	aux.setEgressIPAdvertisedAtNodes(r.eipAdvertisements)
+	aux.setRouteImportPolicy(r.routeImportPolicy)
 	aux.namespaces = r.namespaces.Clone()
 	r.RUnlock()
 	l.Lock()
@@ -282,6 +311,7 @@ func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
 	l.nads = aux.nads
 	l.podNetworkAdvertisements = aux.podNetworkAdvertisements
 	l.eipAdvertisements = aux.eipAdvertisements
+	l.routeImportPolicy = aux.routeImportPolicy
 	l.namespaces = aux.namespaces
 }
 
@@ -367,6 +397,31 @@ func (nInfo *mutableNetInfo) GetEgressIPAdvertisedNodes() []string {
 	return maps.Keys(nInfo.eipAdvertisements)
 }
 
+func (nInfo *mutableNetInfo) SetRouteImportPolicy(policy *RouteImportPolicy) {
+	nInfo.Lock()
+	defer nInfo.Unlock()
+	nInfo.setRouteImportPolicy(policy)
+}
+
+func (nInfo *mutableNetInfo) setRouteImportPolicy(policy *RouteImportPolicy) {
+	if policy == nil {
+		nInfo.routeImportPolicy = nil
+		return
+	}
+	nInfo.routeImportPolicy = &RouteImportPolicy{
+		AllowedPrefixes:  slices.Clone(policy.AllowedPrefixes),
+		DeniedPrefixes:   slices.Clone(policy.DeniedPrefixes),
+		MaxRoutes:        policy.MaxRoutes,
+		DefaultRouteOnly: policy.DefaultRouteOnly,
+	}
+}
+
+func (nInfo *mutableNetInfo) GetRouteImportPolicy() *RouteImportPolicy {
+	nInfo.RLock()
+	defer nInfo.RUnlock()
+	return nInfo.routeImportPolicy
+}
+
 // GetNADs returns all the NADs associated with this network
 func (nInfo *mutableNetInfo) GetNADs() []string {
 	nInfo.RLock()
@@ -633,6 +688,26 @@ func (nInfo *DefaultNetInfo) PhysicalNetworkName() string {
 	return ""
 }
 
+// IPAMProvider returns no external IPAM provider for the default network
+func (nInfo *DefaultNetInfo) IPAMProvider() string {
+	return ""
+}
+
+// HybridOverlaySubnets returns the configured hybrid overlay cluster subnets
+func (nInfo *DefaultNetInfo) HybridOverlaySubnets() []*net.IPNet {
+	var subnets []*net.IPNet
+	for _, subnet := range config.HybridOverlay.ClusterSubnets {
+		subnets = append(subnets, subnet.CIDR)
+	}
+	return subnets
+}
+
+// AllowsMulticast returns whether multicast is enabled in the cluster, the
+// default network doesn't need to opt in
+func (nInfo *DefaultNetInfo) AllowsMulticast() bool {
+	return config.EnableMulticast
+}
+
 // SecondaryNetInfo holds the network name information for secondary network if non-nil
 type secondaryNetInfo struct {
 	mutableNetInfo
@@ -651,7 +726,10 @@ type secondaryNetInfo struct {
 	excludeSubnets     []*net.IPNet
 	joinSubnets        []*net.IPNet
 
-	physicalNetworkName string
+	physicalNetworkName  string
+	ipamProvider         string
+	hybridOverlaySubnets []*net.IPNet
+	allowMulticast       bool
 }
 
 func (nInfo *secondaryNetInfo) GetNetInfo() NetInfo {
@@ -774,6 +852,23 @@ func (nInfo *secondaryNetInfo) PhysicalNetworkName() string {
 	return nInfo.physicalNetworkName
 }
 
+// IPAMProvider returns the name of the external IPAM provider allocating the
+// IPs of the network, if any
+func (nInfo *secondaryNetInfo) IPAMProvider() string {
+	return nInfo.ipamProvider
+}
+
+// HybridOverlaySubnets returns the hybrid overlay subnets reachable from the
+// network, if any
+func (nInfo *secondaryNetInfo) HybridOverlaySubnets() []*net.IPNet {
+	return nInfo.hybridOverlaySubnets
+}
+
+// AllowsMulticast returns whether the network opted in to multicast
+func (nInfo *secondaryNetInfo) AllowsMulticast() bool {
+	return nInfo.allowMulticast
+}
+
 // IPMode returns the ipv4/ipv6 mode
 func (nInfo *secondaryNetInfo) IPMode() (bool, bool) {
 	return nInfo.ipv4mode, nInfo.ipv6mode
@@ -836,9 +931,15 @@ func (nInfo *secondaryNetInfo) canReconcile(other NetInfo) bool {
 	if nInfo.allowPersistentIPs != other.AllowsPersistentIPs() {
 		return false
 	}
+	if nInfo.ipamProvider != other.IPAMProvider() {
+		return false
+	}
 	if nInfo.primaryNetwork != other.IsPrimaryNetwork() {
 		return false
 	}
+	if nInfo.allowMulticast != other.AllowsMulticast() {
+		return false
+	}
 
 	lessCIDRNetworkEntry := func(a, b config.CIDRNetworkEntry) bool { return a.String() < b.String() }
 	if !cmp.Equal(nInfo.subnets, other.Subnets(), cmpopts.SortSlices(lessCIDRNetworkEntry)) {
@@ -849,24 +950,30 @@ func (nInfo *secondaryNetInfo) canReconcile(other NetInfo) bool {
 	if !cmp.Equal(nInfo.excludeSubnets, other.ExcludeSubnets(), cmpopts.SortSlices(lessIPNet)) {
 		return false
 	}
+	if !cmp.Equal(nInfo.hybridOverlaySubnets, other.HybridOverlaySubnets(), cmpopts.SortSlices(lessIPNet)) {
+		return false
+	}
 	return cmp.Equal(nInfo.joinSubnets, other.JoinSubnets(), cmpopts.SortSlices(lessIPNet))
 }
 
 func (nInfo *secondaryNetInfo) copy() *secondaryNetInfo {
 	// everything here is immutable
 	c := &secondaryNetInfo{
-		netName:             nInfo.netName,
-		primaryNetwork:      nInfo.primaryNetwork,
-		topology:            nInfo.topology,
-		mtu:                 nInfo.mtu,
-		vlan:                nInfo.vlan,
-		allowPersistentIPs:  nInfo.allowPersistentIPs,
-		ipv4mode:            nInfo.ipv4mode,
-		ipv6mode:            nInfo.ipv6mode,
-		subnets:             nInfo.subnets,
-		excludeSubnets:      nInfo.excludeSubnets,
-		joinSubnets:         nInfo.joinSubnets,
-		physicalNetworkName: nInfo.physicalNetworkName,
+		netName:              nInfo.netName,
+		primaryNetwork:       nInfo.primaryNetwork,
+		topology:             nInfo.topology,
+		mtu:                  nInfo.mtu,
+		vlan:                 nInfo.vlan,
+		allowPersistentIPs:   nInfo.allowPersistentIPs,
+		ipv4mode:             nInfo.ipv4mode,
+		ipv6mode:             nInfo.ipv6mode,
+		subnets:              nInfo.subnets,
+		excludeSubnets:       nInfo.excludeSubnets,
+		joinSubnets:          nInfo.joinSubnets,
+		physicalNetworkName:  nInfo.physicalNetworkName,
+		ipamProvider:         nInfo.ipamProvider,
+		hybridOverlaySubnets: nInfo.hybridOverlaySubnets,
+		allowMulticast:       nInfo.allowMulticast,
 	}
 	// copy mutables
 	c.mutableNetInfo.copyFrom(&nInfo.mutableNetInfo)
@@ -883,13 +990,19 @@ func newLayer3NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 	if err != nil {
 		return nil, err
 	}
+	hybridOverlaySubnets, err := parseHybridOverlaySubnets(netconf.HybridOverlaySubnets)
+	if err != nil {
+		return nil, err
+	}
 	ni := &secondaryNetInfo{
-		netName:        netconf.Name,
-		primaryNetwork: netconf.Role == types.NetworkRolePrimary,
-		topology:       types.Layer3Topology,
-		subnets:        subnets,
-		joinSubnets:    joinSubnets,
-		mtu:            netconf.MTU,
+		netName:              netconf.Name,
+		primaryNetwork:       netconf.Role == types.NetworkRolePrimary,
+		topology:             types.Layer3Topology,
+		subnets:              subnets,
+		joinSubnets:          joinSubnets,
+		hybridOverlaySubnets: hybridOverlaySubnets,
+		allowMulticast:       netconf.EnableMulticast,
+		mtu:                  netconf.MTU,
 		mutableNetInfo: mutableNetInfo{
 			id:   InvalidID,
 			nads: sets.Set[string]{},
@@ -917,6 +1030,8 @@ func newLayer2NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 		excludeSubnets:     excludes,
 		mtu:                netconf.MTU,
 		allowPersistentIPs: netconf.AllowPersistentIPs,
+		ipamProvider:       netconf.IPAMProvider,
+		allowMulticast:     netconf.EnableMulticast,
 		mutableNetInfo: mutableNetInfo{
 			id:   InvalidID,
 			nads: sets.Set[string]{},
@@ -941,6 +1056,8 @@ func newLocalnetNetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error
 		vlan:                uint(netconf.VLANID),
 		allowPersistentIPs:  netconf.AllowPersistentIPs,
 		physicalNetworkName: netconf.PhysicalNetworkName,
+		ipamProvider:        netconf.IPAMProvider,
+		allowMulticast:      netconf.EnableMulticast,
 		mutableNetInfo: mutableNetInfo{
 			id:   InvalidID,
 			nads: sets.Set[string]{},
@@ -1034,6 +1151,21 @@ func parseJoinSubnet(joinSubnet string) ([]*net.IPNet, error) {
 	return joinSubnets, nil
 }
 
+func parseHybridOverlaySubnets(hybridOverlaySubnets string) ([]*net.IPNet, error) {
+	if strings.TrimSpace(hybridOverlaySubnets) == "" {
+		return nil, nil
+	}
+	entries, err := config.ParseClusterSubnetEntriesWithDefaults(hybridOverlaySubnets, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hybrid overlay subnets %q: %w", hybridOverlaySubnets, err)
+	}
+	subnets := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		subnets = append(subnets, entry.CIDR)
+	}
+	return subnets, nil
+}
+
 func getIPMode(subnets []config.CIDRNetworkEntry) (bool, bool) {
 	var ipv6Mode, ipv4Mode bool
 	for _, subnet := range subnets {
@@ -1168,6 +1300,31 @@ func ValidateNetConf(nadName string, netconf *ovncnitypes.NetConf) error {
 		return fmt.Errorf("error parsing Network Attachment Definition %s: %w", nadName, ErrorUnsupportedIPAMKey)
 	}
 
+	if netconf.IPAMProvider != "" {
+		// layer3 pod IPs are allocated by each zone from the node subnets
+		// rather than by cluster manager, which is the only one talking to
+		// the external IPAM providers
+		if netconf.Topology != types.Layer2Topology && netconf.Topology != types.LocalnetTopology {
+			return fmt.Errorf("%s topology does not allow an external IPAM provider: only layer2 and localnet topologies do", netconf.Topology)
+		}
+		if netconf.Subnets == "" {
+			return fmt.Errorf("the subnet attribute must be defined for networks with an external IPAM provider")
+		}
+	}
+
+	if netconf.HybridOverlaySubnets != "" {
+		if netconf.Topology != types.Layer3Topology {
+			return fmt.Errorf("%s topology does not allow hybrid overlay subnets", netconf.Topology)
+		}
+		if !config.HybridOverlay.Enabled {
+			return fmt.Errorf("hybrid overlay subnets require hybrid overlay to be enabled")
+		}
+	}
+
+	if netconf.EnableMulticast && netconf.Subnets == "" {
+		return fmt.Errorf("the subnet attribute must be defined for networks with multicast enabled")
+	}
+
 	if netconf.JoinSubnet != "" && netconf.Topology == types.LocalnetTopology {
 		return fmt.Errorf("localnet topology does not allow specifying join-subnet as services are not supported")
 	}
@@ -1219,6 +1376,9 @@ func subnetOverlapCheck(netconf *ovncnitypes.NetConf) error {
 	for _, subnet := range ni.JoinSubnets() {
 		allSubnets.Append(config.UserDefinedJoinSubnet, subnet)
 	}
+	for _, subnet := range ni.HybridOverlaySubnets() {
+		allSubnets.Append(config.ConfigSubnetHybrid, subnet)
+	}
 	if ni.ExcludeSubnets() != nil {
 		for i, configSubnet := range allSubnets.Subnets {
 			if IsContainedInAnyCIDR(configSubnet.Subnet, ni.ExcludeSubnets()...) {
@@ -1331,6 +1491,24 @@ func GetPodNADToNetworkMappingWithActiveNetwork(pod *kapi.Pod, nInfo NetInfo, ac
 		}
 	}
 
+	if nInfo.IsPrimaryNetwork() && nInfo.TopologyType() == types.Layer2Topology {
+		if ips := pod.Annotations[OvnUDNIPs]; ips != "" {
+			for _, ip := range strings.Split(ips, ",") {
+				networkSelections[activeNetworkNADs[0]].IPRequest = append(networkSelections[activeNetworkNADs[0]].IPRequest, strings.TrimSpace(ip))
+			}
+		}
+		networkSelections[activeNetworkNADs[0]].MacRequest = pod.Annotations[OvnUDNMAC]
+	} else if nInfo.IsPrimaryNetwork() && (pod.Annotations[OvnUDNIPs] != "" || pod.Annotations[OvnUDNMAC] != "") {
+		// the IPs of the pods of layer3 networks are allocated from the subnet
+		// of their node and their MACs generated from them: fail the pods
+		// requesting them rather than ignoring their requests, unless
+		// already allocated
+		if _, err := UnmarshalPodAnnotation(pod.Annotations, activeNetworkNADs[0]); err != nil {
+			return false, nil, fmt.Errorf("static IPs and MAC requests are only supported on layer2 primary networks, network %q has %s topology",
+				nInfo.GetNetworkName(), nInfo.TopologyType())
+		}
+	}
+
 	return true, networkSelections, nil
 }
 
@@ -139,6 +139,7 @@ func TestParseNetconf(t *testing.T) {
 		inputNetAttachDefConfigSpec string
 		expectedNetConf             *ovncnitypes.NetConf
 		expectedError               error
+		hybridOverlayEnabled        bool
 		unsupportedReason           string
 	}
 
@@ -339,6 +340,142 @@ func TestParseNetconf(t *testing.T) {
 `,
 			expectedError: fmt.Errorf("layer3 topology does not allow persistent IPs"),
 		},
+		{
+			desc: "valid attachment definition for a layer3 topology with hybrid overlay subnets",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer3",
+            "role": "primary",
+            "subnets": "192.168.200.0/16",
+            "hybridOverlaySubnets": "10.132.0.0/16",
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			hybridOverlayEnabled: true,
+			expectedNetConf: &ovncnitypes.NetConf{
+				Topology:             "layer3",
+				NADName:              "ns1/nad1",
+				MTU:                  1400,
+				Role:                 "primary",
+				Subnets:              "192.168.200.0/16",
+				HybridOverlaySubnets: "10.132.0.0/16",
+				NetConf:              cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
+			},
+		},
+		{
+			desc: "invalid attachment definition for a layer3 topology with hybrid overlay subnets and hybrid overlay disabled",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer3",
+            "role": "primary",
+            "subnets": "192.168.200.0/16",
+            "hybridOverlaySubnets": "10.132.0.0/16",
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("hybrid overlay subnets require hybrid overlay to be enabled"),
+		},
+		{
+			desc: "invalid attachment definition for a layer2 topology with hybrid overlay subnets",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+            "subnets": "192.168.200.0/16",
+            "hybridOverlaySubnets": "10.132.0.0/16",
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			hybridOverlayEnabled: true,
+			expectedError:        fmt.Errorf("layer2 topology does not allow hybrid overlay subnets"),
+		},
+		{
+			desc: "valid attachment definition for a layer2 topology with multicast enabled",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+            "subnets": "192.168.200.0/16",
+            "enableMulticast": true,
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedNetConf: &ovncnitypes.NetConf{
+				Topology:        "layer2",
+				NADName:         "ns1/nad1",
+				MTU:             1400,
+				Subnets:         "192.168.200.0/16",
+				EnableMulticast: true,
+				NetConf:         cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
+			},
+		},
+		{
+			desc: "invalid attachment definition for a layer2 topology with multicast enabled and no subnets",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+            "enableMulticast": true,
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("the subnet attribute must be defined for networks with multicast enabled"),
+		},
+		{
+			desc: "valid attachment definition for a layer2 topology with an external IPAM provider",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+			"subnets": "192.168.200.0/16",
+			"ipamProvider": "netbox",
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedNetConf: &ovncnitypes.NetConf{
+				Topology:     "layer2",
+				NADName:      "ns1/nad1",
+				MTU:          1400,
+				IPAMProvider: "netbox",
+				Subnets:      "192.168.200.0/16",
+				NetConf:      cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
+			},
+		},
+		{
+			desc: "invalid attachment definition for a layer3 topology with an external IPAM provider",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer3",
+			"subnets": "192.168.200.0/16",
+			"ipamProvider": "netbox",
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("layer3 topology does not allow an external IPAM provider: only layer2 and localnet topologies do"),
+		},
+		{
+			desc: "invalid attachment definition for a layer2 topology with an external IPAM provider and no subnets",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+			"ipamProvider": "netbox",
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("the subnet attribute must be defined for networks with an external IPAM provider"),
+		},
 		{
 			desc: "valid attachment definition for a layer2 topology with role:primary",
 			inputNetAttachDefConfigSpec: `
@@ -467,6 +604,8 @@ func TestParseNetconf(t *testing.T) {
 		t.Run(test.desc, func(t *testing.T) {
 			config.IPv4Mode = true
 			config.IPv6Mode = true
+			config.HybridOverlay.Enabled = test.hybridOverlayEnabled
+			defer func() { config.HybridOverlay.Enabled = false }()
 			if test.unsupportedReason != "" {
 				t.Skip(test.unsupportedReason)
 			}
@@ -959,6 +1098,55 @@ func TestGetPodNADToNetworkMappingWithActiveNetwork(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "the network configuration for a primary layer2 UDN and the pod requests static IPs and MAC",
+			inputNetConf: &ovncnitypes.NetConf{
+				NetConf:  cnitypes.NetConf{Name: networkName},
+				Topology: ovntypes.Layer2Topology,
+				NADName:  GetNADName(namespaceName, attachmentName),
+				Role:     ovntypes.NetworkRolePrimary,
+			},
+			inputPrimaryUDNConfig: &ovncnitypes.NetConf{
+				NetConf:  cnitypes.NetConf{Name: networkName},
+				Topology: ovntypes.Layer2Topology,
+				NADName:  GetNADName(namespaceName, attachmentName),
+				Role:     ovntypes.NetworkRolePrimary,
+			},
+			inputPodAnnotations: map[string]string{
+				OvnUDNIPs: "192.168.0.10/24, fd00::10/64",
+				OvnUDNMAC: "02:03:04:05:06:07",
+			},
+			expectedIsAttachmentRequested: true,
+			expectedNetworkSelectionElements: map[string]*nadv1.NetworkSelectionElement{
+				"ns1/attachment1": {
+					Name:       "attachment1",
+					Namespace:  "ns1",
+					IPRequest:  []string{"192.168.0.10/24", "fd00::10/64"},
+					MacRequest: "02:03:04:05:06:07",
+				},
+			},
+		},
+		{
+			desc: "the network configuration for a primary layer3 UDN and the pod requests static IPs and MAC",
+			inputNetConf: &ovncnitypes.NetConf{
+				NetConf:  cnitypes.NetConf{Name: networkName},
+				Topology: ovntypes.Layer3Topology,
+				NADName:  GetNADName(namespaceName, attachmentName),
+				Role:     ovntypes.NetworkRolePrimary,
+			},
+			inputPrimaryUDNConfig: &ovncnitypes.NetConf{
+				NetConf:  cnitypes.NetConf{Name: networkName},
+				Topology: ovntypes.Layer3Topology,
+				NADName:  GetNADName(namespaceName, attachmentName),
+				Role:     ovntypes.NetworkRolePrimary,
+			},
+			inputPodAnnotations: map[string]string{
+				OvnUDNIPs: "192.168.0.10/24",
+				OvnUDNMAC: "02:03:04:05:06:07",
+			},
+			expectedError: fmt.Errorf("static IPs and MAC requests are only supported on layer2 primary networks, network %q has %s topology",
+				networkName, ovntypes.Layer3Topology),
+		},
 		{
 			desc: "the network configuration for a secondary layer2 UDN features allow persistent IPs and the pod requests it",
 			inputNetConf: &ovncnitypes.NetConf{
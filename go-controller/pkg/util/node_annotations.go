@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/netip"
 	"strconv"
+	"time"
 
 	"github.com/gaissmai/cidrtree"
 	corev1 "k8s.io/api/core/v1"
@@ -73,6 +74,10 @@ const (
 	// OvnNodeChassisID is the systemID of the node needed for creating L3 gateway
 	OvnNodeChassisID = "k8s.ovn.org/node-chassis-id"
 
+	// OvnNodeIPsecStatus is the status of the IPsec configuration managed on
+	// the node, i.e: {"certificateExpiry":"2025-01-01T00:00:00Z"}
+	OvnNodeIPsecStatus = "k8s.ovn.org/node-ipsec-status"
+
 	// OvnNodeIfAddr is the CIDR form representation of primary network interface's attached IP address (i.e: 192.168.126.31/24 or 0:0:0:0:0:feff:c0a8:8e0c/64)
 	OvnNodeIfAddr = "k8s.ovn.org/node-primary-ifaddr"
 
@@ -107,6 +112,21 @@ const (
 	// OVNNodeBridgeEgressIPs contains the EIP addresses that are assigned to default external bridge linux interface of type OVS.
 	OVNNodeBridgeEgressIPs = "k8s.ovn.org/bridge-egress-ips"
 
+	// OVNNodeEgressIPBFDStatus contains the status of the BFD sessions this node's cluster router keeps
+	// over the transit switch with every egress node, keyed by the egress node name. It is set by
+	// ovnkube-controller when egress IP BFD reachability is enabled and consumed by cluster manager.
+	// "k8s.ovn.org/egress-ip-bfd-status": "{
+	//		"node-b":"up",
+	//		"node-c":"down"
+	// }",
+	OVNNodeEgressIPBFDStatus = "k8s.ovn.org/egress-ip-bfd-status"
+
+	// OVNNodeEncapIPs contains the list of encapsulation IPs ovn-controller on the node uses as
+	// tunnel endpoints. It is set by ovnkube-node from the configured encap IPs and is used to
+	// create the encap records of the node chassis, so that remote nodes can reach any of them.
+	// "k8s.ovn.org/node-encap-ips": "["10.0.0.10","10.1.0.10"]",
+	OVNNodeEncapIPs = "k8s.ovn.org/node-encap-ips"
+
 	// egressIPConfigAnnotationKey is used to indicate the cloud subnet and
 	// capacity for each node. It is set by
 	// openshift/cloud-network-config-controller
@@ -403,6 +423,36 @@ func NodeChassisIDAnnotationChanged(oldNode, newNode *kapi.Node) bool {
 	return oldNode.Annotations[OvnNodeChassisID] != newNode.Annotations[OvnNodeChassisID]
 }
 
+// IPsecStatus is the status of the IPsec configuration managed on a node
+type IPsecStatus struct {
+	// CertificateExpiry is the expiration time of the certificate the node
+	// uses to authenticate to its IPsec peers
+	CertificateExpiry time.Time `json:"certificateExpiry"`
+}
+
+// CreateNodeIPsecStatusAnnotation creates the annotation that reports the
+// IPsec status of a node
+func CreateNodeIPsecStatusAnnotation(status *IPsecStatus) (map[string]interface{}, error) {
+	bytes, err := json.Marshal(status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal IPsec status %+v: %w", status, err)
+	}
+	return map[string]interface{}{OvnNodeIPsecStatus: string(bytes)}, nil
+}
+
+// ParseNodeIPsecStatusAnnotation returns the node's IPsec status
+func ParseNodeIPsecStatusAnnotation(node *kapi.Node) (*IPsecStatus, error) {
+	annotation, ok := node.Annotations[OvnNodeIPsecStatus]
+	if !ok {
+		return nil, newAnnotationNotSetError("%s annotation not found for node %s", OvnNodeIPsecStatus, node.Name)
+	}
+	status := &IPsecStatus{}
+	if err := json.Unmarshal([]byte(annotation), status); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s annotation %q for node %s: %w", OvnNodeIPsecStatus, annotation, node.Name, err)
+	}
+	return status, nil
+}
+
 type ManagementPortDetails struct {
 	PfId   int `json:"PfId"`
 	FuncId int `json:"FuncId"`
@@ -1072,6 +1122,58 @@ func ParseNodeHostCIDRs(node *kapi.Node) (sets.Set[string], error) {
 	return sets.New(cfg...), nil
 }
 
+// SetNodeEncapIPs sets the encapsulation IPs of the node in the 'OVNNodeEncapIPs' node annotation.
+func SetNodeEncapIPs(nodeAnnotator kube.Annotator, encapIPs []string) error {
+	return nodeAnnotator.Set(OVNNodeEncapIPs, encapIPs)
+}
+
+// NodeEncapIPsAnnotationChanged returns true if the 'OVNNodeEncapIPs' annotation changed for the node
+func NodeEncapIPsAnnotationChanged(oldNode, newNode *corev1.Node) bool {
+	return oldNode.Annotations[OVNNodeEncapIPs] != newNode.Annotations[OVNNodeEncapIPs]
+}
+
+// ParseNodeEncapIPsAnnotation returns the encapsulation IPs set in the node's 'OVNNodeEncapIPs' annotation
+func ParseNodeEncapIPsAnnotation(node *kapi.Node) ([]string, error) {
+	encapIPsAnnotation, ok := node.Annotations[OVNNodeEncapIPs]
+	if !ok {
+		return nil, newAnnotationNotSetError("%s annotation not found for node %q", OVNNodeEncapIPs, node.Name)
+	}
+
+	var encapIPs []string
+	if err := json.Unmarshal([]byte(encapIPsAnnotation), &encapIPs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal encap IPs annotation %s for node %q: %v",
+			encapIPsAnnotation, node.Name, err)
+	}
+	for _, encapIP := range encapIPs {
+		if net.ParseIP(encapIP) == nil {
+			return nil, fmt.Errorf("invalid encap IP %q in annotation %s for node %q", encapIP, OVNNodeEncapIPs, node.Name)
+		}
+	}
+	if len(encapIPs) == 0 {
+		return nil, fmt.Errorf("unexpected empty %s annotation for node %q", OVNNodeEncapIPs, node.Name)
+	}
+
+	return encapIPs, nil
+}
+
+// GetNodeEncapIPs returns the encap IPs published by the node. Nodes that
+// don't publish them yet use their primary IP as the only encap IP.
+func GetNodeEncapIPs(node *kapi.Node) ([]string, error) {
+	encapIPs, err := ParseNodeEncapIPsAnnotation(node)
+	if err == nil {
+		return encapIPs, nil
+	}
+	if !IsAnnotationNotSetError(err) {
+		return nil, fmt.Errorf("failed to parse node %s encap IPs: %w", node.Name, err)
+	}
+
+	nodePrimaryIp, err := GetNodePrimaryIP(node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse node %s primary IP %w", node.Name, err)
+	}
+	return []string{nodePrimaryIp}, nil
+}
+
 // ParseNodeHostIPDropNetMask returns the parsed host IP addresses found on a node's host CIDR annotation. Removes the mask.
 func ParseNodeHostIPDropNetMask(node *kapi.Node) (sets.Set[string], error) {
 	nodeIfAddrAnnotation, ok := node.Annotations[OvnNodeIfAddr]
@@ -1211,6 +1313,34 @@ func ParseNodeBridgeEgressIPsAnnotation(node *kapi.Node) ([]string, error) {
 	return cfg, nil
 }
 
+// ParseNodeEgressIPBFDStatusAnnotation returns the BFD session status reported by a node for each egress node
+func ParseNodeEgressIPBFDStatusAnnotation(node *kapi.Node) (map[string]string, error) {
+	statusAnnotation, ok := node.Annotations[OVNNodeEgressIPBFDStatus]
+	if !ok {
+		return nil, newAnnotationNotSetError("%s annotation not found for node %q", OVNNodeEgressIPBFDStatus, node.Name)
+	}
+
+	status := map[string]string{}
+	if err := json.Unmarshal([]byte(statusAnnotation), &status); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s annotation %s for node %q: %v", OVNNodeEgressIPBFDStatus, statusAnnotation, node.Name, err)
+	}
+	return status, nil
+}
+
+// SetNodeEgressIPBFDStatus sets the BFD session status of every egress node on the reporting node
+func SetNodeEgressIPBFDStatus(k kube.Interface, nodeName string, status map[string]string) error {
+	bytes, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s annotation for node %q: %v", OVNNodeEgressIPBFDStatus, nodeName, err)
+	}
+	return k.SetAnnotationsOnNode(nodeName, map[string]interface{}{OVNNodeEgressIPBFDStatus: string(bytes)})
+}
+
+// NodeEgressIPBFDStatusAnnotationChanged returns true if the egress IP BFD status annotation changed
+func NodeEgressIPBFDStatusAnnotationChanged(oldNode, newNode *kapi.Node) bool {
+	return oldNode.Annotations[OVNNodeEgressIPBFDStatus] != newNode.Annotations[OVNNodeEgressIPBFDStatus]
+}
+
 // IsSecondaryHostNetworkContainingIP attempts to find a secondary host network that will host the argument IP. If no network is
 // found, false is returned
 func IsSecondaryHostNetworkContainingIP(node *v1.Node, ip net.IP) (bool, error) {
@@ -892,3 +892,85 @@ func TestParseUDNLayer2NodeGRLRPTunnelIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestParseNodeEncapIPsAnnotation(t *testing.T) {
+	tests := []struct {
+		desc        string
+		inpNode     *v1.Node
+		res         []string
+		errExpected bool
+	}{
+		{
+			desc:        "annotation not found for node",
+			inpNode:     &v1.Node{},
+			errExpected: true,
+		},
+		{
+			desc: "parse completed with a single encap IP",
+			inpNode: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-encap-ips": `["10.0.0.10"]`,
+					},
+				},
+			},
+			res: []string{"10.0.0.10"},
+		},
+		{
+			desc: "parse completed with multiple encap IPs",
+			inpNode: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-encap-ips": `["10.0.0.10","10.1.0.10","fd00::10"]`,
+					},
+				},
+			},
+			res: []string{"10.0.0.10", "10.1.0.10", "fd00::10"},
+		},
+		{
+			desc: "parse completed and invalid IP",
+			inpNode: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-encap-ips": `["10.0.0.10","blah"]`,
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			desc: "parse completed and empty list",
+			inpNode: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-encap-ips": `[]`,
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			desc: "parse failed with invalid value",
+			inpNode: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-encap-ips": `blah`,
+					},
+				},
+			},
+			errExpected: true,
+		},
+	}
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d:%s", i, tc.desc), func(t *testing.T) {
+			res, err := ParseNodeEncapIPsAnnotation(tc.inpNode)
+			if tc.errExpected {
+				t.Log(err)
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.res, res)
+		})
+	}
+}
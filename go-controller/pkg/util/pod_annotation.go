@@ -57,6 +57,12 @@ const (
 	// OvnUDNIPAMClaimName is used for workload owners to instruct OVN-K which
 	// IPAMClaim will hold the allocation for the workload
 	OvnUDNIPAMClaimName = "k8s.ovn.org/primary-udn-ipamclaim"
+	// OvnUDNIPs is used for workload owners to request specific IPs, as a comma
+	// separated list of CIDRs, on a layer2 primary UDN
+	OvnUDNIPs = "k8s.ovn.org/primary-udn-ips"
+	// OvnUDNMAC is used for workload owners to request a specific MAC on a
+	// layer2 primary UDN
+	OvnUDNMAC = "k8s.ovn.org/primary-udn-mac"
 	// UDNOpenPortsAnnotationName is the pod annotation to open default network pods on UDN pods.
 	UDNOpenPortsAnnotationName = "k8s.ovn.org/open-default-ports"
 )